@@ -74,6 +74,10 @@ type CertificateExternalStatus struct {
 	// "_a79865eb4cd1a6ab990a45779b4e0b96.yourdomain.com", only
 	// "_a79865eb4cd1a6ab990a45779b4e0b96" must be used.
 	ResourceRecord *ResourceRecord `json:"resourceRecord,omitempty"`
+
+	// ValidationRecords are the CNAME records that are managed for the DNS
+	// validation of the certificate, if dnsValidation is set.
+	ValidationRecords []ResourceRecord `json:"validationRecords,omitempty"`
 }
 
 // An CertificateStatus represents the observed state of an Certificate manager.
@@ -145,6 +149,37 @@ type CertificateParameters struct {
 	// +optional
 	// +kubebuilder:validation:Enum=DNS;EMAIL
 	ValidationMethod string `json:"validationMethod,omitempty"`
+
+	// DNSValidation enables the automatic DNS validation of the certificate.
+	// If set, the CNAME records that ACM requires to validate the domains of
+	// the certificate are created in the given Route53 hosted zone and deleted
+	// together with the certificate, unless another Certificate validating in
+	// the same hosted zone uses them too. The validation method must be DNS.
+	// +optional
+	DNSValidation *DNSValidation `json:"dnsValidation,omitempty"`
+}
+
+// DNSValidation configures the automatic DNS validation of a certificate
+// through Route53.
+type DNSValidation struct {
+	// HostedZoneID is the ID of the Route53 hosted zone to create the
+	// validation records in.
+	// +optional
+	// +crossplane:generate:reference:type=github.com/crossplane-contrib/provider-aws/apis/route53/v1alpha1.HostedZone
+	HostedZoneID *string `json:"hostedZoneId,omitempty"`
+
+	// HostedZoneIDRef references a HostedZone to retrieve its ID.
+	// +optional
+	HostedZoneIDRef *xpv1.Reference `json:"hostedZoneIdRef,omitempty"`
+
+	// HostedZoneIDSelector selects a reference to a HostedZone to retrieve
+	// its ID.
+	// +optional
+	HostedZoneIDSelector *xpv1.Selector `json:"hostedZoneIdSelector,omitempty"`
+
+	// TTL of the validation records in seconds. Defaults to 300.
+	// +optional
+	TTL *int64 `json:"ttl,omitempty"`
 }
 
 // CertificateOptions contains options for your certificate. Currently, you can use
@@ -0,0 +1,69 @@
+/*
+Copyright 2023 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1beta1
+
+import (
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+)
+
+// TypeValidated indicates whether a Certificate with DNS validation has been
+// validated and issued by ACM.
+const TypeValidated xpv1.ConditionType = "Validated"
+
+// Reasons a Certificate is or is not validated.
+const (
+	ReasonIssued            xpv1.ConditionReason = "Issued"
+	ReasonPendingValidation xpv1.ConditionReason = "PendingValidation"
+	ReasonValidationFailed  xpv1.ConditionReason = "ValidationFailed"
+)
+
+// Issued returns a condition that indicates the Certificate has been
+// validated and issued.
+func Issued() xpv1.Condition {
+	return xpv1.Condition{
+		Type:               TypeValidated,
+		Status:             corev1.ConditionTrue,
+		LastTransitionTime: metav1.Now(),
+		Reason:             ReasonIssued,
+	}
+}
+
+// PendingValidation returns a condition that indicates ACM is waiting for
+// the validation records of the Certificate.
+func PendingValidation() xpv1.Condition {
+	return xpv1.Condition{
+		Type:               TypeValidated,
+		Status:             corev1.ConditionFalse,
+		LastTransitionTime: metav1.Now(),
+		Reason:             ReasonPendingValidation,
+	}
+}
+
+// ValidationFailed returns a condition that indicates the Certificate could
+// not be validated, with the status of the Certificate as message.
+func ValidationFailed(status string) xpv1.Condition {
+	return xpv1.Condition{
+		Type:               TypeValidated,
+		Status:             corev1.ConditionFalse,
+		LastTransitionTime: metav1.Now(),
+		Reason:             ReasonValidationFailed,
+		Message:            "certificate status is " + status,
+	}
+}
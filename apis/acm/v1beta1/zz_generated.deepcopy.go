@@ -61,6 +61,13 @@ func (in *CertificateExternalStatus) DeepCopyInto(out *CertificateExternalStatus
 		*out = new(ResourceRecord)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.ValidationRecords != nil {
+		in, out := &in.ValidationRecords, &out.ValidationRecords
+		*out = make([]ResourceRecord, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CertificateExternalStatus.
@@ -170,6 +177,11 @@ func (in *CertificateParameters) DeepCopyInto(out *CertificateParameters) {
 		*out = make([]Tag, len(*in))
 		copy(*out, *in)
 	}
+	if in.DNSValidation != nil {
+		in, out := &in.DNSValidation, &out.DNSValidation
+		*out = new(DNSValidation)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CertificateParameters.
@@ -216,6 +228,41 @@ func (in *CertificateStatus) DeepCopy() *CertificateStatus {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DNSValidation) DeepCopyInto(out *DNSValidation) {
+	*out = *in
+	if in.HostedZoneID != nil {
+		in, out := &in.HostedZoneID, &out.HostedZoneID
+		*out = new(string)
+		**out = **in
+	}
+	if in.HostedZoneIDRef != nil {
+		in, out := &in.HostedZoneIDRef, &out.HostedZoneIDRef
+		*out = new(v1.Reference)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.HostedZoneIDSelector != nil {
+		in, out := &in.HostedZoneIDSelector, &out.HostedZoneIDSelector
+		*out = new(v1.Selector)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.TTL != nil {
+		in, out := &in.TTL, &out.TTL
+		*out = new(int64)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DNSValidation.
+func (in *DNSValidation) DeepCopy() *DNSValidation {
+	if in == nil {
+		return nil
+	}
+	out := new(DNSValidation)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *DomainValidationOption) DeepCopyInto(out *DomainValidationOption) {
 	*out = *in
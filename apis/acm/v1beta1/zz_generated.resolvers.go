@@ -21,6 +21,7 @@ package v1beta1
 import (
 	"context"
 	v1beta1 "github.com/crossplane-contrib/provider-aws/apis/acmpca/v1beta1"
+	v1alpha1 "github.com/crossplane-contrib/provider-aws/apis/route53/v1alpha1"
 	reference "github.com/crossplane/crossplane-runtime/pkg/reference"
 	errors "github.com/pkg/errors"
 	client "sigs.k8s.io/controller-runtime/pkg/client"
@@ -49,5 +50,24 @@ func (mg *Certificate) ResolveReferences(ctx context.Context, c client.Reader) e
 	mg.Spec.ForProvider.CertificateAuthorityARN = reference.ToPtrValue(rsp.ResolvedValue)
 	mg.Spec.ForProvider.CertificateAuthorityARNRef = rsp.ResolvedReference
 
+	if mg.Spec.ForProvider.DNSValidation != nil {
+		rsp, err = r.Resolve(ctx, reference.ResolutionRequest{
+			CurrentValue: reference.FromPtrValue(mg.Spec.ForProvider.DNSValidation.HostedZoneID),
+			Extract:      reference.ExternalName(),
+			Reference:    mg.Spec.ForProvider.DNSValidation.HostedZoneIDRef,
+			Selector:     mg.Spec.ForProvider.DNSValidation.HostedZoneIDSelector,
+			To: reference.To{
+				List:    &v1alpha1.HostedZoneList{},
+				Managed: &v1alpha1.HostedZone{},
+			},
+		})
+		if err != nil {
+			return errors.Wrap(err, "mg.Spec.ForProvider.DNSValidation.HostedZoneID")
+		}
+		mg.Spec.ForProvider.DNSValidation.HostedZoneID = reference.ToPtrValue(rsp.ResolvedValue)
+		mg.Spec.ForProvider.DNSValidation.HostedZoneIDRef = rsp.ResolvedReference
+
+	}
+
 	return nil
 }
@@ -79,6 +79,7 @@ import (
 	route53v1alpha1 "github.com/crossplane-contrib/provider-aws/apis/route53/v1alpha1"
 	route53resolvermanualv1alpha1 "github.com/crossplane-contrib/provider-aws/apis/route53resolver/manualv1alpha1"
 	route53resolverv1alpha1 "github.com/crossplane-contrib/provider-aws/apis/route53resolver/v1alpha1"
+	s3v1alpha1 "github.com/crossplane-contrib/provider-aws/apis/s3/v1alpha1"
 	s3v1alpha2 "github.com/crossplane-contrib/provider-aws/apis/s3/v1alpha3"
 	s3v1beta1 "github.com/crossplane-contrib/provider-aws/apis/s3/v1beta1"
 	s3control "github.com/crossplane-contrib/provider-aws/apis/s3control/v1alpha1"
@@ -114,6 +115,7 @@ func init() {
 		awsv1beta1.SchemeBuilder.AddToScheme,
 		acmv1alpha1.SchemeBuilder.AddToScheme,
 		acmv1beta1.SchemeBuilder.AddToScheme,
+		s3v1alpha1.SchemeBuilder.AddToScheme,
 		s3v1alpha2.SchemeBuilder.AddToScheme,
 		s3v1beta1.SchemeBuilder.AddToScheme,
 		secretsmanagerv1alpha1.SchemeBuilder.AddToScheme,
@@ -0,0 +1,80 @@
+/*
+Copyright 2023 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package manualv1alpha1
+
+import (
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+)
+
+// TypeDisruptiveUpdate indicates the progress of an update of an Instance
+// that requires the Instance to be stopped.
+const TypeDisruptiveUpdate xpv1.ConditionType = "DisruptiveUpdate"
+
+// Reasons of the progress of a disruptive update.
+const (
+	ReasonStopping     xpv1.ConditionReason = "Stopping"
+	ReasonStarting     xpv1.ConditionReason = "Starting"
+	ReasonRequiresStop xpv1.ConditionReason = "RequiresStop"
+	ReasonUpdated      xpv1.ConditionReason = "Updated"
+)
+
+// Stopping returns a condition that indicates the Instance is being stopped
+// to apply an update.
+func Stopping() xpv1.Condition {
+	return xpv1.Condition{
+		Type:               TypeDisruptiveUpdate,
+		Status:             corev1.ConditionFalse,
+		LastTransitionTime: metav1.Now(),
+		Reason:             ReasonStopping,
+	}
+}
+
+// Starting returns a condition that indicates the Instance has been updated
+// and is being started again.
+func Starting() xpv1.Condition {
+	return xpv1.Condition{
+		Type:               TypeDisruptiveUpdate,
+		Status:             corev1.ConditionFalse,
+		LastTransitionTime: metav1.Now(),
+		Reason:             ReasonStarting,
+	}
+}
+
+// RequiresStop returns a condition that indicates an update of the Instance
+// requires it to be stopped, which its update strategy does not allow.
+func RequiresStop() xpv1.Condition {
+	return xpv1.Condition{
+		Type:               TypeDisruptiveUpdate,
+		Status:             corev1.ConditionFalse,
+		LastTransitionTime: metav1.Now(),
+		Reason:             ReasonRequiresStop,
+	}
+}
+
+// Updated returns a condition that indicates no update that requires the
+// Instance to be stopped is pending.
+func Updated() xpv1.Condition {
+	return xpv1.Condition{
+		Type:               TypeDisruptiveUpdate,
+		Status:             corev1.ConditionTrue,
+		LastTransitionTime: metav1.Now(),
+		Reason:             ReasonUpdated,
+	}
+}
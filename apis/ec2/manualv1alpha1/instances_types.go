@@ -165,8 +165,18 @@ type InstanceParameters struct {
 	// If you do not specify a key pair, you can't connect to the instance unless
 	// you choose an AMI that is configured to allow users another way to log in.
 	// +optional
+	// +crossplane:generate:reference:type=KeyPair
 	KeyName *string `json:"keyName,omitempty"`
 
+	// KeyNameRef is a reference to a KeyPair used to set the KeyName.
+	// +optional
+	KeyNameRef *xpv1.Reference `json:"keyNameRef,omitempty"`
+
+	// KeyNameSelector selects a reference to a KeyPair used to set the
+	// KeyName.
+	// +optional
+	KeyNameSelector *xpv1.Selector `json:"keyNameSelector,omitempty"`
+
 	// The launch template to use to launch the instances. Any parameters that you
 	// specify in RunInstances override the same parameters in the launch template.
 	// You can specify either the name or ID of a launch template, but not both.
@@ -281,8 +291,30 @@ type InstanceParameters struct {
 	// +optional
 	// +kubebuilder:validation:Pattern=`^(?:[A-Za-z0-9+/]{4})*(?:[A-Za-z0-9+/]{2}==|[A-Za-z0-9+/]{3}=)?$`
 	UserData *string `json:"userData,omitempty"`
+
+	// UpdateStrategy defines how changes of the instance type, the EBS
+	// optimization, the kernel, the RAM disk and the user data are applied,
+	// which require the instance to be stopped.
+	// With Never, such changes are only applied if the instance is stopped
+	// already, and reported as an error otherwise. With StopStart, the
+	// instance is stopped, modified and started again.
+	//
+	// Default: Never
+	// +optional
+	// +kubebuilder:validation:Enum=Never;StopStart
+	UpdateStrategy *string `json:"updateStrategy,omitempty"`
 }
 
+// Strategies to apply changes that require an Instance to be stopped.
+const (
+	// InstanceUpdateStrategyNever never stops an instance to apply changes.
+	InstanceUpdateStrategyNever = "Never"
+
+	// InstanceUpdateStrategyStopStart stops an instance to apply changes and
+	// starts it again afterwards.
+	InstanceUpdateStrategyStopStart = "StopStart"
+)
+
 // An InstanceSpec defines the desired state of Instances.
 type InstanceSpec struct {
 	xpv1.ResourceSpec `json:",inline"`
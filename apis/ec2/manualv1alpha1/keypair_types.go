@@ -0,0 +1,102 @@
+/*
+Copyright 2023 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package manualv1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+)
+
+// KeyPairParameters define the desired state of an AWS EC2 Key Pair.
+type KeyPairParameters struct {
+	// Region is the region you'd like your Key Pair to be created in.
+	Region string `json:"region"`
+
+	// The type of key pair that is created when no public key is imported.
+	// Note that ED25519 keys are not supported for Windows instances.
+	// Default: rsa
+	// +optional
+	// +immutable
+	// +kubebuilder:validation:Enum=rsa;ed25519
+	KeyType *string `json:"keyType,omitempty"`
+
+	// PublicKeySecretRef references the key of a Secret that contains the
+	// public key to import, for example an OpenSSH public key. If it is not
+	// set, a key pair is created and its private key is written to the
+	// connection secret in PEM format.
+	// +optional
+	// +immutable
+	PublicKeySecretRef *xpv1.SecretKeySelector `json:"publicKeySecretRef,omitempty"`
+
+	// Tags represents to current ec2 tags.
+	// +optional
+	Tags []Tag `json:"tags,omitempty"`
+}
+
+// A KeyPairSpec defines the desired state of a KeyPair.
+type KeyPairSpec struct {
+	xpv1.ResourceSpec `json:",inline"`
+	ForProvider       KeyPairParameters `json:"forProvider"`
+}
+
+// KeyPairObservation keeps the state for the external resource
+type KeyPairObservation struct {
+	// The ID of the key pair.
+	KeyPairID string `json:"keyPairId,omitempty"`
+
+	// The SHA-1 digest of the DER encoded private key of a created key pair,
+	// or the MD5 public key fingerprint of an imported key pair.
+	KeyFingerprint string `json:"keyFingerprint,omitempty"`
+
+	// The type of the key pair.
+	KeyType string `json:"keyType,omitempty"`
+}
+
+// A KeyPairStatus represents the observed state of a KeyPair.
+type KeyPairStatus struct {
+	xpv1.ResourceStatus `json:",inline"`
+	AtProvider          KeyPairObservation `json:"atProvider,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// A KeyPair is a managed resource that represents an AWS EC2 Key Pair. The
+// external name of a KeyPair is the name of the key pair.
+// +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="EXTERNAL-NAME",type="string",JSONPath=".metadata.annotations.crossplane\\.io/external-name"
+// +kubebuilder:printcolumn:name="ID",type="string",JSONPath=".status.atProvider.keyPairId"
+// +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:scope=Cluster,categories={crossplane,managed,aws}
+type KeyPair struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   KeyPairSpec   `json:"spec"`
+	Status KeyPairStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// KeyPairList contains a list of KeyPairs
+type KeyPairList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []KeyPair `json:"items"`
+}
@@ -0,0 +1,221 @@
+/*
+Copyright 2023 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package manualv1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+)
+
+// NetworkACLRule describes an inline entry of a network ACL.
+type NetworkACLRule struct {
+	// The rule number for the entry. ACL entries are processed in ascending
+	// order by rule number.
+	// +kubebuilder:validation:Minimum=1
+	// +kubebuilder:validation:Maximum=32766
+	RuleNumber int32 `json:"ruleNumber"`
+
+	// The protocol number. A value of "-1" means all protocols. The names
+	// tcp, udp, icmp and icmpv6 are accepted as well.
+	Protocol string `json:"protocol"`
+
+	// Indicates whether to allow or deny the traffic that matches the rule.
+	// +kubebuilder:validation:Enum=allow;deny
+	RuleAction string `json:"ruleAction"`
+
+	// The IPv4 network range to allow or deny, in CIDR notation.
+	// +optional
+	CIDRBlock *string `json:"cidrBlock,omitempty"`
+
+	// The IPv6 network range to allow or deny, in CIDR notation.
+	// +optional
+	IPv6CIDRBlock *string `json:"ipv6CidrBlock,omitempty"`
+
+	// The first port in the range. Required for TCP and UDP.
+	// +optional
+	FromPort *int32 `json:"fromPort,omitempty"`
+
+	// The last port in the range. Required for TCP and UDP.
+	// +optional
+	ToPort *int32 `json:"toPort,omitempty"`
+
+	// The ICMP type. Required for the ICMP protocol, -1 means all types.
+	// +optional
+	ICMPType *int32 `json:"icmpType,omitempty"`
+
+	// The ICMP code. Required for the ICMP protocol, -1 means all codes.
+	// +optional
+	ICMPCode *int32 `json:"icmpCode,omitempty"`
+}
+
+// NetworkACLAssociation describes an association between a network ACL and
+// a subnet.
+type NetworkACLAssociation struct {
+	// The ID of the subnet.
+	// +optional
+	// +crossplane:generate:reference:type=github.com/crossplane-contrib/provider-aws/apis/ec2/v1beta1.Subnet
+	SubnetID *string `json:"subnetId,omitempty"`
+
+	// A referencer to retrieve the ID of a subnet
+	// +optional
+	SubnetIDRef *xpv1.Reference `json:"subnetIdRef,omitempty"`
+
+	// A selector to select a referencer to retrieve the ID of a subnet
+	// +optional
+	SubnetIDSelector *xpv1.Selector `json:"subnetIdSelector,omitempty"`
+}
+
+// NetworkACLParameters define the desired state of an AWS VPC Network ACL.
+type NetworkACLParameters struct {
+	// Region is the region you'd like your Network ACL to be created in.
+	Region string `json:"region"`
+
+	// VPCID is the ID of the VPC.
+	// +optional
+	// +immutable
+	// +crossplane:generate:reference:type=github.com/crossplane-contrib/provider-aws/apis/ec2/v1beta1.VPC
+	VPCID *string `json:"vpcId,omitempty"`
+
+	// VPCIDRef references a VPC to retrieve its vpcId
+	// +optional
+	// +immutable
+	VPCIDRef *xpv1.Reference `json:"vpcIdRef,omitempty"`
+
+	// VPCIDSelector selects a reference to a VPC to retrieve its vpcId
+	// +optional
+	VPCIDSelector *xpv1.Selector `json:"vpcIdSelector,omitempty"`
+
+	// Ingress is the list of inline inbound entries of the Network ACL.
+	// provider-aws also provides a standalone NetworkACLEntry resource. Set
+	// ignoreIngress when the inbound entries are managed with NetworkACLEntry
+	// resources, otherwise they are removed.
+	// +optional
+	Ingress []NetworkACLRule `json:"ingress,omitempty"`
+
+	// Egress is the list of inline outbound entries of the Network ACL.
+	// provider-aws also provides a standalone NetworkACLEntry resource. Set
+	// ignoreEgress when the outbound entries are managed with NetworkACLEntry
+	// resources, otherwise they are removed.
+	// +optional
+	Egress []NetworkACLRule `json:"egress,omitempty"`
+
+	// Dont manage the inbound entries of the Network ACL
+	// +optional
+	IgnoreIngress *bool `json:"ignoreIngress,omitempty"`
+
+	// Dont manage the outbound entries of the Network ACL
+	// +optional
+	IgnoreEgress *bool `json:"ignoreEgress,omitempty"`
+
+	// The subnets associated with the Network ACL. Subnets that are removed
+	// from this list are associated with the default Network ACL of the VPC
+	// again.
+	// +optional
+	Associations []NetworkACLAssociation `json:"associations,omitempty"`
+
+	// Tags represents to current ec2 tags.
+	// +optional
+	Tags []Tag `json:"tags,omitempty"`
+}
+
+// A NetworkACLSpec defines the desired state of a NetworkACL.
+type NetworkACLSpec struct {
+	xpv1.ResourceSpec `json:",inline"`
+	ForProvider       NetworkACLParameters `json:"forProvider"`
+}
+
+// NetworkACLEntryState describes an entry of a network ACL.
+type NetworkACLEntryState struct {
+	// The rule number for the entry.
+	RuleNumber int32 `json:"ruleNumber"`
+
+	// Indicates whether the rule is an egress rule.
+	Egress bool `json:"egress"`
+
+	// The protocol number.
+	Protocol string `json:"protocol,omitempty"`
+
+	// Indicates whether to allow or deny the traffic that matches the rule.
+	RuleAction string `json:"ruleAction,omitempty"`
+
+	// The IPv4 network range to allow or deny, in CIDR notation.
+	CIDRBlock string `json:"cidrBlock,omitempty"`
+
+	// The IPv6 network range to allow or deny, in CIDR notation.
+	IPv6CIDRBlock string `json:"ipv6CidrBlock,omitempty"`
+}
+
+// NetworkACLAssociationState describes an association of a network ACL.
+type NetworkACLAssociationState struct {
+	// The ID of the association between a network ACL and a subnet.
+	AssociationID string `json:"associationId,omitempty"`
+
+	// The ID of the subnet.
+	SubnetID string `json:"subnetId,omitempty"`
+}
+
+// NetworkACLObservation keeps the state for the external resource
+type NetworkACLObservation struct {
+	// NetworkACLID is the ID of the Network ACL.
+	NetworkACLID string `json:"networkAclId,omitempty"`
+
+	// The ID of the AWS account that owns the Network ACL.
+	OwnerID string `json:"ownerId,omitempty"`
+
+	// Indicates whether this is the default Network ACL for the VPC.
+	IsDefault bool `json:"isDefault,omitempty"`
+
+	// The entries of the Network ACL.
+	Entries []NetworkACLEntryState `json:"entries,omitempty"`
+
+	// The subnets associated with the Network ACL.
+	Associations []NetworkACLAssociationState `json:"associations,omitempty"`
+}
+
+// A NetworkACLStatus represents the observed state of a NetworkACL.
+type NetworkACLStatus struct {
+	xpv1.ResourceStatus `json:",inline"`
+	AtProvider          NetworkACLObservation `json:"atProvider,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// A NetworkACL is a managed resource that represents an AWS VPC Network ACL.
+// +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="ID",type="string",JSONPath=".metadata.annotations.crossplane\\.io/external-name"
+// +kubebuilder:printcolumn:name="VPC",type="string",JSONPath=".spec.forProvider.vpcId"
+// +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:scope=Cluster,categories={crossplane,managed,aws}
+type NetworkACL struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   NetworkACLSpec   `json:"spec"`
+	Status NetworkACLStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// NetworkACLList contains a list of NetworkACLs
+type NetworkACLList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []NetworkACL `json:"items"`
+}
@@ -0,0 +1,133 @@
+/*
+Copyright 2023 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package manualv1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+)
+
+// NetworkACLEntryParameters define the desired state of an entry of an AWS
+// VPC Network ACL.
+type NetworkACLEntryParameters struct {
+	// Region is the region you'd like your Network ACL entry to be created in.
+	Region string `json:"region"`
+
+	// The ID of the Network ACL the entry belongs to. If the Network ACL is
+	// managed by crossplane, enable ignoreIngress or ignoreEgress on it to
+	// prevent the entries to be constantly created and deleted.
+	// +optional
+	// +immutable
+	// +crossplane:generate:reference:type=NetworkACL
+	NetworkACLID *string `json:"networkAclId,omitempty"`
+
+	// NetworkACLIDRef references a NetworkACL to retrieve its ID
+	// +optional
+	// +immutable
+	NetworkACLIDRef *xpv1.Reference `json:"networkAclIdRef,omitempty"`
+
+	// NetworkACLIDSelector selects a reference to a NetworkACL to retrieve
+	// its ID
+	// +optional
+	NetworkACLIDSelector *xpv1.Selector `json:"networkAclIdSelector,omitempty"`
+
+	// Indicates whether this is an egress rule (rule is applied to traffic
+	// leaving the subnet).
+	// +optional
+	// +immutable
+	Egress *bool `json:"egress,omitempty"`
+
+	// The rule number for the entry. ACL entries are processed in ascending
+	// order by rule number.
+	// +immutable
+	// +kubebuilder:validation:Minimum=1
+	// +kubebuilder:validation:Maximum=32766
+	RuleNumber int32 `json:"ruleNumber"`
+
+	// The protocol number. A value of "-1" means all protocols. The names
+	// tcp, udp, icmp and icmpv6 are accepted as well.
+	Protocol string `json:"protocol"`
+
+	// Indicates whether to allow or deny the traffic that matches the rule.
+	// +kubebuilder:validation:Enum=allow;deny
+	RuleAction string `json:"ruleAction"`
+
+	// The IPv4 network range to allow or deny, in CIDR notation.
+	// +optional
+	CIDRBlock *string `json:"cidrBlock,omitempty"`
+
+	// The IPv6 network range to allow or deny, in CIDR notation.
+	// +optional
+	IPv6CIDRBlock *string `json:"ipv6CidrBlock,omitempty"`
+
+	// The first port in the range. Required for TCP and UDP.
+	// +optional
+	FromPort *int32 `json:"fromPort,omitempty"`
+
+	// The last port in the range. Required for TCP and UDP.
+	// +optional
+	ToPort *int32 `json:"toPort,omitempty"`
+
+	// The ICMP type. Required for the ICMP protocol, -1 means all types.
+	// +optional
+	ICMPType *int32 `json:"icmpType,omitempty"`
+
+	// The ICMP code. Required for the ICMP protocol, -1 means all codes.
+	// +optional
+	ICMPCode *int32 `json:"icmpCode,omitempty"`
+}
+
+// A NetworkACLEntrySpec defines the desired state of a NetworkACLEntry.
+type NetworkACLEntrySpec struct {
+	xpv1.ResourceSpec `json:",inline"`
+	ForProvider       NetworkACLEntryParameters `json:"forProvider"`
+}
+
+// A NetworkACLEntryStatus represents the observed state of a NetworkACLEntry.
+type NetworkACLEntryStatus struct {
+	xpv1.ResourceStatus `json:",inline"`
+	AtProvider          NetworkACLEntryState `json:"atProvider,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// A NetworkACLEntry is a managed resource that represents an entry of an AWS
+// VPC Network ACL.
+// +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="ACL",type="string",JSONPath=".spec.forProvider.networkAclId"
+// +kubebuilder:printcolumn:name="RULE",type="integer",JSONPath=".spec.forProvider.ruleNumber"
+// +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:scope=Cluster,categories={crossplane,managed,aws}
+type NetworkACLEntry struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   NetworkACLEntrySpec   `json:"spec"`
+	Status NetworkACLEntryStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// NetworkACLEntryList contains a list of NetworkACLEntries
+type NetworkACLEntryList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []NetworkACLEntry `json:"items"`
+}
@@ -64,8 +64,62 @@ var (
 	InstanceGroupVersionKind = SchemeGroupVersion.WithKind(InstanceKind)
 )
 
+// NetworkACL type metadata.
+var (
+	NetworkACLKind             = reflect.TypeOf(NetworkACL{}).Name()
+	NetworkACLGroupKind        = schema.GroupKind{Group: Group, Kind: NetworkACLKind}.String()
+	NetworkACLKindAPIVersion   = NetworkACLKind + "." + SchemeGroupVersion.String()
+	NetworkACLGroupVersionKind = SchemeGroupVersion.WithKind(NetworkACLKind)
+)
+
+// NetworkACLEntry type metadata.
+var (
+	NetworkACLEntryKind             = reflect.TypeOf(NetworkACLEntry{}).Name()
+	NetworkACLEntryGroupKind        = schema.GroupKind{Group: Group, Kind: NetworkACLEntryKind}.String()
+	NetworkACLEntryKindAPIVersion   = NetworkACLEntryKind + "." + SchemeGroupVersion.String()
+	NetworkACLEntryGroupVersionKind = SchemeGroupVersion.WithKind(NetworkACLEntryKind)
+)
+
+// KeyPair type metadata.
+var (
+	KeyPairKind             = reflect.TypeOf(KeyPair{}).Name()
+	KeyPairGroupKind        = schema.GroupKind{Group: Group, Kind: KeyPairKind}.String()
+	KeyPairKindAPIVersion   = KeyPairKind + "." + SchemeGroupVersion.String()
+	KeyPairGroupVersionKind = SchemeGroupVersion.WithKind(KeyPairKind)
+)
+
+// CustomerGateway type metadata.
+var (
+	CustomerGatewayKind             = reflect.TypeOf(CustomerGateway{}).Name()
+	CustomerGatewayGroupKind        = schema.GroupKind{Group: Group, Kind: CustomerGatewayKind}.String()
+	CustomerGatewayKindAPIVersion   = CustomerGatewayKind + "." + SchemeGroupVersion.String()
+	CustomerGatewayGroupVersionKind = SchemeGroupVersion.WithKind(CustomerGatewayKind)
+)
+
+// VPNGateway type metadata.
+var (
+	VPNGatewayKind             = reflect.TypeOf(VPNGateway{}).Name()
+	VPNGatewayGroupKind        = schema.GroupKind{Group: Group, Kind: VPNGatewayKind}.String()
+	VPNGatewayKindAPIVersion   = VPNGatewayKind + "." + SchemeGroupVersion.String()
+	VPNGatewayGroupVersionKind = SchemeGroupVersion.WithKind(VPNGatewayKind)
+)
+
+// VPNConnection type metadata.
+var (
+	VPNConnectionKind             = reflect.TypeOf(VPNConnection{}).Name()
+	VPNConnectionGroupKind        = schema.GroupKind{Group: Group, Kind: VPNConnectionKind}.String()
+	VPNConnectionKindAPIVersion   = VPNConnectionKind + "." + SchemeGroupVersion.String()
+	VPNConnectionGroupVersionKind = SchemeGroupVersion.WithKind(VPNConnectionKind)
+)
+
 func init() {
 	SchemeBuilder.Register(&VPCCIDRBlock{}, &VPCCIDRBlockList{})
 	SchemeBuilder.Register(&SecurityGroupRule{}, &SecurityGroupRuleList{})
 	SchemeBuilder.Register(&Instance{}, &InstanceList{})
+	SchemeBuilder.Register(&NetworkACL{}, &NetworkACLList{})
+	SchemeBuilder.Register(&NetworkACLEntry{}, &NetworkACLEntryList{})
+	SchemeBuilder.Register(&KeyPair{}, &KeyPairList{})
+	SchemeBuilder.Register(&CustomerGateway{}, &CustomerGatewayList{})
+	SchemeBuilder.Register(&VPNGateway{}, &VPNGatewayList{})
+	SchemeBuilder.Register(&VPNConnection{}, &VPNConnectionList{})
 }
@@ -0,0 +1,178 @@
+/*
+Copyright 2023 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package manualv1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+)
+
+// VPNConnectionParameters define the desired state of an AWS EC2
+// Site-to-Site VPN Connection.
+type VPNConnectionParameters struct {
+	// Region is the region you'd like your VPN Connection to be created in.
+	Region string `json:"region"`
+
+	// The type of VPN connection.
+	// +immutable
+	// +kubebuilder:validation:Enum=ipsec.1
+	Type string `json:"type"`
+
+	// The ID of the customer gateway.
+	// +optional
+	// +immutable
+	// +crossplane:generate:reference:type=CustomerGateway
+	CustomerGatewayID *string `json:"customerGatewayId,omitempty"`
+
+	// CustomerGatewayIDRef references a CustomerGateway to retrieve its ID
+	// +optional
+	CustomerGatewayIDRef *xpv1.Reference `json:"customerGatewayIdRef,omitempty"`
+
+	// CustomerGatewayIDSelector selects a reference to a CustomerGateway to
+	// retrieve its ID
+	// +optional
+	CustomerGatewayIDSelector *xpv1.Selector `json:"customerGatewayIdSelector,omitempty"`
+
+	// The ID of the virtual private gateway. Either a virtual private
+	// gateway or a transit gateway has to be given.
+	// +optional
+	// +immutable
+	// +crossplane:generate:reference:type=VPNGateway
+	VPNGatewayID *string `json:"vpnGatewayId,omitempty"`
+
+	// VPNGatewayIDRef references a VPNGateway to retrieve its ID
+	// +optional
+	VPNGatewayIDRef *xpv1.Reference `json:"vpnGatewayIdRef,omitempty"`
+
+	// VPNGatewayIDSelector selects a reference to a VPNGateway to retrieve
+	// its ID
+	// +optional
+	VPNGatewayIDSelector *xpv1.Selector `json:"vpnGatewayIdSelector,omitempty"`
+
+	// The ID of the transit gateway. Either a virtual private gateway or a
+	// transit gateway has to be given.
+	// +optional
+	// +immutable
+	TransitGatewayID *string `json:"transitGatewayId,omitempty"`
+
+	// Indicates whether the VPN connection uses static routes only. Static
+	// routes must be used for devices that don't support BGP.
+	// Default: false
+	// +optional
+	// +immutable
+	StaticRoutesOnly *bool `json:"staticRoutesOnly,omitempty"`
+
+	// The IPv4 CIDR blocks of the static routes that are used to route
+	// traffic from the virtual private gateway to the customer gateway.
+	// Only supported for connections that use static routes only.
+	// +optional
+	StaticRoutes []string `json:"staticRoutes,omitempty"`
+
+	// Tags represents to current ec2 tags.
+	// +optional
+	Tags []Tag `json:"tags,omitempty"`
+}
+
+// A VPNConnectionSpec defines the desired state of a VPNConnection.
+type VPNConnectionSpec struct {
+	xpv1.ResourceSpec `json:",inline"`
+	ForProvider       VPNConnectionParameters `json:"forProvider"`
+}
+
+// VPNTunnelTelemetry describes the status of a VPN tunnel.
+type VPNTunnelTelemetry struct {
+	// The Internet-routable IP address of the virtual private gateway's
+	// outside interface.
+	OutsideIPAddress string `json:"outsideIpAddress,omitempty"`
+
+	// The status of the VPN tunnel.
+	Status string `json:"status,omitempty"`
+
+	// If an error occurs, a description of the error.
+	StatusMessage string `json:"statusMessage,omitempty"`
+
+	// The number of accepted routes.
+	AcceptedRouteCount int32 `json:"acceptedRouteCount,omitempty"`
+
+	// The date and time of the last change in status.
+	LastStatusChange *metav1.Time `json:"lastStatusChange,omitempty"`
+}
+
+// VPNStaticRoute describes a static route of a VPN connection.
+type VPNStaticRoute struct {
+	// The CIDR block associated with the local subnet of the customer data
+	// center.
+	DestinationCIDRBlock string `json:"destinationCidrBlock,omitempty"`
+
+	// The current state of the static route.
+	State string `json:"state,omitempty"`
+}
+
+// VPNConnectionObservation keeps the state for the external resource
+type VPNConnectionObservation struct {
+	// The ID of the VPN connection.
+	VPNConnectionID string `json:"vpnConnectionId,omitempty"`
+
+	// The current state of the VPN connection.
+	State string `json:"state,omitempty"`
+
+	// The category of the VPN connection.
+	Category string `json:"category,omitempty"`
+
+	// The static routes of the VPN connection.
+	Routes []VPNStaticRoute `json:"routes,omitempty"`
+
+	// Information about the VPN tunnels.
+	Tunnels []VPNTunnelTelemetry `json:"tunnels,omitempty"`
+}
+
+// A VPNConnectionStatus represents the observed state of a VPNConnection.
+type VPNConnectionStatus struct {
+	xpv1.ResourceStatus `json:",inline"`
+	AtProvider          VPNConnectionObservation `json:"atProvider,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// A VPNConnection is a managed resource that represents an AWS EC2
+// Site-to-Site VPN Connection. The outside IP addresses and the pre-shared
+// keys of its two tunnels are published to its connection secret, together
+// with the configuration of the customer gateway device.
+// +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="ID",type="string",JSONPath=".metadata.annotations.crossplane\\.io/external-name"
+// +kubebuilder:printcolumn:name="STATE",type="string",JSONPath=".status.atProvider.state"
+// +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:scope=Cluster,categories={crossplane,managed,aws}
+type VPNConnection struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   VPNConnectionSpec   `json:"spec"`
+	Status VPNConnectionStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// VPNConnectionList contains a list of VPNConnections
+type VPNConnectionList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []VPNConnection `json:"items"`
+}
@@ -0,0 +1,152 @@
+/*
+Copyright 2023 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package manualv1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+)
+
+// VPNGatewayParameters define the desired state of an AWS EC2 Virtual
+// Private Gateway.
+type VPNGatewayParameters struct {
+	// Region is the region you'd like your VPN Gateway to be created in.
+	Region string `json:"region"`
+
+	// The type of VPN connection this virtual private gateway supports.
+	// +immutable
+	// +kubebuilder:validation:Enum=ipsec.1
+	Type string `json:"type"`
+
+	// A private Autonomous System Number (ASN) for the Amazon side of a BGP
+	// session. If it is not set, the default ASN is used.
+	// +optional
+	// +immutable
+	AmazonSideASN *int64 `json:"amazonSideAsn,omitempty"`
+
+	// The Availability Zone for the virtual private gateway.
+	// +optional
+	// +immutable
+	AvailabilityZone *string `json:"availabilityZone,omitempty"`
+
+	// The ID of the VPC the virtual private gateway is attached to.
+	// +optional
+	// +crossplane:generate:reference:type=github.com/crossplane-contrib/provider-aws/apis/ec2/v1beta1.VPC
+	VPCID *string `json:"vpcId,omitempty"`
+
+	// VPCIDRef references a VPC to retrieve its vpcId
+	// +optional
+	VPCIDRef *xpv1.Reference `json:"vpcIdRef,omitempty"`
+
+	// VPCIDSelector selects a reference to a VPC to retrieve its vpcId
+	// +optional
+	VPCIDSelector *xpv1.Selector `json:"vpcIdSelector,omitempty"`
+
+	// The IDs of the route tables of the attached VPC that the routes of
+	// the virtual private gateway are propagated to. Propagation is
+	// disabled for every other route table of the VPC.
+	// +optional
+	// +crossplane:generate:reference:type=github.com/crossplane-contrib/provider-aws/apis/ec2/v1beta1.RouteTable
+	// +crossplane:generate:reference:refFieldName=RouteTableIDRefs
+	// +crossplane:generate:reference:selectorFieldName=RouteTableIDSelector
+	RouteTableIDs []string `json:"routeTableIds,omitempty"`
+
+	// RouteTableIDRefs is a list of references to RouteTables used to set
+	// the RouteTableIDs.
+	// +optional
+	RouteTableIDRefs []xpv1.Reference `json:"routeTableIdRefs,omitempty"`
+
+	// RouteTableIDSelector selects references to RouteTables used to set
+	// the RouteTableIDs.
+	// +optional
+	RouteTableIDSelector *xpv1.Selector `json:"routeTableIdSelector,omitempty"`
+
+	// Tags represents to current ec2 tags.
+	// +optional
+	Tags []Tag `json:"tags,omitempty"`
+}
+
+// A VPNGatewaySpec defines the desired state of a VPNGateway.
+type VPNGatewaySpec struct {
+	xpv1.ResourceSpec `json:",inline"`
+	ForProvider       VPNGatewayParameters `json:"forProvider"`
+}
+
+// VPNGatewayVPCAttachment describes an attachment between a virtual private
+// gateway and a VPC.
+type VPNGatewayVPCAttachment struct {
+	// The ID of the VPC.
+	VPCID string `json:"vpcId,omitempty"`
+
+	// The current state of the attachment.
+	State string `json:"state,omitempty"`
+}
+
+// VPNGatewayObservation keeps the state for the external resource
+type VPNGatewayObservation struct {
+	// The ID of the virtual private gateway.
+	VPNGatewayID string `json:"vpnGatewayId,omitempty"`
+
+	// The private Autonomous System Number (ASN) for the Amazon side of a
+	// BGP session.
+	AmazonSideASN *int64 `json:"amazonSideAsn,omitempty"`
+
+	// The current state of the virtual private gateway.
+	State string `json:"state,omitempty"`
+
+	// Any VPCs attached to the virtual private gateway.
+	VPCAttachments []VPNGatewayVPCAttachment `json:"vpcAttachments,omitempty"`
+
+	// The IDs of the route tables of the attached VPC that the routes of
+	// the virtual private gateway are propagated to.
+	PropagatingRouteTableIDs []string `json:"propagatingRouteTableIds,omitempty"`
+}
+
+// A VPNGatewayStatus represents the observed state of a VPNGateway.
+type VPNGatewayStatus struct {
+	xpv1.ResourceStatus `json:",inline"`
+	AtProvider          VPNGatewayObservation `json:"atProvider,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// A VPNGateway is a managed resource that represents an AWS EC2 Virtual
+// Private Gateway, the Amazon end of a site-to-site VPN connection.
+// +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="ID",type="string",JSONPath=".metadata.annotations.crossplane\\.io/external-name"
+// +kubebuilder:printcolumn:name="VPC",type="string",JSONPath=".spec.forProvider.vpcId"
+// +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:scope=Cluster,categories={crossplane,managed,aws}
+type VPNGateway struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   VPNGatewaySpec   `json:"spec"`
+	Status VPNGatewayStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// VPNGatewayList contains a list of VPNGateways
+type VPNGatewayList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []VPNGateway `json:"items"`
+}
@@ -166,6 +166,154 @@ func (in *CreditSpecificationRequest) DeepCopy() *CreditSpecificationRequest {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *CustomerGateway) DeepCopyInto(out *CustomerGateway) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CustomerGateway.
+func (in *CustomerGateway) DeepCopy() *CustomerGateway {
+	if in == nil {
+		return nil
+	}
+	out := new(CustomerGateway)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *CustomerGateway) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *CustomerGatewayList) DeepCopyInto(out *CustomerGatewayList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]CustomerGateway, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CustomerGatewayList.
+func (in *CustomerGatewayList) DeepCopy() *CustomerGatewayList {
+	if in == nil {
+		return nil
+	}
+	out := new(CustomerGatewayList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *CustomerGatewayList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *CustomerGatewayObservation) DeepCopyInto(out *CustomerGatewayObservation) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CustomerGatewayObservation.
+func (in *CustomerGatewayObservation) DeepCopy() *CustomerGatewayObservation {
+	if in == nil {
+		return nil
+	}
+	out := new(CustomerGatewayObservation)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *CustomerGatewayParameters) DeepCopyInto(out *CustomerGatewayParameters) {
+	*out = *in
+	if in.BGPASN != nil {
+		in, out := &in.BGPASN, &out.BGPASN
+		*out = new(int32)
+		**out = **in
+	}
+	if in.IPAddress != nil {
+		in, out := &in.IPAddress, &out.IPAddress
+		*out = new(string)
+		**out = **in
+	}
+	if in.CertificateARN != nil {
+		in, out := &in.CertificateARN, &out.CertificateARN
+		*out = new(string)
+		**out = **in
+	}
+	if in.DeviceName != nil {
+		in, out := &in.DeviceName, &out.DeviceName
+		*out = new(string)
+		**out = **in
+	}
+	if in.Tags != nil {
+		in, out := &in.Tags, &out.Tags
+		*out = make([]Tag, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CustomerGatewayParameters.
+func (in *CustomerGatewayParameters) DeepCopy() *CustomerGatewayParameters {
+	if in == nil {
+		return nil
+	}
+	out := new(CustomerGatewayParameters)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *CustomerGatewaySpec) DeepCopyInto(out *CustomerGatewaySpec) {
+	*out = *in
+	in.ResourceSpec.DeepCopyInto(&out.ResourceSpec)
+	in.ForProvider.DeepCopyInto(&out.ForProvider)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CustomerGatewaySpec.
+func (in *CustomerGatewaySpec) DeepCopy() *CustomerGatewaySpec {
+	if in == nil {
+		return nil
+	}
+	out := new(CustomerGatewaySpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *CustomerGatewayStatus) DeepCopyInto(out *CustomerGatewayStatus) {
+	*out = *in
+	in.ResourceStatus.DeepCopyInto(&out.ResourceStatus)
+	out.AtProvider = in.AtProvider
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CustomerGatewayStatus.
+func (in *CustomerGatewayStatus) DeepCopy() *CustomerGatewayStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(CustomerGatewayStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *EBSBlockDevice) DeepCopyInto(out *EBSBlockDevice) {
 	*out = *in
@@ -1162,6 +1310,16 @@ func (in *InstanceParameters) DeepCopyInto(out *InstanceParameters) {
 		*out = new(string)
 		**out = **in
 	}
+	if in.KeyNameRef != nil {
+		in, out := &in.KeyNameRef, &out.KeyNameRef
+		*out = new(v1.Reference)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.KeyNameSelector != nil {
+		in, out := &in.KeyNameSelector, &out.KeyNameSelector
+		*out = new(v1.Selector)
+		(*in).DeepCopyInto(*out)
+	}
 	if in.LaunchTemplate != nil {
 		in, out := &in.LaunchTemplate, &out.LaunchTemplate
 		*out = new(LaunchTemplateSpecification)
@@ -1260,6 +1418,11 @@ func (in *InstanceParameters) DeepCopyInto(out *InstanceParameters) {
 		*out = new(string)
 		**out = **in
 	}
+	if in.UpdateStrategy != nil {
+		in, out := &in.UpdateStrategy, &out.UpdateStrategy
+		*out = new(string)
+		**out = **in
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new InstanceParameters.
@@ -1357,187 +1520,1094 @@ func (in *Ipv6PrefixSpecificationRequest) DeepCopy() *Ipv6PrefixSpecificationReq
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *LaunchTemplateSpecification) DeepCopyInto(out *LaunchTemplateSpecification) {
+func (in *KeyPair) DeepCopyInto(out *KeyPair) {
 	*out = *in
-	if in.LaunchTemplateID != nil {
-		in, out := &in.LaunchTemplateID, &out.LaunchTemplateID
-		*out = new(string)
-		**out = **in
-	}
-	if in.LaunchTemplateName != nil {
-		in, out := &in.LaunchTemplateName, &out.LaunchTemplateName
-		*out = new(string)
-		**out = **in
-	}
-	if in.Version != nil {
-		in, out := &in.Version, &out.Version
-		*out = new(string)
-		**out = **in
-	}
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new LaunchTemplateSpecification.
-func (in *LaunchTemplateSpecification) DeepCopy() *LaunchTemplateSpecification {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new KeyPair.
+func (in *KeyPair) DeepCopy() *KeyPair {
 	if in == nil {
 		return nil
 	}
-	out := new(LaunchTemplateSpecification)
+	out := new(KeyPair)
 	in.DeepCopyInto(out)
 	return out
 }
 
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *KeyPair) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *LicenseConfigurationRequest) DeepCopyInto(out *LicenseConfigurationRequest) {
+func (in *KeyPairList) DeepCopyInto(out *KeyPairList) {
 	*out = *in
-	if in.LicenseConfigurationARN != nil {
-		in, out := &in.LicenseConfigurationARN, &out.LicenseConfigurationARN
-		*out = new(string)
-		**out = **in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]KeyPair, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new LicenseConfigurationRequest.
-func (in *LicenseConfigurationRequest) DeepCopy() *LicenseConfigurationRequest {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new KeyPairList.
+func (in *KeyPairList) DeepCopy() *KeyPairList {
 	if in == nil {
 		return nil
 	}
-	out := new(LicenseConfigurationRequest)
+	out := new(KeyPairList)
 	in.DeepCopyInto(out)
 	return out
 }
 
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *KeyPairList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *Monitoring) DeepCopyInto(out *Monitoring) {
+func (in *KeyPairObservation) DeepCopyInto(out *KeyPairObservation) {
 	*out = *in
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Monitoring.
-func (in *Monitoring) DeepCopy() *Monitoring {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new KeyPairObservation.
+func (in *KeyPairObservation) DeepCopy() *KeyPairObservation {
 	if in == nil {
 		return nil
 	}
-	out := new(Monitoring)
+	out := new(KeyPairObservation)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *Placement) DeepCopyInto(out *Placement) {
+func (in *KeyPairParameters) DeepCopyInto(out *KeyPairParameters) {
 	*out = *in
-	if in.Affinity != nil {
-		in, out := &in.Affinity, &out.Affinity
-		*out = new(string)
-		**out = **in
-	}
-	if in.AvailabilityZone != nil {
-		in, out := &in.AvailabilityZone, &out.AvailabilityZone
-		*out = new(string)
-		**out = **in
-	}
-	if in.GroupName != nil {
-		in, out := &in.GroupName, &out.GroupName
+	if in.KeyType != nil {
+		in, out := &in.KeyType, &out.KeyType
 		*out = new(string)
 		**out = **in
 	}
-	if in.HostID != nil {
-		in, out := &in.HostID, &out.HostID
-		*out = new(string)
-		**out = **in
-	}
-	if in.HostResourceGroupARN != nil {
-		in, out := &in.HostResourceGroupARN, &out.HostResourceGroupARN
-		*out = new(string)
+	if in.PublicKeySecretRef != nil {
+		in, out := &in.PublicKeySecretRef, &out.PublicKeySecretRef
+		*out = new(v1.SecretKeySelector)
 		**out = **in
 	}
-	if in.PartitionNumber != nil {
-		in, out := &in.PartitionNumber, &out.PartitionNumber
-		*out = new(int32)
-		**out = **in
-	}
-	if in.SpreadDomain != nil {
-		in, out := &in.SpreadDomain, &out.SpreadDomain
-		*out = new(string)
-		**out = **in
+	if in.Tags != nil {
+		in, out := &in.Tags, &out.Tags
+		*out = make([]Tag, len(*in))
+		copy(*out, *in)
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Placement.
-func (in *Placement) DeepCopy() *Placement {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new KeyPairParameters.
+func (in *KeyPairParameters) DeepCopy() *KeyPairParameters {
 	if in == nil {
 		return nil
 	}
-	out := new(Placement)
+	out := new(KeyPairParameters)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *PrivateIPAddressSpecification) DeepCopyInto(out *PrivateIPAddressSpecification) {
+func (in *KeyPairSpec) DeepCopyInto(out *KeyPairSpec) {
 	*out = *in
-	if in.Primary != nil {
-		in, out := &in.Primary, &out.Primary
-		*out = new(bool)
-		**out = **in
-	}
-	if in.PrivateIPAddress != nil {
-		in, out := &in.PrivateIPAddress, &out.PrivateIPAddress
-		*out = new(string)
-		**out = **in
-	}
+	in.ResourceSpec.DeepCopyInto(&out.ResourceSpec)
+	in.ForProvider.DeepCopyInto(&out.ForProvider)
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PrivateIPAddressSpecification.
-func (in *PrivateIPAddressSpecification) DeepCopy() *PrivateIPAddressSpecification {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new KeyPairSpec.
+func (in *KeyPairSpec) DeepCopy() *KeyPairSpec {
 	if in == nil {
 		return nil
 	}
-	out := new(PrivateIPAddressSpecification)
+	out := new(KeyPairSpec)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *ProductCode) DeepCopyInto(out *ProductCode) {
+func (in *KeyPairStatus) DeepCopyInto(out *KeyPairStatus) {
 	*out = *in
-	if in.ProductCodeID != nil {
-		in, out := &in.ProductCodeID, &out.ProductCodeID
-		*out = new(string)
-		**out = **in
-	}
+	in.ResourceStatus.DeepCopyInto(&out.ResourceStatus)
+	out.AtProvider = in.AtProvider
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ProductCode.
-func (in *ProductCode) DeepCopy() *ProductCode {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new KeyPairStatus.
+func (in *KeyPairStatus) DeepCopy() *KeyPairStatus {
 	if in == nil {
 		return nil
 	}
-	out := new(ProductCode)
+	out := new(KeyPairStatus)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *RunInstancesMonitoringEnabled) DeepCopyInto(out *RunInstancesMonitoringEnabled) {
+func (in *LaunchTemplateSpecification) DeepCopyInto(out *LaunchTemplateSpecification) {
 	*out = *in
-	if in.Enabled != nil {
-		in, out := &in.Enabled, &out.Enabled
-		*out = new(bool)
+	if in.LaunchTemplateID != nil {
+		in, out := &in.LaunchTemplateID, &out.LaunchTemplateID
+		*out = new(string)
 		**out = **in
 	}
-}
-
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RunInstancesMonitoringEnabled.
-func (in *RunInstancesMonitoringEnabled) DeepCopy() *RunInstancesMonitoringEnabled {
-	if in == nil {
-		return nil
+	if in.LaunchTemplateName != nil {
+		in, out := &in.LaunchTemplateName, &out.LaunchTemplateName
+		*out = new(string)
+		**out = **in
+	}
+	if in.Version != nil {
+		in, out := &in.Version, &out.Version
+		*out = new(string)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new LaunchTemplateSpecification.
+func (in *LaunchTemplateSpecification) DeepCopy() *LaunchTemplateSpecification {
+	if in == nil {
+		return nil
+	}
+	out := new(LaunchTemplateSpecification)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *LicenseConfigurationRequest) DeepCopyInto(out *LicenseConfigurationRequest) {
+	*out = *in
+	if in.LicenseConfigurationARN != nil {
+		in, out := &in.LicenseConfigurationARN, &out.LicenseConfigurationARN
+		*out = new(string)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new LicenseConfigurationRequest.
+func (in *LicenseConfigurationRequest) DeepCopy() *LicenseConfigurationRequest {
+	if in == nil {
+		return nil
+	}
+	out := new(LicenseConfigurationRequest)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *Monitoring) DeepCopyInto(out *Monitoring) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Monitoring.
+func (in *Monitoring) DeepCopy() *Monitoring {
+	if in == nil {
+		return nil
+	}
+	out := new(Monitoring)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *NetworkACL) DeepCopyInto(out *NetworkACL) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NetworkACL.
+func (in *NetworkACL) DeepCopy() *NetworkACL {
+	if in == nil {
+		return nil
+	}
+	out := new(NetworkACL)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *NetworkACL) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *NetworkACLAssociation) DeepCopyInto(out *NetworkACLAssociation) {
+	*out = *in
+	if in.SubnetID != nil {
+		in, out := &in.SubnetID, &out.SubnetID
+		*out = new(string)
+		**out = **in
+	}
+	if in.SubnetIDRef != nil {
+		in, out := &in.SubnetIDRef, &out.SubnetIDRef
+		*out = new(v1.Reference)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.SubnetIDSelector != nil {
+		in, out := &in.SubnetIDSelector, &out.SubnetIDSelector
+		*out = new(v1.Selector)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NetworkACLAssociation.
+func (in *NetworkACLAssociation) DeepCopy() *NetworkACLAssociation {
+	if in == nil {
+		return nil
+	}
+	out := new(NetworkACLAssociation)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *NetworkACLAssociationState) DeepCopyInto(out *NetworkACLAssociationState) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NetworkACLAssociationState.
+func (in *NetworkACLAssociationState) DeepCopy() *NetworkACLAssociationState {
+	if in == nil {
+		return nil
+	}
+	out := new(NetworkACLAssociationState)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *NetworkACLEntry) DeepCopyInto(out *NetworkACLEntry) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NetworkACLEntry.
+func (in *NetworkACLEntry) DeepCopy() *NetworkACLEntry {
+	if in == nil {
+		return nil
+	}
+	out := new(NetworkACLEntry)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *NetworkACLEntry) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *NetworkACLEntryList) DeepCopyInto(out *NetworkACLEntryList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]NetworkACLEntry, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NetworkACLEntryList.
+func (in *NetworkACLEntryList) DeepCopy() *NetworkACLEntryList {
+	if in == nil {
+		return nil
+	}
+	out := new(NetworkACLEntryList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *NetworkACLEntryList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *NetworkACLEntryParameters) DeepCopyInto(out *NetworkACLEntryParameters) {
+	*out = *in
+	if in.NetworkACLID != nil {
+		in, out := &in.NetworkACLID, &out.NetworkACLID
+		*out = new(string)
+		**out = **in
+	}
+	if in.NetworkACLIDRef != nil {
+		in, out := &in.NetworkACLIDRef, &out.NetworkACLIDRef
+		*out = new(v1.Reference)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.NetworkACLIDSelector != nil {
+		in, out := &in.NetworkACLIDSelector, &out.NetworkACLIDSelector
+		*out = new(v1.Selector)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Egress != nil {
+		in, out := &in.Egress, &out.Egress
+		*out = new(bool)
+		**out = **in
+	}
+	if in.CIDRBlock != nil {
+		in, out := &in.CIDRBlock, &out.CIDRBlock
+		*out = new(string)
+		**out = **in
+	}
+	if in.IPv6CIDRBlock != nil {
+		in, out := &in.IPv6CIDRBlock, &out.IPv6CIDRBlock
+		*out = new(string)
+		**out = **in
+	}
+	if in.FromPort != nil {
+		in, out := &in.FromPort, &out.FromPort
+		*out = new(int32)
+		**out = **in
+	}
+	if in.ToPort != nil {
+		in, out := &in.ToPort, &out.ToPort
+		*out = new(int32)
+		**out = **in
+	}
+	if in.ICMPType != nil {
+		in, out := &in.ICMPType, &out.ICMPType
+		*out = new(int32)
+		**out = **in
+	}
+	if in.ICMPCode != nil {
+		in, out := &in.ICMPCode, &out.ICMPCode
+		*out = new(int32)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NetworkACLEntryParameters.
+func (in *NetworkACLEntryParameters) DeepCopy() *NetworkACLEntryParameters {
+	if in == nil {
+		return nil
+	}
+	out := new(NetworkACLEntryParameters)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *NetworkACLEntrySpec) DeepCopyInto(out *NetworkACLEntrySpec) {
+	*out = *in
+	in.ResourceSpec.DeepCopyInto(&out.ResourceSpec)
+	in.ForProvider.DeepCopyInto(&out.ForProvider)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NetworkACLEntrySpec.
+func (in *NetworkACLEntrySpec) DeepCopy() *NetworkACLEntrySpec {
+	if in == nil {
+		return nil
+	}
+	out := new(NetworkACLEntrySpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *NetworkACLEntryState) DeepCopyInto(out *NetworkACLEntryState) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NetworkACLEntryState.
+func (in *NetworkACLEntryState) DeepCopy() *NetworkACLEntryState {
+	if in == nil {
+		return nil
+	}
+	out := new(NetworkACLEntryState)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *NetworkACLEntryStatus) DeepCopyInto(out *NetworkACLEntryStatus) {
+	*out = *in
+	in.ResourceStatus.DeepCopyInto(&out.ResourceStatus)
+	out.AtProvider = in.AtProvider
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NetworkACLEntryStatus.
+func (in *NetworkACLEntryStatus) DeepCopy() *NetworkACLEntryStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(NetworkACLEntryStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *NetworkACLList) DeepCopyInto(out *NetworkACLList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]NetworkACL, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NetworkACLList.
+func (in *NetworkACLList) DeepCopy() *NetworkACLList {
+	if in == nil {
+		return nil
+	}
+	out := new(NetworkACLList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *NetworkACLList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *NetworkACLObservation) DeepCopyInto(out *NetworkACLObservation) {
+	*out = *in
+	if in.Entries != nil {
+		in, out := &in.Entries, &out.Entries
+		*out = make([]NetworkACLEntryState, len(*in))
+		copy(*out, *in)
+	}
+	if in.Associations != nil {
+		in, out := &in.Associations, &out.Associations
+		*out = make([]NetworkACLAssociationState, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NetworkACLObservation.
+func (in *NetworkACLObservation) DeepCopy() *NetworkACLObservation {
+	if in == nil {
+		return nil
+	}
+	out := new(NetworkACLObservation)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *NetworkACLParameters) DeepCopyInto(out *NetworkACLParameters) {
+	*out = *in
+	if in.VPCID != nil {
+		in, out := &in.VPCID, &out.VPCID
+		*out = new(string)
+		**out = **in
+	}
+	if in.VPCIDRef != nil {
+		in, out := &in.VPCIDRef, &out.VPCIDRef
+		*out = new(v1.Reference)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.VPCIDSelector != nil {
+		in, out := &in.VPCIDSelector, &out.VPCIDSelector
+		*out = new(v1.Selector)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Ingress != nil {
+		in, out := &in.Ingress, &out.Ingress
+		*out = make([]NetworkACLRule, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.Egress != nil {
+		in, out := &in.Egress, &out.Egress
+		*out = make([]NetworkACLRule, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.IgnoreIngress != nil {
+		in, out := &in.IgnoreIngress, &out.IgnoreIngress
+		*out = new(bool)
+		**out = **in
+	}
+	if in.IgnoreEgress != nil {
+		in, out := &in.IgnoreEgress, &out.IgnoreEgress
+		*out = new(bool)
+		**out = **in
+	}
+	if in.Associations != nil {
+		in, out := &in.Associations, &out.Associations
+		*out = make([]NetworkACLAssociation, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.Tags != nil {
+		in, out := &in.Tags, &out.Tags
+		*out = make([]Tag, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NetworkACLParameters.
+func (in *NetworkACLParameters) DeepCopy() *NetworkACLParameters {
+	if in == nil {
+		return nil
+	}
+	out := new(NetworkACLParameters)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *NetworkACLRule) DeepCopyInto(out *NetworkACLRule) {
+	*out = *in
+	if in.CIDRBlock != nil {
+		in, out := &in.CIDRBlock, &out.CIDRBlock
+		*out = new(string)
+		**out = **in
+	}
+	if in.IPv6CIDRBlock != nil {
+		in, out := &in.IPv6CIDRBlock, &out.IPv6CIDRBlock
+		*out = new(string)
+		**out = **in
+	}
+	if in.FromPort != nil {
+		in, out := &in.FromPort, &out.FromPort
+		*out = new(int32)
+		**out = **in
+	}
+	if in.ToPort != nil {
+		in, out := &in.ToPort, &out.ToPort
+		*out = new(int32)
+		**out = **in
+	}
+	if in.ICMPType != nil {
+		in, out := &in.ICMPType, &out.ICMPType
+		*out = new(int32)
+		**out = **in
+	}
+	if in.ICMPCode != nil {
+		in, out := &in.ICMPCode, &out.ICMPCode
+		*out = new(int32)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NetworkACLRule.
+func (in *NetworkACLRule) DeepCopy() *NetworkACLRule {
+	if in == nil {
+		return nil
+	}
+	out := new(NetworkACLRule)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *NetworkACLSpec) DeepCopyInto(out *NetworkACLSpec) {
+	*out = *in
+	in.ResourceSpec.DeepCopyInto(&out.ResourceSpec)
+	in.ForProvider.DeepCopyInto(&out.ForProvider)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NetworkACLSpec.
+func (in *NetworkACLSpec) DeepCopy() *NetworkACLSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(NetworkACLSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *NetworkACLStatus) DeepCopyInto(out *NetworkACLStatus) {
+	*out = *in
+	in.ResourceStatus.DeepCopyInto(&out.ResourceStatus)
+	in.AtProvider.DeepCopyInto(&out.AtProvider)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NetworkACLStatus.
+func (in *NetworkACLStatus) DeepCopy() *NetworkACLStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(NetworkACLStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *Placement) DeepCopyInto(out *Placement) {
+	*out = *in
+	if in.Affinity != nil {
+		in, out := &in.Affinity, &out.Affinity
+		*out = new(string)
+		**out = **in
+	}
+	if in.AvailabilityZone != nil {
+		in, out := &in.AvailabilityZone, &out.AvailabilityZone
+		*out = new(string)
+		**out = **in
+	}
+	if in.GroupName != nil {
+		in, out := &in.GroupName, &out.GroupName
+		*out = new(string)
+		**out = **in
+	}
+	if in.HostID != nil {
+		in, out := &in.HostID, &out.HostID
+		*out = new(string)
+		**out = **in
+	}
+	if in.HostResourceGroupARN != nil {
+		in, out := &in.HostResourceGroupARN, &out.HostResourceGroupARN
+		*out = new(string)
+		**out = **in
+	}
+	if in.PartitionNumber != nil {
+		in, out := &in.PartitionNumber, &out.PartitionNumber
+		*out = new(int32)
+		**out = **in
+	}
+	if in.SpreadDomain != nil {
+		in, out := &in.SpreadDomain, &out.SpreadDomain
+		*out = new(string)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Placement.
+func (in *Placement) DeepCopy() *Placement {
+	if in == nil {
+		return nil
+	}
+	out := new(Placement)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PrivateIPAddressSpecification) DeepCopyInto(out *PrivateIPAddressSpecification) {
+	*out = *in
+	if in.Primary != nil {
+		in, out := &in.Primary, &out.Primary
+		*out = new(bool)
+		**out = **in
+	}
+	if in.PrivateIPAddress != nil {
+		in, out := &in.PrivateIPAddress, &out.PrivateIPAddress
+		*out = new(string)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PrivateIPAddressSpecification.
+func (in *PrivateIPAddressSpecification) DeepCopy() *PrivateIPAddressSpecification {
+	if in == nil {
+		return nil
+	}
+	out := new(PrivateIPAddressSpecification)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ProductCode) DeepCopyInto(out *ProductCode) {
+	*out = *in
+	if in.ProductCodeID != nil {
+		in, out := &in.ProductCodeID, &out.ProductCodeID
+		*out = new(string)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ProductCode.
+func (in *ProductCode) DeepCopy() *ProductCode {
+	if in == nil {
+		return nil
+	}
+	out := new(ProductCode)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RunInstancesMonitoringEnabled) DeepCopyInto(out *RunInstancesMonitoringEnabled) {
+	*out = *in
+	if in.Enabled != nil {
+		in, out := &in.Enabled, &out.Enabled
+		*out = new(bool)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RunInstancesMonitoringEnabled.
+func (in *RunInstancesMonitoringEnabled) DeepCopy() *RunInstancesMonitoringEnabled {
+	if in == nil {
+		return nil
+	}
+	out := new(RunInstancesMonitoringEnabled)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SecurityGroupRule) DeepCopyInto(out *SecurityGroupRule) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SecurityGroupRule.
+func (in *SecurityGroupRule) DeepCopy() *SecurityGroupRule {
+	if in == nil {
+		return nil
+	}
+	out := new(SecurityGroupRule)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *SecurityGroupRule) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SecurityGroupRuleList) DeepCopyInto(out *SecurityGroupRuleList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]SecurityGroupRule, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SecurityGroupRuleList.
+func (in *SecurityGroupRuleList) DeepCopy() *SecurityGroupRuleList {
+	if in == nil {
+		return nil
+	}
+	out := new(SecurityGroupRuleList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *SecurityGroupRuleList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SecurityGroupRuleObservation) DeepCopyInto(out *SecurityGroupRuleObservation) {
+	*out = *in
+	if in.SecurityGroupRuleID != nil {
+		in, out := &in.SecurityGroupRuleID, &out.SecurityGroupRuleID
+		*out = new(string)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SecurityGroupRuleObservation.
+func (in *SecurityGroupRuleObservation) DeepCopy() *SecurityGroupRuleObservation {
+	if in == nil {
+		return nil
+	}
+	out := new(SecurityGroupRuleObservation)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SecurityGroupRuleParameters) DeepCopyInto(out *SecurityGroupRuleParameters) {
+	*out = *in
+	if in.FromPort != nil {
+		in, out := &in.FromPort, &out.FromPort
+		*out = new(int32)
+		**out = **in
+	}
+	if in.ToPort != nil {
+		in, out := &in.ToPort, &out.ToPort
+		*out = new(int32)
+		**out = **in
+	}
+	if in.Type != nil {
+		in, out := &in.Type, &out.Type
+		*out = new(string)
+		**out = **in
+	}
+	if in.Protocol != nil {
+		in, out := &in.Protocol, &out.Protocol
+		*out = new(string)
+		**out = **in
+	}
+	if in.CidrBlock != nil {
+		in, out := &in.CidrBlock, &out.CidrBlock
+		*out = new(string)
+		**out = **in
+	}
+	if in.Ipv6CidrBlock != nil {
+		in, out := &in.Ipv6CidrBlock, &out.Ipv6CidrBlock
+		*out = new(string)
+		**out = **in
+	}
+	if in.Description != nil {
+		in, out := &in.Description, &out.Description
+		*out = new(string)
+		**out = **in
+	}
+	if in.PrefixListID != nil {
+		in, out := &in.PrefixListID, &out.PrefixListID
+		*out = new(string)
+		**out = **in
+	}
+	if in.Region != nil {
+		in, out := &in.Region, &out.Region
+		*out = new(string)
+		**out = **in
+	}
+	if in.SecurityGroupID != nil {
+		in, out := &in.SecurityGroupID, &out.SecurityGroupID
+		*out = new(string)
+		**out = **in
+	}
+	if in.SecurityGroupIDRef != nil {
+		in, out := &in.SecurityGroupIDRef, &out.SecurityGroupIDRef
+		*out = new(v1.Reference)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.SecurityGroupIDSelector != nil {
+		in, out := &in.SecurityGroupIDSelector, &out.SecurityGroupIDSelector
+		*out = new(v1.Selector)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.SourceSecurityGroupID != nil {
+		in, out := &in.SourceSecurityGroupID, &out.SourceSecurityGroupID
+		*out = new(string)
+		**out = **in
+	}
+	if in.SourceSecurityGroupIDRef != nil {
+		in, out := &in.SourceSecurityGroupIDRef, &out.SourceSecurityGroupIDRef
+		*out = new(v1.Reference)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.SourceSecurityGroupIDSelector != nil {
+		in, out := &in.SourceSecurityGroupIDSelector, &out.SourceSecurityGroupIDSelector
+		*out = new(v1.Selector)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SecurityGroupRuleParameters.
+func (in *SecurityGroupRuleParameters) DeepCopy() *SecurityGroupRuleParameters {
+	if in == nil {
+		return nil
+	}
+	out := new(SecurityGroupRuleParameters)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SecurityGroupRuleSpec) DeepCopyInto(out *SecurityGroupRuleSpec) {
+	*out = *in
+	in.ResourceSpec.DeepCopyInto(&out.ResourceSpec)
+	in.ForProvider.DeepCopyInto(&out.ForProvider)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SecurityGroupRuleSpec.
+func (in *SecurityGroupRuleSpec) DeepCopy() *SecurityGroupRuleSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(SecurityGroupRuleSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SecurityGroupRuleState) DeepCopyInto(out *SecurityGroupRuleState) {
+	*out = *in
+	if in.State != nil {
+		in, out := &in.State, &out.State
+		*out = new(string)
+		**out = **in
+	}
+	if in.StatusMessage != nil {
+		in, out := &in.StatusMessage, &out.StatusMessage
+		*out = new(string)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SecurityGroupRuleState.
+func (in *SecurityGroupRuleState) DeepCopy() *SecurityGroupRuleState {
+	if in == nil {
+		return nil
+	}
+	out := new(SecurityGroupRuleState)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SecurityGroupRuleStatus) DeepCopyInto(out *SecurityGroupRuleStatus) {
+	*out = *in
+	in.ResourceStatus.DeepCopyInto(&out.ResourceStatus)
+	in.AtProvider.DeepCopyInto(&out.AtProvider)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SecurityGroupRuleStatus.
+func (in *SecurityGroupRuleStatus) DeepCopy() *SecurityGroupRuleStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(SecurityGroupRuleStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SpotMarketOptions) DeepCopyInto(out *SpotMarketOptions) {
+	*out = *in
+	if in.BlockDurationMinutes != nil {
+		in, out := &in.BlockDurationMinutes, &out.BlockDurationMinutes
+		*out = new(int32)
+		**out = **in
+	}
+	if in.MaxPrice != nil {
+		in, out := &in.MaxPrice, &out.MaxPrice
+		*out = new(string)
+		**out = **in
 	}
-	out := new(RunInstancesMonitoringEnabled)
+	if in.ValidUntil != nil {
+		in, out := &in.ValidUntil, &out.ValidUntil
+		*out = (*in).DeepCopy()
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SpotMarketOptions.
+func (in *SpotMarketOptions) DeepCopy() *SpotMarketOptions {
+	if in == nil {
+		return nil
+	}
+	out := new(SpotMarketOptions)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *SecurityGroupRule) DeepCopyInto(out *SecurityGroupRule) {
+func (in *StateReason) DeepCopyInto(out *StateReason) {
+	*out = *in
+	if in.Code != nil {
+		in, out := &in.Code, &out.Code
+		*out = new(string)
+		**out = **in
+	}
+	if in.Message != nil {
+		in, out := &in.Message, &out.Message
+		*out = new(string)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new StateReason.
+func (in *StateReason) DeepCopy() *StateReason {
+	if in == nil {
+		return nil
+	}
+	out := new(StateReason)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *Tag) DeepCopyInto(out *Tag) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Tag.
+func (in *Tag) DeepCopy() *Tag {
+	if in == nil {
+		return nil
+	}
+	out := new(Tag)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *TagSpecification) DeepCopyInto(out *TagSpecification) {
+	*out = *in
+	if in.ResourceType != nil {
+		in, out := &in.ResourceType, &out.ResourceType
+		*out = new(string)
+		**out = **in
+	}
+	if in.Tags != nil {
+		in, out := &in.Tags, &out.Tags
+		*out = make([]Tag, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TagSpecification.
+func (in *TagSpecification) DeepCopy() *TagSpecification {
+	if in == nil {
+		return nil
+	}
+	out := new(TagSpecification)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *VPCCIDRBlock) DeepCopyInto(out *VPCCIDRBlock) {
 	*out = *in
 	out.TypeMeta = in.TypeMeta
 	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
@@ -1545,18 +2615,18 @@ func (in *SecurityGroupRule) DeepCopyInto(out *SecurityGroupRule) {
 	in.Status.DeepCopyInto(&out.Status)
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SecurityGroupRule.
-func (in *SecurityGroupRule) DeepCopy() *SecurityGroupRule {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VPCCIDRBlock.
+func (in *VPCCIDRBlock) DeepCopy() *VPCCIDRBlock {
 	if in == nil {
 		return nil
 	}
-	out := new(SecurityGroupRule)
+	out := new(VPCCIDRBlock)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
-func (in *SecurityGroupRule) DeepCopyObject() runtime.Object {
+func (in *VPCCIDRBlock) DeepCopyObject() runtime.Object {
 	if c := in.DeepCopy(); c != nil {
 		return c
 	}
@@ -1564,31 +2634,31 @@ func (in *SecurityGroupRule) DeepCopyObject() runtime.Object {
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *SecurityGroupRuleList) DeepCopyInto(out *SecurityGroupRuleList) {
+func (in *VPCCIDRBlockList) DeepCopyInto(out *VPCCIDRBlockList) {
 	*out = *in
 	out.TypeMeta = in.TypeMeta
 	in.ListMeta.DeepCopyInto(&out.ListMeta)
 	if in.Items != nil {
 		in, out := &in.Items, &out.Items
-		*out = make([]SecurityGroupRule, len(*in))
+		*out = make([]VPCCIDRBlock, len(*in))
 		for i := range *in {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SecurityGroupRuleList.
-func (in *SecurityGroupRuleList) DeepCopy() *SecurityGroupRuleList {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VPCCIDRBlockList.
+func (in *VPCCIDRBlockList) DeepCopy() *VPCCIDRBlockList {
 	if in == nil {
 		return nil
 	}
-	out := new(SecurityGroupRuleList)
+	out := new(VPCCIDRBlockList)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
-func (in *SecurityGroupRuleList) DeepCopyObject() runtime.Object {
+func (in *VPCCIDRBlockList) DeepCopyObject() runtime.Object {
 	if c := in.DeepCopy(); c != nil {
 		return c
 	}
@@ -1596,134 +2666,129 @@ func (in *SecurityGroupRuleList) DeepCopyObject() runtime.Object {
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *SecurityGroupRuleObservation) DeepCopyInto(out *SecurityGroupRuleObservation) {
+func (in *VPCCIDRBlockObservation) DeepCopyInto(out *VPCCIDRBlockObservation) {
 	*out = *in
-	if in.SecurityGroupRuleID != nil {
-		in, out := &in.SecurityGroupRuleID, &out.SecurityGroupRuleID
+	if in.AssociationID != nil {
+		in, out := &in.AssociationID, &out.AssociationID
 		*out = new(string)
 		**out = **in
 	}
-}
-
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SecurityGroupRuleObservation.
-func (in *SecurityGroupRuleObservation) DeepCopy() *SecurityGroupRuleObservation {
-	if in == nil {
-		return nil
-	}
-	out := new(SecurityGroupRuleObservation)
-	in.DeepCopyInto(out)
-	return out
-}
-
-// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *SecurityGroupRuleParameters) DeepCopyInto(out *SecurityGroupRuleParameters) {
-	*out = *in
-	if in.FromPort != nil {
-		in, out := &in.FromPort, &out.FromPort
-		*out = new(int32)
-		**out = **in
-	}
-	if in.ToPort != nil {
-		in, out := &in.ToPort, &out.ToPort
-		*out = new(int32)
+	if in.CIDRBlock != nil {
+		in, out := &in.CIDRBlock, &out.CIDRBlock
+		*out = new(string)
 		**out = **in
 	}
-	if in.Type != nil {
-		in, out := &in.Type, &out.Type
+	if in.IPv6CIDRBlock != nil {
+		in, out := &in.IPv6CIDRBlock, &out.IPv6CIDRBlock
 		*out = new(string)
 		**out = **in
 	}
-	if in.Protocol != nil {
-		in, out := &in.Protocol, &out.Protocol
+	if in.IPv6CIDRBlockState != nil {
+		in, out := &in.IPv6CIDRBlockState, &out.IPv6CIDRBlockState
+		*out = new(VPCCIDRBlockState)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.IPv6Pool != nil {
+		in, out := &in.IPv6Pool, &out.IPv6Pool
 		*out = new(string)
 		**out = **in
 	}
-	if in.CidrBlock != nil {
-		in, out := &in.CidrBlock, &out.CidrBlock
+	if in.NetworkBorderGroup != nil {
+		in, out := &in.NetworkBorderGroup, &out.NetworkBorderGroup
 		*out = new(string)
 		**out = **in
 	}
-	if in.Ipv6CidrBlock != nil {
-		in, out := &in.Ipv6CidrBlock, &out.Ipv6CidrBlock
-		*out = new(string)
+	if in.CIDRBlockState != nil {
+		in, out := &in.CIDRBlockState, &out.CIDRBlockState
+		*out = new(VPCCIDRBlockState)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VPCCIDRBlockObservation.
+func (in *VPCCIDRBlockObservation) DeepCopy() *VPCCIDRBlockObservation {
+	if in == nil {
+		return nil
+	}
+	out := new(VPCCIDRBlockObservation)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *VPCCIDRBlockParameters) DeepCopyInto(out *VPCCIDRBlockParameters) {
+	*out = *in
+	if in.AmazonProvidedIPv6CIDRBlock != nil {
+		in, out := &in.AmazonProvidedIPv6CIDRBlock, &out.AmazonProvidedIPv6CIDRBlock
+		*out = new(bool)
 		**out = **in
 	}
-	if in.Description != nil {
-		in, out := &in.Description, &out.Description
+	if in.CIDRBlock != nil {
+		in, out := &in.CIDRBlock, &out.CIDRBlock
 		*out = new(string)
 		**out = **in
 	}
-	if in.PrefixListID != nil {
-		in, out := &in.PrefixListID, &out.PrefixListID
+	if in.IPv6CIDRBlock != nil {
+		in, out := &in.IPv6CIDRBlock, &out.IPv6CIDRBlock
 		*out = new(string)
 		**out = **in
 	}
-	if in.Region != nil {
-		in, out := &in.Region, &out.Region
+	if in.IPv6CIDRBlockNetworkBorderGroup != nil {
+		in, out := &in.IPv6CIDRBlockNetworkBorderGroup, &out.IPv6CIDRBlockNetworkBorderGroup
 		*out = new(string)
 		**out = **in
 	}
-	if in.SecurityGroupID != nil {
-		in, out := &in.SecurityGroupID, &out.SecurityGroupID
+	if in.IPv6Pool != nil {
+		in, out := &in.IPv6Pool, &out.IPv6Pool
 		*out = new(string)
 		**out = **in
 	}
-	if in.SecurityGroupIDRef != nil {
-		in, out := &in.SecurityGroupIDRef, &out.SecurityGroupIDRef
-		*out = new(v1.Reference)
-		(*in).DeepCopyInto(*out)
-	}
-	if in.SecurityGroupIDSelector != nil {
-		in, out := &in.SecurityGroupIDSelector, &out.SecurityGroupIDSelector
-		*out = new(v1.Selector)
-		(*in).DeepCopyInto(*out)
-	}
-	if in.SourceSecurityGroupID != nil {
-		in, out := &in.SourceSecurityGroupID, &out.SourceSecurityGroupID
+	if in.VPCID != nil {
+		in, out := &in.VPCID, &out.VPCID
 		*out = new(string)
 		**out = **in
 	}
-	if in.SourceSecurityGroupIDRef != nil {
-		in, out := &in.SourceSecurityGroupIDRef, &out.SourceSecurityGroupIDRef
+	if in.VPCIDRef != nil {
+		in, out := &in.VPCIDRef, &out.VPCIDRef
 		*out = new(v1.Reference)
 		(*in).DeepCopyInto(*out)
 	}
-	if in.SourceSecurityGroupIDSelector != nil {
-		in, out := &in.SourceSecurityGroupIDSelector, &out.SourceSecurityGroupIDSelector
+	if in.VPCIDSelector != nil {
+		in, out := &in.VPCIDSelector, &out.VPCIDSelector
 		*out = new(v1.Selector)
 		(*in).DeepCopyInto(*out)
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SecurityGroupRuleParameters.
-func (in *SecurityGroupRuleParameters) DeepCopy() *SecurityGroupRuleParameters {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VPCCIDRBlockParameters.
+func (in *VPCCIDRBlockParameters) DeepCopy() *VPCCIDRBlockParameters {
 	if in == nil {
 		return nil
 	}
-	out := new(SecurityGroupRuleParameters)
+	out := new(VPCCIDRBlockParameters)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *SecurityGroupRuleSpec) DeepCopyInto(out *SecurityGroupRuleSpec) {
+func (in *VPCCIDRBlockSpec) DeepCopyInto(out *VPCCIDRBlockSpec) {
 	*out = *in
 	in.ResourceSpec.DeepCopyInto(&out.ResourceSpec)
 	in.ForProvider.DeepCopyInto(&out.ForProvider)
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SecurityGroupRuleSpec.
-func (in *SecurityGroupRuleSpec) DeepCopy() *SecurityGroupRuleSpec {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VPCCIDRBlockSpec.
+func (in *VPCCIDRBlockSpec) DeepCopy() *VPCCIDRBlockSpec {
 	if in == nil {
 		return nil
 	}
-	out := new(SecurityGroupRuleSpec)
+	out := new(VPCCIDRBlockSpec)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *SecurityGroupRuleState) DeepCopyInto(out *SecurityGroupRuleState) {
+func (in *VPCCIDRBlockState) DeepCopyInto(out *VPCCIDRBlockState) {
 	*out = *in
 	if in.State != nil {
 		in, out := &in.State, &out.State
@@ -1737,129 +2802,220 @@ func (in *SecurityGroupRuleState) DeepCopyInto(out *SecurityGroupRuleState) {
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SecurityGroupRuleState.
-func (in *SecurityGroupRuleState) DeepCopy() *SecurityGroupRuleState {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VPCCIDRBlockState.
+func (in *VPCCIDRBlockState) DeepCopy() *VPCCIDRBlockState {
+	if in == nil {
+		return nil
+	}
+	out := new(VPCCIDRBlockState)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *VPCCIDRBlockStatus) DeepCopyInto(out *VPCCIDRBlockStatus) {
+	*out = *in
+	in.ResourceStatus.DeepCopyInto(&out.ResourceStatus)
+	in.AtProvider.DeepCopyInto(&out.AtProvider)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VPCCIDRBlockStatus.
+func (in *VPCCIDRBlockStatus) DeepCopy() *VPCCIDRBlockStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(VPCCIDRBlockStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *VPNConnection) DeepCopyInto(out *VPNConnection) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VPNConnection.
+func (in *VPNConnection) DeepCopy() *VPNConnection {
 	if in == nil {
 		return nil
 	}
-	out := new(SecurityGroupRuleState)
+	out := new(VPNConnection)
 	in.DeepCopyInto(out)
 	return out
 }
 
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *VPNConnection) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *SecurityGroupRuleStatus) DeepCopyInto(out *SecurityGroupRuleStatus) {
+func (in *VPNConnectionList) DeepCopyInto(out *VPNConnectionList) {
 	*out = *in
-	in.ResourceStatus.DeepCopyInto(&out.ResourceStatus)
-	in.AtProvider.DeepCopyInto(&out.AtProvider)
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]VPNConnection, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SecurityGroupRuleStatus.
-func (in *SecurityGroupRuleStatus) DeepCopy() *SecurityGroupRuleStatus {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VPNConnectionList.
+func (in *VPNConnectionList) DeepCopy() *VPNConnectionList {
 	if in == nil {
 		return nil
 	}
-	out := new(SecurityGroupRuleStatus)
+	out := new(VPNConnectionList)
 	in.DeepCopyInto(out)
 	return out
 }
 
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *VPNConnectionList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *SpotMarketOptions) DeepCopyInto(out *SpotMarketOptions) {
+func (in *VPNConnectionObservation) DeepCopyInto(out *VPNConnectionObservation) {
 	*out = *in
-	if in.BlockDurationMinutes != nil {
-		in, out := &in.BlockDurationMinutes, &out.BlockDurationMinutes
-		*out = new(int32)
-		**out = **in
-	}
-	if in.MaxPrice != nil {
-		in, out := &in.MaxPrice, &out.MaxPrice
-		*out = new(string)
-		**out = **in
+	if in.Routes != nil {
+		in, out := &in.Routes, &out.Routes
+		*out = make([]VPNStaticRoute, len(*in))
+		copy(*out, *in)
 	}
-	if in.ValidUntil != nil {
-		in, out := &in.ValidUntil, &out.ValidUntil
-		*out = (*in).DeepCopy()
+	if in.Tunnels != nil {
+		in, out := &in.Tunnels, &out.Tunnels
+		*out = make([]VPNTunnelTelemetry, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SpotMarketOptions.
-func (in *SpotMarketOptions) DeepCopy() *SpotMarketOptions {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VPNConnectionObservation.
+func (in *VPNConnectionObservation) DeepCopy() *VPNConnectionObservation {
 	if in == nil {
 		return nil
 	}
-	out := new(SpotMarketOptions)
+	out := new(VPNConnectionObservation)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *StateReason) DeepCopyInto(out *StateReason) {
+func (in *VPNConnectionParameters) DeepCopyInto(out *VPNConnectionParameters) {
 	*out = *in
-	if in.Code != nil {
-		in, out := &in.Code, &out.Code
+	if in.CustomerGatewayID != nil {
+		in, out := &in.CustomerGatewayID, &out.CustomerGatewayID
 		*out = new(string)
 		**out = **in
 	}
-	if in.Message != nil {
-		in, out := &in.Message, &out.Message
+	if in.CustomerGatewayIDRef != nil {
+		in, out := &in.CustomerGatewayIDRef, &out.CustomerGatewayIDRef
+		*out = new(v1.Reference)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.CustomerGatewayIDSelector != nil {
+		in, out := &in.CustomerGatewayIDSelector, &out.CustomerGatewayIDSelector
+		*out = new(v1.Selector)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.VPNGatewayID != nil {
+		in, out := &in.VPNGatewayID, &out.VPNGatewayID
+		*out = new(string)
+		**out = **in
+	}
+	if in.VPNGatewayIDRef != nil {
+		in, out := &in.VPNGatewayIDRef, &out.VPNGatewayIDRef
+		*out = new(v1.Reference)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.VPNGatewayIDSelector != nil {
+		in, out := &in.VPNGatewayIDSelector, &out.VPNGatewayIDSelector
+		*out = new(v1.Selector)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.TransitGatewayID != nil {
+		in, out := &in.TransitGatewayID, &out.TransitGatewayID
 		*out = new(string)
 		**out = **in
 	}
+	if in.StaticRoutesOnly != nil {
+		in, out := &in.StaticRoutesOnly, &out.StaticRoutesOnly
+		*out = new(bool)
+		**out = **in
+	}
+	if in.StaticRoutes != nil {
+		in, out := &in.StaticRoutes, &out.StaticRoutes
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.Tags != nil {
+		in, out := &in.Tags, &out.Tags
+		*out = make([]Tag, len(*in))
+		copy(*out, *in)
+	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new StateReason.
-func (in *StateReason) DeepCopy() *StateReason {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VPNConnectionParameters.
+func (in *VPNConnectionParameters) DeepCopy() *VPNConnectionParameters {
 	if in == nil {
 		return nil
 	}
-	out := new(StateReason)
+	out := new(VPNConnectionParameters)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *Tag) DeepCopyInto(out *Tag) {
+func (in *VPNConnectionSpec) DeepCopyInto(out *VPNConnectionSpec) {
 	*out = *in
+	in.ResourceSpec.DeepCopyInto(&out.ResourceSpec)
+	in.ForProvider.DeepCopyInto(&out.ForProvider)
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Tag.
-func (in *Tag) DeepCopy() *Tag {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VPNConnectionSpec.
+func (in *VPNConnectionSpec) DeepCopy() *VPNConnectionSpec {
 	if in == nil {
 		return nil
 	}
-	out := new(Tag)
+	out := new(VPNConnectionSpec)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *TagSpecification) DeepCopyInto(out *TagSpecification) {
+func (in *VPNConnectionStatus) DeepCopyInto(out *VPNConnectionStatus) {
 	*out = *in
-	if in.ResourceType != nil {
-		in, out := &in.ResourceType, &out.ResourceType
-		*out = new(string)
-		**out = **in
-	}
-	if in.Tags != nil {
-		in, out := &in.Tags, &out.Tags
-		*out = make([]Tag, len(*in))
-		copy(*out, *in)
-	}
+	in.ResourceStatus.DeepCopyInto(&out.ResourceStatus)
+	in.AtProvider.DeepCopyInto(&out.AtProvider)
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TagSpecification.
-func (in *TagSpecification) DeepCopy() *TagSpecification {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VPNConnectionStatus.
+func (in *VPNConnectionStatus) DeepCopy() *VPNConnectionStatus {
 	if in == nil {
 		return nil
 	}
-	out := new(TagSpecification)
+	out := new(VPNConnectionStatus)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *VPCCIDRBlock) DeepCopyInto(out *VPCCIDRBlock) {
+func (in *VPNGateway) DeepCopyInto(out *VPNGateway) {
 	*out = *in
 	out.TypeMeta = in.TypeMeta
 	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
@@ -1867,18 +3023,18 @@ func (in *VPCCIDRBlock) DeepCopyInto(out *VPCCIDRBlock) {
 	in.Status.DeepCopyInto(&out.Status)
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VPCCIDRBlock.
-func (in *VPCCIDRBlock) DeepCopy() *VPCCIDRBlock {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VPNGateway.
+func (in *VPNGateway) DeepCopy() *VPNGateway {
 	if in == nil {
 		return nil
 	}
-	out := new(VPCCIDRBlock)
+	out := new(VPNGateway)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
-func (in *VPCCIDRBlock) DeepCopyObject() runtime.Object {
+func (in *VPNGateway) DeepCopyObject() runtime.Object {
 	if c := in.DeepCopy(); c != nil {
 		return c
 	}
@@ -1886,31 +3042,31 @@ func (in *VPCCIDRBlock) DeepCopyObject() runtime.Object {
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *VPCCIDRBlockList) DeepCopyInto(out *VPCCIDRBlockList) {
+func (in *VPNGatewayList) DeepCopyInto(out *VPNGatewayList) {
 	*out = *in
 	out.TypeMeta = in.TypeMeta
 	in.ListMeta.DeepCopyInto(&out.ListMeta)
 	if in.Items != nil {
 		in, out := &in.Items, &out.Items
-		*out = make([]VPCCIDRBlock, len(*in))
+		*out = make([]VPNGateway, len(*in))
 		for i := range *in {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VPCCIDRBlockList.
-func (in *VPCCIDRBlockList) DeepCopy() *VPCCIDRBlockList {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VPNGatewayList.
+func (in *VPNGatewayList) DeepCopy() *VPNGatewayList {
 	if in == nil {
 		return nil
 	}
-	out := new(VPCCIDRBlockList)
+	out := new(VPNGatewayList)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
-func (in *VPCCIDRBlockList) DeepCopyObject() runtime.Object {
+func (in *VPNGatewayList) DeepCopyObject() runtime.Object {
 	if c := in.DeepCopy(); c != nil {
 		return c
 	}
@@ -1918,80 +3074,45 @@ func (in *VPCCIDRBlockList) DeepCopyObject() runtime.Object {
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *VPCCIDRBlockObservation) DeepCopyInto(out *VPCCIDRBlockObservation) {
+func (in *VPNGatewayObservation) DeepCopyInto(out *VPNGatewayObservation) {
 	*out = *in
-	if in.AssociationID != nil {
-		in, out := &in.AssociationID, &out.AssociationID
-		*out = new(string)
-		**out = **in
-	}
-	if in.CIDRBlock != nil {
-		in, out := &in.CIDRBlock, &out.CIDRBlock
-		*out = new(string)
-		**out = **in
-	}
-	if in.IPv6CIDRBlock != nil {
-		in, out := &in.IPv6CIDRBlock, &out.IPv6CIDRBlock
-		*out = new(string)
+	if in.AmazonSideASN != nil {
+		in, out := &in.AmazonSideASN, &out.AmazonSideASN
+		*out = new(int64)
 		**out = **in
 	}
-	if in.IPv6CIDRBlockState != nil {
-		in, out := &in.IPv6CIDRBlockState, &out.IPv6CIDRBlockState
-		*out = new(VPCCIDRBlockState)
-		(*in).DeepCopyInto(*out)
-	}
-	if in.IPv6Pool != nil {
-		in, out := &in.IPv6Pool, &out.IPv6Pool
-		*out = new(string)
-		**out = **in
-	}
-	if in.NetworkBorderGroup != nil {
-		in, out := &in.NetworkBorderGroup, &out.NetworkBorderGroup
-		*out = new(string)
-		**out = **in
+	if in.VPCAttachments != nil {
+		in, out := &in.VPCAttachments, &out.VPCAttachments
+		*out = make([]VPNGatewayVPCAttachment, len(*in))
+		copy(*out, *in)
 	}
-	if in.CIDRBlockState != nil {
-		in, out := &in.CIDRBlockState, &out.CIDRBlockState
-		*out = new(VPCCIDRBlockState)
-		(*in).DeepCopyInto(*out)
+	if in.PropagatingRouteTableIDs != nil {
+		in, out := &in.PropagatingRouteTableIDs, &out.PropagatingRouteTableIDs
+		*out = make([]string, len(*in))
+		copy(*out, *in)
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VPCCIDRBlockObservation.
-func (in *VPCCIDRBlockObservation) DeepCopy() *VPCCIDRBlockObservation {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VPNGatewayObservation.
+func (in *VPNGatewayObservation) DeepCopy() *VPNGatewayObservation {
 	if in == nil {
 		return nil
 	}
-	out := new(VPCCIDRBlockObservation)
+	out := new(VPNGatewayObservation)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *VPCCIDRBlockParameters) DeepCopyInto(out *VPCCIDRBlockParameters) {
+func (in *VPNGatewayParameters) DeepCopyInto(out *VPNGatewayParameters) {
 	*out = *in
-	if in.AmazonProvidedIPv6CIDRBlock != nil {
-		in, out := &in.AmazonProvidedIPv6CIDRBlock, &out.AmazonProvidedIPv6CIDRBlock
-		*out = new(bool)
-		**out = **in
-	}
-	if in.CIDRBlock != nil {
-		in, out := &in.CIDRBlock, &out.CIDRBlock
-		*out = new(string)
-		**out = **in
-	}
-	if in.IPv6CIDRBlock != nil {
-		in, out := &in.IPv6CIDRBlock, &out.IPv6CIDRBlock
-		*out = new(string)
-		**out = **in
-	}
-	if in.IPv6CIDRBlockNetworkBorderGroup != nil {
-		in, out := &in.IPv6CIDRBlockNetworkBorderGroup, &out.IPv6CIDRBlockNetworkBorderGroup
-		*out = new(string)
+	if in.AmazonSideASN != nil {
+		in, out := &in.AmazonSideASN, &out.AmazonSideASN
+		*out = new(int64)
 		**out = **in
 	}
-	if in.IPv6Pool != nil {
-		in, out := &in.IPv6Pool, &out.IPv6Pool
+	if in.AvailabilityZone != nil {
+		in, out := &in.AvailabilityZone, &out.AvailabilityZone
 		*out = new(string)
 		**out = **in
 	}
@@ -2010,73 +3131,119 @@ func (in *VPCCIDRBlockParameters) DeepCopyInto(out *VPCCIDRBlockParameters) {
 		*out = new(v1.Selector)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.RouteTableIDs != nil {
+		in, out := &in.RouteTableIDs, &out.RouteTableIDs
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.RouteTableIDRefs != nil {
+		in, out := &in.RouteTableIDRefs, &out.RouteTableIDRefs
+		*out = make([]v1.Reference, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.RouteTableIDSelector != nil {
+		in, out := &in.RouteTableIDSelector, &out.RouteTableIDSelector
+		*out = new(v1.Selector)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Tags != nil {
+		in, out := &in.Tags, &out.Tags
+		*out = make([]Tag, len(*in))
+		copy(*out, *in)
+	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VPCCIDRBlockParameters.
-func (in *VPCCIDRBlockParameters) DeepCopy() *VPCCIDRBlockParameters {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VPNGatewayParameters.
+func (in *VPNGatewayParameters) DeepCopy() *VPNGatewayParameters {
 	if in == nil {
 		return nil
 	}
-	out := new(VPCCIDRBlockParameters)
+	out := new(VPNGatewayParameters)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *VPCCIDRBlockSpec) DeepCopyInto(out *VPCCIDRBlockSpec) {
+func (in *VPNGatewaySpec) DeepCopyInto(out *VPNGatewaySpec) {
 	*out = *in
 	in.ResourceSpec.DeepCopyInto(&out.ResourceSpec)
 	in.ForProvider.DeepCopyInto(&out.ForProvider)
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VPCCIDRBlockSpec.
-func (in *VPCCIDRBlockSpec) DeepCopy() *VPCCIDRBlockSpec {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VPNGatewaySpec.
+func (in *VPNGatewaySpec) DeepCopy() *VPNGatewaySpec {
 	if in == nil {
 		return nil
 	}
-	out := new(VPCCIDRBlockSpec)
+	out := new(VPNGatewaySpec)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *VPCCIDRBlockState) DeepCopyInto(out *VPCCIDRBlockState) {
+func (in *VPNGatewayStatus) DeepCopyInto(out *VPNGatewayStatus) {
 	*out = *in
-	if in.State != nil {
-		in, out := &in.State, &out.State
-		*out = new(string)
-		**out = **in
+	in.ResourceStatus.DeepCopyInto(&out.ResourceStatus)
+	in.AtProvider.DeepCopyInto(&out.AtProvider)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VPNGatewayStatus.
+func (in *VPNGatewayStatus) DeepCopy() *VPNGatewayStatus {
+	if in == nil {
+		return nil
 	}
-	if in.StatusMessage != nil {
-		in, out := &in.StatusMessage, &out.StatusMessage
-		*out = new(string)
-		**out = **in
+	out := new(VPNGatewayStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *VPNGatewayVPCAttachment) DeepCopyInto(out *VPNGatewayVPCAttachment) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VPNGatewayVPCAttachment.
+func (in *VPNGatewayVPCAttachment) DeepCopy() *VPNGatewayVPCAttachment {
+	if in == nil {
+		return nil
 	}
+	out := new(VPNGatewayVPCAttachment)
+	in.DeepCopyInto(out)
+	return out
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VPCCIDRBlockState.
-func (in *VPCCIDRBlockState) DeepCopy() *VPCCIDRBlockState {
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *VPNStaticRoute) DeepCopyInto(out *VPNStaticRoute) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VPNStaticRoute.
+func (in *VPNStaticRoute) DeepCopy() *VPNStaticRoute {
 	if in == nil {
 		return nil
 	}
-	out := new(VPCCIDRBlockState)
+	out := new(VPNStaticRoute)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *VPCCIDRBlockStatus) DeepCopyInto(out *VPCCIDRBlockStatus) {
+func (in *VPNTunnelTelemetry) DeepCopyInto(out *VPNTunnelTelemetry) {
 	*out = *in
-	in.ResourceStatus.DeepCopyInto(&out.ResourceStatus)
-	in.AtProvider.DeepCopyInto(&out.AtProvider)
+	if in.LastStatusChange != nil {
+		in, out := &in.LastStatusChange, &out.LastStatusChange
+		*out = (*in).DeepCopy()
+	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VPCCIDRBlockStatus.
-func (in *VPCCIDRBlockStatus) DeepCopy() *VPCCIDRBlockStatus {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VPNTunnelTelemetry.
+func (in *VPNTunnelTelemetry) DeepCopy() *VPNTunnelTelemetry {
 	if in == nil {
 		return nil
 	}
-	out := new(VPCCIDRBlockStatus)
+	out := new(VPNTunnelTelemetry)
 	in.DeepCopyInto(out)
 	return out
 }
@@ -0,0 +1,62 @@
+/*
+Copyright 2023 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	"reflect"
+
+	"k8s.io/apimachinery/pkg/runtime/schema"
+)
+
+// TransitGatewayRouteTableAssociation type metadata.
+var (
+	TransitGatewayRouteTableAssociationKind             = reflect.TypeOf(TransitGatewayRouteTableAssociation{}).Name()
+	TransitGatewayRouteTableAssociationGroupKind        = schema.GroupKind{Group: CRDGroup, Kind: TransitGatewayRouteTableAssociationKind}.String()
+	TransitGatewayRouteTableAssociationKindAPIVersion   = TransitGatewayRouteTableAssociationKind + "." + GroupVersion.String()
+	TransitGatewayRouteTableAssociationGroupVersionKind = GroupVersion.WithKind(TransitGatewayRouteTableAssociationKind)
+)
+
+// TransitGatewayRouteTablePropagation type metadata.
+var (
+	TransitGatewayRouteTablePropagationKind             = reflect.TypeOf(TransitGatewayRouteTablePropagation{}).Name()
+	TransitGatewayRouteTablePropagationGroupKind        = schema.GroupKind{Group: CRDGroup, Kind: TransitGatewayRouteTablePropagationKind}.String()
+	TransitGatewayRouteTablePropagationKindAPIVersion   = TransitGatewayRouteTablePropagationKind + "." + GroupVersion.String()
+	TransitGatewayRouteTablePropagationGroupVersionKind = GroupVersion.WithKind(TransitGatewayRouteTablePropagationKind)
+)
+
+// TransitGatewayPeeringAttachment type metadata.
+var (
+	TransitGatewayPeeringAttachmentKind             = reflect.TypeOf(TransitGatewayPeeringAttachment{}).Name()
+	TransitGatewayPeeringAttachmentGroupKind        = schema.GroupKind{Group: CRDGroup, Kind: TransitGatewayPeeringAttachmentKind}.String()
+	TransitGatewayPeeringAttachmentKindAPIVersion   = TransitGatewayPeeringAttachmentKind + "." + GroupVersion.String()
+	TransitGatewayPeeringAttachmentGroupVersionKind = GroupVersion.WithKind(TransitGatewayPeeringAttachmentKind)
+)
+
+// TransitGatewayPeeringAttachmentAccepter type metadata.
+var (
+	TransitGatewayPeeringAttachmentAccepterKind             = reflect.TypeOf(TransitGatewayPeeringAttachmentAccepter{}).Name()
+	TransitGatewayPeeringAttachmentAccepterGroupKind        = schema.GroupKind{Group: CRDGroup, Kind: TransitGatewayPeeringAttachmentAccepterKind}.String()
+	TransitGatewayPeeringAttachmentAccepterKindAPIVersion   = TransitGatewayPeeringAttachmentAccepterKind + "." + GroupVersion.String()
+	TransitGatewayPeeringAttachmentAccepterGroupVersionKind = GroupVersion.WithKind(TransitGatewayPeeringAttachmentAccepterKind)
+)
+
+func init() {
+	SchemeBuilder.Register(&TransitGatewayRouteTableAssociation{}, &TransitGatewayRouteTableAssociationList{})
+	SchemeBuilder.Register(&TransitGatewayRouteTablePropagation{}, &TransitGatewayRouteTablePropagationList{})
+	SchemeBuilder.Register(&TransitGatewayPeeringAttachment{}, &TransitGatewayPeeringAttachmentList{})
+	SchemeBuilder.Register(&TransitGatewayPeeringAttachmentAccepter{}, &TransitGatewayPeeringAttachmentAccepterList{})
+}
@@ -0,0 +1,221 @@
+/*
+Copyright 2023 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+)
+
+// TransitGatewayPeeringAttachmentParameters define the desired state of a
+// peering attachment between two transit gateways.
+type TransitGatewayPeeringAttachmentParameters struct {
+	// Region is which region the TransitGatewayPeeringAttachment will be
+	// created.
+	// +kubebuilder:validation:Required
+	Region string `json:"region"`
+
+	// The ID of the transit gateway that requests the peering.
+	// +optional
+	// +immutable
+	// +crossplane:generate:reference:type=TransitGateway
+	TransitGatewayID *string `json:"transitGatewayId,omitempty"`
+
+	// TransitGatewayIDRef is a reference to an API used to set
+	// the TransitGatewayID.
+	// +optional
+	TransitGatewayIDRef *xpv1.Reference `json:"transitGatewayIdRef,omitempty"`
+
+	// TransitGatewayIDSelector selects references to API used
+	// to set the TransitGatewayID.
+	// +optional
+	TransitGatewayIDSelector *xpv1.Selector `json:"transitGatewayIdSelector,omitempty"`
+
+	// The ID of the peer transit gateway.
+	// +optional
+	// +immutable
+	// +crossplane:generate:reference:type=TransitGateway
+	PeerTransitGatewayID *string `json:"peerTransitGatewayId,omitempty"`
+
+	// PeerTransitGatewayIDRef is a reference to an API used to set
+	// the PeerTransitGatewayID.
+	// +optional
+	PeerTransitGatewayIDRef *xpv1.Reference `json:"peerTransitGatewayIdRef,omitempty"`
+
+	// PeerTransitGatewayIDSelector selects references to API used
+	// to set the PeerTransitGatewayID.
+	// +optional
+	PeerTransitGatewayIDSelector *xpv1.Selector `json:"peerTransitGatewayIdSelector,omitempty"`
+
+	// The ID of the Amazon Web Services account that owns the peer transit
+	// gateway.
+	// +kubebuilder:validation:Required
+	// +immutable
+	PeerAccountID string `json:"peerAccountId"`
+
+	// The region where the peer transit gateway is located.
+	// +kubebuilder:validation:Required
+	// +immutable
+	PeerRegion string `json:"peerRegion"`
+
+	// Metadata tagging key value pairs
+	// +optional
+	Tags []Tag `json:"tags,omitempty"`
+}
+
+// A TransitGatewayPeeringAttachmentSpec defines the desired state of a
+// TransitGatewayPeeringAttachment.
+type TransitGatewayPeeringAttachmentSpec struct {
+	xpv1.ResourceSpec `json:",inline"`
+	ForProvider       TransitGatewayPeeringAttachmentParameters `json:"forProvider"`
+}
+
+// TransitGatewayPeeringAttachmentObservation keeps the state for the external
+// resource.
+type TransitGatewayPeeringAttachmentObservation struct {
+	// The ID of the attachment.
+	TransitGatewayAttachmentID string `json:"transitGatewayAttachmentId,omitempty"`
+
+	// The state of the attachment.
+	State string `json:"state,omitempty"`
+
+	// The status code and message of the attachment.
+	Status *PeeringAttachmentStatus `json:"status,omitempty"`
+
+	// Information about the requester transit gateway.
+	RequesterTGWInfo *PeeringTgwInfo `json:"requesterTgwInfo,omitempty"`
+
+	// Information about the accepter transit gateway.
+	AccepterTGWInfo *PeeringTgwInfo `json:"accepterTgwInfo,omitempty"`
+
+	// The time the attachment was created.
+	CreationTime *metav1.Time `json:"creationTime,omitempty"`
+}
+
+// A TransitGatewayPeeringAttachmentStatus represents the observed state of a
+// TransitGatewayPeeringAttachment.
+type TransitGatewayPeeringAttachmentStatus struct {
+	xpv1.ResourceStatus `json:",inline"`
+	AtProvider          TransitGatewayPeeringAttachmentObservation `json:"atProvider,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// A TransitGatewayPeeringAttachment is a managed resource that represents the
+// request to peer two transit gateways, which can be located in different
+// regions and accounts. The peering is only available once it has been
+// accepted by the owner of the peer transit gateway, for example with a
+// TransitGatewayPeeringAttachmentAccepter.
+// +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="EXTERNAL-NAME",type="string",JSONPath=".metadata.annotations.crossplane\\.io/external-name"
+// +kubebuilder:printcolumn:name="STATE",type="string",JSONPath=".status.atProvider.state"
+// +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:scope=Cluster,categories={crossplane,managed,aws}
+type TransitGatewayPeeringAttachment struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   TransitGatewayPeeringAttachmentSpec   `json:"spec"`
+	Status TransitGatewayPeeringAttachmentStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// TransitGatewayPeeringAttachmentList contains a list of
+// TransitGatewayPeeringAttachments
+type TransitGatewayPeeringAttachmentList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []TransitGatewayPeeringAttachment `json:"items"`
+}
+
+// TransitGatewayPeeringAttachmentAccepterParameters define the desired state
+// of the acceptance of a transit gateway peering attachment.
+type TransitGatewayPeeringAttachmentAccepterParameters struct {
+	// Region is the region of the peer transit gateway, which accepts the
+	// peering attachment.
+	// +kubebuilder:validation:Required
+	Region string `json:"region"`
+
+	// The ID of the peering attachment to accept.
+	// +optional
+	// +immutable
+	// +crossplane:generate:reference:type=TransitGatewayPeeringAttachment
+	TransitGatewayAttachmentID *string `json:"transitGatewayAttachmentId,omitempty"`
+
+	// TransitGatewayAttachmentIDRef is a reference to an API used to set
+	// the TransitGatewayAttachmentID.
+	// +optional
+	TransitGatewayAttachmentIDRef *xpv1.Reference `json:"transitGatewayAttachmentIdRef,omitempty"`
+
+	// TransitGatewayAttachmentIDSelector selects references to API used
+	// to set the TransitGatewayAttachmentID.
+	// +optional
+	TransitGatewayAttachmentIDSelector *xpv1.Selector `json:"transitGatewayAttachmentIdSelector,omitempty"`
+
+	// Metadata tagging key value pairs of the attachment in the accepter
+	// region.
+	// +optional
+	Tags []Tag `json:"tags,omitempty"`
+}
+
+// A TransitGatewayPeeringAttachmentAccepterSpec defines the desired state of a
+// TransitGatewayPeeringAttachmentAccepter.
+type TransitGatewayPeeringAttachmentAccepterSpec struct {
+	xpv1.ResourceSpec `json:",inline"`
+	ForProvider       TransitGatewayPeeringAttachmentAccepterParameters `json:"forProvider"`
+}
+
+// A TransitGatewayPeeringAttachmentAccepterStatus represents the observed
+// state of a TransitGatewayPeeringAttachmentAccepter.
+type TransitGatewayPeeringAttachmentAccepterStatus struct {
+	xpv1.ResourceStatus `json:",inline"`
+	AtProvider          TransitGatewayPeeringAttachmentObservation `json:"atProvider,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// A TransitGatewayPeeringAttachmentAccepter is a managed resource that accepts
+// a transit gateway peering attachment on the side of the peer transit
+// gateway. Deleting it deletes the peering attachment.
+// +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="EXTERNAL-NAME",type="string",JSONPath=".metadata.annotations.crossplane\\.io/external-name"
+// +kubebuilder:printcolumn:name="STATE",type="string",JSONPath=".status.atProvider.state"
+// +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:scope=Cluster,categories={crossplane,managed,aws}
+type TransitGatewayPeeringAttachmentAccepter struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   TransitGatewayPeeringAttachmentAccepterSpec   `json:"spec"`
+	Status TransitGatewayPeeringAttachmentAccepterStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// TransitGatewayPeeringAttachmentAccepterList contains a list of
+// TransitGatewayPeeringAttachmentAccepters
+type TransitGatewayPeeringAttachmentAccepterList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []TransitGatewayPeeringAttachmentAccepter `json:"items"`
+}
@@ -0,0 +1,123 @@
+/*
+Copyright 2023 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+)
+
+// TransitGatewayRouteTableAssociationParameters define the desired state of
+// an association between a transit gateway attachment and a transit gateway
+// route table.
+type TransitGatewayRouteTableAssociationParameters struct {
+	// Region is which region the TransitGatewayRouteTableAssociation will be
+	// created.
+	// +kubebuilder:validation:Required
+	Region string `json:"region"`
+
+	// The ID of the transit gateway route table.
+	// +optional
+	// +immutable
+	// +crossplane:generate:reference:type=TransitGatewayRouteTable
+	TransitGatewayRouteTableID *string `json:"transitGatewayRouteTableId,omitempty"`
+
+	// TransitGatewayRouteTableIDRef is a reference to an API used to set
+	// the TransitGatewayRouteTableID.
+	// +optional
+	TransitGatewayRouteTableIDRef *xpv1.Reference `json:"transitGatewayRouteTableIdRef,omitempty"`
+
+	// TransitGatewayRouteTableIDSelector selects references to API used
+	// to set the TransitGatewayRouteTableID.
+	// +optional
+	TransitGatewayRouteTableIDSelector *xpv1.Selector `json:"transitGatewayRouteTableIdSelector,omitempty"`
+
+	// The ID of the attachment. An attachment can be associated with a single
+	// route table only.
+	// +optional
+	// +immutable
+	// +crossplane:generate:reference:type=TransitGatewayVPCAttachment
+	TransitGatewayAttachmentID *string `json:"transitGatewayAttachmentId,omitempty"`
+
+	// TransitGatewayAttachmentIDRef is a reference to an API used to set
+	// the TransitGatewayAttachmentID.
+	// +optional
+	TransitGatewayAttachmentIDRef *xpv1.Reference `json:"transitGatewayAttachmentIdRef,omitempty"`
+
+	// TransitGatewayAttachmentIDSelector selects references to API used
+	// to set the TransitGatewayAttachmentID.
+	// +optional
+	TransitGatewayAttachmentIDSelector *xpv1.Selector `json:"transitGatewayAttachmentIdSelector,omitempty"`
+}
+
+// A TransitGatewayRouteTableAssociationSpec defines the desired state of a
+// TransitGatewayRouteTableAssociation.
+type TransitGatewayRouteTableAssociationSpec struct {
+	xpv1.ResourceSpec `json:",inline"`
+	ForProvider       TransitGatewayRouteTableAssociationParameters `json:"forProvider"`
+}
+
+// TransitGatewayRouteTableAssociationObservation keeps the state for the
+// external resource.
+type TransitGatewayRouteTableAssociationObservation struct {
+	// The ID of the resource of the attachment.
+	ResourceID string `json:"resourceId,omitempty"`
+
+	// The type of the resource of the attachment.
+	ResourceType string `json:"resourceType,omitempty"`
+
+	// The state of the association.
+	State string `json:"state,omitempty"`
+}
+
+// A TransitGatewayRouteTableAssociationStatus represents the observed state of
+// a TransitGatewayRouteTableAssociation.
+type TransitGatewayRouteTableAssociationStatus struct {
+	xpv1.ResourceStatus `json:",inline"`
+	AtProvider          TransitGatewayRouteTableAssociationObservation `json:"atProvider,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// A TransitGatewayRouteTableAssociation is a managed resource that represents
+// the association of a transit gateway attachment with a transit gateway
+// route table.
+// +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="ROUTETABLE",type="string",JSONPath=".spec.forProvider.transitGatewayRouteTableId"
+// +kubebuilder:printcolumn:name="ATTACHMENT",type="string",JSONPath=".spec.forProvider.transitGatewayAttachmentId"
+// +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:scope=Cluster,categories={crossplane,managed,aws}
+type TransitGatewayRouteTableAssociation struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   TransitGatewayRouteTableAssociationSpec   `json:"spec"`
+	Status TransitGatewayRouteTableAssociationStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// TransitGatewayRouteTableAssociationList contains a list of
+// TransitGatewayRouteTableAssociations
+type TransitGatewayRouteTableAssociationList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []TransitGatewayRouteTableAssociation `json:"items"`
+}
@@ -3648,67 +3648,6 @@ func (in *CustomVolumeParameters) DeepCopy() *CustomVolumeParameters {
 	return out
 }
 
-// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *CustomerGateway) DeepCopyInto(out *CustomerGateway) {
-	*out = *in
-	if in.BGPASN != nil {
-		in, out := &in.BGPASN, &out.BGPASN
-		*out = new(string)
-		**out = **in
-	}
-	if in.CertificateARN != nil {
-		in, out := &in.CertificateARN, &out.CertificateARN
-		*out = new(string)
-		**out = **in
-	}
-	if in.CustomerGatewayID != nil {
-		in, out := &in.CustomerGatewayID, &out.CustomerGatewayID
-		*out = new(string)
-		**out = **in
-	}
-	if in.DeviceName != nil {
-		in, out := &in.DeviceName, &out.DeviceName
-		*out = new(string)
-		**out = **in
-	}
-	if in.IPAddress != nil {
-		in, out := &in.IPAddress, &out.IPAddress
-		*out = new(string)
-		**out = **in
-	}
-	if in.State != nil {
-		in, out := &in.State, &out.State
-		*out = new(string)
-		**out = **in
-	}
-	if in.Tags != nil {
-		in, out := &in.Tags, &out.Tags
-		*out = make([]*Tag, len(*in))
-		for i := range *in {
-			if (*in)[i] != nil {
-				in, out := &(*in)[i], &(*out)[i]
-				*out = new(Tag)
-				(*in).DeepCopyInto(*out)
-			}
-		}
-	}
-	if in.Type != nil {
-		in, out := &in.Type, &out.Type
-		*out = new(string)
-		**out = **in
-	}
-}
-
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CustomerGateway.
-func (in *CustomerGateway) DeepCopy() *CustomerGateway {
-	if in == nil {
-		return nil
-	}
-	out := new(CustomerGateway)
-	in.DeepCopyInto(out)
-	return out
-}
-
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *DHCPConfiguration) DeepCopyInto(out *DHCPConfiguration) {
 	*out = *in
@@ -12344,52 +12283,6 @@ func (in *NATGatewayAddress) DeepCopy() *NATGatewayAddress {
 	return out
 }
 
-// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *NetworkACL) DeepCopyInto(out *NetworkACL) {
-	*out = *in
-	if in.IsDefault != nil {
-		in, out := &in.IsDefault, &out.IsDefault
-		*out = new(bool)
-		**out = **in
-	}
-	if in.NetworkACLID != nil {
-		in, out := &in.NetworkACLID, &out.NetworkACLID
-		*out = new(string)
-		**out = **in
-	}
-	if in.OwnerID != nil {
-		in, out := &in.OwnerID, &out.OwnerID
-		*out = new(string)
-		**out = **in
-	}
-	if in.Tags != nil {
-		in, out := &in.Tags, &out.Tags
-		*out = make([]*Tag, len(*in))
-		for i := range *in {
-			if (*in)[i] != nil {
-				in, out := &(*in)[i], &(*out)[i]
-				*out = new(Tag)
-				(*in).DeepCopyInto(*out)
-			}
-		}
-	}
-	if in.VPCID != nil {
-		in, out := &in.VPCID, &out.VPCID
-		*out = new(string)
-		**out = **in
-	}
-}
-
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NetworkACL.
-func (in *NetworkACL) DeepCopy() *NetworkACL {
-	if in == nil {
-		return nil
-	}
-	out := new(NetworkACL)
-	in.DeepCopyInto(out)
-	return out
-}
-
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *NetworkACLAssociation) DeepCopyInto(out *NetworkACLAssociation) {
 	*out = *in
@@ -12420,46 +12313,6 @@ func (in *NetworkACLAssociation) DeepCopy() *NetworkACLAssociation {
 	return out
 }
 
-// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *NetworkACLEntry) DeepCopyInto(out *NetworkACLEntry) {
-	*out = *in
-	if in.CIDRBlock != nil {
-		in, out := &in.CIDRBlock, &out.CIDRBlock
-		*out = new(string)
-		**out = **in
-	}
-	if in.Egress != nil {
-		in, out := &in.Egress, &out.Egress
-		*out = new(bool)
-		**out = **in
-	}
-	if in.IPv6CIDRBlock != nil {
-		in, out := &in.IPv6CIDRBlock, &out.IPv6CIDRBlock
-		*out = new(string)
-		**out = **in
-	}
-	if in.Protocol != nil {
-		in, out := &in.Protocol, &out.Protocol
-		*out = new(string)
-		**out = **in
-	}
-	if in.RuleNumber != nil {
-		in, out := &in.RuleNumber, &out.RuleNumber
-		*out = new(int64)
-		**out = **in
-	}
-}
-
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NetworkACLEntry.
-func (in *NetworkACLEntry) DeepCopy() *NetworkACLEntry {
-	if in == nil {
-		return nil
-	}
-	out := new(NetworkACLEntry)
-	in.DeepCopyInto(out)
-	return out
-}
-
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *NetworkBandwidthGbps) DeepCopyInto(out *NetworkBandwidthGbps) {
 	*out = *in
@@ -20154,36 +20007,10 @@ func (in *TransitGatewayParameters) DeepCopy() *TransitGatewayParameters {
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *TransitGatewayPeeringAttachment) DeepCopyInto(out *TransitGatewayPeeringAttachment) {
 	*out = *in
-	if in.AccepterTransitGatewayAttachmentID != nil {
-		in, out := &in.AccepterTransitGatewayAttachmentID, &out.AccepterTransitGatewayAttachmentID
-		*out = new(string)
-		**out = **in
-	}
-	if in.CreationTime != nil {
-		in, out := &in.CreationTime, &out.CreationTime
-		*out = (*in).DeepCopy()
-	}
-	if in.State != nil {
-		in, out := &in.State, &out.State
-		*out = new(string)
-		**out = **in
-	}
-	if in.Tags != nil {
-		in, out := &in.Tags, &out.Tags
-		*out = make([]*Tag, len(*in))
-		for i := range *in {
-			if (*in)[i] != nil {
-				in, out := &(*in)[i], &(*out)[i]
-				*out = new(Tag)
-				(*in).DeepCopyInto(*out)
-			}
-		}
-	}
-	if in.TransitGatewayAttachmentID != nil {
-		in, out := &in.TransitGatewayAttachmentID, &out.TransitGatewayAttachmentID
-		*out = new(string)
-		**out = **in
-	}
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TransitGatewayPeeringAttachment.
@@ -20196,67 +20023,357 @@ func (in *TransitGatewayPeeringAttachment) DeepCopy() *TransitGatewayPeeringAtta
 	return out
 }
 
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *TransitGatewayPeeringAttachment) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *TransitGatewayPolicyRule) DeepCopyInto(out *TransitGatewayPolicyRule) {
+func (in *TransitGatewayPeeringAttachmentAccepter) DeepCopyInto(out *TransitGatewayPeeringAttachmentAccepter) {
 	*out = *in
-	if in.DestinationCIDRBlock != nil {
-		in, out := &in.DestinationCIDRBlock, &out.DestinationCIDRBlock
-		*out = new(string)
-		**out = **in
-	}
-	if in.DestinationPortRange != nil {
-		in, out := &in.DestinationPortRange, &out.DestinationPortRange
-		*out = new(string)
-		**out = **in
-	}
-	if in.Protocol != nil {
-		in, out := &in.Protocol, &out.Protocol
-		*out = new(string)
-		**out = **in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TransitGatewayPeeringAttachmentAccepter.
+func (in *TransitGatewayPeeringAttachmentAccepter) DeepCopy() *TransitGatewayPeeringAttachmentAccepter {
+	if in == nil {
+		return nil
 	}
-	if in.SourceCIDRBlock != nil {
-		in, out := &in.SourceCIDRBlock, &out.SourceCIDRBlock
-		*out = new(string)
-		**out = **in
+	out := new(TransitGatewayPeeringAttachmentAccepter)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *TransitGatewayPeeringAttachmentAccepter) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
 	}
-	if in.SourcePortRange != nil {
-		in, out := &in.SourcePortRange, &out.SourcePortRange
-		*out = new(string)
-		**out = **in
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *TransitGatewayPeeringAttachmentAccepterList) DeepCopyInto(out *TransitGatewayPeeringAttachmentAccepterList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]TransitGatewayPeeringAttachmentAccepter, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TransitGatewayPolicyRule.
-func (in *TransitGatewayPolicyRule) DeepCopy() *TransitGatewayPolicyRule {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TransitGatewayPeeringAttachmentAccepterList.
+func (in *TransitGatewayPeeringAttachmentAccepterList) DeepCopy() *TransitGatewayPeeringAttachmentAccepterList {
 	if in == nil {
 		return nil
 	}
-	out := new(TransitGatewayPolicyRule)
+	out := new(TransitGatewayPeeringAttachmentAccepterList)
 	in.DeepCopyInto(out)
 	return out
 }
 
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *TransitGatewayPeeringAttachmentAccepterList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *TransitGatewayPolicyRuleMetaData) DeepCopyInto(out *TransitGatewayPolicyRuleMetaData) {
+func (in *TransitGatewayPeeringAttachmentAccepterParameters) DeepCopyInto(out *TransitGatewayPeeringAttachmentAccepterParameters) {
 	*out = *in
-	if in.MetaDataKey != nil {
-		in, out := &in.MetaDataKey, &out.MetaDataKey
+	if in.TransitGatewayAttachmentID != nil {
+		in, out := &in.TransitGatewayAttachmentID, &out.TransitGatewayAttachmentID
 		*out = new(string)
 		**out = **in
 	}
-	if in.MetaDataValue != nil {
-		in, out := &in.MetaDataValue, &out.MetaDataValue
-		*out = new(string)
-		**out = **in
+	if in.TransitGatewayAttachmentIDRef != nil {
+		in, out := &in.TransitGatewayAttachmentIDRef, &out.TransitGatewayAttachmentIDRef
+		*out = new(v1.Reference)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.TransitGatewayAttachmentIDSelector != nil {
+		in, out := &in.TransitGatewayAttachmentIDSelector, &out.TransitGatewayAttachmentIDSelector
+		*out = new(v1.Selector)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Tags != nil {
+		in, out := &in.Tags, &out.Tags
+		*out = make([]Tag, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TransitGatewayPolicyRuleMetaData.
-func (in *TransitGatewayPolicyRuleMetaData) DeepCopy() *TransitGatewayPolicyRuleMetaData {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TransitGatewayPeeringAttachmentAccepterParameters.
+func (in *TransitGatewayPeeringAttachmentAccepterParameters) DeepCopy() *TransitGatewayPeeringAttachmentAccepterParameters {
 	if in == nil {
 		return nil
 	}
-	out := new(TransitGatewayPolicyRuleMetaData)
+	out := new(TransitGatewayPeeringAttachmentAccepterParameters)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *TransitGatewayPeeringAttachmentAccepterSpec) DeepCopyInto(out *TransitGatewayPeeringAttachmentAccepterSpec) {
+	*out = *in
+	in.ResourceSpec.DeepCopyInto(&out.ResourceSpec)
+	in.ForProvider.DeepCopyInto(&out.ForProvider)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TransitGatewayPeeringAttachmentAccepterSpec.
+func (in *TransitGatewayPeeringAttachmentAccepterSpec) DeepCopy() *TransitGatewayPeeringAttachmentAccepterSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(TransitGatewayPeeringAttachmentAccepterSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *TransitGatewayPeeringAttachmentAccepterStatus) DeepCopyInto(out *TransitGatewayPeeringAttachmentAccepterStatus) {
+	*out = *in
+	in.ResourceStatus.DeepCopyInto(&out.ResourceStatus)
+	in.AtProvider.DeepCopyInto(&out.AtProvider)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TransitGatewayPeeringAttachmentAccepterStatus.
+func (in *TransitGatewayPeeringAttachmentAccepterStatus) DeepCopy() *TransitGatewayPeeringAttachmentAccepterStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(TransitGatewayPeeringAttachmentAccepterStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *TransitGatewayPeeringAttachmentList) DeepCopyInto(out *TransitGatewayPeeringAttachmentList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]TransitGatewayPeeringAttachment, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TransitGatewayPeeringAttachmentList.
+func (in *TransitGatewayPeeringAttachmentList) DeepCopy() *TransitGatewayPeeringAttachmentList {
+	if in == nil {
+		return nil
+	}
+	out := new(TransitGatewayPeeringAttachmentList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *TransitGatewayPeeringAttachmentList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *TransitGatewayPeeringAttachmentObservation) DeepCopyInto(out *TransitGatewayPeeringAttachmentObservation) {
+	*out = *in
+	if in.Status != nil {
+		in, out := &in.Status, &out.Status
+		*out = new(PeeringAttachmentStatus)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.RequesterTGWInfo != nil {
+		in, out := &in.RequesterTGWInfo, &out.RequesterTGWInfo
+		*out = new(PeeringTgwInfo)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.AccepterTGWInfo != nil {
+		in, out := &in.AccepterTGWInfo, &out.AccepterTGWInfo
+		*out = new(PeeringTgwInfo)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.CreationTime != nil {
+		in, out := &in.CreationTime, &out.CreationTime
+		*out = (*in).DeepCopy()
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TransitGatewayPeeringAttachmentObservation.
+func (in *TransitGatewayPeeringAttachmentObservation) DeepCopy() *TransitGatewayPeeringAttachmentObservation {
+	if in == nil {
+		return nil
+	}
+	out := new(TransitGatewayPeeringAttachmentObservation)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *TransitGatewayPeeringAttachmentParameters) DeepCopyInto(out *TransitGatewayPeeringAttachmentParameters) {
+	*out = *in
+	if in.TransitGatewayID != nil {
+		in, out := &in.TransitGatewayID, &out.TransitGatewayID
+		*out = new(string)
+		**out = **in
+	}
+	if in.TransitGatewayIDRef != nil {
+		in, out := &in.TransitGatewayIDRef, &out.TransitGatewayIDRef
+		*out = new(v1.Reference)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.TransitGatewayIDSelector != nil {
+		in, out := &in.TransitGatewayIDSelector, &out.TransitGatewayIDSelector
+		*out = new(v1.Selector)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.PeerTransitGatewayID != nil {
+		in, out := &in.PeerTransitGatewayID, &out.PeerTransitGatewayID
+		*out = new(string)
+		**out = **in
+	}
+	if in.PeerTransitGatewayIDRef != nil {
+		in, out := &in.PeerTransitGatewayIDRef, &out.PeerTransitGatewayIDRef
+		*out = new(v1.Reference)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.PeerTransitGatewayIDSelector != nil {
+		in, out := &in.PeerTransitGatewayIDSelector, &out.PeerTransitGatewayIDSelector
+		*out = new(v1.Selector)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Tags != nil {
+		in, out := &in.Tags, &out.Tags
+		*out = make([]Tag, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TransitGatewayPeeringAttachmentParameters.
+func (in *TransitGatewayPeeringAttachmentParameters) DeepCopy() *TransitGatewayPeeringAttachmentParameters {
+	if in == nil {
+		return nil
+	}
+	out := new(TransitGatewayPeeringAttachmentParameters)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *TransitGatewayPeeringAttachmentSpec) DeepCopyInto(out *TransitGatewayPeeringAttachmentSpec) {
+	*out = *in
+	in.ResourceSpec.DeepCopyInto(&out.ResourceSpec)
+	in.ForProvider.DeepCopyInto(&out.ForProvider)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TransitGatewayPeeringAttachmentSpec.
+func (in *TransitGatewayPeeringAttachmentSpec) DeepCopy() *TransitGatewayPeeringAttachmentSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(TransitGatewayPeeringAttachmentSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *TransitGatewayPeeringAttachmentStatus) DeepCopyInto(out *TransitGatewayPeeringAttachmentStatus) {
+	*out = *in
+	in.ResourceStatus.DeepCopyInto(&out.ResourceStatus)
+	in.AtProvider.DeepCopyInto(&out.AtProvider)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TransitGatewayPeeringAttachmentStatus.
+func (in *TransitGatewayPeeringAttachmentStatus) DeepCopy() *TransitGatewayPeeringAttachmentStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(TransitGatewayPeeringAttachmentStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *TransitGatewayPolicyRule) DeepCopyInto(out *TransitGatewayPolicyRule) {
+	*out = *in
+	if in.DestinationCIDRBlock != nil {
+		in, out := &in.DestinationCIDRBlock, &out.DestinationCIDRBlock
+		*out = new(string)
+		**out = **in
+	}
+	if in.DestinationPortRange != nil {
+		in, out := &in.DestinationPortRange, &out.DestinationPortRange
+		*out = new(string)
+		**out = **in
+	}
+	if in.Protocol != nil {
+		in, out := &in.Protocol, &out.Protocol
+		*out = new(string)
+		**out = **in
+	}
+	if in.SourceCIDRBlock != nil {
+		in, out := &in.SourceCIDRBlock, &out.SourceCIDRBlock
+		*out = new(string)
+		**out = **in
+	}
+	if in.SourcePortRange != nil {
+		in, out := &in.SourcePortRange, &out.SourcePortRange
+		*out = new(string)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TransitGatewayPolicyRule.
+func (in *TransitGatewayPolicyRule) DeepCopy() *TransitGatewayPolicyRule {
+	if in == nil {
+		return nil
+	}
+	out := new(TransitGatewayPolicyRule)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *TransitGatewayPolicyRuleMetaData) DeepCopyInto(out *TransitGatewayPolicyRuleMetaData) {
+	*out = *in
+	if in.MetaDataKey != nil {
+		in, out := &in.MetaDataKey, &out.MetaDataKey
+		*out = new(string)
+		**out = **in
+	}
+	if in.MetaDataValue != nil {
+		in, out := &in.MetaDataValue, &out.MetaDataValue
+		*out = new(string)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TransitGatewayPolicyRuleMetaData.
+func (in *TransitGatewayPolicyRuleMetaData) DeepCopy() *TransitGatewayPolicyRuleMetaData {
+	if in == nil {
+		return nil
+	}
+	out := new(TransitGatewayPolicyRuleMetaData)
 	in.DeepCopyInto(out)
 	return out
 }
@@ -20782,54 +20899,177 @@ func (in *TransitGatewayRouteTableAnnouncement) DeepCopyInto(out *TransitGateway
 		*out = new(string)
 		**out = **in
 	}
-	if in.TransitGatewayRouteTableAnnouncementID != nil {
-		in, out := &in.TransitGatewayRouteTableAnnouncementID, &out.TransitGatewayRouteTableAnnouncementID
+	if in.TransitGatewayRouteTableAnnouncementID != nil {
+		in, out := &in.TransitGatewayRouteTableAnnouncementID, &out.TransitGatewayRouteTableAnnouncementID
+		*out = new(string)
+		**out = **in
+	}
+	if in.TransitGatewayRouteTableID != nil {
+		in, out := &in.TransitGatewayRouteTableID, &out.TransitGatewayRouteTableID
+		*out = new(string)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TransitGatewayRouteTableAnnouncement.
+func (in *TransitGatewayRouteTableAnnouncement) DeepCopy() *TransitGatewayRouteTableAnnouncement {
+	if in == nil {
+		return nil
+	}
+	out := new(TransitGatewayRouteTableAnnouncement)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *TransitGatewayRouteTableAssociation) DeepCopyInto(out *TransitGatewayRouteTableAssociation) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TransitGatewayRouteTableAssociation.
+func (in *TransitGatewayRouteTableAssociation) DeepCopy() *TransitGatewayRouteTableAssociation {
+	if in == nil {
+		return nil
+	}
+	out := new(TransitGatewayRouteTableAssociation)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *TransitGatewayRouteTableAssociation) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *TransitGatewayRouteTableAssociationList) DeepCopyInto(out *TransitGatewayRouteTableAssociationList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]TransitGatewayRouteTableAssociation, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TransitGatewayRouteTableAssociationList.
+func (in *TransitGatewayRouteTableAssociationList) DeepCopy() *TransitGatewayRouteTableAssociationList {
+	if in == nil {
+		return nil
+	}
+	out := new(TransitGatewayRouteTableAssociationList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *TransitGatewayRouteTableAssociationList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *TransitGatewayRouteTableAssociationObservation) DeepCopyInto(out *TransitGatewayRouteTableAssociationObservation) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TransitGatewayRouteTableAssociationObservation.
+func (in *TransitGatewayRouteTableAssociationObservation) DeepCopy() *TransitGatewayRouteTableAssociationObservation {
+	if in == nil {
+		return nil
+	}
+	out := new(TransitGatewayRouteTableAssociationObservation)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *TransitGatewayRouteTableAssociationParameters) DeepCopyInto(out *TransitGatewayRouteTableAssociationParameters) {
+	*out = *in
+	if in.TransitGatewayRouteTableID != nil {
+		in, out := &in.TransitGatewayRouteTableID, &out.TransitGatewayRouteTableID
+		*out = new(string)
+		**out = **in
+	}
+	if in.TransitGatewayRouteTableIDRef != nil {
+		in, out := &in.TransitGatewayRouteTableIDRef, &out.TransitGatewayRouteTableIDRef
+		*out = new(v1.Reference)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.TransitGatewayRouteTableIDSelector != nil {
+		in, out := &in.TransitGatewayRouteTableIDSelector, &out.TransitGatewayRouteTableIDSelector
+		*out = new(v1.Selector)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.TransitGatewayAttachmentID != nil {
+		in, out := &in.TransitGatewayAttachmentID, &out.TransitGatewayAttachmentID
 		*out = new(string)
 		**out = **in
 	}
-	if in.TransitGatewayRouteTableID != nil {
-		in, out := &in.TransitGatewayRouteTableID, &out.TransitGatewayRouteTableID
-		*out = new(string)
-		**out = **in
+	if in.TransitGatewayAttachmentIDRef != nil {
+		in, out := &in.TransitGatewayAttachmentIDRef, &out.TransitGatewayAttachmentIDRef
+		*out = new(v1.Reference)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.TransitGatewayAttachmentIDSelector != nil {
+		in, out := &in.TransitGatewayAttachmentIDSelector, &out.TransitGatewayAttachmentIDSelector
+		*out = new(v1.Selector)
+		(*in).DeepCopyInto(*out)
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TransitGatewayRouteTableAnnouncement.
-func (in *TransitGatewayRouteTableAnnouncement) DeepCopy() *TransitGatewayRouteTableAnnouncement {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TransitGatewayRouteTableAssociationParameters.
+func (in *TransitGatewayRouteTableAssociationParameters) DeepCopy() *TransitGatewayRouteTableAssociationParameters {
 	if in == nil {
 		return nil
 	}
-	out := new(TransitGatewayRouteTableAnnouncement)
+	out := new(TransitGatewayRouteTableAssociationParameters)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *TransitGatewayRouteTableAssociation) DeepCopyInto(out *TransitGatewayRouteTableAssociation) {
+func (in *TransitGatewayRouteTableAssociationSpec) DeepCopyInto(out *TransitGatewayRouteTableAssociationSpec) {
 	*out = *in
-	if in.ResourceID != nil {
-		in, out := &in.ResourceID, &out.ResourceID
-		*out = new(string)
-		**out = **in
-	}
-	if in.ResourceType != nil {
-		in, out := &in.ResourceType, &out.ResourceType
-		*out = new(string)
-		**out = **in
-	}
-	if in.TransitGatewayAttachmentID != nil {
-		in, out := &in.TransitGatewayAttachmentID, &out.TransitGatewayAttachmentID
-		*out = new(string)
-		**out = **in
+	in.ResourceSpec.DeepCopyInto(&out.ResourceSpec)
+	in.ForProvider.DeepCopyInto(&out.ForProvider)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TransitGatewayRouteTableAssociationSpec.
+func (in *TransitGatewayRouteTableAssociationSpec) DeepCopy() *TransitGatewayRouteTableAssociationSpec {
+	if in == nil {
+		return nil
 	}
+	out := new(TransitGatewayRouteTableAssociationSpec)
+	in.DeepCopyInto(out)
+	return out
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TransitGatewayRouteTableAssociation.
-func (in *TransitGatewayRouteTableAssociation) DeepCopy() *TransitGatewayRouteTableAssociation {
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *TransitGatewayRouteTableAssociationStatus) DeepCopyInto(out *TransitGatewayRouteTableAssociationStatus) {
+	*out = *in
+	in.ResourceStatus.DeepCopyInto(&out.ResourceStatus)
+	out.AtProvider = in.AtProvider
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TransitGatewayRouteTableAssociationStatus.
+func (in *TransitGatewayRouteTableAssociationStatus) DeepCopy() *TransitGatewayRouteTableAssociationStatus {
 	if in == nil {
 		return nil
 	}
-	out := new(TransitGatewayRouteTableAssociation)
+	out := new(TransitGatewayRouteTableAssociationStatus)
 	in.DeepCopyInto(out)
 	return out
 }
@@ -20951,34 +21191,152 @@ func (in *TransitGatewayRouteTableParameters) DeepCopy() *TransitGatewayRouteTab
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *TransitGatewayRouteTablePropagation) DeepCopyInto(out *TransitGatewayRouteTablePropagation) {
 	*out = *in
-	if in.ResourceID != nil {
-		in, out := &in.ResourceID, &out.ResourceID
-		*out = new(string)
-		**out = **in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TransitGatewayRouteTablePropagation.
+func (in *TransitGatewayRouteTablePropagation) DeepCopy() *TransitGatewayRouteTablePropagation {
+	if in == nil {
+		return nil
 	}
-	if in.ResourceType != nil {
-		in, out := &in.ResourceType, &out.ResourceType
+	out := new(TransitGatewayRouteTablePropagation)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *TransitGatewayRouteTablePropagation) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *TransitGatewayRouteTablePropagationList) DeepCopyInto(out *TransitGatewayRouteTablePropagationList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]TransitGatewayRouteTablePropagation, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TransitGatewayRouteTablePropagationList.
+func (in *TransitGatewayRouteTablePropagationList) DeepCopy() *TransitGatewayRouteTablePropagationList {
+	if in == nil {
+		return nil
+	}
+	out := new(TransitGatewayRouteTablePropagationList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *TransitGatewayRouteTablePropagationList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *TransitGatewayRouteTablePropagationObservation) DeepCopyInto(out *TransitGatewayRouteTablePropagationObservation) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TransitGatewayRouteTablePropagationObservation.
+func (in *TransitGatewayRouteTablePropagationObservation) DeepCopy() *TransitGatewayRouteTablePropagationObservation {
+	if in == nil {
+		return nil
+	}
+	out := new(TransitGatewayRouteTablePropagationObservation)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *TransitGatewayRouteTablePropagationParameters) DeepCopyInto(out *TransitGatewayRouteTablePropagationParameters) {
+	*out = *in
+	if in.TransitGatewayRouteTableID != nil {
+		in, out := &in.TransitGatewayRouteTableID, &out.TransitGatewayRouteTableID
 		*out = new(string)
 		**out = **in
 	}
+	if in.TransitGatewayRouteTableIDRef != nil {
+		in, out := &in.TransitGatewayRouteTableIDRef, &out.TransitGatewayRouteTableIDRef
+		*out = new(v1.Reference)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.TransitGatewayRouteTableIDSelector != nil {
+		in, out := &in.TransitGatewayRouteTableIDSelector, &out.TransitGatewayRouteTableIDSelector
+		*out = new(v1.Selector)
+		(*in).DeepCopyInto(*out)
+	}
 	if in.TransitGatewayAttachmentID != nil {
 		in, out := &in.TransitGatewayAttachmentID, &out.TransitGatewayAttachmentID
 		*out = new(string)
 		**out = **in
 	}
-	if in.TransitGatewayRouteTableAnnouncementID != nil {
-		in, out := &in.TransitGatewayRouteTableAnnouncementID, &out.TransitGatewayRouteTableAnnouncementID
-		*out = new(string)
-		**out = **in
+	if in.TransitGatewayAttachmentIDRef != nil {
+		in, out := &in.TransitGatewayAttachmentIDRef, &out.TransitGatewayAttachmentIDRef
+		*out = new(v1.Reference)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.TransitGatewayAttachmentIDSelector != nil {
+		in, out := &in.TransitGatewayAttachmentIDSelector, &out.TransitGatewayAttachmentIDSelector
+		*out = new(v1.Selector)
+		(*in).DeepCopyInto(*out)
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TransitGatewayRouteTablePropagation.
-func (in *TransitGatewayRouteTablePropagation) DeepCopy() *TransitGatewayRouteTablePropagation {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TransitGatewayRouteTablePropagationParameters.
+func (in *TransitGatewayRouteTablePropagationParameters) DeepCopy() *TransitGatewayRouteTablePropagationParameters {
 	if in == nil {
 		return nil
 	}
-	out := new(TransitGatewayRouteTablePropagation)
+	out := new(TransitGatewayRouteTablePropagationParameters)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *TransitGatewayRouteTablePropagationSpec) DeepCopyInto(out *TransitGatewayRouteTablePropagationSpec) {
+	*out = *in
+	in.ResourceSpec.DeepCopyInto(&out.ResourceSpec)
+	in.ForProvider.DeepCopyInto(&out.ForProvider)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TransitGatewayRouteTablePropagationSpec.
+func (in *TransitGatewayRouteTablePropagationSpec) DeepCopy() *TransitGatewayRouteTablePropagationSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(TransitGatewayRouteTablePropagationSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *TransitGatewayRouteTablePropagationStatus) DeepCopyInto(out *TransitGatewayRouteTablePropagationStatus) {
+	*out = *in
+	in.ResourceStatus.DeepCopyInto(&out.ResourceStatus)
+	out.AtProvider = in.AtProvider
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TransitGatewayRouteTablePropagationStatus.
+func (in *TransitGatewayRouteTablePropagationStatus) DeepCopy() *TransitGatewayRouteTablePropagationStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(TransitGatewayRouteTablePropagationStatus)
 	in.DeepCopyInto(out)
 	return out
 }
@@ -23182,72 +23540,6 @@ func (in *VPCPeeringConnection_SDK) DeepCopy() *VPCPeeringConnection_SDK {
 	return out
 }
 
-// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *VPNConnection) DeepCopyInto(out *VPNConnection) {
-	*out = *in
-	if in.Category != nil {
-		in, out := &in.Category, &out.Category
-		*out = new(string)
-		**out = **in
-	}
-	if in.CoreNetworkARN != nil {
-		in, out := &in.CoreNetworkARN, &out.CoreNetworkARN
-		*out = new(string)
-		**out = **in
-	}
-	if in.CoreNetworkAttachmentARN != nil {
-		in, out := &in.CoreNetworkAttachmentARN, &out.CoreNetworkAttachmentARN
-		*out = new(string)
-		**out = **in
-	}
-	if in.CustomerGatewayConfiguration != nil {
-		in, out := &in.CustomerGatewayConfiguration, &out.CustomerGatewayConfiguration
-		*out = new(string)
-		**out = **in
-	}
-	if in.CustomerGatewayID != nil {
-		in, out := &in.CustomerGatewayID, &out.CustomerGatewayID
-		*out = new(string)
-		**out = **in
-	}
-	if in.Tags != nil {
-		in, out := &in.Tags, &out.Tags
-		*out = make([]*Tag, len(*in))
-		for i := range *in {
-			if (*in)[i] != nil {
-				in, out := &(*in)[i], &(*out)[i]
-				*out = new(Tag)
-				(*in).DeepCopyInto(*out)
-			}
-		}
-	}
-	if in.TransitGatewayID != nil {
-		in, out := &in.TransitGatewayID, &out.TransitGatewayID
-		*out = new(string)
-		**out = **in
-	}
-	if in.VPNConnectionID != nil {
-		in, out := &in.VPNConnectionID, &out.VPNConnectionID
-		*out = new(string)
-		**out = **in
-	}
-	if in.VPNGatewayID != nil {
-		in, out := &in.VPNGatewayID, &out.VPNGatewayID
-		*out = new(string)
-		**out = **in
-	}
-}
-
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VPNConnection.
-func (in *VPNConnection) DeepCopy() *VPNConnection {
-	if in == nil {
-		return nil
-	}
-	out := new(VPNConnection)
-	in.DeepCopyInto(out)
-	return out
-}
-
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *VPNConnectionDeviceType) DeepCopyInto(out *VPNConnectionDeviceType) {
 	*out = *in
@@ -23393,47 +23685,6 @@ func (in *VPNConnectionOptionsSpecification) DeepCopy() *VPNConnectionOptionsSpe
 	return out
 }
 
-// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *VPNGateway) DeepCopyInto(out *VPNGateway) {
-	*out = *in
-	if in.AmazonSideASN != nil {
-		in, out := &in.AmazonSideASN, &out.AmazonSideASN
-		*out = new(int64)
-		**out = **in
-	}
-	if in.AvailabilityZone != nil {
-		in, out := &in.AvailabilityZone, &out.AvailabilityZone
-		*out = new(string)
-		**out = **in
-	}
-	if in.Tags != nil {
-		in, out := &in.Tags, &out.Tags
-		*out = make([]*Tag, len(*in))
-		for i := range *in {
-			if (*in)[i] != nil {
-				in, out := &(*in)[i], &(*out)[i]
-				*out = new(Tag)
-				(*in).DeepCopyInto(*out)
-			}
-		}
-	}
-	if in.VPNGatewayID != nil {
-		in, out := &in.VPNGatewayID, &out.VPNGatewayID
-		*out = new(string)
-		**out = **in
-	}
-}
-
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VPNGateway.
-func (in *VPNGateway) DeepCopy() *VPNGateway {
-	if in == nil {
-		return nil
-	}
-	out := new(VPNGateway)
-	in.DeepCopyInto(out)
-	return out
-}
-
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *VPNStaticRoute) DeepCopyInto(out *VPNStaticRoute) {
 	*out = *in
@@ -65,6 +65,24 @@ func (l *TransitGatewayList) GetItems() []resource.Managed {
 	return items
 }
 
+// GetItems of this TransitGatewayPeeringAttachmentAccepterList.
+func (l *TransitGatewayPeeringAttachmentAccepterList) GetItems() []resource.Managed {
+	items := make([]resource.Managed, len(l.Items))
+	for i := range l.Items {
+		items[i] = &l.Items[i]
+	}
+	return items
+}
+
+// GetItems of this TransitGatewayPeeringAttachmentList.
+func (l *TransitGatewayPeeringAttachmentList) GetItems() []resource.Managed {
+	items := make([]resource.Managed, len(l.Items))
+	for i := range l.Items {
+		items[i] = &l.Items[i]
+	}
+	return items
+}
+
 // GetItems of this TransitGatewayRouteList.
 func (l *TransitGatewayRouteList) GetItems() []resource.Managed {
 	items := make([]resource.Managed, len(l.Items))
@@ -74,6 +92,15 @@ func (l *TransitGatewayRouteList) GetItems() []resource.Managed {
 	return items
 }
 
+// GetItems of this TransitGatewayRouteTableAssociationList.
+func (l *TransitGatewayRouteTableAssociationList) GetItems() []resource.Managed {
+	items := make([]resource.Managed, len(l.Items))
+	for i := range l.Items {
+		items[i] = &l.Items[i]
+	}
+	return items
+}
+
 // GetItems of this TransitGatewayRouteTableList.
 func (l *TransitGatewayRouteTableList) GetItems() []resource.Managed {
 	items := make([]resource.Managed, len(l.Items))
@@ -83,6 +110,15 @@ func (l *TransitGatewayRouteTableList) GetItems() []resource.Managed {
 	return items
 }
 
+// GetItems of this TransitGatewayRouteTablePropagationList.
+func (l *TransitGatewayRouteTablePropagationList) GetItems() []resource.Managed {
+	items := make([]resource.Managed, len(l.Items))
+	for i := range l.Items {
+		items[i] = &l.Items[i]
+	}
+	return items
+}
+
 // GetItems of this TransitGatewayVPCAttachmentList.
 func (l *TransitGatewayVPCAttachmentList) GetItems() []resource.Managed {
 	items := make([]resource.Managed, len(l.Items))
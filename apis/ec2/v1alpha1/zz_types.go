@@ -851,25 +851,6 @@ type CreditSpecificationRequest struct {
 	CPUCredits *string `json:"cpuCredits,omitempty"`
 }
 
-// +kubebuilder:skipversion
-type CustomerGateway struct {
-	BGPASN *string `json:"bgpASN,omitempty"`
-
-	CertificateARN *string `json:"certificateARN,omitempty"`
-
-	CustomerGatewayID *string `json:"customerGatewayID,omitempty"`
-
-	DeviceName *string `json:"deviceName,omitempty"`
-
-	IPAddress *string `json:"ipAddress,omitempty"`
-
-	State *string `json:"state,omitempty"`
-
-	Tags []*Tag `json:"tags,omitempty"`
-
-	Type *string `json:"type_,omitempty"`
-}
-
 // +kubebuilder:skipversion
 type DHCPConfiguration struct {
 	Key *string `json:"key,omitempty"`
@@ -3378,19 +3359,6 @@ type NATGatewayAddress struct {
 	PublicIP *string `json:"publicIP,omitempty"`
 }
 
-// +kubebuilder:skipversion
-type NetworkACL struct {
-	IsDefault *bool `json:"isDefault,omitempty"`
-
-	NetworkACLID *string `json:"networkACLID,omitempty"`
-
-	OwnerID *string `json:"ownerID,omitempty"`
-
-	Tags []*Tag `json:"tags,omitempty"`
-
-	VPCID *string `json:"vpcID,omitempty"`
-}
-
 // +kubebuilder:skipversion
 type NetworkACLAssociation struct {
 	NetworkACLAssociationID *string `json:"networkACLAssociationID,omitempty"`
@@ -3400,19 +3368,6 @@ type NetworkACLAssociation struct {
 	SubnetID *string `json:"subnetID,omitempty"`
 }
 
-// +kubebuilder:skipversion
-type NetworkACLEntry struct {
-	CIDRBlock *string `json:"cidrBlock,omitempty"`
-
-	Egress *bool `json:"egress,omitempty"`
-
-	IPv6CIDRBlock *string `json:"ipv6CIDRBlock,omitempty"`
-
-	Protocol *string `json:"protocol,omitempty"`
-
-	RuleNumber *int64 `json:"ruleNumber,omitempty"`
-}
-
 // +kubebuilder:skipversion
 type NetworkBandwidthGbps struct {
 	Max *float64 `json:"max,omitempty"`
@@ -5692,19 +5647,6 @@ type TransitGatewayOptions struct {
 	VPNECMPSupport *string `json:"vpnECMPSupport,omitempty"`
 }
 
-// +kubebuilder:skipversion
-type TransitGatewayPeeringAttachment struct {
-	AccepterTransitGatewayAttachmentID *string `json:"accepterTransitGatewayAttachmentID,omitempty"`
-
-	CreationTime *metav1.Time `json:"creationTime,omitempty"`
-
-	State *string `json:"state,omitempty"`
-
-	Tags []*Tag `json:"tags,omitempty"`
-
-	TransitGatewayAttachmentID *string `json:"transitGatewayAttachmentID,omitempty"`
-}
-
 // +kubebuilder:skipversion
 type TransitGatewayPolicyRule struct {
 	DestinationCIDRBlock *string `json:"destinationCIDRBlock,omitempty"`
@@ -5832,26 +5774,6 @@ type TransitGatewayRouteTableAnnouncement struct {
 	TransitGatewayRouteTableID *string `json:"transitGatewayRouteTableID,omitempty"`
 }
 
-// +kubebuilder:skipversion
-type TransitGatewayRouteTableAssociation struct {
-	ResourceID *string `json:"resourceID,omitempty"`
-
-	ResourceType *string `json:"resourceType,omitempty"`
-
-	TransitGatewayAttachmentID *string `json:"transitGatewayAttachmentID,omitempty"`
-}
-
-// +kubebuilder:skipversion
-type TransitGatewayRouteTablePropagation struct {
-	ResourceID *string `json:"resourceID,omitempty"`
-
-	ResourceType *string `json:"resourceType,omitempty"`
-
-	TransitGatewayAttachmentID *string `json:"transitGatewayAttachmentID,omitempty"`
-
-	TransitGatewayRouteTableAnnouncementID *string `json:"transitGatewayRouteTableAnnouncementID,omitempty"`
-}
-
 // +kubebuilder:skipversion
 type TransitGatewayRouteTableRoute struct {
 	AttachmentID *string `json:"attachmentID,omitempty"`
@@ -6249,27 +6171,6 @@ type VPCPeeringConnection_SDK struct {
 	VPCPeeringConnectionID *string `json:"vpcPeeringConnectionID,omitempty"`
 }
 
-// +kubebuilder:skipversion
-type VPNConnection struct {
-	Category *string `json:"category,omitempty"`
-
-	CoreNetworkARN *string `json:"coreNetworkARN,omitempty"`
-
-	CoreNetworkAttachmentARN *string `json:"coreNetworkAttachmentARN,omitempty"`
-
-	CustomerGatewayConfiguration *string `json:"customerGatewayConfiguration,omitempty"`
-
-	CustomerGatewayID *string `json:"customerGatewayID,omitempty"`
-
-	Tags []*Tag `json:"tags,omitempty"`
-
-	TransitGatewayID *string `json:"transitGatewayID,omitempty"`
-
-	VPNConnectionID *string `json:"vpnConnectionID,omitempty"`
-
-	VPNGatewayID *string `json:"vpnGatewayID,omitempty"`
-}
-
 // +kubebuilder:skipversion
 type VPNConnectionDeviceType struct {
 	Platform *string `json:"platform,omitempty"`
@@ -6319,17 +6220,6 @@ type VPNConnectionOptionsSpecification struct {
 	TransportTransitGatewayAttachmentID *string `json:"transportTransitGatewayAttachmentID,omitempty"`
 }
 
-// +kubebuilder:skipversion
-type VPNGateway struct {
-	AmazonSideASN *int64 `json:"amazonSideASN,omitempty"`
-
-	AvailabilityZone *string `json:"availabilityZone,omitempty"`
-
-	Tags []*Tag `json:"tags,omitempty"`
-
-	VPNGatewayID *string `json:"vpnGatewayID,omitempty"`
-}
-
 // +kubebuilder:skipversion
 type VPNStaticRoute struct {
 	DestinationCIDRBlock *string `json:"destinationCIDRBlock,omitempty"`
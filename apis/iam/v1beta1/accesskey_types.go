@@ -42,6 +42,28 @@ type AccessKeyParameters struct {
 	// Must be either Active or Inactive.
 	// +kubebuilder:validation:Enum=Active;Inactive
 	Status string `json:"accessKeyStatus,omitempty"`
+
+	// Rotation configures the periodic replacement of the access key. A new
+	// access key is created and published to the connection secret when the
+	// current one reaches the rotation period. The previous access key is
+	// deactivated and deleted once the overlap period has passed. Other access
+	// keys of the user are left untouched.
+	// +optional
+	Rotation *AccessKeyRotation `json:"rotation,omitempty"`
+}
+
+// AccessKeyRotation defines when an access key is rotated.
+type AccessKeyRotation struct {
+	// RotationPeriod is the age at which the access key is replaced by a new
+	// one, e.g. 2160h for 90 days.
+	RotationPeriod metav1.Duration `json:"rotationPeriod"`
+
+	// OverlapPeriod is the time the previous access key stays active after the
+	// new one has been published, so that its consumers can switch to the new
+	// access key.
+	// Default: 24h
+	// +optional
+	OverlapPeriod *metav1.Duration `json:"overlapPeriod,omitempty"`
 }
 
 // An AccessKeySpec defines the desired state of an IAM Access Key.
@@ -50,9 +72,36 @@ type AccessKeySpec struct {
 	ForProvider       AccessKeyParameters `json:"forProvider"`
 }
 
+// AccessKeyObservation keeps the state for the external resource
+type AccessKeyObservation struct {
+	// CreateDate is the time the current access key was created.
+	CreateDate *metav1.Time `json:"createDate,omitempty"`
+
+	// LastUsedDate is the time the current access key was most recently used
+	// to sign a request.
+	LastUsedDate *metav1.Time `json:"lastUsedDate,omitempty"`
+
+	// LastUsedServiceName is the name of the AWS service with which the
+	// current access key was most recently used.
+	LastUsedServiceName string `json:"lastUsedServiceName,omitempty"`
+
+	// LastUsedRegion is the AWS region where the current access key was most
+	// recently used.
+	LastUsedRegion string `json:"lastUsedRegion,omitempty"`
+
+	// PreviousAccessKeyID is the ID of the access key that was replaced by the
+	// current one and is deleted after the overlap period. It is also recorded
+	// in the crossplane.io/previous-aws-iam-access-key-id annotation.
+	PreviousAccessKeyID string `json:"previousAccessKeyId,omitempty"`
+
+	// LastRotationTime is the time the access key was last rotated.
+	LastRotationTime *metav1.Time `json:"lastRotationTime,omitempty"`
+}
+
 // AccessKeyStatus represents the observed state of an IAM Access Key.
 type AccessKeyStatus struct {
 	xpv1.ResourceStatus `json:",inline"`
+	AtProvider          AccessKeyObservation `json:"atProvider,omitempty"`
 }
 
 // +kubebuilder:object:root=true
@@ -0,0 +1,73 @@
+/*
+Copyright 2023 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1beta1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+)
+
+// AccountAliasParameters define the desired state of the alias of an AWS
+// account.
+type AccountAliasParameters struct {
+	// AccountAlias is the alias of the account. It must be unique across all
+	// AWS accounts and is used in the sign-in URL of the account.
+	// +kubebuilder:validation:MinLength=3
+	// +kubebuilder:validation:MaxLength=63
+	AccountAlias string `json:"accountAlias"`
+}
+
+// An AccountAliasSpec defines the desired state of an AccountAlias.
+type AccountAliasSpec struct {
+	xpv1.ResourceSpec `json:",inline"`
+	ForProvider       AccountAliasParameters `json:"forProvider"`
+}
+
+// An AccountAliasStatus represents the observed state of an AccountAlias.
+type AccountAliasStatus struct {
+	xpv1.ResourceStatus `json:",inline"`
+}
+
+// +kubebuilder:object:root=true
+
+// An AccountAlias is a managed resource that represents the alias of an AWS
+// account. An account has at most one alias, so there should be only one
+// AccountAlias per account. A different alias that is observed in the account
+// is replaced by the desired one.
+// +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="ALIAS",type="string",JSONPath=".spec.forProvider.accountAlias"
+// +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:scope=Cluster,categories={crossplane,managed,aws}
+type AccountAlias struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   AccountAliasSpec   `json:"spec"`
+	Status AccountAliasStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// AccountAliasList contains a list of AccountAliases
+type AccountAliasList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []AccountAlias `json:"items"`
+}
@@ -0,0 +1,129 @@
+/*
+Copyright 2023 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1beta1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+)
+
+// AccountPasswordPolicyParameters define the desired state of the password
+// policy of an AWS account. Fields that are not set are late initialized
+// from the current password policy.
+type AccountPasswordPolicyParameters struct {
+	// AllowUsersToChangePassword allows all IAM users in the account to use
+	// the AWS Management Console to change their own passwords.
+	// +optional
+	AllowUsersToChangePassword *bool `json:"allowUsersToChangePassword,omitempty"`
+
+	// HardExpiry prevents IAM users who are accessing the account via the AWS
+	// Management Console from setting a new console password after their
+	// password has expired.
+	// +optional
+	HardExpiry *bool `json:"hardExpiry,omitempty"`
+
+	// MaxPasswordAge is the number of days that an IAM user password is valid.
+	// +kubebuilder:validation:Minimum=1
+	// +kubebuilder:validation:Maximum=1095
+	// +optional
+	MaxPasswordAge *int32 `json:"maxPasswordAge,omitempty"`
+
+	// MinimumPasswordLength is the minimum number of characters allowed in an
+	// IAM user password.
+	// +kubebuilder:validation:Minimum=6
+	// +kubebuilder:validation:Maximum=128
+	// +optional
+	MinimumPasswordLength *int32 `json:"minimumPasswordLength,omitempty"`
+
+	// PasswordReusePrevention specifies the number of previous passwords that
+	// IAM users are prevented from reusing.
+	// +kubebuilder:validation:Minimum=1
+	// +kubebuilder:validation:Maximum=24
+	// +optional
+	PasswordReusePrevention *int32 `json:"passwordReusePrevention,omitempty"`
+
+	// RequireLowercaseCharacters specifies whether IAM user passwords must
+	// contain at least one lowercase character from the ISO basic Latin
+	// alphabet (a to z).
+	// +optional
+	RequireLowercaseCharacters *bool `json:"requireLowercaseCharacters,omitempty"`
+
+	// RequireNumbers specifies whether IAM user passwords must contain at
+	// least one numeric character (0 to 9).
+	// +optional
+	RequireNumbers *bool `json:"requireNumbers,omitempty"`
+
+	// RequireSymbols specifies whether IAM user passwords must contain at
+	// least one of the following non-alphanumeric characters:
+	// ! @ # $ % ^ & * ( ) _ + - = [ ] { } | '
+	// +optional
+	RequireSymbols *bool `json:"requireSymbols,omitempty"`
+
+	// RequireUppercaseCharacters specifies whether IAM user passwords must
+	// contain at least one uppercase character from the ISO basic Latin
+	// alphabet (A to Z).
+	// +optional
+	RequireUppercaseCharacters *bool `json:"requireUppercaseCharacters,omitempty"`
+}
+
+// An AccountPasswordPolicySpec defines the desired state of an
+// AccountPasswordPolicy.
+type AccountPasswordPolicySpec struct {
+	xpv1.ResourceSpec `json:",inline"`
+	ForProvider       AccountPasswordPolicyParameters `json:"forProvider"`
+}
+
+// AccountPasswordPolicyObservation keeps the state for the external resource
+type AccountPasswordPolicyObservation struct {
+	// ExpirePasswords indicates whether passwords in the account expire.
+	ExpirePasswords bool `json:"expirePasswords,omitempty"`
+}
+
+// An AccountPasswordPolicyStatus represents the observed state of an
+// AccountPasswordPolicy.
+type AccountPasswordPolicyStatus struct {
+	xpv1.ResourceStatus `json:",inline"`
+	AtProvider          AccountPasswordPolicyObservation `json:"atProvider,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// An AccountPasswordPolicy is a managed resource that represents the password
+// policy of an AWS account. An account has exactly one password policy, so
+// there should be only one AccountPasswordPolicy per account.
+// +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:scope=Cluster,categories={crossplane,managed,aws}
+type AccountPasswordPolicy struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   AccountPasswordPolicySpec   `json:"spec"`
+	Status AccountPasswordPolicyStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// AccountPasswordPolicyList contains a list of AccountPasswordPolicies
+type AccountPasswordPolicyList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []AccountPasswordPolicy `json:"items"`
+}
@@ -27,6 +27,11 @@ type GroupParameters struct {
 	// The path for the group name.
 	// +optional
 	Path *string `json:"path,omitempty"`
+
+	// RemoveUnmanagedInlinePolicies removes the inline policies of the group
+	// that are not managed by a GroupPolicy resource.
+	// +optional
+	RemoveUnmanagedInlinePolicies *bool `json:"removeUnmanagedInlinePolicies,omitempty"`
 }
 
 // A GroupSpec defines the desired state of an IAM Group.
@@ -0,0 +1,84 @@
+/*
+Copyright 2023 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY GroupIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1beta1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+)
+
+// GroupPolicyParameters define the desired state of an AWS IAM group inline
+// policy. The name of the inline policy is the external name of the
+// resource.
+type GroupPolicyParameters struct {
+
+	// Document is the JSON policy document that is embedded in the group.
+	Document string `json:"document"`
+
+	// GroupName presents the name of the IAM group.
+	// +immutable
+	// +crossplane:generate:reference:type=Group
+	GroupName string `json:"groupName,omitempty"`
+
+	// GroupNameRef references a Group to retrieve its Name
+	// +optional
+	GroupNameRef *xpv1.Reference `json:"groupNameRef,omitempty"`
+
+	// GroupNameSelector selects a reference to a Group to retrieve its Name
+	// +optional
+	GroupNameSelector *xpv1.Selector `json:"groupNameSelector,omitempty"`
+}
+
+// A GroupPolicySpec defines the desired state of a GroupPolicy.
+type GroupPolicySpec struct {
+	xpv1.ResourceSpec `json:",inline"`
+	ForProvider       GroupPolicyParameters `json:"forProvider"`
+}
+
+// A GroupPolicyStatus represents the observed state of a GroupPolicy.
+type GroupPolicyStatus struct {
+	xpv1.ResourceStatus `json:",inline"`
+}
+
+// +kubebuilder:object:root=true
+
+// A GroupPolicy is a managed resource that represents an AWS IAM group inline
+// policy.
+// +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="GROUPNAME",type="string",JSONPath=".spec.forProvider.groupName"
+// +kubebuilder:printcolumn:name="EXTERNAL-NAME",type="string",JSONPath=".metadata.annotations.crossplane\\.io/external-name"
+// +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:scope=Cluster,categories={crossplane,managed,aws}
+type GroupPolicy struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   GroupPolicySpec   `json:"spec"`
+	Status GroupPolicyStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// GroupPolicyList contains a list of GroupPolicies
+type GroupPolicyList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []GroupPolicy `json:"items"`
+}
@@ -0,0 +1,103 @@
+/*
+Copyright 2023 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1beta1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+)
+
+// LoginProfileParameters define the desired state of an AWS IAM login
+// profile, which gives an IAM user access to the AWS Management Console.
+type LoginProfileParameters struct {
+	// UserName is the name of the IAM user the login profile belongs to.
+	// +immutable
+	// +crossplane:generate:reference:type=User
+	UserName string `json:"userName,omitempty"`
+
+	// UserNameRef references a User to retrieve its Name
+	// +optional
+	UserNameRef *xpv1.Reference `json:"userNameRef,omitempty"`
+
+	// UserNameSelector selects a reference to a User to retrieve its Name
+	// +optional
+	UserNameSelector *xpv1.Selector `json:"userNameSelector,omitempty"`
+
+	// PasswordSecretRef references the key of a Secret that contains the
+	// console password of the user. A random password is generated if it is
+	// not set. The password is changed whenever the value in the Secret
+	// changes.
+	// +optional
+	PasswordSecretRef *xpv1.SecretKeySelector `json:"passwordSecretRef,omitempty"`
+
+	// PasswordResetRequired specifies whether the user is required to set a
+	// new password on next sign-in.
+	// +optional
+	PasswordResetRequired *bool `json:"passwordResetRequired,omitempty"`
+}
+
+// A LoginProfileSpec defines the desired state of a LoginProfile.
+type LoginProfileSpec struct {
+	xpv1.ResourceSpec `json:",inline"`
+	ForProvider       LoginProfileParameters `json:"forProvider"`
+}
+
+// LoginProfileObservation keeps the state for the external resource
+type LoginProfileObservation struct {
+	// CreateDate is the time the login profile was created.
+	CreateDate *metav1.Time `json:"createDate,omitempty"`
+
+	// PasswordResetRequired specifies whether the user is required to set a
+	// new password on next sign-in.
+	PasswordResetRequired bool `json:"passwordResetRequired,omitempty"`
+}
+
+// A LoginProfileStatus represents the observed state of a LoginProfile.
+type LoginProfileStatus struct {
+	xpv1.ResourceStatus `json:",inline"`
+	AtProvider          LoginProfileObservation `json:"atProvider,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// A LoginProfile is a managed resource that represents the console password
+// of an AWS IAM User. The user name, the password and the console sign-in
+// URL are published to the connection secret under the keys username,
+// password and signInURL.
+// +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="USERNAME",type="string",JSONPath=".spec.forProvider.userName"
+// +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:scope=Cluster,categories={crossplane,managed,aws}
+type LoginProfile struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   LoginProfileSpec   `json:"spec"`
+	Status LoginProfileStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// LoginProfileList contains a list of LoginProfiles
+type LoginProfileList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []LoginProfile `json:"items"`
+}
@@ -44,6 +44,49 @@ type PolicyParameters struct {
 	// +immutable
 	// +optional
 	Tags []Tag `json:"tags,omitempty"`
+
+	// VersionRetention controls how the versions of the policy that are no
+	// longer the default version are retained when the document changes.
+	// Defaults to keeping the 5 latest versions, which is the maximum IAM
+	// allows.
+	// +optional
+	VersionRetention *PolicyVersionRetention `json:"versionRetention,omitempty"`
+
+	// DefaultVersionID pins the default version of the policy to an existing
+	// version, e.g. to roll back to a previous document. While it is set, the
+	// Document is not applied and no new versions are created.
+	// +optional
+	DefaultVersionID *string `json:"defaultVersionId,omitempty"`
+}
+
+// Strategies to retain the versions of an IAM Policy.
+const (
+	// PolicyVersionRetentionKeepLatest deletes the oldest non-default versions
+	// so that no more than the maximum number of versions exist.
+	PolicyVersionRetentionKeepLatest = "KeepLatest"
+
+	// PolicyVersionRetentionNever never deletes any version and fails to
+	// update the policy instead when the maximum number of versions exist.
+	PolicyVersionRetentionNever = "Never"
+)
+
+// PolicyVersionRetention defines how the versions of an IAM Policy are
+// retained.
+type PolicyVersionRetention struct {
+	// Strategy to retain the versions of the policy. KeepLatest deletes the
+	// oldest non-default versions when a new version is created, Never fails
+	// the update instead.
+	// +kubebuilder:validation:Enum=KeepLatest;Never
+	// +kubebuilder:default:=KeepLatest
+	// +optional
+	Strategy string `json:"strategy,omitempty"`
+
+	// MaxVersions is the maximum number of versions of the policy, including
+	// the default version. Defaults to 5.
+	// +kubebuilder:validation:Minimum=1
+	// +kubebuilder:validation:Maximum=5
+	// +optional
+	MaxVersions *int32 `json:"maxVersions,omitempty"`
 }
 
 // A PolicySpec defines the desired state of a Policy.
@@ -73,6 +116,21 @@ type PolicyObservation struct {
 
 	// The stable and unique string identifying the policy.
 	PolicyID string `json:"policyId,omitempty"`
+
+	// The versions of the policy.
+	Versions []PolicyVersion `json:"versions,omitempty"`
+}
+
+// PolicyVersion is a version of an IAM Policy.
+type PolicyVersion struct {
+	// The identifier of the version.
+	VersionID string `json:"versionId"`
+
+	// The date and time when the version was created.
+	CreateDate *metav1.Time `json:"createDate,omitempty"`
+
+	// Specifies whether the version is the default version of the policy.
+	IsDefaultVersion bool `json:"isDefaultVersion,omitempty"`
 }
 
 // A PolicyStatus represents the observed state of a Policy.
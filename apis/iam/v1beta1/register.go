@@ -48,6 +48,14 @@ var (
 	RoleGroupVersionKind = SchemeGroupVersion.WithKind(RoleKind)
 )
 
+// RolePolicy type metadata.
+var (
+	RolePolicyKind             = reflect.TypeOf(RolePolicy{}).Name()
+	RolePolicyGroupKind        = schema.GroupKind{Group: CRDGroup, Kind: RolePolicyKind}.String()
+	RolePolicyKindAPIVersion   = RolePolicyKind + "." + SchemeGroupVersion.String()
+	RolePolicyGroupVersionKind = SchemeGroupVersion.WithKind(RolePolicyKind)
+)
+
 // RolePolicyAttachment type metadata.
 var (
 	RolePolicyAttachmentKind             = reflect.TypeOf(RolePolicyAttachment{}).Name()
@@ -64,6 +72,14 @@ var (
 	UserGroupVersionKind = SchemeGroupVersion.WithKind(UserKind)
 )
 
+// UserPolicy type metadata.
+var (
+	UserPolicyKind             = reflect.TypeOf(UserPolicy{}).Name()
+	UserPolicyGroupKind        = schema.GroupKind{Group: CRDGroup, Kind: UserPolicyKind}.String()
+	UserPolicyKindAPIVersion   = UserPolicyKind + "." + SchemeGroupVersion.String()
+	UserPolicyGroupVersionKind = SchemeGroupVersion.WithKind(UserPolicyKind)
+)
+
 // UserPolicyAttachment type metadata.
 var (
 	UserPolicyAttachmentKind             = reflect.TypeOf(UserPolicyAttachment{}).Name()
@@ -96,6 +112,14 @@ var (
 	GroupUserMembershipGroupVersionKind = SchemeGroupVersion.WithKind(GroupUserMembershipKind)
 )
 
+// GroupPolicy type metadata.
+var (
+	GroupPolicyKind             = reflect.TypeOf(GroupPolicy{}).Name()
+	GroupPolicyGroupKind        = schema.GroupKind{Group: CRDGroup, Kind: GroupPolicyKind}.String()
+	GroupPolicyKindAPIVersion   = GroupPolicyKind + "." + SchemeGroupVersion.String()
+	GroupPolicyGroupVersionKind = SchemeGroupVersion.WithKind(GroupPolicyKind)
+)
+
 // GroupPolicyAttachment type metadata.
 var (
 	GroupPolicyAttachmentKind             = reflect.TypeOf(GroupPolicyAttachment{}).Name()
@@ -120,6 +144,38 @@ var (
 	OpenIDConnectProviderGroupVersionKind = SchemeGroupVersion.WithKind(OpenIDConnectProviderKind)
 )
 
+// LoginProfile type metadata.
+var (
+	LoginProfileKind             = reflect.TypeOf(LoginProfile{}).Name()
+	LoginProfileGroupKind        = schema.GroupKind{Group: CRDGroup, Kind: LoginProfileKind}.String()
+	LoginProfileKindAPIVersion   = LoginProfileKind + "." + SchemeGroupVersion.String()
+	LoginProfileGroupVersionKind = SchemeGroupVersion.WithKind(LoginProfileKind)
+)
+
+// AccountPasswordPolicy type metadata.
+var (
+	AccountPasswordPolicyKind             = reflect.TypeOf(AccountPasswordPolicy{}).Name()
+	AccountPasswordPolicyGroupKind        = schema.GroupKind{Group: CRDGroup, Kind: AccountPasswordPolicyKind}.String()
+	AccountPasswordPolicyKindAPIVersion   = AccountPasswordPolicyKind + "." + SchemeGroupVersion.String()
+	AccountPasswordPolicyGroupVersionKind = SchemeGroupVersion.WithKind(AccountPasswordPolicyKind)
+)
+
+// AccountAlias type metadata.
+var (
+	AccountAliasKind             = reflect.TypeOf(AccountAlias{}).Name()
+	AccountAliasGroupKind        = schema.GroupKind{Group: CRDGroup, Kind: AccountAliasKind}.String()
+	AccountAliasKindAPIVersion   = AccountAliasKind + "." + SchemeGroupVersion.String()
+	AccountAliasGroupVersionKind = SchemeGroupVersion.WithKind(AccountAliasKind)
+)
+
+// SAMLProvider type metadata.
+var (
+	SAMLProviderKind             = reflect.TypeOf(SAMLProvider{}).Name()
+	SAMLProviderGroupKind        = schema.GroupKind{Group: CRDGroup, Kind: SAMLProviderKind}.String()
+	SAMLProviderKindAPIVersion   = SAMLProviderKind + "." + SchemeGroupVersion.String()
+	SAMLProviderGroupVersionKind = SchemeGroupVersion.WithKind(SAMLProviderKind)
+)
+
 func init() {
 	SchemeBuilder.Register(&Role{}, &RoleList{})
 	SchemeBuilder.Register(&RolePolicyAttachment{}, &RolePolicyAttachmentList{})
@@ -131,4 +187,11 @@ func init() {
 	SchemeBuilder.Register(&GroupPolicyAttachment{}, &GroupPolicyAttachmentList{})
 	SchemeBuilder.Register(&AccessKey{}, &AccessKeyList{})
 	SchemeBuilder.Register(&OpenIDConnectProvider{}, &OpenIDConnectProviderList{})
+	SchemeBuilder.Register(&RolePolicy{}, &RolePolicyList{})
+	SchemeBuilder.Register(&UserPolicy{}, &UserPolicyList{})
+	SchemeBuilder.Register(&GroupPolicy{}, &GroupPolicyList{})
+	SchemeBuilder.Register(&LoginProfile{}, &LoginProfileList{})
+	SchemeBuilder.Register(&AccountPasswordPolicy{}, &AccountPasswordPolicyList{})
+	SchemeBuilder.Register(&AccountAlias{}, &AccountAliasList{})
+	SchemeBuilder.Register(&SAMLProvider{}, &SAMLProviderList{})
 }
@@ -80,6 +80,11 @@ type RoleParameters struct {
 	// +immutable
 	// +optional
 	Tags []Tag `json:"tags,omitempty"`
+
+	// RemoveUnmanagedInlinePolicies removes the inline policies of the role
+	// that are not managed by a RolePolicy resource.
+	// +optional
+	RemoveUnmanagedInlinePolicies *bool `json:"removeUnmanagedInlinePolicies,omitempty"`
 }
 
 // A RoleSpec defines the desired state of a Role.
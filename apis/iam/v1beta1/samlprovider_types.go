@@ -0,0 +1,92 @@
+/*
+Copyright 2023 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1beta1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+)
+
+// SAMLProviderParameters defines the desired state of SAMLProvider
+type SAMLProviderParameters struct {
+	// Name of the SAML provider.
+	// +immutable
+	// +kubebuilder:validation:MinLength=1
+	// +kubebuilder:validation:MaxLength=128
+	Name string `json:"name"`
+
+	// SAMLMetadataDocument is an XML document generated by an identity
+	// provider (IdP) that supports SAML 2.0. The document includes the issuer's
+	// name, expiration information, and keys that can be used to validate the
+	// SAML authentication response (assertions) that are received from the
+	// IdP.
+	// +kubebuilder:validation:MinLength=1000
+	SAMLMetadataDocument string `json:"samlMetadataDocument"`
+
+	// Tags. For more information about tagging, see Tagging IAM identity
+	// providers (https://docs.aws.amazon.com/IAM/latest/UserGuide/id_tags_idps_saml.html)
+	// in the IAM User Guide.
+	// +optional
+	Tags []Tag `json:"tags,omitempty"`
+}
+
+// SAMLProviderSpec defines the desired state of SAMLProvider
+type SAMLProviderSpec struct {
+	xpv1.ResourceSpec `json:",inline"`
+	ForProvider       SAMLProviderParameters `json:"forProvider"`
+}
+
+// SAMLProviderObservation defines the observed state of SAMLProvider
+type SAMLProviderObservation struct {
+	// The date and time when the SAML provider was created.
+	CreateDate *metav1.Time `json:"createDate,omitempty"`
+
+	// The expiration date and time for the SAML provider.
+	ValidUntil *metav1.Time `json:"validUntil,omitempty"`
+}
+
+// SAMLProviderStatus defines the observed state of SAMLProvider.
+type SAMLProviderStatus struct {
+	xpv1.ResourceStatus `json:",inline"`
+	AtProvider          SAMLProviderObservation `json:"atProvider,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// SAMLProvider is the Schema for the SAMLProviders API. The external name of
+// a SAMLProvider is its ARN.
+// +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="NAME",type="string",JSONPath=".spec.forProvider.name"
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:scope=Cluster,categories={crossplane,managed,aws}
+type SAMLProvider struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+	Spec              SAMLProviderSpec   `json:"spec"`
+	Status            SAMLProviderStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// SAMLProviderList contains a list of SAMLProviders
+type SAMLProviderList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []SAMLProvider `json:"items"`
+}
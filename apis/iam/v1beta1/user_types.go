@@ -36,6 +36,11 @@ type UserParameters struct {
 	// A list of tags that you want to attach to the newly created user.
 	// +optional
 	Tags []Tag `json:"tags,omitempty"`
+
+	// RemoveUnmanagedInlinePolicies removes the inline policies of the user
+	// that are not managed by a UserPolicy resource.
+	// +optional
+	RemoveUnmanagedInlinePolicies *bool `json:"removeUnmanagedInlinePolicies,omitempty"`
 }
 
 // UserSpec defines the desired state of an IAM User.
@@ -0,0 +1,84 @@
+/*
+Copyright 2023 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1beta1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+)
+
+// UserPolicyParameters define the desired state of an AWS IAM user inline
+// policy. The name of the inline policy is the external name of the
+// resource.
+type UserPolicyParameters struct {
+
+	// Document is the JSON policy document that is embedded in the user.
+	Document string `json:"document"`
+
+	// UserName presents the name of the IAM user.
+	// +immutable
+	// +crossplane:generate:reference:type=User
+	UserName string `json:"userName,omitempty"`
+
+	// UserNameRef references a User to retrieve its Name
+	// +optional
+	UserNameRef *xpv1.Reference `json:"userNameRef,omitempty"`
+
+	// UserNameSelector selects a reference to a User to retrieve its Name
+	// +optional
+	UserNameSelector *xpv1.Selector `json:"userNameSelector,omitempty"`
+}
+
+// A UserPolicySpec defines the desired state of a UserPolicy.
+type UserPolicySpec struct {
+	xpv1.ResourceSpec `json:",inline"`
+	ForProvider       UserPolicyParameters `json:"forProvider"`
+}
+
+// A UserPolicyStatus represents the observed state of a UserPolicy.
+type UserPolicyStatus struct {
+	xpv1.ResourceStatus `json:",inline"`
+}
+
+// +kubebuilder:object:root=true
+
+// A UserPolicy is a managed resource that represents an AWS IAM user inline
+// policy.
+// +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="USERNAME",type="string",JSONPath=".spec.forProvider.userName"
+// +kubebuilder:printcolumn:name="EXTERNAL-NAME",type="string",JSONPath=".metadata.annotations.crossplane\\.io/external-name"
+// +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:scope=Cluster,categories={crossplane,managed,aws}
+type UserPolicy struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   UserPolicySpec   `json:"spec"`
+	Status UserPolicyStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// UserPolicyList contains a list of UserPolicies
+type UserPolicyList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []UserPolicy `json:"items"`
+}
@@ -23,6 +23,7 @@ package v1beta1
 
 import (
 	"github.com/crossplane/crossplane-runtime/apis/common/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	runtime "k8s.io/apimachinery/pkg/runtime"
 )
 
@@ -85,6 +86,33 @@ func (in *AccessKeyList) DeepCopyObject() runtime.Object {
 	return nil
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AccessKeyObservation) DeepCopyInto(out *AccessKeyObservation) {
+	*out = *in
+	if in.CreateDate != nil {
+		in, out := &in.CreateDate, &out.CreateDate
+		*out = (*in).DeepCopy()
+	}
+	if in.LastUsedDate != nil {
+		in, out := &in.LastUsedDate, &out.LastUsedDate
+		*out = (*in).DeepCopy()
+	}
+	if in.LastRotationTime != nil {
+		in, out := &in.LastRotationTime, &out.LastRotationTime
+		*out = (*in).DeepCopy()
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AccessKeyObservation.
+func (in *AccessKeyObservation) DeepCopy() *AccessKeyObservation {
+	if in == nil {
+		return nil
+	}
+	out := new(AccessKeyObservation)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *AccessKeyParameters) DeepCopyInto(out *AccessKeyParameters) {
 	*out = *in
@@ -98,6 +126,11 @@ func (in *AccessKeyParameters) DeepCopyInto(out *AccessKeyParameters) {
 		*out = new(v1.Selector)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.Rotation != nil {
+		in, out := &in.Rotation, &out.Rotation
+		*out = new(AccessKeyRotation)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AccessKeyParameters.
@@ -110,6 +143,27 @@ func (in *AccessKeyParameters) DeepCopy() *AccessKeyParameters {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AccessKeyRotation) DeepCopyInto(out *AccessKeyRotation) {
+	*out = *in
+	out.RotationPeriod = in.RotationPeriod
+	if in.OverlapPeriod != nil {
+		in, out := &in.OverlapPeriod, &out.OverlapPeriod
+		*out = new(metav1.Duration)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AccessKeyRotation.
+func (in *AccessKeyRotation) DeepCopy() *AccessKeyRotation {
+	if in == nil {
+		return nil
+	}
+	out := new(AccessKeyRotation)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *AccessKeySpec) DeepCopyInto(out *AccessKeySpec) {
 	*out = *in
@@ -131,6 +185,7 @@ func (in *AccessKeySpec) DeepCopy() *AccessKeySpec {
 func (in *AccessKeyStatus) DeepCopyInto(out *AccessKeyStatus) {
 	*out = *in
 	in.ResourceStatus.DeepCopyInto(&out.ResourceStatus)
+	in.AtProvider.DeepCopyInto(&out.AtProvider)
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AccessKeyStatus.
@@ -144,7 +199,7 @@ func (in *AccessKeyStatus) DeepCopy() *AccessKeyStatus {
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *Group) DeepCopyInto(out *Group) {
+func (in *AccountAlias) DeepCopyInto(out *AccountAlias) {
 	*out = *in
 	out.TypeMeta = in.TypeMeta
 	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
@@ -152,18 +207,18 @@ func (in *Group) DeepCopyInto(out *Group) {
 	in.Status.DeepCopyInto(&out.Status)
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Group.
-func (in *Group) DeepCopy() *Group {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AccountAlias.
+func (in *AccountAlias) DeepCopy() *AccountAlias {
 	if in == nil {
 		return nil
 	}
-	out := new(Group)
+	out := new(AccountAlias)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
-func (in *Group) DeepCopyObject() runtime.Object {
+func (in *AccountAlias) DeepCopyObject() runtime.Object {
 	if c := in.DeepCopy(); c != nil {
 		return c
 	}
@@ -171,31 +226,31 @@ func (in *Group) DeepCopyObject() runtime.Object {
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *GroupList) DeepCopyInto(out *GroupList) {
+func (in *AccountAliasList) DeepCopyInto(out *AccountAliasList) {
 	*out = *in
 	out.TypeMeta = in.TypeMeta
 	in.ListMeta.DeepCopyInto(&out.ListMeta)
 	if in.Items != nil {
 		in, out := &in.Items, &out.Items
-		*out = make([]Group, len(*in))
+		*out = make([]AccountAlias, len(*in))
 		for i := range *in {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new GroupList.
-func (in *GroupList) DeepCopy() *GroupList {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AccountAliasList.
+func (in *AccountAliasList) DeepCopy() *AccountAliasList {
 	if in == nil {
 		return nil
 	}
-	out := new(GroupList)
+	out := new(AccountAliasList)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
-func (in *GroupList) DeepCopyObject() runtime.Object {
+func (in *AccountAliasList) DeepCopyObject() runtime.Object {
 	if c := in.DeepCopy(); c != nil {
 		return c
 	}
@@ -203,42 +258,55 @@ func (in *GroupList) DeepCopyObject() runtime.Object {
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *GroupObservation) DeepCopyInto(out *GroupObservation) {
+func (in *AccountAliasParameters) DeepCopyInto(out *AccountAliasParameters) {
 	*out = *in
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new GroupObservation.
-func (in *GroupObservation) DeepCopy() *GroupObservation {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AccountAliasParameters.
+func (in *AccountAliasParameters) DeepCopy() *AccountAliasParameters {
 	if in == nil {
 		return nil
 	}
-	out := new(GroupObservation)
+	out := new(AccountAliasParameters)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *GroupParameters) DeepCopyInto(out *GroupParameters) {
+func (in *AccountAliasSpec) DeepCopyInto(out *AccountAliasSpec) {
 	*out = *in
-	if in.Path != nil {
-		in, out := &in.Path, &out.Path
-		*out = new(string)
-		**out = **in
+	in.ResourceSpec.DeepCopyInto(&out.ResourceSpec)
+	out.ForProvider = in.ForProvider
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AccountAliasSpec.
+func (in *AccountAliasSpec) DeepCopy() *AccountAliasSpec {
+	if in == nil {
+		return nil
 	}
+	out := new(AccountAliasSpec)
+	in.DeepCopyInto(out)
+	return out
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new GroupParameters.
-func (in *GroupParameters) DeepCopy() *GroupParameters {
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AccountAliasStatus) DeepCopyInto(out *AccountAliasStatus) {
+	*out = *in
+	in.ResourceStatus.DeepCopyInto(&out.ResourceStatus)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AccountAliasStatus.
+func (in *AccountAliasStatus) DeepCopy() *AccountAliasStatus {
 	if in == nil {
 		return nil
 	}
-	out := new(GroupParameters)
+	out := new(AccountAliasStatus)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *GroupPolicyAttachment) DeepCopyInto(out *GroupPolicyAttachment) {
+func (in *AccountPasswordPolicy) DeepCopyInto(out *AccountPasswordPolicy) {
 	*out = *in
 	out.TypeMeta = in.TypeMeta
 	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
@@ -246,18 +314,18 @@ func (in *GroupPolicyAttachment) DeepCopyInto(out *GroupPolicyAttachment) {
 	in.Status.DeepCopyInto(&out.Status)
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new GroupPolicyAttachment.
-func (in *GroupPolicyAttachment) DeepCopy() *GroupPolicyAttachment {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AccountPasswordPolicy.
+func (in *AccountPasswordPolicy) DeepCopy() *AccountPasswordPolicy {
 	if in == nil {
 		return nil
 	}
-	out := new(GroupPolicyAttachment)
+	out := new(AccountPasswordPolicy)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
-func (in *GroupPolicyAttachment) DeepCopyObject() runtime.Object {
+func (in *AccountPasswordPolicy) DeepCopyObject() runtime.Object {
 	if c := in.DeepCopy(); c != nil {
 		return c
 	}
@@ -265,31 +333,31 @@ func (in *GroupPolicyAttachment) DeepCopyObject() runtime.Object {
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *GroupPolicyAttachmentList) DeepCopyInto(out *GroupPolicyAttachmentList) {
+func (in *AccountPasswordPolicyList) DeepCopyInto(out *AccountPasswordPolicyList) {
 	*out = *in
 	out.TypeMeta = in.TypeMeta
 	in.ListMeta.DeepCopyInto(&out.ListMeta)
 	if in.Items != nil {
 		in, out := &in.Items, &out.Items
-		*out = make([]GroupPolicyAttachment, len(*in))
+		*out = make([]AccountPasswordPolicy, len(*in))
 		for i := range *in {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new GroupPolicyAttachmentList.
-func (in *GroupPolicyAttachmentList) DeepCopy() *GroupPolicyAttachmentList {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AccountPasswordPolicyList.
+func (in *AccountPasswordPolicyList) DeepCopy() *AccountPasswordPolicyList {
 	if in == nil {
 		return nil
 	}
-	out := new(GroupPolicyAttachmentList)
+	out := new(AccountPasswordPolicyList)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
-func (in *GroupPolicyAttachmentList) DeepCopyObject() runtime.Object {
+func (in *AccountPasswordPolicyList) DeepCopyObject() runtime.Object {
 	if c := in.DeepCopy(); c != nil {
 		return c
 	}
@@ -297,125 +365,116 @@ func (in *GroupPolicyAttachmentList) DeepCopyObject() runtime.Object {
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *GroupPolicyAttachmentObservation) DeepCopyInto(out *GroupPolicyAttachmentObservation) {
+func (in *AccountPasswordPolicyObservation) DeepCopyInto(out *AccountPasswordPolicyObservation) {
 	*out = *in
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new GroupPolicyAttachmentObservation.
-func (in *GroupPolicyAttachmentObservation) DeepCopy() *GroupPolicyAttachmentObservation {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AccountPasswordPolicyObservation.
+func (in *AccountPasswordPolicyObservation) DeepCopy() *AccountPasswordPolicyObservation {
 	if in == nil {
 		return nil
 	}
-	out := new(GroupPolicyAttachmentObservation)
+	out := new(AccountPasswordPolicyObservation)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *GroupPolicyAttachmentParameters) DeepCopyInto(out *GroupPolicyAttachmentParameters) {
+func (in *AccountPasswordPolicyParameters) DeepCopyInto(out *AccountPasswordPolicyParameters) {
 	*out = *in
-	if in.PolicyARNRef != nil {
-		in, out := &in.PolicyARNRef, &out.PolicyARNRef
-		*out = new(v1.Reference)
-		(*in).DeepCopyInto(*out)
+	if in.AllowUsersToChangePassword != nil {
+		in, out := &in.AllowUsersToChangePassword, &out.AllowUsersToChangePassword
+		*out = new(bool)
+		**out = **in
 	}
-	if in.PolicyARNSelector != nil {
-		in, out := &in.PolicyARNSelector, &out.PolicyARNSelector
-		*out = new(v1.Selector)
-		(*in).DeepCopyInto(*out)
+	if in.HardExpiry != nil {
+		in, out := &in.HardExpiry, &out.HardExpiry
+		*out = new(bool)
+		**out = **in
 	}
-	if in.GroupNameRef != nil {
-		in, out := &in.GroupNameRef, &out.GroupNameRef
-		*out = new(v1.Reference)
-		(*in).DeepCopyInto(*out)
+	if in.MaxPasswordAge != nil {
+		in, out := &in.MaxPasswordAge, &out.MaxPasswordAge
+		*out = new(int32)
+		**out = **in
 	}
-	if in.GroupNameSelector != nil {
-		in, out := &in.GroupNameSelector, &out.GroupNameSelector
-		*out = new(v1.Selector)
-		(*in).DeepCopyInto(*out)
+	if in.MinimumPasswordLength != nil {
+		in, out := &in.MinimumPasswordLength, &out.MinimumPasswordLength
+		*out = new(int32)
+		**out = **in
 	}
-}
-
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new GroupPolicyAttachmentParameters.
-func (in *GroupPolicyAttachmentParameters) DeepCopy() *GroupPolicyAttachmentParameters {
-	if in == nil {
-		return nil
+	if in.PasswordReusePrevention != nil {
+		in, out := &in.PasswordReusePrevention, &out.PasswordReusePrevention
+		*out = new(int32)
+		**out = **in
 	}
-	out := new(GroupPolicyAttachmentParameters)
-	in.DeepCopyInto(out)
-	return out
-}
-
-// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *GroupPolicyAttachmentSpec) DeepCopyInto(out *GroupPolicyAttachmentSpec) {
-	*out = *in
-	in.ResourceSpec.DeepCopyInto(&out.ResourceSpec)
-	in.ForProvider.DeepCopyInto(&out.ForProvider)
-}
-
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new GroupPolicyAttachmentSpec.
-func (in *GroupPolicyAttachmentSpec) DeepCopy() *GroupPolicyAttachmentSpec {
-	if in == nil {
-		return nil
+	if in.RequireLowercaseCharacters != nil {
+		in, out := &in.RequireLowercaseCharacters, &out.RequireLowercaseCharacters
+		*out = new(bool)
+		**out = **in
+	}
+	if in.RequireNumbers != nil {
+		in, out := &in.RequireNumbers, &out.RequireNumbers
+		*out = new(bool)
+		**out = **in
+	}
+	if in.RequireSymbols != nil {
+		in, out := &in.RequireSymbols, &out.RequireSymbols
+		*out = new(bool)
+		**out = **in
+	}
+	if in.RequireUppercaseCharacters != nil {
+		in, out := &in.RequireUppercaseCharacters, &out.RequireUppercaseCharacters
+		*out = new(bool)
+		**out = **in
 	}
-	out := new(GroupPolicyAttachmentSpec)
-	in.DeepCopyInto(out)
-	return out
-}
-
-// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *GroupPolicyAttachmentStatus) DeepCopyInto(out *GroupPolicyAttachmentStatus) {
-	*out = *in
-	in.ResourceStatus.DeepCopyInto(&out.ResourceStatus)
-	out.AtProvider = in.AtProvider
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new GroupPolicyAttachmentStatus.
-func (in *GroupPolicyAttachmentStatus) DeepCopy() *GroupPolicyAttachmentStatus {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AccountPasswordPolicyParameters.
+func (in *AccountPasswordPolicyParameters) DeepCopy() *AccountPasswordPolicyParameters {
 	if in == nil {
 		return nil
 	}
-	out := new(GroupPolicyAttachmentStatus)
+	out := new(AccountPasswordPolicyParameters)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *GroupSpec) DeepCopyInto(out *GroupSpec) {
+func (in *AccountPasswordPolicySpec) DeepCopyInto(out *AccountPasswordPolicySpec) {
 	*out = *in
 	in.ResourceSpec.DeepCopyInto(&out.ResourceSpec)
 	in.ForProvider.DeepCopyInto(&out.ForProvider)
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new GroupSpec.
-func (in *GroupSpec) DeepCopy() *GroupSpec {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AccountPasswordPolicySpec.
+func (in *AccountPasswordPolicySpec) DeepCopy() *AccountPasswordPolicySpec {
 	if in == nil {
 		return nil
 	}
-	out := new(GroupSpec)
+	out := new(AccountPasswordPolicySpec)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *GroupStatus) DeepCopyInto(out *GroupStatus) {
+func (in *AccountPasswordPolicyStatus) DeepCopyInto(out *AccountPasswordPolicyStatus) {
 	*out = *in
 	in.ResourceStatus.DeepCopyInto(&out.ResourceStatus)
 	out.AtProvider = in.AtProvider
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new GroupStatus.
-func (in *GroupStatus) DeepCopy() *GroupStatus {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AccountPasswordPolicyStatus.
+func (in *AccountPasswordPolicyStatus) DeepCopy() *AccountPasswordPolicyStatus {
 	if in == nil {
 		return nil
 	}
-	out := new(GroupStatus)
+	out := new(AccountPasswordPolicyStatus)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *GroupUserMembership) DeepCopyInto(out *GroupUserMembership) {
+func (in *Group) DeepCopyInto(out *Group) {
 	*out = *in
 	out.TypeMeta = in.TypeMeta
 	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
@@ -423,18 +482,18 @@ func (in *GroupUserMembership) DeepCopyInto(out *GroupUserMembership) {
 	in.Status.DeepCopyInto(&out.Status)
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new GroupUserMembership.
-func (in *GroupUserMembership) DeepCopy() *GroupUserMembership {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Group.
+func (in *Group) DeepCopy() *Group {
 	if in == nil {
 		return nil
 	}
-	out := new(GroupUserMembership)
+	out := new(Group)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
-func (in *GroupUserMembership) DeepCopyObject() runtime.Object {
+func (in *Group) DeepCopyObject() runtime.Object {
 	if c := in.DeepCopy(); c != nil {
 		return c
 	}
@@ -442,31 +501,31 @@ func (in *GroupUserMembership) DeepCopyObject() runtime.Object {
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *GroupUserMembershipList) DeepCopyInto(out *GroupUserMembershipList) {
+func (in *GroupList) DeepCopyInto(out *GroupList) {
 	*out = *in
 	out.TypeMeta = in.TypeMeta
 	in.ListMeta.DeepCopyInto(&out.ListMeta)
 	if in.Items != nil {
 		in, out := &in.Items, &out.Items
-		*out = make([]GroupUserMembership, len(*in))
+		*out = make([]Group, len(*in))
 		for i := range *in {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new GroupUserMembershipList.
-func (in *GroupUserMembershipList) DeepCopy() *GroupUserMembershipList {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new GroupList.
+func (in *GroupList) DeepCopy() *GroupList {
 	if in == nil {
 		return nil
 	}
-	out := new(GroupUserMembershipList)
+	out := new(GroupList)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
-func (in *GroupUserMembershipList) DeepCopyObject() runtime.Object {
+func (in *GroupList) DeepCopyObject() runtime.Object {
 	if c := in.DeepCopy(); c != nil {
 		return c
 	}
@@ -474,91 +533,74 @@ func (in *GroupUserMembershipList) DeepCopyObject() runtime.Object {
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *GroupUserMembershipObservation) DeepCopyInto(out *GroupUserMembershipObservation) {
+func (in *GroupObservation) DeepCopyInto(out *GroupObservation) {
 	*out = *in
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new GroupUserMembershipObservation.
-func (in *GroupUserMembershipObservation) DeepCopy() *GroupUserMembershipObservation {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new GroupObservation.
+func (in *GroupObservation) DeepCopy() *GroupObservation {
 	if in == nil {
 		return nil
 	}
-	out := new(GroupUserMembershipObservation)
+	out := new(GroupObservation)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *GroupUserMembershipParameters) DeepCopyInto(out *GroupUserMembershipParameters) {
+func (in *GroupParameters) DeepCopyInto(out *GroupParameters) {
 	*out = *in
-	if in.GroupNameRef != nil {
-		in, out := &in.GroupNameRef, &out.GroupNameRef
-		*out = new(v1.Reference)
-		(*in).DeepCopyInto(*out)
-	}
-	if in.GroupNameSelector != nil {
-		in, out := &in.GroupNameSelector, &out.GroupNameSelector
-		*out = new(v1.Selector)
-		(*in).DeepCopyInto(*out)
-	}
-	if in.UserNameRef != nil {
-		in, out := &in.UserNameRef, &out.UserNameRef
-		*out = new(v1.Reference)
-		(*in).DeepCopyInto(*out)
+	if in.Path != nil {
+		in, out := &in.Path, &out.Path
+		*out = new(string)
+		**out = **in
 	}
-	if in.UserNameSelector != nil {
-		in, out := &in.UserNameSelector, &out.UserNameSelector
-		*out = new(v1.Selector)
-		(*in).DeepCopyInto(*out)
+	if in.RemoveUnmanagedInlinePolicies != nil {
+		in, out := &in.RemoveUnmanagedInlinePolicies, &out.RemoveUnmanagedInlinePolicies
+		*out = new(bool)
+		**out = **in
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new GroupUserMembershipParameters.
-func (in *GroupUserMembershipParameters) DeepCopy() *GroupUserMembershipParameters {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new GroupParameters.
+func (in *GroupParameters) DeepCopy() *GroupParameters {
 	if in == nil {
 		return nil
 	}
-	out := new(GroupUserMembershipParameters)
+	out := new(GroupParameters)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *GroupUserMembershipSpec) DeepCopyInto(out *GroupUserMembershipSpec) {
+func (in *GroupPolicy) DeepCopyInto(out *GroupPolicy) {
 	*out = *in
-	in.ResourceSpec.DeepCopyInto(&out.ResourceSpec)
-	in.ForProvider.DeepCopyInto(&out.ForProvider)
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new GroupUserMembershipSpec.
-func (in *GroupUserMembershipSpec) DeepCopy() *GroupUserMembershipSpec {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new GroupPolicy.
+func (in *GroupPolicy) DeepCopy() *GroupPolicy {
 	if in == nil {
 		return nil
 	}
-	out := new(GroupUserMembershipSpec)
+	out := new(GroupPolicy)
 	in.DeepCopyInto(out)
 	return out
 }
 
-// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *GroupUserMembershipStatus) DeepCopyInto(out *GroupUserMembershipStatus) {
-	*out = *in
-	in.ResourceStatus.DeepCopyInto(&out.ResourceStatus)
-	out.AtProvider = in.AtProvider
-}
-
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new GroupUserMembershipStatus.
-func (in *GroupUserMembershipStatus) DeepCopy() *GroupUserMembershipStatus {
-	if in == nil {
-		return nil
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *GroupPolicy) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
 	}
-	out := new(GroupUserMembershipStatus)
-	in.DeepCopyInto(out)
-	return out
+	return nil
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *OpenIDConnectProvider) DeepCopyInto(out *OpenIDConnectProvider) {
+func (in *GroupPolicyAttachment) DeepCopyInto(out *GroupPolicyAttachment) {
 	*out = *in
 	out.TypeMeta = in.TypeMeta
 	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
@@ -566,18 +608,18 @@ func (in *OpenIDConnectProvider) DeepCopyInto(out *OpenIDConnectProvider) {
 	in.Status.DeepCopyInto(&out.Status)
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new OpenIDConnectProvider.
-func (in *OpenIDConnectProvider) DeepCopy() *OpenIDConnectProvider {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new GroupPolicyAttachment.
+func (in *GroupPolicyAttachment) DeepCopy() *GroupPolicyAttachment {
 	if in == nil {
 		return nil
 	}
-	out := new(OpenIDConnectProvider)
+	out := new(GroupPolicyAttachment)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
-func (in *OpenIDConnectProvider) DeepCopyObject() runtime.Object {
+func (in *GroupPolicyAttachment) DeepCopyObject() runtime.Object {
 	if c := in.DeepCopy(); c != nil {
 		return c
 	}
@@ -585,31 +627,31 @@ func (in *OpenIDConnectProvider) DeepCopyObject() runtime.Object {
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *OpenIDConnectProviderList) DeepCopyInto(out *OpenIDConnectProviderList) {
+func (in *GroupPolicyAttachmentList) DeepCopyInto(out *GroupPolicyAttachmentList) {
 	*out = *in
 	out.TypeMeta = in.TypeMeta
 	in.ListMeta.DeepCopyInto(&out.ListMeta)
 	if in.Items != nil {
 		in, out := &in.Items, &out.Items
-		*out = make([]OpenIDConnectProvider, len(*in))
+		*out = make([]GroupPolicyAttachment, len(*in))
 		for i := range *in {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new OpenIDConnectProviderList.
-func (in *OpenIDConnectProviderList) DeepCopy() *OpenIDConnectProviderList {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new GroupPolicyAttachmentList.
+func (in *GroupPolicyAttachmentList) DeepCopy() *GroupPolicyAttachmentList {
 	if in == nil {
 		return nil
 	}
-	out := new(OpenIDConnectProviderList)
+	out := new(GroupPolicyAttachmentList)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
-func (in *OpenIDConnectProviderList) DeepCopyObject() runtime.Object {
+func (in *GroupPolicyAttachmentList) DeepCopyObject() runtime.Object {
 	if c := in.DeepCopy(); c != nil {
 		return c
 	}
@@ -617,109 +659,115 @@ func (in *OpenIDConnectProviderList) DeepCopyObject() runtime.Object {
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *OpenIDConnectProviderObservation) DeepCopyInto(out *OpenIDConnectProviderObservation) {
+func (in *GroupPolicyAttachmentObservation) DeepCopyInto(out *GroupPolicyAttachmentObservation) {
 	*out = *in
-	if in.CreateDate != nil {
-		in, out := &in.CreateDate, &out.CreateDate
-		*out = (*in).DeepCopy()
-	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new OpenIDConnectProviderObservation.
-func (in *OpenIDConnectProviderObservation) DeepCopy() *OpenIDConnectProviderObservation {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new GroupPolicyAttachmentObservation.
+func (in *GroupPolicyAttachmentObservation) DeepCopy() *GroupPolicyAttachmentObservation {
 	if in == nil {
 		return nil
 	}
-	out := new(OpenIDConnectProviderObservation)
+	out := new(GroupPolicyAttachmentObservation)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *OpenIDConnectProviderParameters) DeepCopyInto(out *OpenIDConnectProviderParameters) {
+func (in *GroupPolicyAttachmentParameters) DeepCopyInto(out *GroupPolicyAttachmentParameters) {
 	*out = *in
-	if in.ClientIDList != nil {
-		in, out := &in.ClientIDList, &out.ClientIDList
-		*out = make([]string, len(*in))
-		copy(*out, *in)
+	if in.PolicyARNRef != nil {
+		in, out := &in.PolicyARNRef, &out.PolicyARNRef
+		*out = new(v1.Reference)
+		(*in).DeepCopyInto(*out)
 	}
-	if in.Tags != nil {
-		in, out := &in.Tags, &out.Tags
-		*out = make([]Tag, len(*in))
-		copy(*out, *in)
+	if in.PolicyARNSelector != nil {
+		in, out := &in.PolicyARNSelector, &out.PolicyARNSelector
+		*out = new(v1.Selector)
+		(*in).DeepCopyInto(*out)
 	}
-	if in.ThumbprintList != nil {
-		in, out := &in.ThumbprintList, &out.ThumbprintList
-		*out = make([]string, len(*in))
-		copy(*out, *in)
+	if in.GroupNameRef != nil {
+		in, out := &in.GroupNameRef, &out.GroupNameRef
+		*out = new(v1.Reference)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.GroupNameSelector != nil {
+		in, out := &in.GroupNameSelector, &out.GroupNameSelector
+		*out = new(v1.Selector)
+		(*in).DeepCopyInto(*out)
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new OpenIDConnectProviderParameters.
-func (in *OpenIDConnectProviderParameters) DeepCopy() *OpenIDConnectProviderParameters {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new GroupPolicyAttachmentParameters.
+func (in *GroupPolicyAttachmentParameters) DeepCopy() *GroupPolicyAttachmentParameters {
 	if in == nil {
 		return nil
 	}
-	out := new(OpenIDConnectProviderParameters)
+	out := new(GroupPolicyAttachmentParameters)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *OpenIDConnectProviderSpec) DeepCopyInto(out *OpenIDConnectProviderSpec) {
+func (in *GroupPolicyAttachmentSpec) DeepCopyInto(out *GroupPolicyAttachmentSpec) {
 	*out = *in
 	in.ResourceSpec.DeepCopyInto(&out.ResourceSpec)
 	in.ForProvider.DeepCopyInto(&out.ForProvider)
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new OpenIDConnectProviderSpec.
-func (in *OpenIDConnectProviderSpec) DeepCopy() *OpenIDConnectProviderSpec {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new GroupPolicyAttachmentSpec.
+func (in *GroupPolicyAttachmentSpec) DeepCopy() *GroupPolicyAttachmentSpec {
 	if in == nil {
 		return nil
 	}
-	out := new(OpenIDConnectProviderSpec)
+	out := new(GroupPolicyAttachmentSpec)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *OpenIDConnectProviderStatus) DeepCopyInto(out *OpenIDConnectProviderStatus) {
+func (in *GroupPolicyAttachmentStatus) DeepCopyInto(out *GroupPolicyAttachmentStatus) {
 	*out = *in
 	in.ResourceStatus.DeepCopyInto(&out.ResourceStatus)
-	in.AtProvider.DeepCopyInto(&out.AtProvider)
+	out.AtProvider = in.AtProvider
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new OpenIDConnectProviderStatus.
-func (in *OpenIDConnectProviderStatus) DeepCopy() *OpenIDConnectProviderStatus {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new GroupPolicyAttachmentStatus.
+func (in *GroupPolicyAttachmentStatus) DeepCopy() *GroupPolicyAttachmentStatus {
 	if in == nil {
 		return nil
 	}
-	out := new(OpenIDConnectProviderStatus)
+	out := new(GroupPolicyAttachmentStatus)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *Policy) DeepCopyInto(out *Policy) {
+func (in *GroupPolicyList) DeepCopyInto(out *GroupPolicyList) {
 	*out = *in
 	out.TypeMeta = in.TypeMeta
-	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
-	in.Spec.DeepCopyInto(&out.Spec)
-	in.Status.DeepCopyInto(&out.Status)
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]GroupPolicy, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Policy.
-func (in *Policy) DeepCopy() *Policy {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new GroupPolicyList.
+func (in *GroupPolicyList) DeepCopy() *GroupPolicyList {
 	if in == nil {
 		return nil
 	}
-	out := new(Policy)
+	out := new(GroupPolicyList)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
-func (in *Policy) DeepCopyObject() runtime.Object {
+func (in *GroupPolicyList) DeepCopyObject() runtime.Object {
 	if c := in.DeepCopy(); c != nil {
 		return c
 	}
@@ -727,118 +775,99 @@ func (in *Policy) DeepCopyObject() runtime.Object {
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *PolicyList) DeepCopyInto(out *PolicyList) {
+func (in *GroupPolicyParameters) DeepCopyInto(out *GroupPolicyParameters) {
 	*out = *in
-	out.TypeMeta = in.TypeMeta
-	in.ListMeta.DeepCopyInto(&out.ListMeta)
-	if in.Items != nil {
-		in, out := &in.Items, &out.Items
-		*out = make([]Policy, len(*in))
-		for i := range *in {
-			(*in)[i].DeepCopyInto(&(*out)[i])
-		}
+	if in.GroupNameRef != nil {
+		in, out := &in.GroupNameRef, &out.GroupNameRef
+		*out = new(v1.Reference)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.GroupNameSelector != nil {
+		in, out := &in.GroupNameSelector, &out.GroupNameSelector
+		*out = new(v1.Selector)
+		(*in).DeepCopyInto(*out)
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PolicyList.
-func (in *PolicyList) DeepCopy() *PolicyList {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new GroupPolicyParameters.
+func (in *GroupPolicyParameters) DeepCopy() *GroupPolicyParameters {
 	if in == nil {
 		return nil
 	}
-	out := new(PolicyList)
+	out := new(GroupPolicyParameters)
 	in.DeepCopyInto(out)
 	return out
 }
 
-// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
-func (in *PolicyList) DeepCopyObject() runtime.Object {
-	if c := in.DeepCopy(); c != nil {
-		return c
-	}
-	return nil
-}
-
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *PolicyObservation) DeepCopyInto(out *PolicyObservation) {
+func (in *GroupPolicySpec) DeepCopyInto(out *GroupPolicySpec) {
 	*out = *in
+	in.ResourceSpec.DeepCopyInto(&out.ResourceSpec)
+	in.ForProvider.DeepCopyInto(&out.ForProvider)
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PolicyObservation.
-func (in *PolicyObservation) DeepCopy() *PolicyObservation {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new GroupPolicySpec.
+func (in *GroupPolicySpec) DeepCopy() *GroupPolicySpec {
 	if in == nil {
 		return nil
 	}
-	out := new(PolicyObservation)
+	out := new(GroupPolicySpec)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *PolicyParameters) DeepCopyInto(out *PolicyParameters) {
+func (in *GroupPolicyStatus) DeepCopyInto(out *GroupPolicyStatus) {
 	*out = *in
-	if in.Description != nil {
-		in, out := &in.Description, &out.Description
-		*out = new(string)
-		**out = **in
-	}
-	if in.Path != nil {
-		in, out := &in.Path, &out.Path
-		*out = new(string)
-		**out = **in
-	}
-	if in.Tags != nil {
-		in, out := &in.Tags, &out.Tags
-		*out = make([]Tag, len(*in))
-		copy(*out, *in)
-	}
+	in.ResourceStatus.DeepCopyInto(&out.ResourceStatus)
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PolicyParameters.
-func (in *PolicyParameters) DeepCopy() *PolicyParameters {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new GroupPolicyStatus.
+func (in *GroupPolicyStatus) DeepCopy() *GroupPolicyStatus {
 	if in == nil {
 		return nil
 	}
-	out := new(PolicyParameters)
+	out := new(GroupPolicyStatus)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *PolicySpec) DeepCopyInto(out *PolicySpec) {
+func (in *GroupSpec) DeepCopyInto(out *GroupSpec) {
 	*out = *in
 	in.ResourceSpec.DeepCopyInto(&out.ResourceSpec)
 	in.ForProvider.DeepCopyInto(&out.ForProvider)
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PolicySpec.
-func (in *PolicySpec) DeepCopy() *PolicySpec {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new GroupSpec.
+func (in *GroupSpec) DeepCopy() *GroupSpec {
 	if in == nil {
 		return nil
 	}
-	out := new(PolicySpec)
+	out := new(GroupSpec)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *PolicyStatus) DeepCopyInto(out *PolicyStatus) {
+func (in *GroupStatus) DeepCopyInto(out *GroupStatus) {
 	*out = *in
 	in.ResourceStatus.DeepCopyInto(&out.ResourceStatus)
 	out.AtProvider = in.AtProvider
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PolicyStatus.
-func (in *PolicyStatus) DeepCopy() *PolicyStatus {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new GroupStatus.
+func (in *GroupStatus) DeepCopy() *GroupStatus {
 	if in == nil {
 		return nil
 	}
-	out := new(PolicyStatus)
+	out := new(GroupStatus)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *Role) DeepCopyInto(out *Role) {
+func (in *GroupUserMembership) DeepCopyInto(out *GroupUserMembership) {
 	*out = *in
 	out.TypeMeta = in.TypeMeta
 	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
@@ -846,113 +875,1038 @@ func (in *Role) DeepCopyInto(out *Role) {
 	in.Status.DeepCopyInto(&out.Status)
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Role.
-func (in *Role) DeepCopy() *Role {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new GroupUserMembership.
+func (in *GroupUserMembership) DeepCopy() *GroupUserMembership {
 	if in == nil {
 		return nil
 	}
-	out := new(Role)
+	out := new(GroupUserMembership)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
-func (in *Role) DeepCopyObject() runtime.Object {
+func (in *GroupUserMembership) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *GroupUserMembershipList) DeepCopyInto(out *GroupUserMembershipList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]GroupUserMembership, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new GroupUserMembershipList.
+func (in *GroupUserMembershipList) DeepCopy() *GroupUserMembershipList {
+	if in == nil {
+		return nil
+	}
+	out := new(GroupUserMembershipList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *GroupUserMembershipList) DeepCopyObject() runtime.Object {
 	if c := in.DeepCopy(); c != nil {
 		return c
 	}
-	return nil
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *GroupUserMembershipObservation) DeepCopyInto(out *GroupUserMembershipObservation) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new GroupUserMembershipObservation.
+func (in *GroupUserMembershipObservation) DeepCopy() *GroupUserMembershipObservation {
+	if in == nil {
+		return nil
+	}
+	out := new(GroupUserMembershipObservation)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *GroupUserMembershipParameters) DeepCopyInto(out *GroupUserMembershipParameters) {
+	*out = *in
+	if in.GroupNameRef != nil {
+		in, out := &in.GroupNameRef, &out.GroupNameRef
+		*out = new(v1.Reference)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.GroupNameSelector != nil {
+		in, out := &in.GroupNameSelector, &out.GroupNameSelector
+		*out = new(v1.Selector)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.UserNameRef != nil {
+		in, out := &in.UserNameRef, &out.UserNameRef
+		*out = new(v1.Reference)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.UserNameSelector != nil {
+		in, out := &in.UserNameSelector, &out.UserNameSelector
+		*out = new(v1.Selector)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new GroupUserMembershipParameters.
+func (in *GroupUserMembershipParameters) DeepCopy() *GroupUserMembershipParameters {
+	if in == nil {
+		return nil
+	}
+	out := new(GroupUserMembershipParameters)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *GroupUserMembershipSpec) DeepCopyInto(out *GroupUserMembershipSpec) {
+	*out = *in
+	in.ResourceSpec.DeepCopyInto(&out.ResourceSpec)
+	in.ForProvider.DeepCopyInto(&out.ForProvider)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new GroupUserMembershipSpec.
+func (in *GroupUserMembershipSpec) DeepCopy() *GroupUserMembershipSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(GroupUserMembershipSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *GroupUserMembershipStatus) DeepCopyInto(out *GroupUserMembershipStatus) {
+	*out = *in
+	in.ResourceStatus.DeepCopyInto(&out.ResourceStatus)
+	out.AtProvider = in.AtProvider
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new GroupUserMembershipStatus.
+func (in *GroupUserMembershipStatus) DeepCopy() *GroupUserMembershipStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(GroupUserMembershipStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *LoginProfile) DeepCopyInto(out *LoginProfile) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new LoginProfile.
+func (in *LoginProfile) DeepCopy() *LoginProfile {
+	if in == nil {
+		return nil
+	}
+	out := new(LoginProfile)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *LoginProfile) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *LoginProfileList) DeepCopyInto(out *LoginProfileList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]LoginProfile, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new LoginProfileList.
+func (in *LoginProfileList) DeepCopy() *LoginProfileList {
+	if in == nil {
+		return nil
+	}
+	out := new(LoginProfileList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *LoginProfileList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *LoginProfileObservation) DeepCopyInto(out *LoginProfileObservation) {
+	*out = *in
+	if in.CreateDate != nil {
+		in, out := &in.CreateDate, &out.CreateDate
+		*out = (*in).DeepCopy()
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new LoginProfileObservation.
+func (in *LoginProfileObservation) DeepCopy() *LoginProfileObservation {
+	if in == nil {
+		return nil
+	}
+	out := new(LoginProfileObservation)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *LoginProfileParameters) DeepCopyInto(out *LoginProfileParameters) {
+	*out = *in
+	if in.UserNameRef != nil {
+		in, out := &in.UserNameRef, &out.UserNameRef
+		*out = new(v1.Reference)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.UserNameSelector != nil {
+		in, out := &in.UserNameSelector, &out.UserNameSelector
+		*out = new(v1.Selector)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.PasswordSecretRef != nil {
+		in, out := &in.PasswordSecretRef, &out.PasswordSecretRef
+		*out = new(v1.SecretKeySelector)
+		**out = **in
+	}
+	if in.PasswordResetRequired != nil {
+		in, out := &in.PasswordResetRequired, &out.PasswordResetRequired
+		*out = new(bool)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new LoginProfileParameters.
+func (in *LoginProfileParameters) DeepCopy() *LoginProfileParameters {
+	if in == nil {
+		return nil
+	}
+	out := new(LoginProfileParameters)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *LoginProfileSpec) DeepCopyInto(out *LoginProfileSpec) {
+	*out = *in
+	in.ResourceSpec.DeepCopyInto(&out.ResourceSpec)
+	in.ForProvider.DeepCopyInto(&out.ForProvider)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new LoginProfileSpec.
+func (in *LoginProfileSpec) DeepCopy() *LoginProfileSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(LoginProfileSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *LoginProfileStatus) DeepCopyInto(out *LoginProfileStatus) {
+	*out = *in
+	in.ResourceStatus.DeepCopyInto(&out.ResourceStatus)
+	in.AtProvider.DeepCopyInto(&out.AtProvider)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new LoginProfileStatus.
+func (in *LoginProfileStatus) DeepCopy() *LoginProfileStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(LoginProfileStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *OpenIDConnectProvider) DeepCopyInto(out *OpenIDConnectProvider) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new OpenIDConnectProvider.
+func (in *OpenIDConnectProvider) DeepCopy() *OpenIDConnectProvider {
+	if in == nil {
+		return nil
+	}
+	out := new(OpenIDConnectProvider)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *OpenIDConnectProvider) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *OpenIDConnectProviderList) DeepCopyInto(out *OpenIDConnectProviderList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]OpenIDConnectProvider, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new OpenIDConnectProviderList.
+func (in *OpenIDConnectProviderList) DeepCopy() *OpenIDConnectProviderList {
+	if in == nil {
+		return nil
+	}
+	out := new(OpenIDConnectProviderList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *OpenIDConnectProviderList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *OpenIDConnectProviderObservation) DeepCopyInto(out *OpenIDConnectProviderObservation) {
+	*out = *in
+	if in.CreateDate != nil {
+		in, out := &in.CreateDate, &out.CreateDate
+		*out = (*in).DeepCopy()
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new OpenIDConnectProviderObservation.
+func (in *OpenIDConnectProviderObservation) DeepCopy() *OpenIDConnectProviderObservation {
+	if in == nil {
+		return nil
+	}
+	out := new(OpenIDConnectProviderObservation)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *OpenIDConnectProviderParameters) DeepCopyInto(out *OpenIDConnectProviderParameters) {
+	*out = *in
+	if in.ClientIDList != nil {
+		in, out := &in.ClientIDList, &out.ClientIDList
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.Tags != nil {
+		in, out := &in.Tags, &out.Tags
+		*out = make([]Tag, len(*in))
+		copy(*out, *in)
+	}
+	if in.ThumbprintList != nil {
+		in, out := &in.ThumbprintList, &out.ThumbprintList
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new OpenIDConnectProviderParameters.
+func (in *OpenIDConnectProviderParameters) DeepCopy() *OpenIDConnectProviderParameters {
+	if in == nil {
+		return nil
+	}
+	out := new(OpenIDConnectProviderParameters)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *OpenIDConnectProviderSpec) DeepCopyInto(out *OpenIDConnectProviderSpec) {
+	*out = *in
+	in.ResourceSpec.DeepCopyInto(&out.ResourceSpec)
+	in.ForProvider.DeepCopyInto(&out.ForProvider)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new OpenIDConnectProviderSpec.
+func (in *OpenIDConnectProviderSpec) DeepCopy() *OpenIDConnectProviderSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(OpenIDConnectProviderSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *OpenIDConnectProviderStatus) DeepCopyInto(out *OpenIDConnectProviderStatus) {
+	*out = *in
+	in.ResourceStatus.DeepCopyInto(&out.ResourceStatus)
+	in.AtProvider.DeepCopyInto(&out.AtProvider)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new OpenIDConnectProviderStatus.
+func (in *OpenIDConnectProviderStatus) DeepCopy() *OpenIDConnectProviderStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(OpenIDConnectProviderStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *Policy) DeepCopyInto(out *Policy) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Policy.
+func (in *Policy) DeepCopy() *Policy {
+	if in == nil {
+		return nil
+	}
+	out := new(Policy)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *Policy) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PolicyList) DeepCopyInto(out *PolicyList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]Policy, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PolicyList.
+func (in *PolicyList) DeepCopy() *PolicyList {
+	if in == nil {
+		return nil
+	}
+	out := new(PolicyList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *PolicyList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PolicyObservation) DeepCopyInto(out *PolicyObservation) {
+	*out = *in
+	if in.Versions != nil {
+		in, out := &in.Versions, &out.Versions
+		*out = make([]PolicyVersion, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PolicyObservation.
+func (in *PolicyObservation) DeepCopy() *PolicyObservation {
+	if in == nil {
+		return nil
+	}
+	out := new(PolicyObservation)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PolicyParameters) DeepCopyInto(out *PolicyParameters) {
+	*out = *in
+	if in.Description != nil {
+		in, out := &in.Description, &out.Description
+		*out = new(string)
+		**out = **in
+	}
+	if in.Path != nil {
+		in, out := &in.Path, &out.Path
+		*out = new(string)
+		**out = **in
+	}
+	if in.Tags != nil {
+		in, out := &in.Tags, &out.Tags
+		*out = make([]Tag, len(*in))
+		copy(*out, *in)
+	}
+	if in.VersionRetention != nil {
+		in, out := &in.VersionRetention, &out.VersionRetention
+		*out = new(PolicyVersionRetention)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.DefaultVersionID != nil {
+		in, out := &in.DefaultVersionID, &out.DefaultVersionID
+		*out = new(string)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PolicyParameters.
+func (in *PolicyParameters) DeepCopy() *PolicyParameters {
+	if in == nil {
+		return nil
+	}
+	out := new(PolicyParameters)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PolicySpec) DeepCopyInto(out *PolicySpec) {
+	*out = *in
+	in.ResourceSpec.DeepCopyInto(&out.ResourceSpec)
+	in.ForProvider.DeepCopyInto(&out.ForProvider)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PolicySpec.
+func (in *PolicySpec) DeepCopy() *PolicySpec {
+	if in == nil {
+		return nil
+	}
+	out := new(PolicySpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PolicyStatus) DeepCopyInto(out *PolicyStatus) {
+	*out = *in
+	in.ResourceStatus.DeepCopyInto(&out.ResourceStatus)
+	in.AtProvider.DeepCopyInto(&out.AtProvider)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PolicyStatus.
+func (in *PolicyStatus) DeepCopy() *PolicyStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(PolicyStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PolicyVersion) DeepCopyInto(out *PolicyVersion) {
+	*out = *in
+	if in.CreateDate != nil {
+		in, out := &in.CreateDate, &out.CreateDate
+		*out = (*in).DeepCopy()
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PolicyVersion.
+func (in *PolicyVersion) DeepCopy() *PolicyVersion {
+	if in == nil {
+		return nil
+	}
+	out := new(PolicyVersion)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PolicyVersionRetention) DeepCopyInto(out *PolicyVersionRetention) {
+	*out = *in
+	if in.MaxVersions != nil {
+		in, out := &in.MaxVersions, &out.MaxVersions
+		*out = new(int32)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PolicyVersionRetention.
+func (in *PolicyVersionRetention) DeepCopy() *PolicyVersionRetention {
+	if in == nil {
+		return nil
+	}
+	out := new(PolicyVersionRetention)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *Role) DeepCopyInto(out *Role) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Role.
+func (in *Role) DeepCopy() *Role {
+	if in == nil {
+		return nil
+	}
+	out := new(Role)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *Role) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RoleExternalStatus) DeepCopyInto(out *RoleExternalStatus) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RoleExternalStatus.
+func (in *RoleExternalStatus) DeepCopy() *RoleExternalStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(RoleExternalStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RoleList) DeepCopyInto(out *RoleList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]Role, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RoleList.
+func (in *RoleList) DeepCopy() *RoleList {
+	if in == nil {
+		return nil
+	}
+	out := new(RoleList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *RoleList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RoleParameters) DeepCopyInto(out *RoleParameters) {
+	*out = *in
+	if in.Description != nil {
+		in, out := &in.Description, &out.Description
+		*out = new(string)
+		**out = **in
+	}
+	if in.MaxSessionDuration != nil {
+		in, out := &in.MaxSessionDuration, &out.MaxSessionDuration
+		*out = new(int32)
+		**out = **in
+	}
+	if in.Path != nil {
+		in, out := &in.Path, &out.Path
+		*out = new(string)
+		**out = **in
+	}
+	if in.PermissionsBoundary != nil {
+		in, out := &in.PermissionsBoundary, &out.PermissionsBoundary
+		*out = new(string)
+		**out = **in
+	}
+	if in.Tags != nil {
+		in, out := &in.Tags, &out.Tags
+		*out = make([]Tag, len(*in))
+		copy(*out, *in)
+	}
+	if in.RemoveUnmanagedInlinePolicies != nil {
+		in, out := &in.RemoveUnmanagedInlinePolicies, &out.RemoveUnmanagedInlinePolicies
+		*out = new(bool)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RoleParameters.
+func (in *RoleParameters) DeepCopy() *RoleParameters {
+	if in == nil {
+		return nil
+	}
+	out := new(RoleParameters)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RolePolicy) DeepCopyInto(out *RolePolicy) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RolePolicy.
+func (in *RolePolicy) DeepCopy() *RolePolicy {
+	if in == nil {
+		return nil
+	}
+	out := new(RolePolicy)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *RolePolicy) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RolePolicyAttachment) DeepCopyInto(out *RolePolicyAttachment) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RolePolicyAttachment.
+func (in *RolePolicyAttachment) DeepCopy() *RolePolicyAttachment {
+	if in == nil {
+		return nil
+	}
+	out := new(RolePolicyAttachment)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *RolePolicyAttachment) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RolePolicyAttachmentExternalStatus) DeepCopyInto(out *RolePolicyAttachmentExternalStatus) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RolePolicyAttachmentExternalStatus.
+func (in *RolePolicyAttachmentExternalStatus) DeepCopy() *RolePolicyAttachmentExternalStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(RolePolicyAttachmentExternalStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RolePolicyAttachmentList) DeepCopyInto(out *RolePolicyAttachmentList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]RolePolicyAttachment, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RolePolicyAttachmentList.
+func (in *RolePolicyAttachmentList) DeepCopy() *RolePolicyAttachmentList {
+	if in == nil {
+		return nil
+	}
+	out := new(RolePolicyAttachmentList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *RolePolicyAttachmentList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RolePolicyAttachmentParameters) DeepCopyInto(out *RolePolicyAttachmentParameters) {
+	*out = *in
+	if in.PolicyARNRef != nil {
+		in, out := &in.PolicyARNRef, &out.PolicyARNRef
+		*out = new(v1.Reference)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.PolicyARNSelector != nil {
+		in, out := &in.PolicyARNSelector, &out.PolicyARNSelector
+		*out = new(v1.Selector)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.RoleNameRef != nil {
+		in, out := &in.RoleNameRef, &out.RoleNameRef
+		*out = new(v1.Reference)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.RoleNameSelector != nil {
+		in, out := &in.RoleNameSelector, &out.RoleNameSelector
+		*out = new(v1.Selector)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RolePolicyAttachmentParameters.
+func (in *RolePolicyAttachmentParameters) DeepCopy() *RolePolicyAttachmentParameters {
+	if in == nil {
+		return nil
+	}
+	out := new(RolePolicyAttachmentParameters)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RolePolicyAttachmentSpec) DeepCopyInto(out *RolePolicyAttachmentSpec) {
+	*out = *in
+	in.ResourceSpec.DeepCopyInto(&out.ResourceSpec)
+	in.ForProvider.DeepCopyInto(&out.ForProvider)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RolePolicyAttachmentSpec.
+func (in *RolePolicyAttachmentSpec) DeepCopy() *RolePolicyAttachmentSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(RolePolicyAttachmentSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RolePolicyAttachmentStatus) DeepCopyInto(out *RolePolicyAttachmentStatus) {
+	*out = *in
+	in.ResourceStatus.DeepCopyInto(&out.ResourceStatus)
+	out.AtProvider = in.AtProvider
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RolePolicyAttachmentStatus.
+func (in *RolePolicyAttachmentStatus) DeepCopy() *RolePolicyAttachmentStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(RolePolicyAttachmentStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RolePolicyList) DeepCopyInto(out *RolePolicyList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]RolePolicy, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RolePolicyList.
+func (in *RolePolicyList) DeepCopy() *RolePolicyList {
+	if in == nil {
+		return nil
+	}
+	out := new(RolePolicyList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *RolePolicyList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RolePolicyParameters) DeepCopyInto(out *RolePolicyParameters) {
+	*out = *in
+	if in.RoleNameRef != nil {
+		in, out := &in.RoleNameRef, &out.RoleNameRef
+		*out = new(v1.Reference)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.RoleNameSelector != nil {
+		in, out := &in.RoleNameSelector, &out.RoleNameSelector
+		*out = new(v1.Selector)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RolePolicyParameters.
+func (in *RolePolicyParameters) DeepCopy() *RolePolicyParameters {
+	if in == nil {
+		return nil
+	}
+	out := new(RolePolicyParameters)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RolePolicySpec) DeepCopyInto(out *RolePolicySpec) {
+	*out = *in
+	in.ResourceSpec.DeepCopyInto(&out.ResourceSpec)
+	in.ForProvider.DeepCopyInto(&out.ForProvider)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RolePolicySpec.
+func (in *RolePolicySpec) DeepCopy() *RolePolicySpec {
+	if in == nil {
+		return nil
+	}
+	out := new(RolePolicySpec)
+	in.DeepCopyInto(out)
+	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *RoleExternalStatus) DeepCopyInto(out *RoleExternalStatus) {
+func (in *RolePolicyStatus) DeepCopyInto(out *RolePolicyStatus) {
 	*out = *in
+	in.ResourceStatus.DeepCopyInto(&out.ResourceStatus)
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RoleExternalStatus.
-func (in *RoleExternalStatus) DeepCopy() *RoleExternalStatus {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RolePolicyStatus.
+func (in *RolePolicyStatus) DeepCopy() *RolePolicyStatus {
 	if in == nil {
 		return nil
 	}
-	out := new(RoleExternalStatus)
+	out := new(RolePolicyStatus)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *RoleList) DeepCopyInto(out *RoleList) {
+func (in *RoleSpec) DeepCopyInto(out *RoleSpec) {
 	*out = *in
-	out.TypeMeta = in.TypeMeta
-	in.ListMeta.DeepCopyInto(&out.ListMeta)
-	if in.Items != nil {
-		in, out := &in.Items, &out.Items
-		*out = make([]Role, len(*in))
-		for i := range *in {
-			(*in)[i].DeepCopyInto(&(*out)[i])
-		}
-	}
+	in.ResourceSpec.DeepCopyInto(&out.ResourceSpec)
+	in.ForProvider.DeepCopyInto(&out.ForProvider)
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RoleList.
-func (in *RoleList) DeepCopy() *RoleList {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RoleSpec.
+func (in *RoleSpec) DeepCopy() *RoleSpec {
 	if in == nil {
 		return nil
 	}
-	out := new(RoleList)
+	out := new(RoleSpec)
 	in.DeepCopyInto(out)
 	return out
 }
 
-// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
-func (in *RoleList) DeepCopyObject() runtime.Object {
-	if c := in.DeepCopy(); c != nil {
-		return c
-	}
-	return nil
-}
-
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *RoleParameters) DeepCopyInto(out *RoleParameters) {
+func (in *RoleStatus) DeepCopyInto(out *RoleStatus) {
 	*out = *in
-	if in.Description != nil {
-		in, out := &in.Description, &out.Description
-		*out = new(string)
-		**out = **in
-	}
-	if in.MaxSessionDuration != nil {
-		in, out := &in.MaxSessionDuration, &out.MaxSessionDuration
-		*out = new(int32)
-		**out = **in
-	}
-	if in.Path != nil {
-		in, out := &in.Path, &out.Path
-		*out = new(string)
-		**out = **in
-	}
-	if in.PermissionsBoundary != nil {
-		in, out := &in.PermissionsBoundary, &out.PermissionsBoundary
-		*out = new(string)
-		**out = **in
-	}
-	if in.Tags != nil {
-		in, out := &in.Tags, &out.Tags
-		*out = make([]Tag, len(*in))
-		copy(*out, *in)
-	}
+	in.ResourceStatus.DeepCopyInto(&out.ResourceStatus)
+	out.AtProvider = in.AtProvider
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RoleParameters.
-func (in *RoleParameters) DeepCopy() *RoleParameters {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RoleStatus.
+func (in *RoleStatus) DeepCopy() *RoleStatus {
 	if in == nil {
 		return nil
 	}
-	out := new(RoleParameters)
+	out := new(RoleStatus)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *RolePolicyAttachment) DeepCopyInto(out *RolePolicyAttachment) {
+func (in *SAMLProvider) DeepCopyInto(out *SAMLProvider) {
 	*out = *in
 	out.TypeMeta = in.TypeMeta
 	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
@@ -960,18 +1914,18 @@ func (in *RolePolicyAttachment) DeepCopyInto(out *RolePolicyAttachment) {
 	in.Status.DeepCopyInto(&out.Status)
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RolePolicyAttachment.
-func (in *RolePolicyAttachment) DeepCopy() *RolePolicyAttachment {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SAMLProvider.
+func (in *SAMLProvider) DeepCopy() *SAMLProvider {
 	if in == nil {
 		return nil
 	}
-	out := new(RolePolicyAttachment)
+	out := new(SAMLProvider)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
-func (in *RolePolicyAttachment) DeepCopyObject() runtime.Object {
+func (in *SAMLProvider) DeepCopyObject() runtime.Object {
 	if c := in.DeepCopy(); c != nil {
 		return c
 	}
@@ -979,46 +1933,31 @@ func (in *RolePolicyAttachment) DeepCopyObject() runtime.Object {
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *RolePolicyAttachmentExternalStatus) DeepCopyInto(out *RolePolicyAttachmentExternalStatus) {
-	*out = *in
-}
-
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RolePolicyAttachmentExternalStatus.
-func (in *RolePolicyAttachmentExternalStatus) DeepCopy() *RolePolicyAttachmentExternalStatus {
-	if in == nil {
-		return nil
-	}
-	out := new(RolePolicyAttachmentExternalStatus)
-	in.DeepCopyInto(out)
-	return out
-}
-
-// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *RolePolicyAttachmentList) DeepCopyInto(out *RolePolicyAttachmentList) {
+func (in *SAMLProviderList) DeepCopyInto(out *SAMLProviderList) {
 	*out = *in
 	out.TypeMeta = in.TypeMeta
 	in.ListMeta.DeepCopyInto(&out.ListMeta)
 	if in.Items != nil {
 		in, out := &in.Items, &out.Items
-		*out = make([]RolePolicyAttachment, len(*in))
+		*out = make([]SAMLProvider, len(*in))
 		for i := range *in {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RolePolicyAttachmentList.
-func (in *RolePolicyAttachmentList) DeepCopy() *RolePolicyAttachmentList {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SAMLProviderList.
+func (in *SAMLProviderList) DeepCopy() *SAMLProviderList {
 	if in == nil {
 		return nil
 	}
-	out := new(RolePolicyAttachmentList)
+	out := new(SAMLProviderList)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
-func (in *RolePolicyAttachmentList) DeepCopyObject() runtime.Object {
+func (in *SAMLProviderList) DeepCopyObject() runtime.Object {
 	if c := in.DeepCopy(); c != nil {
 		return c
 	}
@@ -1026,104 +1965,78 @@ func (in *RolePolicyAttachmentList) DeepCopyObject() runtime.Object {
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *RolePolicyAttachmentParameters) DeepCopyInto(out *RolePolicyAttachmentParameters) {
+func (in *SAMLProviderObservation) DeepCopyInto(out *SAMLProviderObservation) {
 	*out = *in
-	if in.PolicyARNRef != nil {
-		in, out := &in.PolicyARNRef, &out.PolicyARNRef
-		*out = new(v1.Reference)
-		(*in).DeepCopyInto(*out)
-	}
-	if in.PolicyARNSelector != nil {
-		in, out := &in.PolicyARNSelector, &out.PolicyARNSelector
-		*out = new(v1.Selector)
-		(*in).DeepCopyInto(*out)
-	}
-	if in.RoleNameRef != nil {
-		in, out := &in.RoleNameRef, &out.RoleNameRef
-		*out = new(v1.Reference)
-		(*in).DeepCopyInto(*out)
+	if in.CreateDate != nil {
+		in, out := &in.CreateDate, &out.CreateDate
+		*out = (*in).DeepCopy()
 	}
-	if in.RoleNameSelector != nil {
-		in, out := &in.RoleNameSelector, &out.RoleNameSelector
-		*out = new(v1.Selector)
-		(*in).DeepCopyInto(*out)
+	if in.ValidUntil != nil {
+		in, out := &in.ValidUntil, &out.ValidUntil
+		*out = (*in).DeepCopy()
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RolePolicyAttachmentParameters.
-func (in *RolePolicyAttachmentParameters) DeepCopy() *RolePolicyAttachmentParameters {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SAMLProviderObservation.
+func (in *SAMLProviderObservation) DeepCopy() *SAMLProviderObservation {
 	if in == nil {
 		return nil
 	}
-	out := new(RolePolicyAttachmentParameters)
+	out := new(SAMLProviderObservation)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *RolePolicyAttachmentSpec) DeepCopyInto(out *RolePolicyAttachmentSpec) {
+func (in *SAMLProviderParameters) DeepCopyInto(out *SAMLProviderParameters) {
 	*out = *in
-	in.ResourceSpec.DeepCopyInto(&out.ResourceSpec)
-	in.ForProvider.DeepCopyInto(&out.ForProvider)
-}
-
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RolePolicyAttachmentSpec.
-func (in *RolePolicyAttachmentSpec) DeepCopy() *RolePolicyAttachmentSpec {
-	if in == nil {
-		return nil
+	if in.Tags != nil {
+		in, out := &in.Tags, &out.Tags
+		*out = make([]Tag, len(*in))
+		copy(*out, *in)
 	}
-	out := new(RolePolicyAttachmentSpec)
-	in.DeepCopyInto(out)
-	return out
-}
-
-// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *RolePolicyAttachmentStatus) DeepCopyInto(out *RolePolicyAttachmentStatus) {
-	*out = *in
-	in.ResourceStatus.DeepCopyInto(&out.ResourceStatus)
-	out.AtProvider = in.AtProvider
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RolePolicyAttachmentStatus.
-func (in *RolePolicyAttachmentStatus) DeepCopy() *RolePolicyAttachmentStatus {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SAMLProviderParameters.
+func (in *SAMLProviderParameters) DeepCopy() *SAMLProviderParameters {
 	if in == nil {
 		return nil
 	}
-	out := new(RolePolicyAttachmentStatus)
+	out := new(SAMLProviderParameters)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *RoleSpec) DeepCopyInto(out *RoleSpec) {
+func (in *SAMLProviderSpec) DeepCopyInto(out *SAMLProviderSpec) {
 	*out = *in
 	in.ResourceSpec.DeepCopyInto(&out.ResourceSpec)
 	in.ForProvider.DeepCopyInto(&out.ForProvider)
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RoleSpec.
-func (in *RoleSpec) DeepCopy() *RoleSpec {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SAMLProviderSpec.
+func (in *SAMLProviderSpec) DeepCopy() *SAMLProviderSpec {
 	if in == nil {
 		return nil
 	}
-	out := new(RoleSpec)
+	out := new(SAMLProviderSpec)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *RoleStatus) DeepCopyInto(out *RoleStatus) {
+func (in *SAMLProviderStatus) DeepCopyInto(out *SAMLProviderStatus) {
 	*out = *in
 	in.ResourceStatus.DeepCopyInto(&out.ResourceStatus)
-	out.AtProvider = in.AtProvider
+	in.AtProvider.DeepCopyInto(&out.AtProvider)
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RoleStatus.
-func (in *RoleStatus) DeepCopy() *RoleStatus {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SAMLProviderStatus.
+func (in *SAMLProviderStatus) DeepCopy() *SAMLProviderStatus {
 	if in == nil {
 		return nil
 	}
-	out := new(RoleStatus)
+	out := new(SAMLProviderStatus)
 	in.DeepCopyInto(out)
 	return out
 }
@@ -1235,6 +2148,11 @@ func (in *UserParameters) DeepCopyInto(out *UserParameters) {
 		*out = make([]Tag, len(*in))
 		copy(*out, *in)
 	}
+	if in.RemoveUnmanagedInlinePolicies != nil {
+		in, out := &in.RemoveUnmanagedInlinePolicies, &out.RemoveUnmanagedInlinePolicies
+		*out = new(bool)
+		**out = **in
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new UserParameters.
@@ -1247,6 +2165,33 @@ func (in *UserParameters) DeepCopy() *UserParameters {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *UserPolicy) DeepCopyInto(out *UserPolicy) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new UserPolicy.
+func (in *UserPolicy) DeepCopy() *UserPolicy {
+	if in == nil {
+		return nil
+	}
+	out := new(UserPolicy)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *UserPolicy) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *UserPolicyAttachment) DeepCopyInto(out *UserPolicyAttachment) {
 	*out = *in
@@ -1390,6 +2335,96 @@ func (in *UserPolicyAttachmentStatus) DeepCopy() *UserPolicyAttachmentStatus {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *UserPolicyList) DeepCopyInto(out *UserPolicyList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]UserPolicy, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new UserPolicyList.
+func (in *UserPolicyList) DeepCopy() *UserPolicyList {
+	if in == nil {
+		return nil
+	}
+	out := new(UserPolicyList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *UserPolicyList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *UserPolicyParameters) DeepCopyInto(out *UserPolicyParameters) {
+	*out = *in
+	if in.UserNameRef != nil {
+		in, out := &in.UserNameRef, &out.UserNameRef
+		*out = new(v1.Reference)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.UserNameSelector != nil {
+		in, out := &in.UserNameSelector, &out.UserNameSelector
+		*out = new(v1.Selector)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new UserPolicyParameters.
+func (in *UserPolicyParameters) DeepCopy() *UserPolicyParameters {
+	if in == nil {
+		return nil
+	}
+	out := new(UserPolicyParameters)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *UserPolicySpec) DeepCopyInto(out *UserPolicySpec) {
+	*out = *in
+	in.ResourceSpec.DeepCopyInto(&out.ResourceSpec)
+	in.ForProvider.DeepCopyInto(&out.ForProvider)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new UserPolicySpec.
+func (in *UserPolicySpec) DeepCopy() *UserPolicySpec {
+	if in == nil {
+		return nil
+	}
+	out := new(UserPolicySpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *UserPolicyStatus) DeepCopyInto(out *UserPolicyStatus) {
+	*out = *in
+	in.ResourceStatus.DeepCopyInto(&out.ResourceStatus)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new UserPolicyStatus.
+func (in *UserPolicyStatus) DeepCopy() *UserPolicyStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(UserPolicyStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *UserSpec) DeepCopyInto(out *UserSpec) {
 	*out = *in
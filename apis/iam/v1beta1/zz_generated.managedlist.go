@@ -29,6 +29,24 @@ func (l *AccessKeyList) GetItems() []resource.Managed {
 	return items
 }
 
+// GetItems of this AccountAliasList.
+func (l *AccountAliasList) GetItems() []resource.Managed {
+	items := make([]resource.Managed, len(l.Items))
+	for i := range l.Items {
+		items[i] = &l.Items[i]
+	}
+	return items
+}
+
+// GetItems of this AccountPasswordPolicyList.
+func (l *AccountPasswordPolicyList) GetItems() []resource.Managed {
+	items := make([]resource.Managed, len(l.Items))
+	for i := range l.Items {
+		items[i] = &l.Items[i]
+	}
+	return items
+}
+
 // GetItems of this GroupList.
 func (l *GroupList) GetItems() []resource.Managed {
 	items := make([]resource.Managed, len(l.Items))
@@ -47,6 +65,15 @@ func (l *GroupPolicyAttachmentList) GetItems() []resource.Managed {
 	return items
 }
 
+// GetItems of this GroupPolicyList.
+func (l *GroupPolicyList) GetItems() []resource.Managed {
+	items := make([]resource.Managed, len(l.Items))
+	for i := range l.Items {
+		items[i] = &l.Items[i]
+	}
+	return items
+}
+
 // GetItems of this GroupUserMembershipList.
 func (l *GroupUserMembershipList) GetItems() []resource.Managed {
 	items := make([]resource.Managed, len(l.Items))
@@ -56,6 +83,15 @@ func (l *GroupUserMembershipList) GetItems() []resource.Managed {
 	return items
 }
 
+// GetItems of this LoginProfileList.
+func (l *LoginProfileList) GetItems() []resource.Managed {
+	items := make([]resource.Managed, len(l.Items))
+	for i := range l.Items {
+		items[i] = &l.Items[i]
+	}
+	return items
+}
+
 // GetItems of this OpenIDConnectProviderList.
 func (l *OpenIDConnectProviderList) GetItems() []resource.Managed {
 	items := make([]resource.Managed, len(l.Items))
@@ -92,6 +128,24 @@ func (l *RolePolicyAttachmentList) GetItems() []resource.Managed {
 	return items
 }
 
+// GetItems of this RolePolicyList.
+func (l *RolePolicyList) GetItems() []resource.Managed {
+	items := make([]resource.Managed, len(l.Items))
+	for i := range l.Items {
+		items[i] = &l.Items[i]
+	}
+	return items
+}
+
+// GetItems of this SAMLProviderList.
+func (l *SAMLProviderList) GetItems() []resource.Managed {
+	items := make([]resource.Managed, len(l.Items))
+	for i := range l.Items {
+		items[i] = &l.Items[i]
+	}
+	return items
+}
+
 // GetItems of this UserList.
 func (l *UserList) GetItems() []resource.Managed {
 	items := make([]resource.Managed, len(l.Items))
@@ -109,3 +163,12 @@ func (l *UserPolicyAttachmentList) GetItems() []resource.Managed {
 	}
 	return items
 }
+
+// GetItems of this UserPolicyList.
+func (l *UserPolicyList) GetItems() []resource.Managed {
+	items := make([]resource.Managed, len(l.Items))
+	for i := range l.Items {
+		items[i] = &l.Items[i]
+	}
+	return items
+}
@@ -51,6 +51,32 @@ func (mg *AccessKey) ResolveReferences(ctx context.Context, c client.Reader) err
 	return nil
 }
 
+// ResolveReferences of this GroupPolicy.
+func (mg *GroupPolicy) ResolveReferences(ctx context.Context, c client.Reader) error {
+	r := reference.NewAPIResolver(c, mg)
+
+	var rsp reference.ResolutionResponse
+	var err error
+
+	rsp, err = r.Resolve(ctx, reference.ResolutionRequest{
+		CurrentValue: mg.Spec.ForProvider.GroupName,
+		Extract:      reference.ExternalName(),
+		Reference:    mg.Spec.ForProvider.GroupNameRef,
+		Selector:     mg.Spec.ForProvider.GroupNameSelector,
+		To: reference.To{
+			List:    &GroupList{},
+			Managed: &Group{},
+		},
+	})
+	if err != nil {
+		return errors.Wrap(err, "mg.Spec.ForProvider.GroupName")
+	}
+	mg.Spec.ForProvider.GroupName = rsp.ResolvedValue
+	mg.Spec.ForProvider.GroupNameRef = rsp.ResolvedReference
+
+	return nil
+}
+
 // ResolveReferences of this GroupPolicyAttachment.
 func (mg *GroupPolicyAttachment) ResolveReferences(ctx context.Context, c client.Reader) error {
 	r := reference.NewAPIResolver(c, mg)
@@ -135,6 +161,58 @@ func (mg *GroupUserMembership) ResolveReferences(ctx context.Context, c client.R
 	return nil
 }
 
+// ResolveReferences of this LoginProfile.
+func (mg *LoginProfile) ResolveReferences(ctx context.Context, c client.Reader) error {
+	r := reference.NewAPIResolver(c, mg)
+
+	var rsp reference.ResolutionResponse
+	var err error
+
+	rsp, err = r.Resolve(ctx, reference.ResolutionRequest{
+		CurrentValue: mg.Spec.ForProvider.UserName,
+		Extract:      reference.ExternalName(),
+		Reference:    mg.Spec.ForProvider.UserNameRef,
+		Selector:     mg.Spec.ForProvider.UserNameSelector,
+		To: reference.To{
+			List:    &UserList{},
+			Managed: &User{},
+		},
+	})
+	if err != nil {
+		return errors.Wrap(err, "mg.Spec.ForProvider.UserName")
+	}
+	mg.Spec.ForProvider.UserName = rsp.ResolvedValue
+	mg.Spec.ForProvider.UserNameRef = rsp.ResolvedReference
+
+	return nil
+}
+
+// ResolveReferences of this RolePolicy.
+func (mg *RolePolicy) ResolveReferences(ctx context.Context, c client.Reader) error {
+	r := reference.NewAPIResolver(c, mg)
+
+	var rsp reference.ResolutionResponse
+	var err error
+
+	rsp, err = r.Resolve(ctx, reference.ResolutionRequest{
+		CurrentValue: mg.Spec.ForProvider.RoleName,
+		Extract:      reference.ExternalName(),
+		Reference:    mg.Spec.ForProvider.RoleNameRef,
+		Selector:     mg.Spec.ForProvider.RoleNameSelector,
+		To: reference.To{
+			List:    &RoleList{},
+			Managed: &Role{},
+		},
+	})
+	if err != nil {
+		return errors.Wrap(err, "mg.Spec.ForProvider.RoleName")
+	}
+	mg.Spec.ForProvider.RoleName = rsp.ResolvedValue
+	mg.Spec.ForProvider.RoleNameRef = rsp.ResolvedReference
+
+	return nil
+}
+
 // ResolveReferences of this RolePolicyAttachment.
 func (mg *RolePolicyAttachment) ResolveReferences(ctx context.Context, c client.Reader) error {
 	r := reference.NewAPIResolver(c, mg)
@@ -177,6 +255,32 @@ func (mg *RolePolicyAttachment) ResolveReferences(ctx context.Context, c client.
 	return nil
 }
 
+// ResolveReferences of this UserPolicy.
+func (mg *UserPolicy) ResolveReferences(ctx context.Context, c client.Reader) error {
+	r := reference.NewAPIResolver(c, mg)
+
+	var rsp reference.ResolutionResponse
+	var err error
+
+	rsp, err = r.Resolve(ctx, reference.ResolutionRequest{
+		CurrentValue: mg.Spec.ForProvider.UserName,
+		Extract:      reference.ExternalName(),
+		Reference:    mg.Spec.ForProvider.UserNameRef,
+		Selector:     mg.Spec.ForProvider.UserNameSelector,
+		To: reference.To{
+			List:    &UserList{},
+			Managed: &User{},
+		},
+	})
+	if err != nil {
+		return errors.Wrap(err, "mg.Spec.ForProvider.UserName")
+	}
+	mg.Spec.ForProvider.UserName = rsp.ResolvedValue
+	mg.Spec.ForProvider.UserNameRef = rsp.ResolvedReference
+
+	return nil
+}
+
 // ResolveReferences of this UserPolicyAttachment.
 func (mg *UserPolicyAttachment) ResolveReferences(ctx context.Context, c client.Reader) error {
 	r := reference.NewAPIResolver(c, mg)
@@ -0,0 +1,176 @@
+/*
+Copyright 2023 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+)
+
+// ObjectParameters define the desired state of an AWS S3 Object.
+type ObjectParameters struct {
+	// Region is where the Bucket of this Object resides.
+	// +immutable
+	Region string `json:"region"`
+
+	// BucketName is the name of the Bucket that the Object is stored in.
+	// +optional
+	// +immutable
+	// +crossplane:generate:reference:type=github.com/crossplane-contrib/provider-aws/apis/s3/v1beta1.Bucket
+	BucketName *string `json:"bucketName,omitempty"`
+
+	// BucketNameRef references a Bucket to retrieve its name.
+	// +optional
+	BucketNameRef *xpv1.Reference `json:"bucketNameRef,omitempty"`
+
+	// BucketNameSelector selects a reference to a Bucket to retrieve its name.
+	// +optional
+	BucketNameSelector *xpv1.Selector `json:"bucketNameSelector,omitempty"`
+
+	// Key is the key of the Object in the Bucket.
+	// +immutable
+	// +kubebuilder:validation:MinLength=1
+	Key string `json:"key"`
+
+	// Content is the inline content of the Object.
+	// Exactly one of content and contentFrom must be specified.
+	// +optional
+	Content *string `json:"content,omitempty"`
+
+	// ContentFrom is the source of the content of the Object.
+	// Exactly one of content and contentFrom must be specified.
+	// +optional
+	ContentFrom *ObjectContentSource `json:"contentFrom,omitempty"`
+
+	// A standard MIME type describing the format of the contents. For more
+	// information, see http://www.w3.org/Protocols/rfc2616/rfc2616-sec14.html#sec14.17
+	// (http://www.w3.org/Protocols/rfc2616/rfc2616-sec14.html#sec14.17).
+	// +optional
+	ContentType *string `json:"contentType,omitempty"`
+
+	// The server-side encryption algorithm used when storing this object in
+	// Amazon S3. Options are AES256 or aws:kms.
+	// +optional
+	ServerSideEncryption *string `json:"serverSideEncryption,omitempty"`
+
+	// SSEKMSKeyID is the ID of the symmetric customer managed AWS KMS key to
+	// use for the object encryption if serverSideEncryption is aws:kms.
+	// +optional
+	// +crossplane:generate:reference:type=github.com/crossplane-contrib/provider-aws/apis/kms/v1alpha1.Key
+	SSEKMSKeyID *string `json:"sseKmsKeyId,omitempty"`
+
+	// SSEKMSKeyIDRef references a KMS Key to retrieve its ID.
+	// +optional
+	SSEKMSKeyIDRef *xpv1.Reference `json:"sseKmsKeyIdRef,omitempty"`
+
+	// SSEKMSKeyIDSelector selects a reference to a KMS Key to retrieve its ID.
+	// +optional
+	SSEKMSKeyIDSelector *xpv1.Selector `json:"sseKmsKeyIdSelector,omitempty"`
+
+	// Metadata is a map of user-defined metadata to store with the Object.
+	// Keys are case-insensitive and are stored in lower case.
+	// +optional
+	Metadata map[string]string `json:"metadata,omitempty"`
+
+	// Tags is a map of tags to assign to the Object.
+	// +optional
+	Tags map[string]string `json:"tags,omitempty"`
+}
+
+// ObjectContentSource is the source of the content of an Object.
+// Exactly one of configMapKeyRef and secretKeyRef must be specified.
+type ObjectContentSource struct {
+	// ConfigMapKeyRef selects a key of a ConfigMap whose value is the
+	// content of the Object. Both data and binaryData of the ConfigMap are
+	// looked up.
+	// +optional
+	ConfigMapKeyRef *ConfigMapKeySelector `json:"configMapKeyRef,omitempty"`
+
+	// SecretKeyRef selects a key of a Secret whose value is the content of
+	// the Object.
+	// +optional
+	SecretKeyRef *xpv1.SecretKeySelector `json:"secretKeyRef,omitempty"`
+}
+
+// A ConfigMapKeySelector is a reference to a key of a ConfigMap in an
+// arbitrary namespace.
+type ConfigMapKeySelector struct {
+	// Name of the ConfigMap.
+	Name string `json:"name"`
+
+	// Namespace of the ConfigMap.
+	Namespace string `json:"namespace"`
+
+	// The key to select.
+	Key string `json:"key"`
+}
+
+// ObjectObservation keeps the state for the external resource
+type ObjectObservation struct {
+	// ETag is the entity tag of the Object as last uploaded by the controller.
+	ETag string `json:"etag,omitempty"`
+
+	// VersionID is the version of the Object as last uploaded by the
+	// controller, if versioning is enabled for the Bucket.
+	VersionID string `json:"versionId,omitempty"`
+
+	// ContentMD5 is the base64 encoded MD5 digest of the content as last
+	// uploaded by the controller. It is used to detect changes of the
+	// content if the ETag of the Object is not its MD5 digest, e.g. for
+	// objects encrypted with SSE-KMS.
+	ContentMD5 string `json:"contentMD5,omitempty"`
+}
+
+// An ObjectSpec defines the desired state of an Object.
+type ObjectSpec struct {
+	xpv1.ResourceSpec `json:",inline"`
+	ForProvider       ObjectParameters `json:"forProvider"`
+}
+
+// An ObjectStatus represents the observed state of an Object.
+type ObjectStatus struct {
+	xpv1.ResourceStatus `json:",inline"`
+	AtProvider          ObjectObservation `json:"atProvider,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// An Object is a managed resource that represents an AWS S3 Object.
+// +kubebuilder:printcolumn:name="BUCKET",type="string",JSONPath=".spec.forProvider.bucketName"
+// +kubebuilder:printcolumn:name="KEY",type="string",JSONPath=".spec.forProvider.key"
+// +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:scope=Cluster,categories={crossplane,managed,aws}
+type Object struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   ObjectSpec   `json:"spec"`
+	Status ObjectStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// ObjectList contains a list of Objects
+type ObjectList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []Object `json:"items"`
+}
@@ -0,0 +1,119 @@
+/*
+Copyright 2023 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1beta1
+
+// AnalyticsConfiguration specifies the configuration and any analyses for
+// the analytics filter of an Amazon S3 bucket. For more information, see
+// Amazon S3 Analytics – Storage Class Analysis
+// (https://docs.aws.amazon.com/AmazonS3/latest/dev/analytics-storage-class.html)
+// in the Amazon Simple Storage Service Developer Guide.
+type AnalyticsConfiguration struct {
+	// The ID that identifies the analytics configuration.
+	ID string `json:"id"`
+
+	// The filter used to describe a set of objects for analyses. A filter must
+	// have exactly one prefix, one tag, or one conjunction (AnalyticsAndOperator).
+	// If no filter is provided, all objects will be considered in any
+	// analysis.
+	// +optional
+	Filter *AnalyticsFilter `json:"filter,omitempty"`
+
+	// Contains data related to access patterns to be collected and made
+	// available to analyze the tradeoffs between different storage classes.
+	StorageClassAnalysis StorageClassAnalysis `json:"storageClassAnalysis"`
+}
+
+// AnalyticsFilter is the filter used to describe a set of objects for
+// analyses. Exactly one of And, Prefix, or Tag must be specified.
+type AnalyticsFilter struct {
+	// A conjunction (logical AND) of predicates, which is used in evaluating
+	// an analytics filter. The operator must have at least two predicates.
+	// +optional
+	And *AnalyticsAndOperator `json:"and,omitempty"`
+
+	// The prefix to use when evaluating an analytics filter.
+	// +optional
+	Prefix *string `json:"prefix,omitempty"`
+
+	// The tag to use when evaluating an analytics filter.
+	// +optional
+	Tag *Tag `json:"tag,omitempty"`
+}
+
+// AnalyticsAndOperator is a conjunction (logical AND) of predicates, which is
+// used in evaluating an analytics filter.
+type AnalyticsAndOperator struct {
+	// The prefix to use when evaluating an AND predicate: The prefix that an
+	// object must have to be included in the metrics results.
+	// +optional
+	Prefix *string `json:"prefix,omitempty"`
+
+	// The list of tags to use when evaluating an AND predicate.
+	// +optional
+	Tags []Tag `json:"tags,omitempty"`
+}
+
+// StorageClassAnalysis specifies data related to access patterns to be
+// collected and made available to analyze the tradeoffs between different
+// storage classes for an Amazon S3 bucket.
+type StorageClassAnalysis struct {
+	// Specifies how data related to the storage class analysis for an Amazon
+	// S3 bucket should be exported.
+	// +optional
+	DataExport *StorageClassAnalysisDataExport `json:"dataExport,omitempty"`
+}
+
+// StorageClassAnalysisDataExport is a container for data related to the
+// storage class analysis for an Amazon S3 bucket for export.
+type StorageClassAnalysisDataExport struct {
+	// The place to store the data for an analysis.
+	Destination AnalyticsExportDestination `json:"destination"`
+
+	// The version of the output schema to use when exporting data.
+	// +kubebuilder:validation:Enum=V_1
+	OutputSchemaVersion string `json:"outputSchemaVersion"`
+}
+
+// AnalyticsExportDestination specifies where to publish the analytics
+// results.
+type AnalyticsExportDestination struct {
+	// A destination signifying output to an S3 bucket.
+	S3BucketDestination AnalyticsS3BucketDestination `json:"s3BucketDestination"`
+}
+
+// AnalyticsS3BucketDestination contains information about where to publish
+// the analytics results.
+type AnalyticsS3BucketDestination struct {
+	// The Amazon Resource Name (ARN) of the bucket to which data is exported.
+	Bucket string `json:"bucket"`
+
+	// The account ID that owns the destination S3 bucket. If no account ID is
+	// provided, the owner is not validated before exporting data. Although
+	// this value is optional, we strongly recommend that you set it to help
+	// prevent problems if the destination bucket ownership changes.
+	// +optional
+	BucketAccountID *string `json:"bucketAccountId,omitempty"`
+
+	// Specifies the file format used when exporting data to Amazon S3.
+	// +kubebuilder:validation:Enum=CSV
+	Format string `json:"format"`
+
+	// The prefix to use when exporting data. The prefix is prepended to all
+	// results.
+	// +optional
+	Prefix *string `json:"prefix,omitempty"`
+}
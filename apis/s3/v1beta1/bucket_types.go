@@ -173,6 +173,50 @@ type BucketParameters struct {
 
 	// PolicyUpdatePolicy specifies the update behaviour of `policy`.
 	PolicyUpdatePolicy *BucketPolicyUpdatePolicy `json:"policyUpdatePolicy,omitempty"`
+
+	// ObjectLockConfiguration places an Object Lock configuration on the
+	// bucket. The rule specified in the Object Lock configuration will be
+	// applied by default to every new object placed in the bucket. Object
+	// Lock can only be configured for buckets that were created with
+	// `objectLockEnabledForBucket`.
+	// +optional
+	ObjectLockConfiguration *ObjectLockConfiguration `json:"objectLockConfiguration,omitempty"`
+
+	// IntelligentTieringConfigurations are the S3 Intelligent-Tiering
+	// configurations of the bucket. Configurations that are not listed are
+	// removed from the bucket.
+	// +optional
+	IntelligentTieringConfigurations []IntelligentTieringConfiguration `json:"intelligentTieringConfigurations,omitempty"`
+
+	// InventoryConfigurations are the inventory configurations of the
+	// bucket. Configurations that are not listed are removed from the bucket.
+	// +optional
+	InventoryConfigurations []InventoryConfiguration `json:"inventoryConfigurations,omitempty"`
+
+	// MetricsConfigurations are the CloudWatch request metrics configurations
+	// of the bucket. Configurations that are not listed are removed from the
+	// bucket.
+	// +optional
+	MetricsConfigurations []MetricsConfiguration `json:"metricsConfigurations,omitempty"`
+
+	// AnalyticsConfigurations are the storage class analytics configurations
+	// of the bucket. Configurations that are not listed are removed from the
+	// bucket.
+	// +optional
+	AnalyticsConfigurations []AnalyticsConfiguration `json:"analyticsConfigurations,omitempty"`
+
+	// ForceDestroy specifies whether all objects, object versions, delete
+	// markers and incomplete multipart uploads are deleted from the bucket
+	// before the bucket itself is deleted. Otherwise, deleting a bucket that
+	// is not empty fails. Large buckets are emptied over several reconciles,
+	// see `status.atProvider.forceDestroy` for the progress.
+	// Object versions under a governance-mode Object Lock are deleted as
+	// well, which requires the s3:BypassGovernanceRetention permission.
+	// Object versions under a compliance-mode Object Lock or a legal hold
+	// cannot be deleted, so the deletion fails until they can.
+	// WARNING: The deleted objects cannot be recovered.
+	// +optional
+	ForceDestroy *bool `json:"forceDestroy,omitempty"`
 }
 
 // BucketPolicyUpdatePolicy specifies the update behaviour of a bucket policy.
@@ -219,6 +263,23 @@ type BucketExternalStatus struct {
 	// about ARNs and how to use them, see S3 Resources (https://docs.aws.amazon.com/AmazonS3/latest/dev/s3-arn-format.html)
 	// in the Amazon Simple Storage Service guide.
 	ARN string `json:"arn"`
+
+	// ForceDestroy reports the progress of emptying the bucket before it is
+	// deleted if `forceDestroy` is set.
+	// +optional
+	ForceDestroy *BucketForceDestroyStatus `json:"forceDestroy,omitempty"`
+}
+
+// BucketForceDestroyStatus reports the progress of emptying a bucket before it
+// is deleted.
+type BucketForceDestroyStatus struct {
+	// DeletedObjects is the number of object versions and delete markers that
+	// have been deleted from the bucket so far.
+	DeletedObjects int64 `json:"deletedObjects"`
+
+	// AbortedMultipartUploads is the number of incomplete multipart uploads
+	// that have been aborted so far.
+	AbortedMultipartUploads int64 `json:"abortedMultipartUploads"`
 }
 
 // BucketStatus represents the observed state of the Bucket.
@@ -0,0 +1,65 @@
+/*
+Copyright 2023 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1beta1
+
+// ObjectLockConfiguration specifies the Object Lock configuration of an
+// Amazon S3 bucket. For more information, see Locking Objects
+// (https://docs.aws.amazon.com/AmazonS3/latest/dev/object-lock.html) in the
+// Amazon Simple Storage Service Developer Guide.
+type ObjectLockConfiguration struct {
+	// Indicates whether this bucket has an Object Lock configuration enabled.
+	// Enable ObjectLockEnabled when you apply ObjectLockConfiguration to a
+	// bucket.
+	// +kubebuilder:validation:Enum=Enabled
+	// +optional
+	ObjectLockEnabled *string `json:"objectLockEnabled,omitempty"`
+
+	// Specifies the Object Lock rule for the specified object. Enable the this
+	// rule when you apply ObjectLockConfiguration to a bucket. Bucket settings
+	// require both a mode and a period. The period can be either Days or Years
+	// but you must select one. You cannot specify Days and Years at the same
+	// time.
+	// +optional
+	Rule *ObjectLockRule `json:"rule,omitempty"`
+}
+
+// ObjectLockRule is the container element for an Object Lock rule.
+type ObjectLockRule struct {
+	// The default Object Lock retention mode and period that you want to apply
+	// to new objects placed in the specified bucket.
+	// +optional
+	DefaultRetention *DefaultRetention `json:"defaultRetention,omitempty"`
+}
+
+// DefaultRetention specifies the default Object Lock retention mode and
+// period for new objects placed in a bucket.
+type DefaultRetention struct {
+	// The number of days that you want to specify for the default retention
+	// period. Must be used with Mode.
+	// +optional
+	Days *int32 `json:"days,omitempty"`
+
+	// The default Object Lock retention mode you want to apply to new objects
+	// placed in the specified bucket. Must be used with either Days or Years.
+	// +kubebuilder:validation:Enum=GOVERNANCE;COMPLIANCE
+	Mode string `json:"mode"`
+
+	// The number of years that you want to specify for the default retention
+	// period. Must be used with Mode.
+	// +optional
+	Years *int32 `json:"years,omitempty"`
+}
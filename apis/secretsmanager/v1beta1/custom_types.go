@@ -20,6 +20,16 @@ import xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
 
 // CustomSecretParameters contains the additional fields for SecretParameters.
 type CustomSecretParameters struct {
+	// A list of Regions and KMS keys to replicate secrets.
+	//
+	// If no Regions are listed, the replicas of the secret are not managed.
+	// Otherwise replicas in Regions that are not listed are removed. A
+	// replica whose KMS key differs from the desired one is deleted and
+	// created again with the desired key, since Secrets Manager cannot change
+	// the key of a replica. This recreates the replica secret in that Region.
+	// +optional
+	AddReplicaRegions []*ReplicaRegionType `json:"addReplicaRegions,omitempty"`
+
 	// KMSKeyIDRef is a reference to an kms/v1alpha1.Key used
 	// to set the KMSKeyID field.
 	// +optional
@@ -77,6 +87,40 @@ type CustomSecretParameters struct {
 	// ResourcePolicy is a required field
 	// +optional
 	ResourcePolicy *string `json:"resourcePolicy,omitempty"`
+
+	// The ARN of the Lambda rotation function that can rotate the secret.
+	// Rotation is turned on if it is set. If it is not set, the rotation of
+	// the secret is not managed, e.g. rotation that was turned on outside of
+	// Crossplane is left as it is.
+	//
+	// Once rotation is turned on, the value of the secret is managed by the
+	// rotation function and changes of the referenced Kubernetes Secret are
+	// not sent to AWS anymore.
+	// +optional
+	RotationLambdaARN *string `json:"rotationLambdaARN,omitempty"`
+
+	// RotationLambdaARNRef is a reference to a lambda/v1beta1.Function used
+	// to set the RotationLambdaARN field.
+	// +optional
+	RotationLambdaARNRef *xpv1.Reference `json:"rotationLambdaARNRef,omitempty"`
+
+	// RotationLambdaARNSelector selects references to lambda/v1beta1.Function
+	// used to set the RotationLambdaARN.
+	// +optional
+	RotationLambdaARNSelector *xpv1.Selector `json:"rotationLambdaARNSelector,omitempty"`
+
+	// A structure that defines the rotation configuration for this secret,
+	// e.g. a schedule expression like rate(10 days) or cron(0 16 1,15 * ? *).
+	// Only the rules that are set are compared with the ones observed, since
+	// Secrets Manager derives some rules from others.
+	// +optional
+	RotationRules *RotationRulesType `json:"rotationRules,omitempty"`
+
+	// Specifies whether to rotate the secret immediately or wait until the
+	// next scheduled rotation window when rotation is turned on or its
+	// configuration changes. Defaults to true.
+	// +optional
+	RotateImmediately *bool `json:"rotateImmediately,omitempty"`
 }
 
 // A SecretReference is a reference to a secret in an arbitrary namespace.
@@ -29,6 +29,17 @@ import (
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *CustomSecretParameters) DeepCopyInto(out *CustomSecretParameters) {
 	*out = *in
+	if in.AddReplicaRegions != nil {
+		in, out := &in.AddReplicaRegions, &out.AddReplicaRegions
+		*out = make([]*ReplicaRegionType, len(*in))
+		for i := range *in {
+			if (*in)[i] != nil {
+				in, out := &(*in)[i], &(*out)[i]
+				*out = new(ReplicaRegionType)
+				(*in).DeepCopyInto(*out)
+			}
+		}
+	}
 	if in.KMSKeyIDRef != nil {
 		in, out := &in.KMSKeyIDRef, &out.KMSKeyIDRef
 		*out = new(v1.Reference)
@@ -64,6 +75,31 @@ func (in *CustomSecretParameters) DeepCopyInto(out *CustomSecretParameters) {
 		*out = new(string)
 		**out = **in
 	}
+	if in.RotationLambdaARN != nil {
+		in, out := &in.RotationLambdaARN, &out.RotationLambdaARN
+		*out = new(string)
+		**out = **in
+	}
+	if in.RotationLambdaARNRef != nil {
+		in, out := &in.RotationLambdaARNRef, &out.RotationLambdaARNRef
+		*out = new(v1.Reference)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.RotationLambdaARNSelector != nil {
+		in, out := &in.RotationLambdaARNSelector, &out.RotationLambdaARNSelector
+		*out = new(v1.Selector)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.RotationRules != nil {
+		in, out := &in.RotationRules, &out.RotationRules
+		*out = new(RotationRulesType)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.RotateImmediately != nil {
+		in, out := &in.RotateImmediately, &out.RotateImmediately
+		*out = new(bool)
+		**out = **in
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CustomSecretParameters.
@@ -384,6 +420,14 @@ func (in *SecretObservation) DeepCopyInto(out *SecretObservation) {
 		*out = new(string)
 		**out = **in
 	}
+	if in.LastRotatedDate != nil {
+		in, out := &in.LastRotatedDate, &out.LastRotatedDate
+		*out = (*in).DeepCopy()
+	}
+	if in.NextRotationDate != nil {
+		in, out := &in.NextRotationDate, &out.NextRotationDate
+		*out = (*in).DeepCopy()
+	}
 	if in.ReplicationStatus != nil {
 		in, out := &in.ReplicationStatus, &out.ReplicationStatus
 		*out = make([]*ReplicationStatusType, len(*in))
@@ -395,6 +439,11 @@ func (in *SecretObservation) DeepCopyInto(out *SecretObservation) {
 			}
 		}
 	}
+	if in.RotationEnabled != nil {
+		in, out := &in.RotationEnabled, &out.RotationEnabled
+		*out = new(bool)
+		**out = **in
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SecretObservation.
@@ -410,17 +459,6 @@ func (in *SecretObservation) DeepCopy() *SecretObservation {
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *SecretParameters) DeepCopyInto(out *SecretParameters) {
 	*out = *in
-	if in.AddReplicaRegions != nil {
-		in, out := &in.AddReplicaRegions, &out.AddReplicaRegions
-		*out = make([]*ReplicaRegionType, len(*in))
-		for i := range *in {
-			if (*in)[i] != nil {
-				in, out := &(*in)[i], &(*out)[i]
-				*out = new(ReplicaRegionType)
-				(*in).DeepCopyInto(*out)
-			}
-		}
-	}
 	if in.Description != nil {
 		in, out := &in.Description, &out.Description
 		*out = new(string)
@@ -29,8 +29,6 @@ type SecretParameters struct {
 	// Region is which region the Secret will be created.
 	// +kubebuilder:validation:Required
 	Region string `json:"region"`
-	// A list of Regions and KMS keys to replicate secrets.
-	AddReplicaRegions []*ReplicaRegionType `json:"addReplicaRegions,omitempty"`
 	// The description of the secret.
 	Description *string `json:"description,omitempty"`
 	// Specifies whether to overwrite a secret with the same name in the destination
@@ -108,6 +106,11 @@ type SecretObservation struct {
 	// the same name as a deleted secret, then users with access to the old secret
 	// don't get access to the new secret because the ARNs are different.
 	ARN *string `json:"arn,omitempty"`
+	// The last date and time that Secrets Manager rotated the secret. If the secret
+	// isn't configured for rotation, Secrets Manager returns null.
+	LastRotatedDate *metav1.Time `json:"lastRotatedDate,omitempty"`
+
+	NextRotationDate *metav1.Time `json:"nextRotationDate,omitempty"`
 	// A list of the replicas of this secret and their status:
 	//
 	//    * Failed, which indicates that the replica was not created.
@@ -117,6 +120,10 @@ type SecretObservation struct {
 	//
 	//    * InSync, which indicates that the replica was created.
 	ReplicationStatus []*ReplicationStatusType `json:"replicationStatus,omitempty"`
+	// Specifies whether automatic rotation is turned on for this secret.
+	//
+	// To turn on rotation, use RotateSecret. To turn off rotation, use CancelRotateSecret.
+	RotationEnabled *bool `json:"rotationEnabled,omitempty"`
 }
 
 // SecretStatus defines the observed state of Secret.
@@ -30,7 +30,19 @@ type ProviderConfigSpec struct {
 	// AssumeRole defines the options for assuming an IAM role
 	AssumeRole *AssumeRoleOptions `json:"assumeRole,omitempty"`
 
-	// AssumeRoleWithWebIdentity defines the options for assuming an IAM role with a Web Identity
+	// AssumeRoleChain defines the options for assuming a chain of IAM roles.
+	// The roles are assumed in the given order, each one with the
+	// credentials of the role assumed before it. The first role is assumed
+	// with the provider credentials, or with the role in AssumeRole if it is
+	// set.
+	// +optional
+	AssumeRoleChain []AssumeRoleOptions `json:"assumeRoleChain,omitempty"`
+
+	// AssumeRoleWithWebIdentity defines the options for assuming an IAM role with a Web Identity.
+	// When the credentials source is Secret, Filesystem or Environment, the
+	// source is expected to supply the web identity token instead of AWS
+	// credentials. The token is read again whenever the role is assumed, so
+	// rotated tokens are picked up.
 	AssumeRoleWithWebIdentity *AssumeRoleWithWebIdentityOptions `json:"assumeRoleWithWebIdentity,omitempty"`
 
 	// AssumeRoleARN to assume with provider credentials
@@ -47,6 +59,112 @@ type ProviderConfigSpec struct {
 	// of AWS calls made by the provider.
 	// +optional
 	Endpoint *EndpointConfig `json:"endpoint,omitempty"`
+
+	// ServiceEndpoints lets you override the endpoint configuration of
+	// individual AWS services. The keys are the service IDs of the AWS SDK,
+	// e.g. "S3", "STS" or "Route 53". Services that are not listed here use
+	// the endpoint configuration given in the endpoint field, or the default
+	// AWS endpoints if that is not given either.
+	// Note that resources using AWS SDK v1 match the keys against the
+	// endpoint IDs of the services, ignoring case and spaces, so "Route 53"
+	// also matches "route53".
+	// +optional
+	ServiceEndpoints map[string]EndpointConfig `json:"serviceEndpoints,omitempty"`
+
+	// DefaultTags are the tags that are added to every taggable managed
+	// resource that uses this ProviderConfig.
+	// +optional
+	DefaultTags *DefaultTags `json:"defaultTags,omitempty"`
+
+	// CABundleSecretRef refers to a secret key that contains a bundle of PEM
+	// encoded CA certificates. These are trusted in addition to the system
+	// CA certificates when connecting to AWS and to the proxy.
+	// +optional
+	CABundleSecretRef *xpv1.SecretKeySelector `json:"caBundleSecretRef,omitempty"`
+
+	// Proxy configures the HTTP(S) proxy that calls to AWS are sent
+	// through. If not set, the HTTPS_PROXY, HTTP_PROXY and NO_PROXY
+	// environment variables of the provider are used.
+	// +optional
+	Proxy *ProxyConfig `json:"proxy,omitempty"`
+
+	// UseFIPSEndpoint makes the AWS clients use the FIPS endpoints of the
+	// services. Calls to services that do not have a FIPS endpoint in the
+	// region fail.
+	// +optional
+	UseFIPSEndpoint *bool `json:"useFIPSEndpoint,omitempty"`
+
+	// UseDualStackEndpoint makes the AWS clients use the dual-stack (IPv4
+	// and IPv6) endpoints of the services. Calls to services that do not
+	// have a dual-stack endpoint in the region fail.
+	// +optional
+	UseDualStackEndpoint *bool `json:"useDualStackEndpoint,omitempty"`
+
+	// STSRegionalEndpoints configures whether STS calls are sent to the
+	// regional endpoint of STS or, for the regions that used to do so, to
+	// the legacy global endpoint. If not set, the defaults of the AWS SDK
+	// apply.
+	// +optional
+	// +kubebuilder:validation:Enum=regional;legacy
+	STSRegionalEndpoints *string `json:"stsRegionalEndpoints,omitempty"`
+
+	// HealthCheckRegion is the region whose STS endpoint is used to
+	// validate the credentials of this ProviderConfig. It must be a region
+	// of the partition the credentials belong to, e.g. cn-north-1 for
+	// credentials of the aws-cn partition. Defaults to us-east-1.
+	// +optional
+	HealthCheckRegion *string `json:"healthCheckRegion,omitempty"`
+
+	// Retry configures how the AWS clients retry failed calls.
+	// +optional
+	Retry *RetryConfig `json:"retry,omitempty"`
+}
+
+// ProxyConfig configures the proxy that calls to AWS are sent through.
+type ProxyConfig struct {
+	// URL of the proxy, e.g. https://proxy.example.com:3128.
+	URL string `json:"url"`
+
+	// CredentialsSecretRef refers to a secret key that contains the
+	// credentials to authenticate to the proxy in the form
+	// username:password.
+	// +optional
+	CredentialsSecretRef *xpv1.SecretKeySelector `json:"credentialsSecretRef,omitempty"`
+}
+
+// RetryConfig configures how the AWS clients retry failed calls.
+type RetryConfig struct {
+	// MaxAttempts is the maximum number of attempts of a call, including
+	// the first one.
+	// +optional
+	// +kubebuilder:validation:Minimum=1
+	MaxAttempts *int `json:"maxAttempts,omitempty"`
+
+	// MaxBackoff is the maximum time to wait before retrying a call.
+	// +optional
+	MaxBackoff *metav1.Duration `json:"maxBackoff,omitempty"`
+
+	// Mode is the retry mode. The adaptive mode additionally rate limits
+	// the calls when they are throttled. Note that the adaptive mode is
+	// effective only for resources that use AWS SDK v2.
+	// +optional
+	// +kubebuilder:validation:Enum=standard;adaptive
+	Mode *string `json:"mode,omitempty"`
+}
+
+// DefaultTags configures the tags that are added to all taggable managed
+// resources using a ProviderConfig.
+type DefaultTags struct {
+	// Tags to add to every taggable managed resource. Tags that are set on the
+	// managed resource itself take precedence over these.
+	// +optional
+	Tags map[string]string `json:"tags,omitempty"`
+
+	// DisableCrossplaneTags disables adding the crossplane-kind,
+	// crossplane-name and crossplane-providerconfig tags to every taggable
+	// managed resource.
+	// +optional
+	DisableCrossplaneTags bool `json:"disableCrossplaneTags,omitempty"`
 }
 
 // ProviderCredentials required to authenticate.
@@ -92,6 +210,16 @@ type AssumeRoleOptions struct {
 	// (https://docs.aws.amazon.com/IAM/latest/UserGuide/id_session-tags.html#id_session-tags_role-chaining).
 	// +optional
 	TransitiveTagKeys []string `json:"transitiveTagKeys,omitempty"`
+
+	// RoleSessionName is the session name, if you wish to uniquely identify this session.
+	// +optional
+	RoleSessionName *string `json:"roleSessionName,omitempty"`
+
+	// Duration is the duration of the role session. It must be between 15
+	// minutes and the maximum session duration of the role. Defaults to 15
+	// minutes.
+	// +optional
+	Duration *metav1.Duration `json:"duration,omitempty"`
 }
 
 // AssumeRoleWithWebIdentityOptions define the options for assuming an IAM Role
@@ -207,11 +335,24 @@ type DynamicURLConfig struct {
 // A ProviderConfigStatus represents the status of a ProviderConfig.
 type ProviderConfigStatus struct {
 	xpv1.ProviderConfigStatus `json:",inline"`
+
+	// AccountID is the ID of the AWS account that the credentials of this
+	// ProviderConfig were last validated against.
+	// +optional
+	AccountID *string `json:"accountID,omitempty"`
+
+	// CallerARN is the ARN of the IAM identity, e.g. the assumed role, that
+	// the credentials of this ProviderConfig resolved to when they were last
+	// validated.
+	// +optional
+	CallerARN *string `json:"callerARN,omitempty"`
 }
 
 // +kubebuilder:object:root=true
 
 // A ProviderConfig configures how AWS controllers will connect to AWS API.
+// +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="ACCOUNT-ID",type="string",JSONPath=".status.accountID"
 // +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
 // +kubebuilder:printcolumn:name="SECRET-NAME",type="string",JSONPath=".spec.credentialsSecretRef.name",priority=1
 // +kubebuilder:resource:scope=Cluster,categories={crossplane,provider,aws}
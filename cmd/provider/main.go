@@ -28,6 +28,7 @@ import (
 	"k8s.io/client-go/tools/leaderelection/resourcelock"
 	ctrl "sigs.k8s.io/controller-runtime"
 	"sigs.k8s.io/controller-runtime/pkg/log/zap"
+	ctrlmetrics "sigs.k8s.io/controller-runtime/pkg/metrics"
 
 	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
 	xpcontroller "github.com/crossplane/crossplane-runtime/pkg/controller"
@@ -41,6 +42,7 @@ import (
 	"github.com/crossplane-contrib/provider-aws/apis/v1alpha1"
 	"github.com/crossplane-contrib/provider-aws/pkg/controller"
 	"github.com/crossplane-contrib/provider-aws/pkg/features"
+	"github.com/crossplane-contrib/provider-aws/pkg/metrics"
 )
 
 func main() {
@@ -51,12 +53,19 @@ func main() {
 		pollInterval     = app.Flag("poll", "Poll interval controls how often an individual resource should be checked for drift.").Default("1m").Duration()
 		leaderElection   = app.Flag("leader-election", "Use leader election for the conroller manager.").Short('l').Default("false").OverrideDefaultFromEnvar("LEADER_ELECTION").Bool()
 		maxReconcileRate = app.Flag("max-reconcile-rate", "The global maximum rate per second at which resources may checked for drift from the desired state.").Default("10").Int()
+		metricsAddress   = app.Flag("metrics-bind-address", "The address the metrics endpoint binds to. Set to 0 to disable serving metrics.").Default(":8080").String()
 
 		namespace                  = app.Flag("namespace", "Namespace used to set as default scope in default secret store config.").Default("crossplane-system").Envar("POD_NAMESPACE").String()
 		enableExternalSecretStores = app.Flag("enable-external-secret-stores", "Enable support for ExternalSecretStores.").Default("false").Envar("ENABLE_EXTERNAL_SECRET_STORES").Bool()
+
+		enableGroups  = app.Flag("enable-groups", "Only start the controllers of the given API groups or kinds, e.g. s3,iam.aws.crossplane.io,Instance.ec2. All controllers are started if none are given.").Envar("ENABLE_GROUPS").Strings()
+		disableGroups = app.Flag("disable-groups", "Do not start the controllers of the given API groups or kinds, e.g. sfn,Route.apigatewayv2.").Envar("DISABLE_GROUPS").Strings()
 	)
 	kingpin.MustParse(app.Parse(os.Args[1:]))
 
+	filter, err := controller.NewFilter(*enableGroups, *disableGroups)
+	kingpin.FatalIfError(err, "Cannot select API groups")
+
 	zl := zap.New(zap.UseDevMode(*debug), UseISO8601())
 	log := logging.NewLogrLogger(zl.WithName("provider-aws"))
 	if *debug {
@@ -72,7 +81,8 @@ func main() {
 	kingpin.FatalIfError(err, "Cannot get API server rest config")
 
 	mgr, err := ctrl.NewManager(cfg, ctrl.Options{
-		SyncPeriod: syncInterval,
+		SyncPeriod:         syncInterval,
+		MetricsBindAddress: *metricsAddress,
 
 		// controller-runtime uses both ConfigMaps and Leases for leader
 		// election by default. Leases expire after 15 seconds, with a
@@ -89,6 +99,7 @@ func main() {
 	})
 	kingpin.FatalIfError(err, "Cannot create controller manager")
 	kingpin.FatalIfError(apis.AddToScheme(mgr.GetScheme()), "Cannot add AWS APIs to scheme")
+	kingpin.FatalIfError(metrics.Register(ctrlmetrics.Registry), "Cannot register AWS API metrics")
 
 	o := xpcontroller.Options{
 		Logger:                  log,
@@ -117,7 +128,7 @@ func main() {
 		})), "cannot create default store config")
 	}
 
-	kingpin.FatalIfError(controller.Setup(mgr, o), "Cannot setup AWS controllers")
+	kingpin.FatalIfError(controller.SetupWithFilter(mgr, o, filter), "Cannot setup AWS controllers")
 	kingpin.FatalIfError(mgr.Start(ctrl.SetupSignalHandler()), "Cannot start controller manager")
 
 }
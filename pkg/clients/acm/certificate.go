@@ -10,6 +10,8 @@ import (
 	"github.com/aws/aws-sdk-go-v2/service/acm"
 	"github.com/aws/aws-sdk-go-v2/service/acm/types"
 	acmtypes "github.com/aws/aws-sdk-go-v2/service/acm/types"
+	"github.com/aws/aws-sdk-go-v2/service/route53"
+	route53types "github.com/aws/aws-sdk-go-v2/service/route53/types"
 
 	awsclients "github.com/crossplane-contrib/provider-aws/pkg/clients"
 )
@@ -167,3 +169,74 @@ func DiffTags(spec []v1beta1.Tag, current []acmtypes.Tag) (addTags []acmtypes.Ta
 	}
 	return
 }
+
+// DefaultValidationRecordTTL is the TTL of the DNS validation records if none
+// is specified.
+const DefaultValidationRecordTTL = 300
+
+// GenerateValidationRecords returns the CNAME records that ACM requires to
+// validate the domains of the certificate. Domains that share a record, like
+// a domain and its wildcard, result in a single record.
+func GenerateValidationRecords(certificate types.CertificateDetail) []v1beta1.ResourceRecord {
+	var records []v1beta1.ResourceRecord
+	seen := map[string]bool{}
+	for _, o := range certificate.DomainValidationOptions {
+		if o.ResourceRecord == nil || seen[aws.ToString(o.ResourceRecord.Name)] {
+			continue
+		}
+		seen[aws.ToString(o.ResourceRecord.Name)] = true
+		records = append(records, v1beta1.ResourceRecord{
+			Name:  o.ResourceRecord.Name,
+			Type:  aws.String(string(o.ResourceRecord.Type)),
+			Value: o.ResourceRecord.Value,
+		})
+	}
+	return records
+}
+
+// ValidationRecordTTL returns the desired TTL of the DNS validation records.
+func ValidationRecordTTL(v v1beta1.DNSValidation) int64 {
+	if v.TTL != nil {
+		return aws.ToInt64(v.TTL)
+	}
+	return DefaultValidationRecordTTL
+}
+
+// GenerateDeleteValidationRecordsInput returns the input to delete the
+// supplied DNS validation record sets as they were observed in the hosted
+// zone. Route53 only deletes a record set if all of its values match.
+func GenerateDeleteValidationRecordsInput(zoneID *string, sets []route53types.ResourceRecordSet) *route53.ChangeResourceRecordSetsInput {
+	changes := make([]route53types.Change, len(sets))
+	for i := range sets {
+		changes[i] = route53types.Change{
+			Action:            route53types.ChangeActionDelete,
+			ResourceRecordSet: &sets[i],
+		}
+	}
+	return &route53.ChangeResourceRecordSetsInput{
+		HostedZoneId: zoneID,
+		ChangeBatch:  &route53types.ChangeBatch{Changes: changes},
+	}
+}
+
+// GenerateChangeValidationRecordsInput returns the input to apply the supplied
+// action to the DNS validation records of the certificate.
+func GenerateChangeValidationRecordsInput(v v1beta1.DNSValidation, records []v1beta1.ResourceRecord, action route53types.ChangeAction) *route53.ChangeResourceRecordSetsInput {
+	ttl := aws.Int64(ValidationRecordTTL(v))
+	changes := make([]route53types.Change, len(records))
+	for i, r := range records {
+		changes[i] = route53types.Change{
+			Action: action,
+			ResourceRecordSet: &route53types.ResourceRecordSet{
+				Name:            r.Name,
+				Type:            route53types.RRType(aws.ToString(r.Type)),
+				TTL:             ttl,
+				ResourceRecords: []route53types.ResourceRecord{{Value: r.Value}},
+			},
+		}
+	}
+	return &route53.ChangeResourceRecordSetsInput{
+		HostedZoneId: v.HostedZoneID,
+		ChangeBatch:  &route53types.ChangeBatch{Changes: changes},
+	}
+}
@@ -310,3 +310,55 @@ func TestIsCertificateUpToDate(t *testing.T) {
 		})
 	}
 }
+
+func TestGenerateValidationRecords(t *testing.T) {
+	sName := "_xyz.crossplane.io."
+	sType := "CNAME"
+	sValue := "_xxx.zzz.acm-validations.aws."
+	wName := "_abc.www.crossplane.io."
+	wValue := "_yyy.zzz.acm-validations.aws."
+
+	cases := map[string]struct {
+		in  acmtypes.CertificateDetail
+		out []v1beta1.ResourceRecord
+	}{
+		"NoResourceRecords": {
+			in: acmtypes.CertificateDetail{
+				DomainValidationOptions: []acmtypes.DomainValidation{
+					{DomainName: aws.String("crossplane.io")},
+				},
+			},
+		},
+		"DuplicateResourceRecords": {
+			in: acmtypes.CertificateDetail{
+				DomainValidationOptions: []acmtypes.DomainValidation{
+					{
+						DomainName:     aws.String("crossplane.io"),
+						ResourceRecord: &acmtypes.ResourceRecord{Name: &sName, Value: &sValue, Type: acmtypes.RecordType(sType)},
+					},
+					{
+						DomainName:     aws.String("*.crossplane.io"),
+						ResourceRecord: &acmtypes.ResourceRecord{Name: &sName, Value: &sValue, Type: acmtypes.RecordType(sType)},
+					},
+					{
+						DomainName:     aws.String("www.crossplane.io"),
+						ResourceRecord: &acmtypes.ResourceRecord{Name: &wName, Value: &wValue, Type: acmtypes.RecordType(sType)},
+					},
+				},
+			},
+			out: []v1beta1.ResourceRecord{
+				{Name: &sName, Value: &sValue, Type: &sType},
+				{Name: &wName, Value: &wValue, Type: &sType},
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			r := GenerateValidationRecords(tc.in)
+			if diff := cmp.Diff(tc.out, r); diff != "" {
+				t.Errorf("GenerateValidationRecords(...): -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
@@ -57,6 +57,8 @@ import (
 	"github.com/crossplane/crossplane-runtime/pkg/resource"
 
 	"github.com/crossplane-contrib/provider-aws/apis/v1beta1"
+	"github.com/crossplane-contrib/provider-aws/pkg/metrics"
+	policyutils "github.com/crossplane-contrib/provider-aws/pkg/utils/policy"
 	"github.com/crossplane-contrib/provider-aws/pkg/version"
 )
 
@@ -104,7 +106,7 @@ func GetConfig(ctx context.Context, c client.Client, mg resource.Managed, region
 }
 
 // UseProviderConfig to produce a config that can be used to authenticate to AWS.
-func UseProviderConfig(ctx context.Context, c client.Client, mg resource.Managed, region string) (*aws.Config, error) { // nolint:gocyclo
+func UseProviderConfig(ctx context.Context, c client.Client, mg resource.Managed, region string) (*aws.Config, error) {
 	pc := &v1beta1.ProviderConfig{}
 	if err := c.Get(ctx, types.NamespacedName{Name: mg.GetProviderConfigReference().Name}, pc); err != nil {
 		return nil, errors.Wrap(err, "cannot get referenced Provider")
@@ -115,47 +117,94 @@ func UseProviderConfig(ctx context.Context, c client.Client, mg resource.Managed
 		return nil, errors.Wrap(err, "cannot track ProviderConfig usage")
 	}
 
+	return GetConfigForProviderConfig(ctx, c, pc, region)
+}
+
+// GetConfigForProviderConfig produces a config that can be used to
+// authenticate to AWS with the supplied ProviderConfig. Unlike
+// UseProviderConfig, it does not track the usage of the ProviderConfig.
+func GetConfigForProviderConfig(ctx context.Context, c client.Client, pc *v1beta1.ProviderConfig, region string) (*aws.Config, error) {
+	cfg, err := cachedConfig(ctx, c, pc, region)
+	if err != nil {
+		return nil, err
+	}
+	// NOTE: The cached config shares its API options with the returned copy,
+	// so they are cloned before appending to them.
+	apiOptions := make([]func(*middleware.Stack) error, len(cfg.APIOptions), len(cfg.APIOptions)+1)
+	copy(apiOptions, cfg.APIOptions)
+	cfg.APIOptions = append(apiOptions, metrics.APIOptionV2(pc.GetName()))
+	return SetResolver(pc, cfg), nil
+}
+
+// resolveProviderConfig produces the AWS config described by the supplied
+// ProviderConfig.
+func resolveProviderConfig(ctx context.Context, c client.Client, pc *v1beta1.ProviderConfig, region string) (*aws.Config, error) {
+	transport, err := transportOptions(ctx, c, pc)
+	if err != nil {
+		return nil, err
+	}
+	cfg, err := resolveProviderCredentials(ctx, c, pc, region, loadOptions(pc, transport)...)
+	if err != nil {
+		return nil, err
+	}
+	return UseAssumeRoleChain(cfg, pc)
+}
+
+// resolveProviderCredentials produces the AWS config that uses the
+// credentials of the supplied ProviderConfig, with the role in its AssumeRole
+// or AssumeRoleWithWebIdentity options assumed if they are set.
+func resolveProviderCredentials(ctx context.Context, c client.Client, pc *v1beta1.ProviderConfig, region string, optFns ...func(*config.LoadOptions) error) (*aws.Config, error) {
 	switch s := pc.Spec.Credentials.Source; s { //nolint:exhaustive
 	case xpv1.CredentialsSourceInjectedIdentity:
 		if pc.Spec.AssumeRole != nil || pc.Spec.AssumeRoleARN != nil {
-			cfg, err := UsePodServiceAccountAssumeRole(ctx, []byte{}, DefaultSection, region, pc)
-			if err != nil {
-				return nil, err
-			}
-			return SetResolver(pc, cfg), nil
+			return UsePodServiceAccountAssumeRole(ctx, []byte{}, DefaultSection, region, pc, optFns...)
 		}
 		if pc.Spec.AssumeRoleWithWebIdentity != nil && pc.Spec.AssumeRoleWithWebIdentity.RoleARN != nil {
-			cfg, err := UsePodServiceAccountAssumeRoleWithWebIdentity(ctx, []byte{}, DefaultSection, region, pc)
-			if err != nil {
-				return nil, err
-			}
-			return SetResolver(pc, cfg), nil
-		}
-		cfg, err := UsePodServiceAccount(ctx, []byte{}, DefaultSection, region)
-		if err != nil {
-			return nil, err
+			return UsePodServiceAccountAssumeRoleWithWebIdentity(ctx, []byte{}, DefaultSection, region, pc, optFns...)
 		}
-		return SetResolver(pc, cfg), nil
+		return UsePodServiceAccount(ctx, []byte{}, DefaultSection, region, optFns...)
 	default:
+		if usesWebIdentityToken(pc) {
+			return UseWebIdentityToken(ctx, region, pc, &webIdentityTokenRetriever{client: c, credentials: pc.Spec.Credentials}, optFns...)
+		}
 		data, err := resource.CommonCredentialExtractor(ctx, s, c, pc.Spec.Credentials.CommonCredentialSelectors)
 		if err != nil {
 			return nil, errors.Wrap(err, "cannot get credentials")
 		}
 		if pc.Spec.AssumeRole != nil || pc.Spec.AssumeRoleARN != nil {
-			cfg, err := UseProviderSecretAssumeRole(ctx, data, DefaultSection, region, pc)
-			if err != nil {
-				return nil, err
-			}
-			return SetResolver(pc, cfg), nil
-		}
-		cfg, err := UseProviderSecret(ctx, data, DefaultSection, region)
-		if err != nil {
-			return nil, err
+			return UseProviderSecretAssumeRole(ctx, data, DefaultSection, region, pc, optFns...)
 		}
-		return SetResolver(pc, cfg), nil
+		return UseProviderSecret(ctx, data, DefaultSection, region, optFns...)
 	}
 }
 
+// usesWebIdentityToken returns true if the credentials source of the supplied
+// ProviderConfig supplies a web identity token rather than AWS credentials.
+func usesWebIdentityToken(pc *v1beta1.ProviderConfig) bool {
+	switch pc.Spec.Credentials.Source { //nolint:exhaustive
+	case xpv1.CredentialsSourceSecret, xpv1.CredentialsSourceFilesystem, xpv1.CredentialsSourceEnvironment:
+		return pc.Spec.AssumeRoleWithWebIdentity != nil && StringValue(pc.Spec.AssumeRoleWithWebIdentity.RoleARN) != ""
+	}
+	return false
+}
+
+// webIdentityTokenRetriever retrieves a web identity token from the
+// credentials source of a ProviderConfig. The token is read every time new
+// credentials are requested, so that rotated tokens are picked up.
+type webIdentityTokenRetriever struct {
+	client      client.Client
+	credentials v1beta1.ProviderCredentials
+}
+
+// GetIdentityToken retrieves the web identity token.
+func (r *webIdentityTokenRetriever) GetIdentityToken() ([]byte, error) {
+	data, err := resource.CommonCredentialExtractor(context.Background(), r.credentials.Source, r.client, r.credentials.CommonCredentialSelectors)
+	if err != nil {
+		return nil, errors.Wrap(err, "cannot get web identity token")
+	}
+	return bytes.TrimSpace(data), nil
+}
+
 type awsEndpointResolverAdaptorWithOptions func(service, region string, options interface{}) (aws.Endpoint, error)
 
 func (a awsEndpointResolverAdaptorWithOptions) ResolveEndpoint(service, region string, options ...interface{}) (aws.Endpoint, error) {
@@ -165,51 +214,57 @@ func (a awsEndpointResolverAdaptorWithOptions) ResolveEndpoint(service, region s
 // SetResolver parses annotations from the managed resource
 // and returns a configuration accordingly.
 func SetResolver(pc *v1beta1.ProviderConfig, cfg *aws.Config) *aws.Config { // nolint:gocyclo
-	if pc.Spec.Endpoint == nil {
+	if pc.Spec.Endpoint == nil && len(pc.Spec.ServiceEndpoints) == 0 {
 		return cfg
 	}
 	cfg.EndpointResolverWithOptions = awsEndpointResolverAdaptorWithOptions(func(service, region string, options interface{}) (aws.Endpoint, error) {
+		ec := endpointConfigFor(pc, service)
+		if ec == nil {
+			// NOTE: The SDK falls back to its default endpoint resolution
+			// when it gets an EndpointNotFoundError.
+			return aws.Endpoint{}, &aws.EndpointNotFoundError{}
+		}
 		fullURL := ""
-		switch pc.Spec.Endpoint.URL.Type {
+		switch ec.URL.Type {
 		case URLConfigTypeStatic:
-			if pc.Spec.Endpoint.URL.Static == nil {
+			if ec.URL.Static == nil {
 				return aws.Endpoint{}, errors.New("static type is chosen but static field does not have a value")
 			}
-			fullURL = StringValue(pc.Spec.Endpoint.URL.Static)
+			fullURL = StringValue(ec.URL.Static)
 		case URLConfigTypeDynamic:
-			if pc.Spec.Endpoint.URL.Dynamic == nil {
+			if ec.URL.Dynamic == nil {
 				return aws.Endpoint{}, errors.New("dynamic type is chosen but dynamic configuration is not given")
 			}
 			// NOTE(muvaf): IAM and Route 53 do not have a region.
 			if service == "IAM" || service == "Route 53" {
-				fullURL = fmt.Sprintf("%s://%s.%s", pc.Spec.Endpoint.URL.Dynamic.Protocol, strings.ReplaceAll(strings.ToLower(service), " ", ""), pc.Spec.Endpoint.URL.Dynamic.Host)
+				fullURL = fmt.Sprintf("%s://%s.%s", ec.URL.Dynamic.Protocol, strings.ReplaceAll(strings.ToLower(service), " ", ""), ec.URL.Dynamic.Host)
 			} else {
-				fullURL = fmt.Sprintf("%s://%s.%s.%s", pc.Spec.Endpoint.URL.Dynamic.Protocol, strings.ToLower(service), region, pc.Spec.Endpoint.URL.Dynamic.Host)
+				fullURL = fmt.Sprintf("%s://%s.%s.%s", ec.URL.Dynamic.Protocol, strings.ToLower(service), region, ec.URL.Dynamic.Host)
 			}
 		default:
 			return aws.Endpoint{}, errors.New("unsupported url config type is chosen")
 		}
 		e := aws.Endpoint{
 			URL:               fullURL,
-			HostnameImmutable: BoolValue(pc.Spec.Endpoint.HostnameImmutable),
-			PartitionID:       StringValue(pc.Spec.Endpoint.PartitionID),
-			SigningName:       StringValue(pc.Spec.Endpoint.SigningName),
-			SigningRegion:     StringValue(LateInitializeStringPtr(pc.Spec.Endpoint.SigningRegion, &region)),
-			SigningMethod:     StringValue(pc.Spec.Endpoint.SigningMethod),
+			HostnameImmutable: BoolValue(ec.HostnameImmutable),
+			PartitionID:       StringValue(ec.PartitionID),
+			SigningName:       StringValue(ec.SigningName),
+			SigningRegion:     StringValue(LateInitializeStringPtr(ec.SigningRegion, &region)),
+			SigningMethod:     StringValue(ec.SigningMethod),
 		}
 		// Only IAM does not have a region parameter and "aws-global" is used in
 		// SDK setup. However, signing region has to be us-east-1 and it needs
 		// to be set.
 		if region == "aws-global" {
-			switch StringValue(pc.Spec.Endpoint.PartitionID) {
+			switch StringValue(ec.PartitionID) {
 			case "aws-us-gov", "aws-cn", "aws-iso", "aws-iso-b":
-				e.SigningRegion = StringValue(LateInitializeStringPtr(pc.Spec.Endpoint.SigningRegion, &region))
+				e.SigningRegion = StringValue(LateInitializeStringPtr(ec.SigningRegion, &region))
 			default:
 				e.SigningRegion = "us-east-1"
 			}
 		}
-		if pc.Spec.Endpoint.Source != nil {
-			switch *pc.Spec.Endpoint.Source {
+		if ec.Source != nil {
+			switch *ec.Source {
 			case "ServiceMetadata":
 				e.Source = aws.EndpointSourceServiceMetadata
 			case "Custom":
@@ -221,6 +276,28 @@ func SetResolver(pc *v1beta1.ProviderConfig, cfg *aws.Config) *aws.Config { // n
 	return cfg
 }
 
+// endpointConfigFor returns the endpoint configuration of the given service,
+// which is the entry of the service in the serviceEndpoints of the
+// ProviderConfig if there is one and the global endpoint configuration
+// otherwise. The AWS SDK v2 passes service IDs like "Route 53" whereas the
+// AWS SDK v1 passes endpoint IDs like "route53", so the entries are matched
+// ignoring case and spaces if there is no exact match.
+func endpointConfigFor(pc *v1beta1.ProviderConfig, service string) *v1beta1.EndpointConfig {
+	if ec, ok := pc.Spec.ServiceEndpoints[service]; ok {
+		return &ec
+	}
+	normalize := func(s string) string {
+		return strings.ToLower(strings.ReplaceAll(s, " ", ""))
+	}
+	for id, ec := range pc.Spec.ServiceEndpoints {
+		if normalize(id) == normalize(service) {
+			ec := ec
+			return &ec
+		}
+	}
+	return pc.Spec.Endpoint
+}
+
 // CredentialsIDSecret retrieves AWS_ACCESS_KEY_ID and AWS_SECRET_ACCESS_KEY from the data which contains
 // aws credentials under given profile
 // Example:
@@ -260,7 +337,7 @@ func CredentialsIDSecret(data []byte, profile string) (aws.Credentials, error) {
 type AuthMethod func(context.Context, []byte, string, string) (*aws.Config, error)
 
 // UseProviderSecret - AWS configuration which can be used to issue requests against AWS API
-func UseProviderSecret(ctx context.Context, data []byte, profile, region string) (*aws.Config, error) {
+func UseProviderSecret(ctx context.Context, data []byte, profile, region string, optFns ...func(*config.LoadOptions) error) (*aws.Config, error) {
 	creds, err := CredentialsIDSecret(data, profile)
 	if err != nil {
 		return nil, errors.Wrap(err, "cannot parse credentials secret")
@@ -268,18 +345,20 @@ func UseProviderSecret(ctx context.Context, data []byte, profile, region string)
 
 	config, err := config.LoadDefaultConfig(
 		ctx,
-		userAgentV2,
-		config.WithRegion(region),
-		config.WithCredentialsProvider(credentials.StaticCredentialsProvider{
-			Value: creds,
-		}),
+		withLoadOptions(optFns,
+			userAgentV2,
+			config.WithRegion(region),
+			config.WithCredentialsProvider(credentials.StaticCredentialsProvider{
+				Value: creds,
+			}),
+		)...,
 	)
 	return &config, err
 }
 
 // UseProviderSecretAssumeRole - AWS configuration which can be used to issue requests against AWS API
 // assume Cross account IAM roles
-func UseProviderSecretAssumeRole(ctx context.Context, data []byte, profile, region string, pc *v1beta1.ProviderConfig) (*aws.Config, error) {
+func UseProviderSecretAssumeRole(ctx context.Context, data []byte, profile, region string, pc *v1beta1.ProviderConfig, optFns ...func(*config.LoadOptions) error) (*aws.Config, error) {
 	creds, err := CredentialsIDSecret(data, profile)
 	if err != nil {
 		return nil, errors.Wrap(err, "cannot parse credentials secret")
@@ -287,11 +366,13 @@ func UseProviderSecretAssumeRole(ctx context.Context, data []byte, profile, regi
 
 	config, err := config.LoadDefaultConfig(
 		ctx,
-		userAgentV2,
-		config.WithRegion(region),
-		config.WithCredentialsProvider(credentials.StaticCredentialsProvider{
-			Value: creds,
-		}),
+		withLoadOptions(optFns,
+			userAgentV2,
+			config.WithRegion(region),
+			config.WithCredentialsProvider(credentials.StaticCredentialsProvider{
+				Value: creds,
+			}),
+		)...,
 	)
 	if err != nil {
 		return nil, errors.Wrap(err, "failed to load default AWS config")
@@ -302,7 +383,7 @@ func UseProviderSecretAssumeRole(ctx context.Context, data []byte, profile, regi
 		return nil, err
 	}
 
-	stsSvc := sts.NewFromConfig(config)
+	stsSvc := sts.NewFromConfig(config, stsOptions(pc))
 
 	stsAssumeRoleOptions := SetAssumeRoleOptions(pc)
 	stsAssume := stscreds.NewAssumeRoleProvider(
@@ -318,8 +399,8 @@ func UseProviderSecretAssumeRole(ctx context.Context, data []byte, profile, regi
 // UsePodServiceAccountAssumeRole assumes an IAM role configured via a ServiceAccount
 // assume Cross account IAM roles
 // https://aws.amazon.com/blogs/containers/cross-account-iam-roles-for-kubernetes-service-accounts/
-func UsePodServiceAccountAssumeRole(ctx context.Context, _ []byte, _, region string, pc *v1beta1.ProviderConfig) (*aws.Config, error) {
-	cfg, err := UsePodServiceAccount(ctx, []byte{}, DefaultSection, region)
+func UsePodServiceAccountAssumeRole(ctx context.Context, _ []byte, _, region string, pc *v1beta1.ProviderConfig, optFns ...func(*config.LoadOptions) error) (*aws.Config, error) {
+	cfg, err := UsePodServiceAccount(ctx, []byte{}, DefaultSection, region, optFns...)
 	if err != nil {
 		return nil, errors.Wrap(err, "failed to load default AWS config")
 	}
@@ -327,19 +408,21 @@ func UsePodServiceAccountAssumeRole(ctx context.Context, _ []byte, _, region str
 	if err != nil {
 		return nil, err
 	}
-	stsclient := sts.NewFromConfig(*cfg)
+	stsclient := sts.NewFromConfig(*cfg, stsOptions(pc))
 	stsAssumeRoleOptions := SetAssumeRoleOptions(pc)
 	cnf, err := config.LoadDefaultConfig(
 		ctx,
-		userAgentV2,
-		config.WithRegion(cfg.Region),
-		config.WithCredentialsProvider(aws.NewCredentialsCache(
-			stscreds.NewAssumeRoleProvider(
-				stsclient,
-				StringValue(roleArn),
-				stsAssumeRoleOptions,
-			)),
-		),
+		withLoadOptions(optFns,
+			userAgentV2,
+			config.WithRegion(cfg.Region),
+			config.WithCredentialsProvider(aws.NewCredentialsCache(
+				stscreds.NewAssumeRoleProvider(
+					stsclient,
+					StringValue(roleArn),
+					stsAssumeRoleOptions,
+				)),
+			),
+		)...,
 	)
 	if err != nil {
 		return nil, errors.Wrap(err, "failed to load assumed role AWS config")
@@ -350,8 +433,8 @@ func UsePodServiceAccountAssumeRole(ctx context.Context, _ []byte, _, region str
 // UsePodServiceAccountAssumeRoleWithWebIdentity assumes an IAM role
 // configured via a ServiceAccount assume Cross account IAM roles
 // https://aws.amazon.com/blogs/containers/cross-account-iam-roles-for-kubernetes-service-accounts/
-func UsePodServiceAccountAssumeRoleWithWebIdentity(ctx context.Context, _ []byte, _, region string, pc *v1beta1.ProviderConfig) (*aws.Config, error) {
-	cfg, err := config.LoadDefaultConfig(ctx, userAgentV2)
+func UsePodServiceAccountAssumeRoleWithWebIdentity(ctx context.Context, _ []byte, _, region string, pc *v1beta1.ProviderConfig, optFns ...func(*config.LoadOptions) error) (*aws.Config, error) {
+	cfg, err := config.LoadDefaultConfig(ctx, withLoadOptions(optFns, userAgentV2)...)
 	if err != nil {
 		return nil, errors.Wrap(err, "failed to load default AWS config")
 	}
@@ -361,21 +444,23 @@ func UsePodServiceAccountAssumeRoleWithWebIdentity(ctx context.Context, _ []byte
 		return nil, err
 	}
 
-	stsclient := sts.NewFromConfig(cfg)
+	stsclient := sts.NewFromConfig(cfg, stsOptions(pc))
 	webIdentityRoleOptions := SetWebIdentityRoleOptions(pc)
 
 	cnf, err := config.LoadDefaultConfig(
 		ctx,
-		userAgentV2,
-		config.WithRegion(region),
-		config.WithCredentialsProvider(aws.NewCredentialsCache(
-			stscreds.NewWebIdentityRoleProvider(
-				stsclient,
-				StringValue(roleArn),
-				stscreds.IdentityTokenFile(getWebidentityTokenFilePath()),
-				webIdentityRoleOptions,
-			)),
-		),
+		withLoadOptions(optFns,
+			userAgentV2,
+			config.WithRegion(region),
+			config.WithCredentialsProvider(aws.NewCredentialsCache(
+				stscreds.NewWebIdentityRoleProvider(
+					stsclient,
+					StringValue(roleArn),
+					stscreds.IdentityTokenFile(getWebidentityTokenFilePath()),
+					webIdentityRoleOptions,
+				)),
+			),
+		)...,
 	)
 	if err != nil {
 		return nil, errors.Wrap(err, "failed to load assumed role AWS config")
@@ -383,6 +468,54 @@ func UsePodServiceAccountAssumeRoleWithWebIdentity(ctx context.Context, _ []byte
 	return &cnf, err
 }
 
+// UseWebIdentityToken assumes the IAM role in the AssumeRoleWithWebIdentity
+// options of the supplied ProviderConfig with the web identity token supplied
+// by the given retriever. If AssumeRole options are set as well, that role is
+// assumed with the credentials of the web identity role.
+func UseWebIdentityToken(ctx context.Context, region string, pc *v1beta1.ProviderConfig, token stscreds.IdentityTokenRetriever, optFns ...func(*config.LoadOptions) error) (*aws.Config, error) {
+	roleArn, err := GetAssumeRoleWithWebIdentityARN(pc.Spec.DeepCopy())
+	if err != nil {
+		return nil, err
+	}
+
+	// NOTE: AssumeRoleWithWebIdentity calls are not signed, so STS does not
+	// need any credentials.
+	cfg, err := config.LoadDefaultConfig(
+		ctx,
+		withLoadOptions(optFns,
+			userAgentV2,
+			config.WithRegion(region),
+			config.WithCredentialsProvider(aws.AnonymousCredentials{}),
+		)...,
+	)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to load default AWS config")
+	}
+	cfg.Credentials = aws.NewCredentialsCache(
+		stscreds.NewWebIdentityRoleProvider(
+			sts.NewFromConfig(cfg, stsOptions(pc)),
+			StringValue(roleArn),
+			token,
+			SetWebIdentityRoleOptions(pc),
+		),
+	)
+
+	if pc.Spec.AssumeRole != nil || pc.Spec.AssumeRoleARN != nil {
+		roleArn, err := GetAssumeRoleARN(pc.Spec.DeepCopy())
+		if err != nil {
+			return nil, err
+		}
+		cfg.Credentials = aws.NewCredentialsCache(
+			stscreds.NewAssumeRoleProvider(
+				sts.NewFromConfig(cfg, stsOptions(pc)),
+				StringValue(roleArn),
+				SetAssumeRoleOptions(pc),
+			),
+		)
+	}
+	return &cfg, nil
+}
+
 const webIdentityTokenFileDefaultPath = "/var/run/secrets/eks.amazonaws.com/serviceaccount/token"
 
 func getWebidentityTokenFilePath() string {
@@ -394,18 +527,22 @@ func getWebidentityTokenFilePath() string {
 
 // UsePodServiceAccount assumes an IAM role configured via a ServiceAccount.
 // https://docs.aws.amazon.com/eks/latest/userguide/iam-roles-for-service-accounts.html
-func UsePodServiceAccount(ctx context.Context, _ []byte, _, region string) (*aws.Config, error) {
+func UsePodServiceAccount(ctx context.Context, _ []byte, _, region string, optFns ...func(*config.LoadOptions) error) (*aws.Config, error) {
 	if region == GlobalRegion {
 		cfg, err := config.LoadDefaultConfig(
 			ctx,
-			userAgentV2,
+			withLoadOptions(optFns,
+				userAgentV2,
+			)...,
 		)
 		return &cfg, errors.Wrap(err, "failed to load default AWS config")
 	}
 	cfg, err := config.LoadDefaultConfig(
 		ctx,
-		userAgentV2,
-		config.WithRegion(region),
+		withLoadOptions(optFns,
+			userAgentV2,
+			config.WithRegion(region),
+		)...,
 	)
 	if err != nil {
 		return nil, errors.Wrap(err, fmt.Sprintf("failed to load default AWS config with region %s", region))
@@ -418,7 +555,7 @@ func UsePodServiceAccount(ctx context.Context, _ []byte, _, region string) (*aws
 
 // GetConfigV1 constructs an *awsv1.Config that can be used to authenticate to AWS
 // API by the AWSv1 clients.
-func GetConfigV1(ctx context.Context, c client.Client, mg resource.Managed, region string) (*session.Session, error) { // nolint:gocyclo
+func GetConfigV1(ctx context.Context, c client.Client, mg resource.Managed, region string) (*session.Session, error) {
 	if mg.GetProviderConfigReference() == nil {
 		return nil, errors.New("providerConfigRef cannot be empty")
 	}
@@ -431,45 +568,72 @@ func GetConfigV1(ctx context.Context, c client.Client, mg resource.Managed, regi
 	if err := t.Track(ctx, mg); err != nil {
 		return nil, errors.Wrap(err, "cannot track ProviderConfig usage")
 	}
+
+	cfg, err := cachedConfigV1(ctx, c, pc, region)
+	if err != nil {
+		return nil, err
+	}
+	sess, err := GetSessionV1(cfg)
+	if err != nil {
+		return nil, err
+	}
+	sess.Handlers.Complete.PushBackNamed(metrics.HandlerV1(pc.GetName()))
+	return sess, nil
+}
+
+// resolveProviderConfigV1 produces the AWS v1 config described by the
+// supplied ProviderConfig.
+func resolveProviderConfigV1(ctx context.Context, c client.Client, pc *v1beta1.ProviderConfig, region string) (*awsv1.Config, error) {
+	transport, err := transportOptions(ctx, c, pc)
+	if err != nil {
+		return nil, err
+	}
+	cfg, err := resolveProviderCredentialsV1(ctx, c, pc, region, loadOptions(pc, transport)...)
+	if err != nil {
+		return nil, err
+	}
+	return setClientOptionsV1(cfg, pc, transport), nil
+}
+
+// resolveProviderCredentialsV1 produces the AWS v1 config that uses the
+// credentials of the supplied ProviderConfig.
+func resolveProviderCredentialsV1(ctx context.Context, c client.Client, pc *v1beta1.ProviderConfig, region string, optFns ...func(*config.LoadOptions) error) (*awsv1.Config, error) {
+	// NOTE: Role chains and web identity tokens from credential sources are
+	// only implemented for AWS SDK v2 credentials, so we assume the roles
+	// with those and adapt the resulting credentials.
+	if len(pc.Spec.AssumeRoleChain) > 0 || usesWebIdentityToken(pc) {
+		cfg, err := resolveProviderConfig(ctx, c, pc, region)
+		if err != nil {
+			return nil, errors.Wrap(err, "cannot assume role")
+		}
+		v1creds, err := credentialsV1(ctx, cfg.Credentials)
+		if err != nil {
+			return nil, errors.Wrap(err, "cannot assume role")
+		}
+		return SetResolverV1(pc, awsv1.NewConfig().WithCredentials(v1creds).WithRegion(cfg.Region)), nil
+	}
 	switch s := pc.Spec.Credentials.Source; s { //nolint:exhaustive
 	case xpv1.CredentialsSourceInjectedIdentity:
 		if pc.Spec.AssumeRoleARN != nil || pc.Spec.AssumeRole != nil {
-			cfg, err := UsePodServiceAccountV1AssumeRole(ctx, []byte{}, pc, DefaultSection, region)
-			if err != nil {
-				return nil, errors.Wrap(err, "cannot use pod service account to assume role")
-			}
-			return GetSessionV1(cfg)
+			cfg, err := UsePodServiceAccountV1AssumeRole(ctx, []byte{}, pc, DefaultSection, region, optFns...)
+			return cfg, errors.Wrap(err, "cannot use pod service account to assume role")
 		}
 		if pc.Spec.AssumeRoleWithWebIdentity != nil && pc.Spec.AssumeRoleWithWebIdentity.RoleARN != nil {
-			cfg, err := UsePodServiceAccountV1AssumeRoleWithWebIdentity(ctx, []byte{}, pc, DefaultSection, region)
-			if err != nil {
-				return nil, err
-			}
-			return GetSessionV1(cfg)
+			return UsePodServiceAccountV1AssumeRoleWithWebIdentity(ctx, []byte{}, pc, DefaultSection, region, optFns...)
 		}
-		cfg, err := UsePodServiceAccountV1(ctx, []byte{}, pc, DefaultSection, region)
-		if err != nil {
-			return nil, errors.Wrap(err, "cannot use pod service account")
-		}
-		return GetSessionV1(cfg)
+		cfg, err := UsePodServiceAccountV1(ctx, []byte{}, pc, DefaultSection, region, optFns...)
+		return cfg, errors.Wrap(err, "cannot use pod service account")
 	default:
 		data, err := resource.CommonCredentialExtractor(ctx, s, c, pc.Spec.Credentials.CommonCredentialSelectors)
 		if err != nil {
 			return nil, errors.Wrap(err, "cannot get credentials")
 		}
-
 		if pc.Spec.AssumeRole != nil || pc.Spec.AssumeRoleARN != nil {
-			cfg, err := UseProviderSecretV1AssumeRole(ctx, data, pc, DefaultSection, region)
-			if err != nil {
-				return nil, errors.Wrap(err, "cannot use secret")
-			}
-			return GetSessionV1(cfg)
+			cfg, err := UseProviderSecretV1AssumeRole(ctx, data, pc, DefaultSection, region, optFns...)
+			return cfg, errors.Wrap(err, "cannot use secret")
 		}
 		cfg, err := UseProviderSecretV1(ctx, data, pc, DefaultSection, region)
-		if err != nil {
-			return nil, errors.Wrap(err, "cannot use secret")
-		}
-		return GetSessionV1(cfg)
+		return cfg, errors.Wrap(err, "cannot use secret")
 	}
 }
 
@@ -485,7 +649,7 @@ func GetSessionV1(cfg *awsv1.Config) (*session.Session, error) {
 
 // UseProviderSecretV1AssumeRole - AWS v1 configuration which can be used to issue requests against AWS API
 // assume Cross account IAM roles
-func UseProviderSecretV1AssumeRole(ctx context.Context, data []byte, pc *v1beta1.ProviderConfig, profile, region string) (*awsv1.Config, error) {
+func UseProviderSecretV1AssumeRole(ctx context.Context, data []byte, pc *v1beta1.ProviderConfig, profile, region string, optFns ...func(*config.LoadOptions) error) (*awsv1.Config, error) {
 	creds, err := CredentialsIDSecret(data, profile)
 	if err != nil {
 		return nil, errors.Wrap(err, "cannot parse credentials secret")
@@ -493,11 +657,13 @@ func UseProviderSecretV1AssumeRole(ctx context.Context, data []byte, pc *v1beta1
 
 	config, err := config.LoadDefaultConfig(
 		ctx,
-		userAgentV2,
-		config.WithRegion(region),
-		config.WithCredentialsProvider(credentials.StaticCredentialsProvider{
-			Value: creds,
-		}),
+		withLoadOptions(optFns,
+			userAgentV2,
+			config.WithRegion(region),
+			config.WithCredentialsProvider(credentials.StaticCredentialsProvider{
+				Value: creds,
+			}),
+		)...,
 	)
 	if err != nil {
 		return nil, errors.Wrap(err, "failed to load credentials")
@@ -508,7 +674,7 @@ func UseProviderSecretV1AssumeRole(ctx context.Context, data []byte, pc *v1beta1
 		return nil, errors.Wrap(err, "failed to assume IAM Role")
 	}
 
-	stsSvc := sts.NewFromConfig(config)
+	stsSvc := sts.NewFromConfig(config, stsOptions(pc))
 	stsAssumeRoleOptions := SetAssumeRoleOptions(pc)
 	stsAssume := stscreds.NewAssumeRoleProvider(
 		stsSvc,
@@ -517,16 +683,11 @@ func UseProviderSecretV1AssumeRole(ctx context.Context, data []byte, pc *v1beta1
 	)
 	config.Credentials = aws.NewCredentialsCache(stsAssume)
 
-	v2creds, err := config.Credentials.Retrieve(ctx)
+	v1creds, err := credentialsV1(ctx, config.Credentials)
 	if err != nil {
-		return nil, errors.Wrap(err, "failed to retrieve credentials")
+		return nil, err
 	}
 
-	v1creds := credentialsv1.NewStaticCredentials(
-		v2creds.AccessKeyID,
-		v2creds.SecretAccessKey,
-		v2creds.SessionToken)
-
 	return SetResolverV1(pc, awsv1.NewConfig().WithCredentials(v1creds).WithRegion(region)), nil
 }
 
@@ -565,8 +726,8 @@ func UseProviderSecretV1(_ context.Context, data []byte, pc *v1beta1.ProviderCon
 // UsePodServiceAccountV1AssumeRole assumes an IAM role configured via a ServiceAccount and
 // assume Cross account IAM role
 // https://aws.amazon.com/blogs/containers/cross-account-iam-roles-for-kubernetes-service-accounts/
-func UsePodServiceAccountV1AssumeRole(ctx context.Context, _ []byte, pc *v1beta1.ProviderConfig, _, region string) (*awsv1.Config, error) {
-	cfg, err := config.LoadDefaultConfig(ctx, userAgentV2)
+func UsePodServiceAccountV1AssumeRole(ctx context.Context, _ []byte, pc *v1beta1.ProviderConfig, _, region string, optFns ...func(*config.LoadOptions) error) (*awsv1.Config, error) {
+	cfg, err := config.LoadDefaultConfig(ctx, withLoadOptions(optFns, userAgentV2)...)
 	if err != nil {
 		return nil, errors.Wrap(err, "failed to load default AWS config")
 	}
@@ -575,42 +736,40 @@ func UsePodServiceAccountV1AssumeRole(ctx context.Context, _ []byte, pc *v1beta1
 	if err != nil {
 		return nil, errors.Wrap(err, "failed to assume IAM Role")
 	}
-	stsclient := sts.NewFromConfig(cfg)
+	stsclient := sts.NewFromConfig(cfg, stsOptions(pc))
 	stsAssumeRoleOptions := SetAssumeRoleOptions(pc)
 	if region == GlobalRegion {
 		region = cfg.Region
 	}
 	cnf, err := config.LoadDefaultConfig(
 		ctx,
-		userAgentV2,
-		config.WithRegion(region),
-		config.WithCredentialsProvider(aws.NewCredentialsCache(
-			stscreds.NewAssumeRoleProvider(
-				stsclient,
-				StringValue(roleArn),
-				stsAssumeRoleOptions,
-			)),
-		),
+		withLoadOptions(optFns,
+			userAgentV2,
+			config.WithRegion(region),
+			config.WithCredentialsProvider(aws.NewCredentialsCache(
+				stscreds.NewAssumeRoleProvider(
+					stsclient,
+					StringValue(roleArn),
+					stsAssumeRoleOptions,
+				)),
+			),
+		)...,
 	)
 	if err != nil {
 		return nil, errors.Wrap(err, "failed to load assumed role AWS config")
 	}
-	v2creds, err := cnf.Credentials.Retrieve(ctx)
+	v1creds, err := credentialsV1(ctx, cnf.Credentials)
 	if err != nil {
-		return nil, errors.Wrap(err, "failed to retrieve credentials")
+		return nil, err
 	}
-	v1creds := credentialsv1.NewStaticCredentials(
-		v2creds.AccessKeyID,
-		v2creds.SecretAccessKey,
-		v2creds.SessionToken)
 	return SetResolverV1(pc, awsv1.NewConfig().WithCredentials(v1creds).WithRegion(region)), nil
 }
 
 // UsePodServiceAccountV1AssumeRoleWithWebIdentity assumes an IAM role configured via a ServiceAccount and
 // assume Cross account IAM role
 // https://aws.amazon.com/blogs/containers/cross-account-iam-roles-for-kubernetes-service-accounts/
-func UsePodServiceAccountV1AssumeRoleWithWebIdentity(ctx context.Context, _ []byte, pc *v1beta1.ProviderConfig, _, region string) (*awsv1.Config, error) {
-	cfg, err := config.LoadDefaultConfig(ctx, userAgentV2)
+func UsePodServiceAccountV1AssumeRoleWithWebIdentity(ctx context.Context, _ []byte, pc *v1beta1.ProviderConfig, _, region string, optFns ...func(*config.LoadOptions) error) (*awsv1.Config, error) {
+	cfg, err := config.LoadDefaultConfig(ctx, withLoadOptions(optFns, userAgentV2)...)
 	if err != nil {
 		return nil, errors.Wrap(err, "failed to load default AWS config")
 	}
@@ -620,101 +779,101 @@ func UsePodServiceAccountV1AssumeRoleWithWebIdentity(ctx context.Context, _ []by
 		return nil, errors.Wrap(err, "failed to get role arn for assume role with web identity")
 	}
 
-	stsclient := sts.NewFromConfig(cfg)
+	stsclient := sts.NewFromConfig(cfg, stsOptions(pc))
 	webIdentityRoleOptions := SetWebIdentityRoleOptions(pc)
 
 	cnf, err := config.LoadDefaultConfig(
 		ctx,
-		userAgentV2,
-		config.WithRegion(region),
-		config.WithCredentialsProvider(aws.NewCredentialsCache(
-			stscreds.NewWebIdentityRoleProvider(
-				stsclient,
-				StringValue(roleArn),
-				stscreds.IdentityTokenFile("/var/run/secrets/eks.amazonaws.com/serviceaccount/token"),
-				webIdentityRoleOptions,
-			)),
-		),
+		withLoadOptions(optFns,
+			userAgentV2,
+			config.WithRegion(region),
+			config.WithCredentialsProvider(aws.NewCredentialsCache(
+				stscreds.NewWebIdentityRoleProvider(
+					stsclient,
+					StringValue(roleArn),
+					stscreds.IdentityTokenFile(getWebidentityTokenFilePath()),
+					webIdentityRoleOptions,
+				)),
+			),
+		)...,
 	)
 	if err != nil {
 		return nil, errors.Wrap(err, "failed to load assumed role AWS config")
 	}
-	v2creds, err := cnf.Credentials.Retrieve(ctx)
+	v1creds, err := credentialsV1(ctx, cnf.Credentials)
 	if err != nil {
-		return nil, errors.Wrap(err, "failed to retrieve credentials")
+		return nil, err
 	}
-	v1creds := credentialsv1.NewStaticCredentials(
-		v2creds.AccessKeyID,
-		v2creds.SecretAccessKey,
-		v2creds.SessionToken)
 	return SetResolverV1(pc, awsv1.NewConfig().WithCredentials(v1creds).WithRegion(region)), nil
 }
 
 // UsePodServiceAccountV1 assumes an IAM role configured via a ServiceAccount.
 // https://docs.aws.amazon.com/eks/latest/userguide/iam-roles-for-service-accounts.html
-func UsePodServiceAccountV1(ctx context.Context, _ []byte, pc *v1beta1.ProviderConfig, _, region string) (*awsv1.Config, error) {
+func UsePodServiceAccountV1(ctx context.Context, _ []byte, pc *v1beta1.ProviderConfig, _, region string, optFns ...func(*config.LoadOptions) error) (*awsv1.Config, error) {
 	cfg, err := config.LoadDefaultConfig(
 		ctx,
-		userAgentV2,
+		withLoadOptions(optFns,
+			userAgentV2,
+		)...,
 	)
 	if err != nil {
 		return nil, errors.Wrap(err, "failed to load default AWS config")
 	}
-	v2creds, err := cfg.Credentials.Retrieve(ctx)
-	if err != nil {
-		return nil, errors.Wrap(err, "failed to retrieve credentials")
-	}
 	if region == GlobalRegion {
 		region = cfg.Region
 	}
-	v1creds := credentialsv1.NewStaticCredentials(
-		v2creds.AccessKeyID,
-		v2creds.SecretAccessKey,
-		v2creds.SessionToken)
+	v1creds, err := credentialsV1(ctx, cfg.Credentials)
+	if err != nil {
+		return nil, err
+	}
 	return SetResolverV1(pc, awsv1.NewConfig().WithCredentials(v1creds).WithRegion(region)), nil
 }
 
 // SetResolverV1 parses annotations from the managed resource
 // and returns a V1 configuration accordingly.
 func SetResolverV1(pc *v1beta1.ProviderConfig, cfg *awsv1.Config) *awsv1.Config { // nolint:gocyclo
-	if pc.Spec.Endpoint == nil {
+	if pc.Spec.Endpoint == nil && len(pc.Spec.ServiceEndpoints) == 0 {
 		return cfg
 	}
 	cfg.EndpointResolver = endpointsv1.ResolverFunc(func(service, region string, optFns ...func(*endpointsv1.Options)) (endpointsv1.ResolvedEndpoint, error) {
+		ec := endpointConfigFor(pc, service)
+		if ec == nil {
+			return endpointsv1.DefaultResolver().EndpointFor(service, region, optFns...)
+		}
 		fullURL := ""
-		switch pc.Spec.Endpoint.URL.Type {
+		switch ec.URL.Type {
 		case URLConfigTypeStatic:
-			if pc.Spec.Endpoint.URL.Static == nil {
+			if ec.URL.Static == nil {
 				return endpointsv1.ResolvedEndpoint{}, errors.New("static type is chosen but static field does not have a value")
 			}
-			fullURL = StringValue(pc.Spec.Endpoint.URL.Static)
+			fullURL = StringValue(ec.URL.Static)
 		case URLConfigTypeDynamic:
-			if pc.Spec.Endpoint.URL.Dynamic == nil {
+			if ec.URL.Dynamic == nil {
 				return endpointsv1.ResolvedEndpoint{}, errors.New("dynamic type is chosen but dynamic configuration is not given")
 			}
 			// NOTE(muvaf): IAM does not have any region.
 			if service == "IAM" {
-				fullURL = fmt.Sprintf("%s://%s.%s", pc.Spec.Endpoint.URL.Dynamic.Protocol, strings.ToLower(service), pc.Spec.Endpoint.URL.Dynamic.Host)
+				fullURL = fmt.Sprintf("%s://%s.%s", ec.URL.Dynamic.Protocol, strings.ToLower(service), ec.URL.Dynamic.Host)
 			} else {
-				fullURL = fmt.Sprintf("%s://%s.%s.%s", pc.Spec.Endpoint.URL.Dynamic.Protocol, strings.ToLower(service), region, pc.Spec.Endpoint.URL.Dynamic.Host)
+				fullURL = fmt.Sprintf("%s://%s.%s.%s", ec.URL.Dynamic.Protocol, strings.ToLower(service), region, ec.URL.Dynamic.Host)
 			}
 		default:
 			return endpointsv1.ResolvedEndpoint{}, errors.New("unsupported url config type is chosen")
 		}
 		e := endpointsv1.ResolvedEndpoint{
 			URL:           fullURL,
-			PartitionID:   StringValue(pc.Spec.Endpoint.PartitionID),
-			SigningName:   StringValue(pc.Spec.Endpoint.SigningName),
-			SigningRegion: StringValue(LateInitializeStringPtr(pc.Spec.Endpoint.SigningRegion, &region)),
-			SigningMethod: StringValue(pc.Spec.Endpoint.SigningMethod),
+			PartitionID:   StringValue(ec.PartitionID),
+			SigningName:   StringValue(ec.SigningName),
+			SigningRegion: StringValue(LateInitializeStringPtr(ec.SigningRegion, &region)),
+			SigningMethod: StringValue(ec.SigningMethod),
 		}
 		// Only IAM does not have a region parameter and "aws-global" is used in
 		// SDK setup. However, signing region has to be us-east-1 and it needs
 		// to be set.
 		if region == "aws-global" {
-			switch StringValue(pc.Spec.Endpoint.PartitionID) {
+			switch StringValue(ec.PartitionID) {
 			case "aws-us-gov", "aws-cn", "aws-iso", "aws-iso-b":
-				e.SigningRegion = StringValue(LateInitializeStringPtr(pc.Spec.Endpoint.SigningRegion, &region))
+				e.SigningRegion = StringValue(LateInitializeStringPtr(ec.SigningRegion, &region))
 			default:
 				e.SigningRegion = "us-east-1"
 			}
@@ -752,23 +911,7 @@ func GetAssumeRoleWithWebIdentityARN(pcs *v1beta1.ProviderConfigSpec) (*string,
 // SetAssumeRoleOptions sets options when Assuming an IAM Role
 func SetAssumeRoleOptions(pc *v1beta1.ProviderConfig) func(*stscreds.AssumeRoleOptions) {
 	if pc.Spec.AssumeRole != nil {
-		return func(opt *stscreds.AssumeRoleOptions) {
-			if pc.Spec.AssumeRole.ExternalID != nil {
-				opt.ExternalID = pc.Spec.AssumeRole.ExternalID
-			}
-
-			if pc.Spec.AssumeRole.Tags != nil && len(pc.Spec.AssumeRole.Tags) > 0 {
-				for _, t := range pc.Spec.AssumeRole.Tags {
-					opt.Tags = append(
-						opt.Tags,
-						stscredstypesv2.Tag{Key: t.Key, Value: t.Value})
-				}
-			}
-
-			if pc.Spec.AssumeRole.TransitiveTagKeys != nil && len(pc.Spec.AssumeRole.TransitiveTagKeys) > 0 {
-				opt.TransitiveTagKeys = pc.Spec.AssumeRole.TransitiveTagKeys
-			}
-		}
+		return assumeRoleOptions(*pc.Spec.AssumeRole)
 	}
 
 	// Deprecated. Use AssumeRole.ExternalID
@@ -779,6 +922,57 @@ func SetAssumeRoleOptions(pc *v1beta1.ProviderConfig) func(*stscreds.AssumeRoleO
 	return func(opt *stscreds.AssumeRoleOptions) {}
 }
 
+// assumeRoleOptions returns a function that sets the supplied options when
+// assuming an IAM Role.
+func assumeRoleOptions(o v1beta1.AssumeRoleOptions) func(*stscreds.AssumeRoleOptions) {
+	return func(opt *stscreds.AssumeRoleOptions) {
+		if o.ExternalID != nil {
+			opt.ExternalID = o.ExternalID
+		}
+
+		if len(o.Tags) > 0 {
+			for _, t := range o.Tags {
+				opt.Tags = append(
+					opt.Tags,
+					stscredstypesv2.Tag{Key: t.Key, Value: t.Value})
+			}
+		}
+
+		if len(o.TransitiveTagKeys) > 0 {
+			opt.TransitiveTagKeys = o.TransitiveTagKeys
+		}
+
+		if o.RoleSessionName != nil {
+			opt.RoleSessionName = *o.RoleSessionName
+		}
+
+		if o.Duration != nil {
+			opt.Duration = o.Duration.Duration
+		}
+	}
+}
+
+// UseAssumeRoleChain assumes the IAM roles in the AssumeRoleChain of the
+// supplied ProviderConfig in order, starting with the credentials of the
+// supplied config, and returns a config that uses the credentials of the last
+// role in the chain.
+func UseAssumeRoleChain(cfg *aws.Config, pc *v1beta1.ProviderConfig) (*aws.Config, error) {
+	for i, o := range pc.Spec.AssumeRoleChain {
+		if StringValue(o.RoleARN) == "" {
+			return nil, errors.Errorf("a RoleARN must be set to assume role %d of the assume role chain", i)
+		}
+		// NOTE: The STS client is built from a copy of the config, so it
+		// keeps using the credentials of the previous role in the chain.
+		stsSvc := sts.NewFromConfig(*cfg, stsOptions(pc))
+		cfg.Credentials = aws.NewCredentialsCache(stscreds.NewAssumeRoleProvider(
+			stsSvc,
+			StringValue(o.RoleARN),
+			assumeRoleOptions(o),
+		))
+	}
+	return cfg, nil
+}
+
 // SetWebIdentityRoleOptions sets options when exchanging a WebIdentity Token for a Role
 func SetWebIdentityRoleOptions(pc *v1beta1.ProviderConfig) func(*stscreds.WebIdentityRoleOptions) {
 	if pc.Spec.AssumeRoleWithWebIdentity != nil {
@@ -1194,8 +1388,17 @@ func DiffLabels(local, remote map[string]string) (addOrModify map[string]string,
 	return
 }
 
-// IsPolicyUpToDate Marshall policies to json for a compare to get around string ordering
+// IsPolicyUpToDate compares the policy documents semantically, see
+// policyutils.ArePoliciesEqal. Documents that cannot be parsed as IAM policies
+// are marshalled to json for a compare to get around string ordering.
 func IsPolicyUpToDate(local, remote *string) bool {
+	localPolicy, localErr := parsePolicyStrict(StringValue(local))
+	remotePolicy, remoteErr := parsePolicyStrict(StringValue(remote))
+	if localErr == nil && remoteErr == nil {
+		equal, _ := policyutils.ArePoliciesEqal(&localPolicy, &remotePolicy)
+		return equal
+	}
+
 	var localUnmarshalled interface{}
 	var remoteUnmarshalled interface{}
 
@@ -1224,6 +1427,16 @@ func IsPolicyUpToDate(local, remote *string) bool {
 	return cmp.Equal(localUnmarshalled, remoteUnmarshalled, cmpopts.EquateEmpty(), sortSlicesOpt)
 }
 
+// parsePolicyStrict parses an IAM policy document and fails for documents with
+// fields that are not part of an IAM policy.
+func parsePolicyStrict(raw string) (policyutils.Policy, error) {
+	p := policyutils.Policy{}
+	dec := json.NewDecoder(strings.NewReader(raw))
+	dec.DisallowUnknownFields()
+	err := dec.Decode(&p)
+	return p, err
+}
+
 // Wrap will remove the request-specific information from the error and only then
 // wrap it.
 func Wrap(err error, msg string) error {
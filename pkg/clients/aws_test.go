@@ -20,13 +20,17 @@ import (
 	"context"
 	"fmt"
 	"os"
+	"strings"
 	"testing"
+	"time"
 
 	"github.com/aws/aws-sdk-go-v2/aws"
 	"github.com/aws/aws-sdk-go-v2/aws/transport/http"
+	"github.com/aws/aws-sdk-go-v2/credentials"
 	stscreds "github.com/aws/aws-sdk-go-v2/credentials/stscreds"
 	ec2types "github.com/aws/aws-sdk-go-v2/service/ec2/types"
 	stscredstypesv2 "github.com/aws/aws-sdk-go-v2/service/sts/types"
+	awsv1 "github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/smithy-go"
 	"github.com/aws/smithy-go/document"
 	smithyhttp "github.com/aws/smithy-go/transport/http"
@@ -34,6 +38,7 @@ import (
 	"github.com/google/go-cmp/cmp/cmpopts"
 	. "github.com/onsi/gomega"
 	"github.com/pkg/errors"
+	corev1 "k8s.io/api/core/v1"
 	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 
@@ -258,6 +263,7 @@ func TestSetAssumeroleOptions(t *testing.T) {
 
 	key1 := "key1"
 	value1 := "value1"
+	sessionName := "test-session"
 
 	type args struct {
 		pc v1beta1.ProviderConfig
@@ -346,6 +352,24 @@ func TestSetAssumeroleOptions(t *testing.T) {
 				aro: stscreds.AssumeRoleOptions{},
 			},
 		},
+		"SetSessionNameAndDuration": {
+			args: args{
+				pc: v1beta1.ProviderConfig{
+					Spec: v1beta1.ProviderConfigSpec{
+						AssumeRole: &v1beta1.AssumeRoleOptions{
+							RoleSessionName: &sessionName,
+							Duration:        &v1.Duration{Duration: time.Hour},
+						},
+					},
+				},
+			},
+			want: want{
+				aro: stscreds.AssumeRoleOptions{
+					RoleSessionName: sessionName,
+					Duration:        time.Hour,
+				},
+			},
+		},
 	}
 
 	for name, tc := range cases {
@@ -362,6 +386,105 @@ func TestSetAssumeroleOptions(t *testing.T) {
 	}
 }
 
+func TestUseAssumeRoleChain(t *testing.T) {
+	roleARN := "arn:aws:iam::123456789012:role/test"
+
+	type want struct {
+		chained bool
+		err     error
+	}
+	cases := map[string]struct {
+		pc   v1beta1.ProviderConfig
+		want want
+	}{
+		"NoChain": {
+			pc:   v1beta1.ProviderConfig{},
+			want: want{},
+		},
+		"Chain": {
+			pc: v1beta1.ProviderConfig{
+				Spec: v1beta1.ProviderConfigSpec{
+					AssumeRoleChain: []v1beta1.AssumeRoleOptions{{RoleARN: &roleARN}, {RoleARN: &roleARN}},
+				},
+			},
+			want: want{chained: true},
+		},
+		"MissingRoleARN": {
+			pc: v1beta1.ProviderConfig{
+				Spec: v1beta1.ProviderConfigSpec{
+					AssumeRoleChain: []v1beta1.AssumeRoleOptions{{RoleARN: &roleARN}, {}},
+				},
+			},
+			want: want{err: errors.New("a RoleARN must be set to assume role 1 of the assume role chain")},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			static := credentials.NewStaticCredentialsProvider("id", "secret", "")
+			cfg, err := UseAssumeRoleChain(&aws.Config{Credentials: static}, &tc.pc)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("UseAssumeRoleChain(...): -want error, +got error:\n%s", diff)
+			}
+			if err != nil {
+				return
+			}
+			if _, ok := cfg.Credentials.(*aws.CredentialsCache); ok != tc.want.chained {
+				t.Errorf("UseAssumeRoleChain(...): want chained credentials %t, got %T", tc.want.chained, cfg.Credentials)
+			}
+		})
+	}
+}
+
+func TestWebIdentityTokenRetriever(t *testing.T) {
+	type want struct {
+		token []byte
+		err   error
+	}
+	cases := map[string]struct {
+		kube client.Client
+		want want
+	}{
+		"TrimsToken": {
+			kube: &test.MockClient{
+				MockGet: test.NewMockGetFn(nil, func(obj client.Object) error {
+					obj.(*corev1.Secret).Data = map[string][]byte{"token": []byte("some-token\n")}
+					return nil
+				}),
+			},
+			want: want{token: []byte("some-token")},
+		},
+		"GetSecretError": {
+			kube: &test.MockClient{MockGet: test.NewMockGetFn(errors.New(errBoom))},
+			want: want{err: errors.Wrap(errors.Wrap(errors.New(errBoom), "cannot get credentials secret"), "cannot get web identity token")},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			r := &webIdentityTokenRetriever{
+				client: tc.kube,
+				credentials: v1beta1.ProviderCredentials{
+					Source: xpv1.CredentialsSourceSecret,
+					CommonCredentialSelectors: xpv1.CommonCredentialSelectors{
+						SecretRef: &xpv1.SecretKeySelector{
+							SecretReference: xpv1.SecretReference{Namespace: "crossplane-system", Name: "oidc-token"},
+							Key:             "token",
+						},
+					},
+				},
+			}
+			token, err := r.GetIdentityToken()
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("GetIdentityToken(): -want error, +got error:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.token, token); diff != "" {
+				t.Errorf("GetIdentityToken(): -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
 func TestSetWebIdentityRoleOptions(t *testing.T) {
 	sessionName := "test-id"
 
@@ -845,6 +968,13 @@ func TestIsPolicyUpToDate(t *testing.T) {
 			},
 			want: true,
 		},
+		"SemanticallyEqualPolicies": {
+			args: args{
+				local:  `{"Statement":[{"Action":["ecr:ListImages","ecr:DescribeImages"],"Effect":"Allow","Principal":{"AWS":"111122223333"}}],"Version":"2012-10-17"}`,
+				remote: `{"Statement":{"Action":["ecr:describeimages","ecr:listimages"],"Effect":"Allow","Principal":{"AWS":"arn:aws:iam::111122223333:root"}},"Version":"2012-10-17"}`,
+			},
+			want: true,
+		},
 		"SameFieldsNumericPrincipals": {
 			args: args{
 				// This is to test that our slice sorting does not
@@ -1022,6 +1152,114 @@ func TestUseProviderConfigResolveEndpoint(t *testing.T) {
 	}
 }
 
+func TestSetResolverServiceEndpoints(t *testing.T) {
+	localstack := v1beta1.EndpointConfig{
+		URL: v1beta1.URLConfig{
+			Type:   "Static",
+			Static: aws.String("http://localstack:4566"),
+		},
+	}
+	vpce := v1beta1.EndpointConfig{
+		HostnameImmutable: aws.Bool(true),
+		SigningRegion:     aws.String("eu-west-1"),
+		URL: v1beta1.URLConfig{
+			Type:   "Static",
+			Static: aws.String("https://bucket.vpce-1a2b3c4d.s3.eu-west-1.vpce.amazonaws.com"),
+		},
+	}
+
+	type args struct {
+		spec    v1beta1.ProviderConfigSpec
+		service string
+	}
+	type want struct {
+		url           string
+		signingRegion string
+		notFound      bool
+	}
+
+	cases := map[string]struct {
+		args args
+		want want
+	}{
+		"ServiceEndpoint": {
+			args: args{
+				spec:    v1beta1.ProviderConfigSpec{ServiceEndpoints: map[string]v1beta1.EndpointConfig{"S3": vpce}},
+				service: "S3",
+			},
+			want: want{
+				url:           "https://bucket.vpce-1a2b3c4d.s3.eu-west-1.vpce.amazonaws.com",
+				signingRegion: "eu-west-1",
+			},
+		},
+		"FallbackToGlobalEndpoint": {
+			args: args{
+				spec: v1beta1.ProviderConfigSpec{
+					Endpoint:         &localstack,
+					ServiceEndpoints: map[string]v1beta1.EndpointConfig{"S3": vpce},
+				},
+				service: "STS",
+			},
+			want: want{
+				url:           "http://localstack:4566",
+				signingRegion: "us-west-2",
+			},
+		},
+		"FallbackToDefaultEndpoint": {
+			args: args{
+				spec:    v1beta1.ProviderConfigSpec{ServiceEndpoints: map[string]v1beta1.EndpointConfig{"S3": vpce}},
+				service: "STS",
+			},
+			want: want{
+				notFound: true,
+			},
+		},
+		"MatchIgnoringCaseAndSpaces": {
+			args: args{
+				spec:    v1beta1.ProviderConfigSpec{ServiceEndpoints: map[string]v1beta1.EndpointConfig{"Route 53": localstack}},
+				service: "route53",
+			},
+			want: want{
+				url:           "http://localstack:4566",
+				signingRegion: "us-west-2",
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			pc := &v1beta1.ProviderConfig{Spec: tc.args.spec}
+			cfg := SetResolver(pc, &aws.Config{})
+			e, err := cfg.EndpointResolverWithOptions.ResolveEndpoint(tc.args.service, "us-west-2")
+			if tc.want.notFound {
+				var nf *aws.EndpointNotFoundError
+				if !errors.As(err, &nf) {
+					t.Errorf("expected an EndpointNotFoundError, got: %v", err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("ResolveEndpoint(...): unexpected error: %s", err)
+			}
+			if diff := cmp.Diff(tc.want.url, e.URL); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.signingRegion, e.SigningRegion); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+
+			cfgV1 := SetResolverV1(pc, awsv1.NewConfig())
+			eV1, err := cfgV1.EndpointResolver.EndpointFor(strings.ToLower(strings.ReplaceAll(tc.args.service, " ", "")), "us-west-2")
+			if err != nil {
+				t.Fatalf("EndpointFor(...): unexpected error: %s", err)
+			}
+			if diff := cmp.Diff(tc.want.url, eV1.URL); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
 func TestDiffTagsMapPtr(t *testing.T) {
 	type args struct {
 		cr  map[string]*string
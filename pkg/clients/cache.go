@@ -0,0 +1,250 @@
+/*
+Copyright 2023 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package aws
+
+import (
+	"context"
+	"os"
+	"strconv"
+	"sync"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	awsv1 "github.com/aws/aws-sdk-go/aws"
+	credentialsv1 "github.com/aws/aws-sdk-go/aws/credentials"
+	"github.com/pkg/errors"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+
+	"github.com/crossplane-contrib/provider-aws/apis/v1beta1"
+)
+
+// configCacheKey identifies the configs resolved from a ProviderConfig for a
+// given region.
+type configCacheKey struct {
+	uid    types.UID
+	region string
+}
+
+// configCacheEntry is a config that was resolved from a specific revision of
+// a ProviderConfig and its credentials.
+type configCacheEntry struct {
+	revision string
+	cfg      *aws.Config
+	cfgV1    *awsv1.Config
+}
+
+// configCache caches the AWS configs resolved from ProviderConfigs so that
+// credentials, and most importantly assumed role sessions, are shared across
+// reconciles and controllers instead of being rebuilt for every call to
+// UseProviderConfig or GetConfigV1. As soon as the ProviderConfig's generation
+// or the resource version of its credentials secret changes, the entries of
+// the ProviderConfig are evicted for all regions. They are evicted as well
+// once the ProviderConfig is deleted, see EvictCachedConfigs.
+type configCache struct {
+	mu      sync.RWMutex
+	entries map[configCacheKey]configCacheEntry
+}
+
+var providerConfigCache = &configCache{entries: map[configCacheKey]configCacheEntry{}}
+
+func (c *configCache) get(key configCacheKey, revision string) (configCacheEntry, bool) {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+	e, ok := c.entries[key]
+	if !ok || e.revision != revision {
+		return configCacheEntry{}, false
+	}
+	return e, true
+}
+
+func (c *configCache) update(key configCacheKey, revision string, fn func(*configCacheEntry)) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	for k, e := range c.entries {
+		if k.uid == key.uid && e.revision != revision {
+			delete(c.entries, k)
+		}
+	}
+	e, ok := c.entries[key]
+	if !ok {
+		e = configCacheEntry{revision: revision}
+	}
+	fn(&e)
+	c.entries[key] = e
+}
+
+func (c *configCache) evict(uid types.UID) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	for k := range c.entries {
+		if k.uid == uid {
+			delete(c.entries, k)
+		}
+	}
+}
+
+// EvictCachedConfigs evicts the configs, and thereby the credentials, that
+// were resolved from the ProviderConfig with the supplied UID for all regions.
+// It is meant to be called once the ProviderConfig is deleted.
+func EvictCachedConfigs(uid types.UID) {
+	providerConfigCache.evict(uid)
+}
+
+// cachedConfig returns a copy of the AWS SDK v2 config resolved from the
+// supplied ProviderConfig, resolving and caching it if necessary.
+func cachedConfig(ctx context.Context, c client.Client, pc *v1beta1.ProviderConfig, region string) (*aws.Config, error) {
+	// NOTE: ProviderConfigs that do not come from the API server (e.g. in
+	// tests) have no UID, so we cannot tell them apart and don't cache them.
+	if pc.GetUID() == "" {
+		return resolveProviderConfig(ctx, c, pc, region)
+	}
+	key := configCacheKey{uid: pc.GetUID(), region: region}
+	rev, err := providerConfigRevision(ctx, c, pc)
+	if err != nil {
+		return nil, err
+	}
+	if e, ok := providerConfigCache.get(key, rev); ok && e.cfg != nil {
+		cfg := e.cfg.Copy()
+		return &cfg, nil
+	}
+	cfg, err := resolveProviderConfig(ctx, c, pc, region)
+	if err != nil {
+		return nil, err
+	}
+	cached := cfg.Copy()
+	providerConfigCache.update(key, rev, func(e *configCacheEntry) { e.cfg = &cached })
+	return cfg, nil
+}
+
+// cachedConfigV1 returns a copy of the AWS SDK v1 config resolved from the
+// supplied ProviderConfig, resolving and caching it if necessary.
+func cachedConfigV1(ctx context.Context, c client.Client, pc *v1beta1.ProviderConfig, region string) (*awsv1.Config, error) {
+	if pc.GetUID() == "" {
+		return resolveProviderConfigV1(ctx, c, pc, region)
+	}
+	key := configCacheKey{uid: pc.GetUID(), region: region}
+	rev, err := providerConfigRevision(ctx, c, pc)
+	if err != nil {
+		return nil, err
+	}
+	if e, ok := providerConfigCache.get(key, rev); ok && e.cfgV1 != nil {
+		return e.cfgV1.Copy(), nil
+	}
+	cfg, err := resolveProviderConfigV1(ctx, c, pc, region)
+	if err != nil {
+		return nil, err
+	}
+	cached := cfg.Copy()
+	providerConfigCache.update(key, rev, func(e *configCacheEntry) { e.cfgV1 = cached })
+	return cfg, nil
+}
+
+// providerConfigRevision returns a string that changes whenever the supplied
+// ProviderConfig or the credentials and secrets it refers to change.
+func providerConfigRevision(ctx context.Context, c client.Client, pc *v1beta1.ProviderConfig) (string, error) {
+	rev := strconv.FormatInt(pc.GetGeneration(), 10)
+	switch pc.Spec.Credentials.Source { //nolint:exhaustive
+	case xpv1.CredentialsSourceSecret:
+		ref := pc.Spec.Credentials.SecretRef
+		if ref == nil {
+			return "", errors.New("cannot extract from secret key when none specified")
+		}
+		s := &corev1.Secret{}
+		if err := c.Get(ctx, types.NamespacedName{Namespace: ref.Namespace, Name: ref.Name}, s); err != nil {
+			return "", errors.Wrap(err, "cannot get credentials secret")
+		}
+		rev += "/" + s.GetResourceVersion()
+	case xpv1.CredentialsSourceFilesystem:
+		if pc.Spec.Credentials.Fs == nil {
+			return "", errors.New("cannot extract from filesystem when no path specified")
+		}
+		fi, err := os.Stat(pc.Spec.Credentials.Fs.Path)
+		if err != nil {
+			return "", errors.Wrap(err, "cannot stat credentials file")
+		}
+		rev += "/" + fi.ModTime().String()
+	}
+	refs := []*xpv1.SecretKeySelector{pc.Spec.CABundleSecretRef}
+	if pc.Spec.Proxy != nil {
+		refs = append(refs, pc.Spec.Proxy.CredentialsSecretRef)
+	}
+	for _, ref := range refs {
+		if ref == nil {
+			continue
+		}
+		s := &corev1.Secret{}
+		if err := c.Get(ctx, types.NamespacedName{Namespace: ref.Namespace, Name: ref.Name}, s); err != nil {
+			return "", errors.Wrapf(err, "cannot get secret %s/%s", ref.Namespace, ref.Name)
+		}
+		rev += "/" + s.GetResourceVersion()
+	}
+	return rev, nil
+}
+
+// credentialsProviderV1 adapts an AWS SDK v2 credentials provider so that it
+// can be used by AWS SDK v1 clients. Unlike static credentials, this keeps
+// refreshing temporary credentials, e.g. the ones of an assumed role, which is
+// what allows AWS SDK v1 configs to be cached.
+type credentialsProviderV1 struct {
+	credentialsv1.Expiry
+
+	provider  aws.CredentialsProvider
+	canExpire bool
+}
+
+// Retrieve retrieves credentials from the underlying AWS SDK v2 provider.
+func (p *credentialsProviderV1) Retrieve() (credentialsv1.Value, error) {
+	return p.RetrieveWithContext(context.Background())
+}
+
+// RetrieveWithContext retrieves credentials from the underlying AWS SDK v2
+// provider.
+func (p *credentialsProviderV1) RetrieveWithContext(ctx credentialsv1.Context) (credentialsv1.Value, error) {
+	c, err := p.provider.Retrieve(ctx)
+	if err != nil {
+		return credentialsv1.Value{}, errors.Wrap(err, "failed to retrieve credentials")
+	}
+	p.canExpire = c.CanExpire
+	if c.CanExpire {
+		p.SetExpiration(c.Expires, 0)
+	}
+	return credentialsv1.Value{
+		AccessKeyID:     c.AccessKeyID,
+		SecretAccessKey: c.SecretAccessKey,
+		SessionToken:    c.SessionToken,
+		ProviderName:    c.Source,
+	}, nil
+}
+
+// IsExpired returns whether the last retrieved credentials are expired.
+func (p *credentialsProviderV1) IsExpired() bool {
+	return p.canExpire && p.Expiry.IsExpired()
+}
+
+// credentialsV1 returns AWS SDK v1 credentials backed by the supplied AWS SDK
+// v2 credentials provider. The credentials are retrieved once so that any
+// error surfaces right away.
+func credentialsV1(ctx context.Context, provider aws.CredentialsProvider) (*credentialsv1.Credentials, error) {
+	creds := credentialsv1.NewCredentials(&credentialsProviderV1{provider: provider})
+	if _, err := creds.GetWithContext(ctx); err != nil {
+		return nil, err
+	}
+	return creds, nil
+}
@@ -0,0 +1,152 @@
+/*
+Copyright 2023 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package aws
+
+import (
+	"context"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+
+	"github.com/crossplane-contrib/provider-aws/apis/v1beta1"
+)
+
+func TestProviderConfigRevision(t *testing.T) {
+	errBoom := errors.New("boom")
+	secretPC := func(generation int64) *v1beta1.ProviderConfig {
+		return &v1beta1.ProviderConfig{
+			ObjectMeta: metav1.ObjectMeta{Generation: generation},
+			Spec: v1beta1.ProviderConfigSpec{
+				Credentials: v1beta1.ProviderCredentials{
+					Source: xpv1.CredentialsSourceSecret,
+					CommonCredentialSelectors: xpv1.CommonCredentialSelectors{
+						SecretRef: &xpv1.SecretKeySelector{
+							SecretReference: xpv1.SecretReference{Namespace: "crossplane-system", Name: "aws-creds"},
+							Key:             "creds",
+						},
+					},
+				},
+			},
+		}
+	}
+	type want struct {
+		rev string
+		err error
+	}
+	cases := map[string]struct {
+		kube client.Client
+		pc   *v1beta1.ProviderConfig
+		want want
+	}{
+		"InjectedIdentity": {
+			pc: &v1beta1.ProviderConfig{
+				ObjectMeta: metav1.ObjectMeta{Generation: 3},
+				Spec: v1beta1.ProviderConfigSpec{
+					Credentials: v1beta1.ProviderCredentials{Source: xpv1.CredentialsSourceInjectedIdentity},
+				},
+			},
+			want: want{rev: "3"},
+		},
+		"Secret": {
+			kube: &test.MockClient{
+				MockGet: test.NewMockGetFn(nil, func(obj client.Object) error {
+					obj.(*corev1.Secret).SetResourceVersion("42")
+					return nil
+				}),
+			},
+			pc:   secretPC(2),
+			want: want{rev: "2/42"},
+		},
+		"SecretGetError": {
+			kube: &test.MockClient{
+				MockGet: test.NewMockGetFn(errBoom),
+			},
+			pc:   secretPC(2),
+			want: want{err: errors.Wrap(errBoom, "cannot get credentials secret")},
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			rev, err := providerConfigRevision(context.Background(), tc.kube, tc.pc)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.rev, rev); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestConfigCache(t *testing.T) {
+	c := &configCache{entries: map[configCacheKey]configCacheEntry{}}
+	key := configCacheKey{uid: "some-uid", region: "us-east-1"}
+	cfg := &aws.Config{Region: "us-east-1"}
+
+	if _, ok := c.get(key, "1"); ok {
+		t.Errorf("get(...): expected a miss on an empty cache")
+	}
+	c.update(key, "1", func(e *configCacheEntry) { e.cfg = cfg })
+	e, ok := c.get(key, "1")
+	if !ok {
+		t.Fatalf("get(...): expected a hit for the cached revision")
+	}
+	if e.cfg != cfg {
+		t.Errorf("get(...): expected the cached config to be returned")
+	}
+	if _, ok := c.get(key, "2"); ok {
+		t.Errorf("get(...): expected a miss for a newer revision")
+	}
+	other := configCacheKey{uid: "some-uid", region: "eu-west-1"}
+	c.update(other, "1", func(e *configCacheEntry) { e.cfg = cfg })
+	c.update(key, "2", func(e *configCacheEntry) {})
+	if e, _ := c.get(key, "2"); e.cfg != nil {
+		t.Errorf("update(...): expected the entry of the previous revision to be replaced")
+	}
+	if _, ok := c.entries[other]; ok {
+		t.Errorf("update(...): expected the entries of the previous revision to be evicted for all regions")
+	}
+}
+
+func TestConfigCacheEvict(t *testing.T) {
+	c := &configCache{entries: map[configCacheKey]configCacheEntry{}}
+	cfg := &aws.Config{}
+	deleted := []configCacheKey{{uid: "deleted-uid", region: "us-east-1"}, {uid: "deleted-uid", region: "eu-west-1"}}
+	kept := configCacheKey{uid: "other-uid", region: "us-east-1"}
+	for _, k := range append(deleted, kept) {
+		c.update(k, "1", func(e *configCacheEntry) { e.cfg = cfg })
+	}
+
+	c.evict("deleted-uid")
+
+	for _, k := range deleted {
+		if _, ok := c.get(k, "1"); ok {
+			t.Errorf("evict(...): expected the entry for region %s to be evicted", k.region)
+		}
+	}
+	if _, ok := c.get(kept, "1"); !ok {
+		t.Errorf("evict(...): expected the entries of other ProviderConfigs to be kept")
+	}
+}
@@ -0,0 +1,236 @@
+/*
+Copyright 2023 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package aws
+
+import (
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"net/http"
+	"net/url"
+	"strings"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/aws/retry"
+	awshttp "github.com/aws/aws-sdk-go-v2/aws/transport/http"
+	"github.com/aws/aws-sdk-go-v2/config"
+	"github.com/aws/aws-sdk-go-v2/service/sts"
+	awsv1 "github.com/aws/aws-sdk-go/aws"
+	clientv1 "github.com/aws/aws-sdk-go/aws/client"
+	endpointsv1 "github.com/aws/aws-sdk-go/aws/endpoints"
+	requestv1 "github.com/aws/aws-sdk-go/aws/request"
+	"github.com/pkg/errors"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+
+	"github.com/crossplane-contrib/provider-aws/apis/v1beta1"
+)
+
+// STS endpoint modes.
+const (
+	STSRegionalEndpointsRegional = "regional"
+	STSRegionalEndpointsLegacy   = "legacy"
+)
+
+// Retry modes.
+const (
+	RetryModeStandard = "standard"
+	RetryModeAdaptive = "adaptive"
+)
+
+const (
+	errGetCABundle         = "cannot get CA bundle"
+	errParseCABundle       = "cannot parse any PEM encoded certificate from CA bundle"
+	errParseProxyURL       = "cannot parse proxy URL"
+	errGetProxyCredentials = "cannot get proxy credentials"
+)
+
+// legacyGlobalSTSRegions are the regions whose STS calls are sent to the
+// global STS endpoint in legacy mode.
+var legacyGlobalSTSRegions = map[string]bool{
+	"ap-northeast-1": true,
+	"ap-south-1":     true,
+	"ap-southeast-1": true,
+	"ap-southeast-2": true,
+	"ca-central-1":   true,
+	"eu-central-1":   true,
+	"eu-north-1":     true,
+	"eu-west-1":      true,
+	"eu-west-2":      true,
+	"eu-west-3":      true,
+	"sa-east-1":      true,
+	"us-east-1":      true,
+	"us-east-2":      true,
+	"us-west-1":      true,
+	"us-west-2":      true,
+}
+
+// transportOptions returns a function that configures the HTTP transport of
+// the AWS clients to trust the CA bundle and to use the proxy of the supplied
+// ProviderConfig. It returns nil if neither of them is set.
+func transportOptions(ctx context.Context, c client.Client, pc *v1beta1.ProviderConfig) (func(*http.Transport), error) {
+	if pc.Spec.CABundleSecretRef == nil && pc.Spec.Proxy == nil {
+		return nil, nil
+	}
+	var pool *x509.CertPool
+	if ref := pc.Spec.CABundleSecretRef; ref != nil {
+		bundle, err := secretKeyValue(ctx, c, *ref)
+		if err != nil {
+			return nil, errors.Wrap(err, errGetCABundle)
+		}
+		pool, err = x509.SystemCertPool()
+		if err != nil {
+			pool = x509.NewCertPool()
+		}
+		if !pool.AppendCertsFromPEM(bundle) {
+			return nil, errors.New(errParseCABundle)
+		}
+	}
+	var proxy *url.URL
+	if p := pc.Spec.Proxy; p != nil {
+		u, err := url.Parse(p.URL)
+		if err != nil {
+			return nil, errors.Wrap(err, errParseProxyURL)
+		}
+		if ref := p.CredentialsSecretRef; ref != nil {
+			creds, err := secretKeyValue(ctx, c, *ref)
+			if err != nil {
+				return nil, errors.Wrap(err, errGetProxyCredentials)
+			}
+			username, password, _ := strings.Cut(strings.TrimSpace(string(creds)), ":")
+			u.User = url.UserPassword(username, password)
+		}
+		proxy = u
+	}
+	return func(t *http.Transport) {
+		if pool != nil {
+			if t.TLSClientConfig == nil {
+				t.TLSClientConfig = &tls.Config{MinVersion: tls.VersionTLS12}
+			}
+			t.TLSClientConfig.RootCAs = pool
+		}
+		if proxy != nil {
+			t.Proxy = http.ProxyURL(proxy)
+		}
+	}, nil
+}
+
+func secretKeyValue(ctx context.Context, c client.Client, ref xpv1.SecretKeySelector) ([]byte, error) {
+	return resource.CommonCredentialExtractor(ctx, xpv1.CredentialsSourceSecret, c, xpv1.CommonCredentialSelectors{SecretRef: &ref})
+}
+
+// loadOptions returns the options to load AWS SDK v2 configs with that
+// configure the HTTP client, endpoint variants and retries as described by
+// the supplied ProviderConfig.
+func loadOptions(pc *v1beta1.ProviderConfig, transport func(*http.Transport)) []func(*config.LoadOptions) error {
+	var opts []func(*config.LoadOptions) error
+	if transport != nil {
+		opts = append(opts, config.WithHTTPClient(awshttp.NewBuildableClient().WithTransportOptions(transport)))
+	}
+	if BoolValue(pc.Spec.UseFIPSEndpoint) {
+		opts = append(opts, config.WithUseFIPSEndpoint(aws.FIPSEndpointStateEnabled))
+	}
+	if BoolValue(pc.Spec.UseDualStackEndpoint) {
+		opts = append(opts, config.WithUseDualStackEndpoint(aws.DualStackEndpointStateEnabled))
+	}
+	if pc.Spec.Retry != nil {
+		opts = append(opts, config.WithRetryer(newRetryer(pc.Spec.Retry)))
+	}
+	return opts
+}
+
+// withLoadOptions returns the supplied options followed by the options of a
+// ProviderConfig.
+func withLoadOptions(pcOpts []func(*config.LoadOptions) error, opts ...func(*config.LoadOptions) error) []func(*config.LoadOptions) error {
+	return append(append(make([]func(*config.LoadOptions) error, 0, len(opts)+len(pcOpts)), opts...), pcOpts...)
+}
+
+func newRetryer(rc *v1beta1.RetryConfig) func() aws.Retryer {
+	standard := func(o *retry.StandardOptions) {
+		if rc.MaxAttempts != nil {
+			o.MaxAttempts = *rc.MaxAttempts
+		}
+		if rc.MaxBackoff != nil {
+			o.MaxBackoff = rc.MaxBackoff.Duration
+		}
+	}
+	return func() aws.Retryer {
+		if StringValue(rc.Mode) == RetryModeAdaptive {
+			return retry.NewAdaptiveMode(func(o *retry.AdaptiveModeOptions) {
+				o.StandardOptions = append(o.StandardOptions, standard)
+			})
+		}
+		return retry.NewStandard(standard)
+	}
+}
+
+// stsOptions returns the options of the STS clients that are used to assume
+// the roles of the supplied ProviderConfig.
+func stsOptions(pc *v1beta1.ProviderConfig) func(*sts.Options) {
+	return func(o *sts.Options) {
+		o.Region = STSRegion(pc, o.Region)
+	}
+}
+
+// STSRegion returns the region that STS clients of the supplied
+// ProviderConfig should use to send their calls to the STS endpoint of the
+// supplied region. This is the global region if the ProviderConfig uses the
+// legacy STS endpoints and the region used to send its calls to the global
+// endpoint.
+func STSRegion(pc *v1beta1.ProviderConfig, region string) string {
+	if StringValue(pc.Spec.STSRegionalEndpoints) == STSRegionalEndpointsLegacy && legacyGlobalSTSRegions[region] {
+		return GlobalRegion
+	}
+	return region
+}
+
+// setClientOptionsV1 configures the HTTP client, endpoint variants and
+// retries of the supplied AWS SDK v1 config as described by the supplied
+// ProviderConfig.
+func setClientOptionsV1(cfg *awsv1.Config, pc *v1beta1.ProviderConfig, transport func(*http.Transport)) *awsv1.Config {
+	if transport != nil {
+		t := http.DefaultTransport.(*http.Transport).Clone()
+		transport(t)
+		cfg.HTTPClient = &http.Client{Transport: t}
+	}
+	if BoolValue(pc.Spec.UseFIPSEndpoint) {
+		cfg.UseFIPSEndpoint = endpointsv1.FIPSEndpointStateEnabled
+	}
+	if BoolValue(pc.Spec.UseDualStackEndpoint) {
+		cfg.UseDualStackEndpoint = endpointsv1.DualStackEndpointStateEnabled
+	}
+	switch StringValue(pc.Spec.STSRegionalEndpoints) {
+	case STSRegionalEndpointsRegional:
+		cfg.STSRegionalEndpoint = endpointsv1.RegionalSTSEndpoint
+	case STSRegionalEndpointsLegacy:
+		cfg.STSRegionalEndpoint = endpointsv1.LegacySTSEndpoint
+	}
+	if rc := pc.Spec.Retry; rc != nil {
+		r := clientv1.DefaultRetryer{NumMaxRetries: clientv1.DefaultRetryerMaxNumRetries}
+		if rc.MaxAttempts != nil {
+			r.NumMaxRetries = *rc.MaxAttempts - 1
+		}
+		if rc.MaxBackoff != nil {
+			r.MaxRetryDelay = rc.MaxBackoff.Duration
+			r.MaxThrottleDelay = rc.MaxBackoff.Duration
+		}
+		cfg = requestv1.WithRetryer(cfg, r)
+	}
+	return cfg
+}
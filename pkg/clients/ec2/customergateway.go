@@ -0,0 +1,136 @@
+/*
+Copyright 2023 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package ec2
+
+import (
+	"context"
+	"errors"
+	"strconv"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/ec2"
+	ec2types "github.com/aws/aws-sdk-go-v2/service/ec2/types"
+	"github.com/aws/smithy-go"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+
+	"github.com/crossplane-contrib/provider-aws/apis/ec2/manualv1alpha1"
+	awsclients "github.com/crossplane-contrib/provider-aws/pkg/clients"
+)
+
+const (
+	// CustomerGatewayNotFound is the code that is returned by ec2 when the
+	// given customer gateway ID is invalid
+	CustomerGatewayNotFound = "InvalidCustomerGatewayID.NotFound"
+)
+
+// CustomerGatewayClient is the external client used for CustomerGateway Custom Resource
+type CustomerGatewayClient interface {
+	CreateCustomerGateway(ctx context.Context, input *ec2.CreateCustomerGatewayInput, opts ...func(*ec2.Options)) (*ec2.CreateCustomerGatewayOutput, error)
+	DescribeCustomerGateways(ctx context.Context, input *ec2.DescribeCustomerGatewaysInput, opts ...func(*ec2.Options)) (*ec2.DescribeCustomerGatewaysOutput, error)
+	DeleteCustomerGateway(ctx context.Context, input *ec2.DeleteCustomerGatewayInput, opts ...func(*ec2.Options)) (*ec2.DeleteCustomerGatewayOutput, error)
+	CreateTags(ctx context.Context, input *ec2.CreateTagsInput, opts ...func(*ec2.Options)) (*ec2.CreateTagsOutput, error)
+	DeleteTags(ctx context.Context, input *ec2.DeleteTagsInput, opts ...func(*ec2.Options)) (*ec2.DeleteTagsOutput, error)
+}
+
+// NewCustomerGatewayClient returns a new client using AWS credentials as JSON encoded data.
+func NewCustomerGatewayClient(cfg aws.Config) CustomerGatewayClient {
+	return ec2.NewFromConfig(cfg)
+}
+
+// IsCustomerGatewayNotFoundErr returns true if the error is because the
+// customer gateway doesn't exist
+func IsCustomerGatewayNotFoundErr(err error) bool {
+	var awsErr smithy.APIError
+	return errors.As(err, &awsErr) && awsErr.ErrorCode() == CustomerGatewayNotFound
+}
+
+// VPNStateCondition returns the condition that corresponds to the given
+// state of a customer gateway, virtual private gateway or VPN connection.
+func VPNStateCondition(state string) xpv1.Condition {
+	switch ec2types.VpnState(state) {
+	case ec2types.VpnStateAvailable:
+		return xpv1.Available()
+	case ec2types.VpnStatePending:
+		return xpv1.Creating()
+	case ec2types.VpnStateDeleting, ec2types.VpnStateDeleted:
+		return xpv1.Deleting()
+	default:
+		return xpv1.Unavailable()
+	}
+}
+
+// generateVPNTagSpecifications returns the tag specifications to tag a VPN
+// resource of the given type with on creation.
+func generateVPNTagSpecifications(rt ec2types.ResourceType, tags []manualv1alpha1.Tag) []ec2types.TagSpecification {
+	if len(tags) == 0 {
+		return nil
+	}
+	return []ec2types.TagSpecification{{
+		ResourceType: rt,
+		Tags:         manualv1alpha1.GenerateEC2Tags(tags),
+	}}
+}
+
+// GenerateCreateCustomerGatewayInput returns the input to create a customer
+// gateway.
+func GenerateCreateCustomerGatewayInput(p manualv1alpha1.CustomerGatewayParameters) *ec2.CreateCustomerGatewayInput {
+	return &ec2.CreateCustomerGatewayInput{
+		Type:              ec2types.GatewayType(p.Type),
+		BgpAsn:            p.BGPASN,
+		PublicIp:          p.IPAddress,
+		CertificateArn:    p.CertificateARN,
+		DeviceName:        p.DeviceName,
+		TagSpecifications: generateVPNTagSpecifications(ec2types.ResourceTypeCustomerGateway, p.Tags),
+	}
+}
+
+// GenerateCustomerGatewayObservation is used to produce
+// manualv1alpha1.CustomerGatewayObservation from ec2types.CustomerGateway.
+func GenerateCustomerGatewayObservation(cg ec2types.CustomerGateway) manualv1alpha1.CustomerGatewayObservation {
+	return manualv1alpha1.CustomerGatewayObservation{
+		CustomerGatewayID: aws.ToString(cg.CustomerGatewayId),
+		State:             aws.ToString(cg.State),
+	}
+}
+
+// LateInitializeCustomerGateway fills the empty fields in
+// *manualv1alpha1.CustomerGatewayParameters with the values seen in
+// ec2types.CustomerGateway.
+func LateInitializeCustomerGateway(in *manualv1alpha1.CustomerGatewayParameters, cg *ec2types.CustomerGateway) {
+	if cg == nil {
+		return
+	}
+	if in.BGPASN == nil && cg.BgpAsn != nil {
+		if asn, err := strconv.ParseInt(aws.ToString(cg.BgpAsn), 10, 32); err == nil {
+			in.BGPASN = aws.Int32(int32(asn))
+		}
+	}
+	in.IPAddress = awsclients.LateInitializeStringPtr(in.IPAddress, cg.IpAddress)
+	in.CertificateARN = awsclients.LateInitializeStringPtr(in.CertificateARN, cg.CertificateArn)
+	in.DeviceName = awsclients.LateInitializeStringPtr(in.DeviceName, cg.DeviceName)
+	if len(in.Tags) == 0 && len(cg.Tags) != 0 {
+		in.Tags = manualv1alpha1.BuildFromEC2Tags(cg.Tags)
+	}
+}
+
+// IsCustomerGatewayUpToDate checks whether there is a change in any of the
+// modifiable fields.
+func IsCustomerGatewayUpToDate(p manualv1alpha1.CustomerGatewayParameters, cg ec2types.CustomerGateway) bool {
+	add, remove := awsclients.DiffEC2Tags(manualv1alpha1.GenerateEC2Tags(p.Tags), cg.Tags)
+	return len(add) == 0 && len(remove) == 0
+}
@@ -0,0 +1,62 @@
+/*
+Copyright 2023 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package fake
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go-v2/service/ec2"
+
+	clientset "github.com/crossplane-contrib/provider-aws/pkg/clients/ec2"
+)
+
+// this ensures that the mock implements the client interface
+var _ clientset.CustomerGatewayClient = (*MockCustomerGatewayClient)(nil)
+
+// MockCustomerGatewayClient is a type that implements all the methods for CustomerGatewayClient interface
+type MockCustomerGatewayClient struct {
+	MockCreate     func(ctx context.Context, input *ec2.CreateCustomerGatewayInput, opts []func(*ec2.Options)) (*ec2.CreateCustomerGatewayOutput, error)
+	MockDescribe   func(ctx context.Context, input *ec2.DescribeCustomerGatewaysInput, opts []func(*ec2.Options)) (*ec2.DescribeCustomerGatewaysOutput, error)
+	MockDelete     func(ctx context.Context, input *ec2.DeleteCustomerGatewayInput, opts []func(*ec2.Options)) (*ec2.DeleteCustomerGatewayOutput, error)
+	MockCreateTags func(ctx context.Context, input *ec2.CreateTagsInput, opts []func(*ec2.Options)) (*ec2.CreateTagsOutput, error)
+	MockDeleteTags func(ctx context.Context, input *ec2.DeleteTagsInput, opts []func(*ec2.Options)) (*ec2.DeleteTagsOutput, error)
+}
+
+// CreateCustomerGateway mocks CreateCustomerGateway method
+func (m *MockCustomerGatewayClient) CreateCustomerGateway(ctx context.Context, input *ec2.CreateCustomerGatewayInput, opts ...func(*ec2.Options)) (*ec2.CreateCustomerGatewayOutput, error) {
+	return m.MockCreate(ctx, input, opts)
+}
+
+// DescribeCustomerGateways mocks DescribeCustomerGateways method
+func (m *MockCustomerGatewayClient) DescribeCustomerGateways(ctx context.Context, input *ec2.DescribeCustomerGatewaysInput, opts ...func(*ec2.Options)) (*ec2.DescribeCustomerGatewaysOutput, error) {
+	return m.MockDescribe(ctx, input, opts)
+}
+
+// DeleteCustomerGateway mocks DeleteCustomerGateway method
+func (m *MockCustomerGatewayClient) DeleteCustomerGateway(ctx context.Context, input *ec2.DeleteCustomerGatewayInput, opts ...func(*ec2.Options)) (*ec2.DeleteCustomerGatewayOutput, error) {
+	return m.MockDelete(ctx, input, opts)
+}
+
+// CreateTags mocks CreateTags method
+func (m *MockCustomerGatewayClient) CreateTags(ctx context.Context, input *ec2.CreateTagsInput, opts ...func(*ec2.Options)) (*ec2.CreateTagsOutput, error) {
+	return m.MockCreateTags(ctx, input, opts)
+}
+
+// DeleteTags mocks DeleteTags method
+func (m *MockCustomerGatewayClient) DeleteTags(ctx context.Context, input *ec2.DeleteTagsInput, opts ...func(*ec2.Options)) (*ec2.DeleteTagsOutput, error) {
+	return m.MockDeleteTags(ctx, input, opts)
+}
@@ -29,12 +29,15 @@ var _ clientset.InstanceClient = (*MockInstanceClient)(nil)
 
 // MockInstanceClient is a type that implements all the methods for MockInstanceClient interface
 type MockInstanceClient struct {
-	MockRunInstances              func(context.Context, *ec2.RunInstancesInput, []func(*ec2.Options)) (*ec2.RunInstancesOutput, error)
-	MockTerminateInstances        func(context.Context, *ec2.TerminateInstancesInput, []func(*ec2.Options)) (*ec2.TerminateInstancesOutput, error)
-	MockDescribeInstances         func(context.Context, *ec2.DescribeInstancesInput, []func(*ec2.Options)) (*ec2.DescribeInstancesOutput, error)
-	MockDescribeInstanceAttribute func(context.Context, *ec2.DescribeInstanceAttributeInput, []func(*ec2.Options)) (*ec2.DescribeInstanceAttributeOutput, error)
-	MockModifyInstanceAttribute   func(context.Context, *ec2.ModifyInstanceAttributeInput, []func(*ec2.Options)) (*ec2.ModifyInstanceAttributeOutput, error)
-	MockCreateTags                func(context.Context, *ec2.CreateTagsInput, []func(*ec2.Options)) (*ec2.CreateTagsOutput, error)
+	MockRunInstances                  func(context.Context, *ec2.RunInstancesInput, []func(*ec2.Options)) (*ec2.RunInstancesOutput, error)
+	MockTerminateInstances            func(context.Context, *ec2.TerminateInstancesInput, []func(*ec2.Options)) (*ec2.TerminateInstancesOutput, error)
+	MockDescribeInstances             func(context.Context, *ec2.DescribeInstancesInput, []func(*ec2.Options)) (*ec2.DescribeInstancesOutput, error)
+	MockDescribeInstanceAttribute     func(context.Context, *ec2.DescribeInstanceAttributeInput, []func(*ec2.Options)) (*ec2.DescribeInstanceAttributeOutput, error)
+	MockModifyInstanceAttribute       func(context.Context, *ec2.ModifyInstanceAttributeInput, []func(*ec2.Options)) (*ec2.ModifyInstanceAttributeOutput, error)
+	MockCreateTags                    func(context.Context, *ec2.CreateTagsInput, []func(*ec2.Options)) (*ec2.CreateTagsOutput, error)
+	MockModifyInstanceMetadataOptions func(context.Context, *ec2.ModifyInstanceMetadataOptionsInput, []func(*ec2.Options)) (*ec2.ModifyInstanceMetadataOptionsOutput, error)
+	MockStopInstances                 func(context.Context, *ec2.StopInstancesInput, []func(*ec2.Options)) (*ec2.StopInstancesOutput, error)
+	MockStartInstances                func(context.Context, *ec2.StartInstancesInput, []func(*ec2.Options)) (*ec2.StartInstancesOutput, error)
 }
 
 // RunInstances mocks RunInstances method
@@ -66,3 +69,18 @@ func (m *MockInstanceClient) ModifyInstanceAttribute(ctx context.Context, input
 func (m *MockInstanceClient) CreateTags(ctx context.Context, input *ec2.CreateTagsInput, opts ...func(*ec2.Options)) (*ec2.CreateTagsOutput, error) {
 	return m.MockCreateTags(ctx, input, opts)
 }
+
+// ModifyInstanceMetadataOptions mocks ModifyInstanceMetadataOptions method
+func (m *MockInstanceClient) ModifyInstanceMetadataOptions(ctx context.Context, input *ec2.ModifyInstanceMetadataOptionsInput, opts ...func(*ec2.Options)) (*ec2.ModifyInstanceMetadataOptionsOutput, error) {
+	return m.MockModifyInstanceMetadataOptions(ctx, input, opts)
+}
+
+// StopInstances mocks StopInstances method
+func (m *MockInstanceClient) StopInstances(ctx context.Context, input *ec2.StopInstancesInput, opts ...func(*ec2.Options)) (*ec2.StopInstancesOutput, error) {
+	return m.MockStopInstances(ctx, input, opts)
+}
+
+// StartInstances mocks StartInstances method
+func (m *MockInstanceClient) StartInstances(ctx context.Context, input *ec2.StartInstancesInput, opts ...func(*ec2.Options)) (*ec2.StartInstancesOutput, error) {
+	return m.MockStartInstances(ctx, input, opts)
+}
@@ -0,0 +1,86 @@
+/*
+Copyright 2023 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package fake
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go-v2/service/ec2"
+
+	clientset "github.com/crossplane-contrib/provider-aws/pkg/clients/ec2"
+)
+
+// this ensures that the mock implements the client interface
+var _ clientset.NetworkACLClient = (*MockNetworkACLClient)(nil)
+
+// MockNetworkACLClient is a type that implements all the methods for NetworkACLClient interface
+type MockNetworkACLClient struct {
+	MockCreate             func(ctx context.Context, input *ec2.CreateNetworkAclInput, opts []func(*ec2.Options)) (*ec2.CreateNetworkAclOutput, error)
+	MockDelete             func(ctx context.Context, input *ec2.DeleteNetworkAclInput, opts []func(*ec2.Options)) (*ec2.DeleteNetworkAclOutput, error)
+	MockDescribe           func(ctx context.Context, input *ec2.DescribeNetworkAclsInput, opts []func(*ec2.Options)) (*ec2.DescribeNetworkAclsOutput, error)
+	MockCreateEntry        func(ctx context.Context, input *ec2.CreateNetworkAclEntryInput, opts []func(*ec2.Options)) (*ec2.CreateNetworkAclEntryOutput, error)
+	MockReplaceEntry       func(ctx context.Context, input *ec2.ReplaceNetworkAclEntryInput, opts []func(*ec2.Options)) (*ec2.ReplaceNetworkAclEntryOutput, error)
+	MockDeleteEntry        func(ctx context.Context, input *ec2.DeleteNetworkAclEntryInput, opts []func(*ec2.Options)) (*ec2.DeleteNetworkAclEntryOutput, error)
+	MockReplaceAssociation func(ctx context.Context, input *ec2.ReplaceNetworkAclAssociationInput, opts []func(*ec2.Options)) (*ec2.ReplaceNetworkAclAssociationOutput, error)
+	MockCreateTags         func(ctx context.Context, input *ec2.CreateTagsInput, opts []func(*ec2.Options)) (*ec2.CreateTagsOutput, error)
+	MockDeleteTags         func(ctx context.Context, input *ec2.DeleteTagsInput, opts []func(*ec2.Options)) (*ec2.DeleteTagsOutput, error)
+}
+
+// CreateNetworkAcl mocks CreateNetworkAcl method
+func (m *MockNetworkACLClient) CreateNetworkAcl(ctx context.Context, input *ec2.CreateNetworkAclInput, opts ...func(*ec2.Options)) (*ec2.CreateNetworkAclOutput, error) {
+	return m.MockCreate(ctx, input, opts)
+}
+
+// DeleteNetworkAcl mocks DeleteNetworkAcl method
+func (m *MockNetworkACLClient) DeleteNetworkAcl(ctx context.Context, input *ec2.DeleteNetworkAclInput, opts ...func(*ec2.Options)) (*ec2.DeleteNetworkAclOutput, error) {
+	return m.MockDelete(ctx, input, opts)
+}
+
+// DescribeNetworkAcls mocks DescribeNetworkAcls method
+func (m *MockNetworkACLClient) DescribeNetworkAcls(ctx context.Context, input *ec2.DescribeNetworkAclsInput, opts ...func(*ec2.Options)) (*ec2.DescribeNetworkAclsOutput, error) {
+	return m.MockDescribe(ctx, input, opts)
+}
+
+// CreateNetworkAclEntry mocks CreateNetworkAclEntry method
+func (m *MockNetworkACLClient) CreateNetworkAclEntry(ctx context.Context, input *ec2.CreateNetworkAclEntryInput, opts ...func(*ec2.Options)) (*ec2.CreateNetworkAclEntryOutput, error) {
+	return m.MockCreateEntry(ctx, input, opts)
+}
+
+// ReplaceNetworkAclEntry mocks ReplaceNetworkAclEntry method
+func (m *MockNetworkACLClient) ReplaceNetworkAclEntry(ctx context.Context, input *ec2.ReplaceNetworkAclEntryInput, opts ...func(*ec2.Options)) (*ec2.ReplaceNetworkAclEntryOutput, error) {
+	return m.MockReplaceEntry(ctx, input, opts)
+}
+
+// DeleteNetworkAclEntry mocks DeleteNetworkAclEntry method
+func (m *MockNetworkACLClient) DeleteNetworkAclEntry(ctx context.Context, input *ec2.DeleteNetworkAclEntryInput, opts ...func(*ec2.Options)) (*ec2.DeleteNetworkAclEntryOutput, error) {
+	return m.MockDeleteEntry(ctx, input, opts)
+}
+
+// ReplaceNetworkAclAssociation mocks ReplaceNetworkAclAssociation method
+func (m *MockNetworkACLClient) ReplaceNetworkAclAssociation(ctx context.Context, input *ec2.ReplaceNetworkAclAssociationInput, opts ...func(*ec2.Options)) (*ec2.ReplaceNetworkAclAssociationOutput, error) {
+	return m.MockReplaceAssociation(ctx, input, opts)
+}
+
+// CreateTags mocks CreateTags method
+func (m *MockNetworkACLClient) CreateTags(ctx context.Context, input *ec2.CreateTagsInput, opts ...func(*ec2.Options)) (*ec2.CreateTagsOutput, error) {
+	return m.MockCreateTags(ctx, input, opts)
+}
+
+// DeleteTags mocks DeleteTags method
+func (m *MockNetworkACLClient) DeleteTags(ctx context.Context, input *ec2.DeleteTagsInput, opts ...func(*ec2.Options)) (*ec2.DeleteTagsOutput, error) {
+	return m.MockDeleteTags(ctx, input, opts)
+}
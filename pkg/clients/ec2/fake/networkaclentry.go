@@ -0,0 +1,56 @@
+/*
+Copyright 2023 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package fake
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go-v2/service/ec2"
+
+	clientset "github.com/crossplane-contrib/provider-aws/pkg/clients/ec2"
+)
+
+// this ensures that the mock implements the client interface
+var _ clientset.NetworkACLEntryClient = (*MockNetworkACLEntryClient)(nil)
+
+// MockNetworkACLEntryClient is a type that implements all the methods for NetworkACLEntryClient interface
+type MockNetworkACLEntryClient struct {
+	MockDescribe func(ctx context.Context, input *ec2.DescribeNetworkAclsInput, opts []func(*ec2.Options)) (*ec2.DescribeNetworkAclsOutput, error)
+	MockCreate   func(ctx context.Context, input *ec2.CreateNetworkAclEntryInput, opts []func(*ec2.Options)) (*ec2.CreateNetworkAclEntryOutput, error)
+	MockReplace  func(ctx context.Context, input *ec2.ReplaceNetworkAclEntryInput, opts []func(*ec2.Options)) (*ec2.ReplaceNetworkAclEntryOutput, error)
+	MockDelete   func(ctx context.Context, input *ec2.DeleteNetworkAclEntryInput, opts []func(*ec2.Options)) (*ec2.DeleteNetworkAclEntryOutput, error)
+}
+
+// DescribeNetworkAcls mocks DescribeNetworkAcls method
+func (m *MockNetworkACLEntryClient) DescribeNetworkAcls(ctx context.Context, input *ec2.DescribeNetworkAclsInput, opts ...func(*ec2.Options)) (*ec2.DescribeNetworkAclsOutput, error) {
+	return m.MockDescribe(ctx, input, opts)
+}
+
+// CreateNetworkAclEntry mocks CreateNetworkAclEntry method
+func (m *MockNetworkACLEntryClient) CreateNetworkAclEntry(ctx context.Context, input *ec2.CreateNetworkAclEntryInput, opts ...func(*ec2.Options)) (*ec2.CreateNetworkAclEntryOutput, error) {
+	return m.MockCreate(ctx, input, opts)
+}
+
+// ReplaceNetworkAclEntry mocks ReplaceNetworkAclEntry method
+func (m *MockNetworkACLEntryClient) ReplaceNetworkAclEntry(ctx context.Context, input *ec2.ReplaceNetworkAclEntryInput, opts ...func(*ec2.Options)) (*ec2.ReplaceNetworkAclEntryOutput, error) {
+	return m.MockReplace(ctx, input, opts)
+}
+
+// DeleteNetworkAclEntry mocks DeleteNetworkAclEntry method
+func (m *MockNetworkACLEntryClient) DeleteNetworkAclEntry(ctx context.Context, input *ec2.DeleteNetworkAclEntryInput, opts ...func(*ec2.Options)) (*ec2.DeleteNetworkAclEntryOutput, error) {
+	return m.MockDelete(ctx, input, opts)
+}
@@ -0,0 +1,69 @@
+/*
+Copyright 2023 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package fake
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go-v2/service/ec2"
+
+	clientset "github.com/crossplane-contrib/provider-aws/pkg/clients/ec2"
+)
+
+// this ensures that the mock implements the client interface
+var _ clientset.TransitGatewayPeeringAttachmentClient = (*MockTransitGatewayPeeringAttachmentClient)(nil)
+
+// MockTransitGatewayPeeringAttachmentClient is a type that implements all the
+// methods for TransitGatewayPeeringAttachmentClient interface
+type MockTransitGatewayPeeringAttachmentClient struct {
+	MockCreate     func(ctx context.Context, input *ec2.CreateTransitGatewayPeeringAttachmentInput, opts []func(*ec2.Options)) (*ec2.CreateTransitGatewayPeeringAttachmentOutput, error)
+	MockAccept     func(ctx context.Context, input *ec2.AcceptTransitGatewayPeeringAttachmentInput, opts []func(*ec2.Options)) (*ec2.AcceptTransitGatewayPeeringAttachmentOutput, error)
+	MockDescribe   func(ctx context.Context, input *ec2.DescribeTransitGatewayPeeringAttachmentsInput, opts []func(*ec2.Options)) (*ec2.DescribeTransitGatewayPeeringAttachmentsOutput, error)
+	MockDelete     func(ctx context.Context, input *ec2.DeleteTransitGatewayPeeringAttachmentInput, opts []func(*ec2.Options)) (*ec2.DeleteTransitGatewayPeeringAttachmentOutput, error)
+	MockCreateTags func(ctx context.Context, input *ec2.CreateTagsInput, opts []func(*ec2.Options)) (*ec2.CreateTagsOutput, error)
+	MockDeleteTags func(ctx context.Context, input *ec2.DeleteTagsInput, opts []func(*ec2.Options)) (*ec2.DeleteTagsOutput, error)
+}
+
+// CreateTransitGatewayPeeringAttachment mocks CreateTransitGatewayPeeringAttachment method
+func (m *MockTransitGatewayPeeringAttachmentClient) CreateTransitGatewayPeeringAttachment(ctx context.Context, input *ec2.CreateTransitGatewayPeeringAttachmentInput, opts ...func(*ec2.Options)) (*ec2.CreateTransitGatewayPeeringAttachmentOutput, error) {
+	return m.MockCreate(ctx, input, opts)
+}
+
+// AcceptTransitGatewayPeeringAttachment mocks AcceptTransitGatewayPeeringAttachment method
+func (m *MockTransitGatewayPeeringAttachmentClient) AcceptTransitGatewayPeeringAttachment(ctx context.Context, input *ec2.AcceptTransitGatewayPeeringAttachmentInput, opts ...func(*ec2.Options)) (*ec2.AcceptTransitGatewayPeeringAttachmentOutput, error) {
+	return m.MockAccept(ctx, input, opts)
+}
+
+// DescribeTransitGatewayPeeringAttachments mocks DescribeTransitGatewayPeeringAttachments method
+func (m *MockTransitGatewayPeeringAttachmentClient) DescribeTransitGatewayPeeringAttachments(ctx context.Context, input *ec2.DescribeTransitGatewayPeeringAttachmentsInput, opts ...func(*ec2.Options)) (*ec2.DescribeTransitGatewayPeeringAttachmentsOutput, error) {
+	return m.MockDescribe(ctx, input, opts)
+}
+
+// DeleteTransitGatewayPeeringAttachment mocks DeleteTransitGatewayPeeringAttachment method
+func (m *MockTransitGatewayPeeringAttachmentClient) DeleteTransitGatewayPeeringAttachment(ctx context.Context, input *ec2.DeleteTransitGatewayPeeringAttachmentInput, opts ...func(*ec2.Options)) (*ec2.DeleteTransitGatewayPeeringAttachmentOutput, error) {
+	return m.MockDelete(ctx, input, opts)
+}
+
+// CreateTags mocks CreateTags method
+func (m *MockTransitGatewayPeeringAttachmentClient) CreateTags(ctx context.Context, input *ec2.CreateTagsInput, opts ...func(*ec2.Options)) (*ec2.CreateTagsOutput, error) {
+	return m.MockCreateTags(ctx, input, opts)
+}
+
+// DeleteTags mocks DeleteTags method
+func (m *MockTransitGatewayPeeringAttachmentClient) DeleteTags(ctx context.Context, input *ec2.DeleteTagsInput, opts ...func(*ec2.Options)) (*ec2.DeleteTagsOutput, error) {
+	return m.MockDeleteTags(ctx, input, opts)
+}
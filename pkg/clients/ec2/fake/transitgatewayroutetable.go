@@ -0,0 +1,109 @@
+/*
+Copyright 2023 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package fake
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go-v2/service/ec2"
+
+	clientset "github.com/crossplane-contrib/provider-aws/pkg/clients/ec2"
+)
+
+// this ensures that the mock implements the client interface
+var _ clientset.TransitGatewayRouteTableAssociationClient = (*MockTransitGatewayRouteTableAssociationClient)(nil)
+
+// MockTransitGatewayRouteTableAssociationClient is a type that implements all
+// the methods for TransitGatewayRouteTableAssociationClient interface
+type MockTransitGatewayRouteTableAssociationClient struct {
+	MockAssociate       func(ctx context.Context, input *ec2.AssociateTransitGatewayRouteTableInput, opts []func(*ec2.Options)) (*ec2.AssociateTransitGatewayRouteTableOutput, error)
+	MockDisassociate    func(ctx context.Context, input *ec2.DisassociateTransitGatewayRouteTableInput, opts []func(*ec2.Options)) (*ec2.DisassociateTransitGatewayRouteTableOutput, error)
+	MockGetAssociations func(ctx context.Context, input *ec2.GetTransitGatewayRouteTableAssociationsInput, opts []func(*ec2.Options)) (*ec2.GetTransitGatewayRouteTableAssociationsOutput, error)
+}
+
+// AssociateTransitGatewayRouteTable mocks AssociateTransitGatewayRouteTable method
+func (m *MockTransitGatewayRouteTableAssociationClient) AssociateTransitGatewayRouteTable(ctx context.Context, input *ec2.AssociateTransitGatewayRouteTableInput, opts ...func(*ec2.Options)) (*ec2.AssociateTransitGatewayRouteTableOutput, error) {
+	return m.MockAssociate(ctx, input, opts)
+}
+
+// DisassociateTransitGatewayRouteTable mocks DisassociateTransitGatewayRouteTable method
+func (m *MockTransitGatewayRouteTableAssociationClient) DisassociateTransitGatewayRouteTable(ctx context.Context, input *ec2.DisassociateTransitGatewayRouteTableInput, opts ...func(*ec2.Options)) (*ec2.DisassociateTransitGatewayRouteTableOutput, error) {
+	return m.MockDisassociate(ctx, input, opts)
+}
+
+// GetTransitGatewayRouteTableAssociations mocks GetTransitGatewayRouteTableAssociations method
+func (m *MockTransitGatewayRouteTableAssociationClient) GetTransitGatewayRouteTableAssociations(ctx context.Context, input *ec2.GetTransitGatewayRouteTableAssociationsInput, opts ...func(*ec2.Options)) (*ec2.GetTransitGatewayRouteTableAssociationsOutput, error) {
+	return m.MockGetAssociations(ctx, input, opts)
+}
+
+// this ensures that the mock implements the client interface
+var _ clientset.TransitGatewayRouteTablePropagationClient = (*MockTransitGatewayRouteTablePropagationClient)(nil)
+
+// MockTransitGatewayRouteTablePropagationClient is a type that implements all
+// the methods for TransitGatewayRouteTablePropagationClient interface
+type MockTransitGatewayRouteTablePropagationClient struct {
+	MockEnable          func(ctx context.Context, input *ec2.EnableTransitGatewayRouteTablePropagationInput, opts []func(*ec2.Options)) (*ec2.EnableTransitGatewayRouteTablePropagationOutput, error)
+	MockDisable         func(ctx context.Context, input *ec2.DisableTransitGatewayRouteTablePropagationInput, opts []func(*ec2.Options)) (*ec2.DisableTransitGatewayRouteTablePropagationOutput, error)
+	MockGetPropagations func(ctx context.Context, input *ec2.GetTransitGatewayRouteTablePropagationsInput, opts []func(*ec2.Options)) (*ec2.GetTransitGatewayRouteTablePropagationsOutput, error)
+}
+
+// EnableTransitGatewayRouteTablePropagation mocks EnableTransitGatewayRouteTablePropagation method
+func (m *MockTransitGatewayRouteTablePropagationClient) EnableTransitGatewayRouteTablePropagation(ctx context.Context, input *ec2.EnableTransitGatewayRouteTablePropagationInput, opts ...func(*ec2.Options)) (*ec2.EnableTransitGatewayRouteTablePropagationOutput, error) {
+	return m.MockEnable(ctx, input, opts)
+}
+
+// DisableTransitGatewayRouteTablePropagation mocks DisableTransitGatewayRouteTablePropagation method
+func (m *MockTransitGatewayRouteTablePropagationClient) DisableTransitGatewayRouteTablePropagation(ctx context.Context, input *ec2.DisableTransitGatewayRouteTablePropagationInput, opts ...func(*ec2.Options)) (*ec2.DisableTransitGatewayRouteTablePropagationOutput, error) {
+	return m.MockDisable(ctx, input, opts)
+}
+
+// GetTransitGatewayRouteTablePropagations mocks GetTransitGatewayRouteTablePropagations method
+func (m *MockTransitGatewayRouteTablePropagationClient) GetTransitGatewayRouteTablePropagations(ctx context.Context, input *ec2.GetTransitGatewayRouteTablePropagationsInput, opts ...func(*ec2.Options)) (*ec2.GetTransitGatewayRouteTablePropagationsOutput, error) {
+	return m.MockGetPropagations(ctx, input, opts)
+}
+
+// this ensures that the mock implements the client interface
+var _ clientset.TransitGatewayRouteTableLinkClient = (*MockTransitGatewayRouteTableLinkClient)(nil)
+
+// MockTransitGatewayRouteTableLinkClient is a type that implements all the
+// methods for TransitGatewayRouteTableLinkClient interface
+type MockTransitGatewayRouteTableLinkClient struct {
+	MockGetLink    func(ctx context.Context, routeTableID, attachmentID *string) (*clientset.TransitGatewayRouteTableLink, error)
+	MockCreateLink func(ctx context.Context, routeTableID, attachmentID *string) error
+	MockDeleteLink func(ctx context.Context, routeTableID, attachmentID *string) error
+	MockStates     func() clientset.TransitGatewayRouteTableLinkStates
+}
+
+// GetLink mocks GetLink method
+func (m *MockTransitGatewayRouteTableLinkClient) GetLink(ctx context.Context, routeTableID, attachmentID *string) (*clientset.TransitGatewayRouteTableLink, error) {
+	return m.MockGetLink(ctx, routeTableID, attachmentID)
+}
+
+// CreateLink mocks CreateLink method
+func (m *MockTransitGatewayRouteTableLinkClient) CreateLink(ctx context.Context, routeTableID, attachmentID *string) error {
+	return m.MockCreateLink(ctx, routeTableID, attachmentID)
+}
+
+// DeleteLink mocks DeleteLink method
+func (m *MockTransitGatewayRouteTableLinkClient) DeleteLink(ctx context.Context, routeTableID, attachmentID *string) error {
+	return m.MockDeleteLink(ctx, routeTableID, attachmentID)
+}
+
+// States mocks States method
+func (m *MockTransitGatewayRouteTableLinkClient) States() clientset.TransitGatewayRouteTableLinkStates {
+	return m.MockStates()
+}
@@ -42,6 +42,9 @@ type InstanceClient interface {
 	DescribeInstances(context.Context, *ec2.DescribeInstancesInput, ...func(*ec2.Options)) (*ec2.DescribeInstancesOutput, error)
 	DescribeInstanceAttribute(context.Context, *ec2.DescribeInstanceAttributeInput, ...func(*ec2.Options)) (*ec2.DescribeInstanceAttributeOutput, error)
 	ModifyInstanceAttribute(context.Context, *ec2.ModifyInstanceAttributeInput, ...func(*ec2.Options)) (*ec2.ModifyInstanceAttributeOutput, error)
+	ModifyInstanceMetadataOptions(context.Context, *ec2.ModifyInstanceMetadataOptionsInput, ...func(*ec2.Options)) (*ec2.ModifyInstanceMetadataOptionsOutput, error)
+	StopInstances(context.Context, *ec2.StopInstancesInput, ...func(*ec2.Options)) (*ec2.StopInstancesOutput, error)
+	StartInstances(context.Context, *ec2.StartInstancesInput, ...func(*ec2.Options)) (*ec2.StartInstancesOutput, error)
 	CreateTags(context.Context, *ec2.CreateTagsInput, ...func(*ec2.Options)) (*ec2.CreateTagsOutput, error)
 }
 
@@ -79,9 +82,100 @@ func IsInstanceUpToDate(spec manualv1alpha1.InstanceParameters, instance types.I
 	if awsclients.StringValue(spec.UserData) != attributeValue(attributes.UserData) {
 		return false
 	}
+	// MetadataOptions
+	if !IsInstanceMetadataOptionsUpToDate(spec.MetadataOptions, GenerateInstanceMetadataOptionsRequest(instance.MetadataOptions)) {
+		return false
+	}
+	// InstanceType, EBSOptimized, Kernel, Ramdisk and UserData
+	if IsInstanceStopRequired(spec, attributes) {
+		return false
+	}
 	return manualv1alpha1.CompareGroupIDs(spec.SecurityGroupIDs, instance.SecurityGroups)
 }
 
+// IsInstanceStopRequired returns true if any attribute that can only be
+// changed while the instance is stopped differs from the desired one.
+func IsInstanceStopRequired(spec manualv1alpha1.InstanceParameters, attributes ec2.DescribeInstanceAttributeOutput) bool {
+	return len(GenerateStoppedInstanceAttributeInputs("", spec, attributes)) > 0
+}
+
+// GenerateInstanceAttributeInputs returns a ModifyInstanceAttributeInput for
+// each attribute that can be changed while the instance is running and whose
+// desired value differs from the observed one.
+func GenerateInstanceAttributeInputs(id string, spec manualv1alpha1.InstanceParameters, attributes ec2.DescribeInstanceAttributeOutput) []*ec2.ModifyInstanceAttributeInput {
+	var inputs []*ec2.ModifyInstanceAttributeInput
+	if spec.DisableAPITermination != nil && awsclients.BoolValue(spec.DisableAPITermination) != attributeBoolValue(attributes.DisableApiTermination) {
+		inputs = append(inputs, &ec2.ModifyInstanceAttributeInput{
+			InstanceId:            aws.String(id),
+			DisableApiTermination: &types.AttributeBooleanValue{Value: spec.DisableAPITermination},
+		})
+	}
+	if spec.InstanceInitiatedShutdownBehavior != "" && spec.InstanceInitiatedShutdownBehavior != attributeValue(attributes.InstanceInitiatedShutdownBehavior) {
+		inputs = append(inputs, &ec2.ModifyInstanceAttributeInput{
+			InstanceId:                        aws.String(id),
+			InstanceInitiatedShutdownBehavior: &types.AttributeValue{Value: aws.String(spec.InstanceInitiatedShutdownBehavior)},
+		})
+	}
+	return inputs
+}
+
+// GenerateStoppedInstanceAttributeInputs returns a
+// ModifyInstanceAttributeInput for each attribute that can only be changed
+// while the instance is stopped and whose desired value differs from the
+// observed one.
+func GenerateStoppedInstanceAttributeInputs(id string, spec manualv1alpha1.InstanceParameters, attributes ec2.DescribeInstanceAttributeOutput) []*ec2.ModifyInstanceAttributeInput {
+	var inputs []*ec2.ModifyInstanceAttributeInput
+	if spec.InstanceType != "" && spec.InstanceType != attributeValue(attributes.InstanceType) {
+		inputs = append(inputs, &ec2.ModifyInstanceAttributeInput{
+			InstanceId:   aws.String(id),
+			InstanceType: &types.AttributeValue{Value: aws.String(spec.InstanceType)},
+		})
+	}
+	if spec.EBSOptimized != nil && awsclients.BoolValue(spec.EBSOptimized) != attributeBoolValue(attributes.EbsOptimized) {
+		inputs = append(inputs, &ec2.ModifyInstanceAttributeInput{
+			InstanceId:   aws.String(id),
+			EbsOptimized: &types.AttributeBooleanValue{Value: spec.EBSOptimized},
+		})
+	}
+	if spec.KernelID != nil && awsclients.StringValue(spec.KernelID) != attributeValue(attributes.KernelId) {
+		inputs = append(inputs, &ec2.ModifyInstanceAttributeInput{
+			InstanceId: aws.String(id),
+			Kernel:     &types.AttributeValue{Value: spec.KernelID},
+		})
+	}
+	if spec.RAMDiskID != nil && awsclients.StringValue(spec.RAMDiskID) != attributeValue(attributes.RamdiskId) {
+		inputs = append(inputs, &ec2.ModifyInstanceAttributeInput{
+			InstanceId: aws.String(id),
+			Ramdisk:    &types.AttributeValue{Value: spec.RAMDiskID},
+		})
+	}
+	if spec.UserData != nil && awsclients.StringValue(spec.UserData) != attributeValue(attributes.UserData) {
+		inputs = append(inputs, &ec2.ModifyInstanceAttributeInput{
+			InstanceId: aws.String(id),
+			UserData:   &types.BlobAttributeValue{Value: []byte(awsclients.StringValue(spec.UserData))},
+		})
+	}
+	return inputs
+}
+
+// IsInstanceMetadataOptionsUpToDate returns true if the options that are set
+// in the desired metadata options equal the observed ones.
+func IsInstanceMetadataOptionsUpToDate(spec, observed *manualv1alpha1.InstanceMetadataOptionsRequest) bool {
+	if spec == nil {
+		return true
+	}
+	if observed == nil {
+		observed = &manualv1alpha1.InstanceMetadataOptionsRequest{}
+	}
+	if spec.HTTPEndpoint != "" && spec.HTTPEndpoint != observed.HTTPEndpoint {
+		return false
+	}
+	if spec.HTTPTokens != "" && spec.HTTPTokens != observed.HTTPTokens {
+		return false
+	}
+	return spec.HTTPPutResponseHopLimit == nil || awsclients.Int32Value(spec.HTTPPutResponseHopLimit) == awsclients.Int32Value(observed.HTTPPutResponseHopLimit)
+}
+
 // GenerateInstanceObservation is used to produce manualv1alpha1.InstanceObservation from
 // a []ec2.Instance.
 func GenerateInstanceObservation(i types.Instance) manualv1alpha1.InstanceObservation {
@@ -878,3 +878,130 @@ func TestGenerateEC2RunInstancesInput(t *testing.T) {
 		})
 	}
 }
+
+func TestIsInstanceStopRequired(t *testing.T) {
+	type args struct {
+		spec       manualv1alpha1.InstanceParameters
+		attributes ec2.DescribeInstanceAttributeOutput
+	}
+
+	cases := map[string]struct {
+		args args
+		want bool
+	}{
+		"SameInstanceType": {
+			args: args{
+				spec:       manualv1alpha1.InstanceParameters{InstanceType: "m5.large"},
+				attributes: ec2.DescribeInstanceAttributeOutput{InstanceType: &types.AttributeValue{Value: aws.String("m5.large")}},
+			},
+			want: false,
+		},
+		"DifferentInstanceType": {
+			args: args{
+				spec:       manualv1alpha1.InstanceParameters{InstanceType: "m5.large"},
+				attributes: ec2.DescribeInstanceAttributeOutput{InstanceType: &types.AttributeValue{Value: aws.String("m5.xlarge")}},
+			},
+			want: true,
+		},
+		"EBSOptimizedNotSet": {
+			args: args{
+				spec:       manualv1alpha1.InstanceParameters{},
+				attributes: ec2.DescribeInstanceAttributeOutput{EbsOptimized: &types.AttributeBooleanValue{Value: aws.Bool(true)}},
+			},
+			want: false,
+		},
+		"DifferentEBSOptimized": {
+			args: args{
+				spec:       manualv1alpha1.InstanceParameters{EBSOptimized: aws.Bool(true)},
+				attributes: ec2.DescribeInstanceAttributeOutput{EbsOptimized: &types.AttributeBooleanValue{Value: aws.Bool(false)}},
+			},
+			want: true,
+		},
+		"SameUserData": {
+			args: args{
+				spec:       manualv1alpha1.InstanceParameters{UserData: aws.String("dGVzdA==")},
+				attributes: ec2.DescribeInstanceAttributeOutput{UserData: &types.AttributeValue{Value: aws.String("dGVzdA==")}},
+			},
+			want: false,
+		},
+		"DifferentUserData": {
+			args: args{
+				spec:       manualv1alpha1.InstanceParameters{UserData: aws.String("dGVzdA==")},
+				attributes: ec2.DescribeInstanceAttributeOutput{UserData: &types.AttributeValue{Value: aws.String("b3RoZXI=")}},
+			},
+			want: true,
+		},
+		"DifferentKernel": {
+			args: args{
+				spec:       manualv1alpha1.InstanceParameters{KernelID: aws.String("aki-1")},
+				attributes: ec2.DescribeInstanceAttributeOutput{KernelId: &types.AttributeValue{Value: aws.String("aki-2")}},
+			},
+			want: true,
+		},
+		"DifferentRAMDisk": {
+			args: args{
+				spec:       manualv1alpha1.InstanceParameters{RAMDiskID: aws.String("ari-1")},
+				attributes: ec2.DescribeInstanceAttributeOutput{RamdiskId: &types.AttributeValue{Value: aws.String("ari-2")}},
+			},
+			want: true,
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got := IsInstanceStopRequired(tc.args.spec, tc.args.attributes)
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestIsInstanceMetadataOptionsUpToDate(t *testing.T) {
+	type args struct {
+		spec     *manualv1alpha1.InstanceMetadataOptionsRequest
+		observed *manualv1alpha1.InstanceMetadataOptionsRequest
+	}
+
+	cases := map[string]struct {
+		args args
+		want bool
+	}{
+		"NotSet": {
+			args: args{
+				observed: &manualv1alpha1.InstanceMetadataOptionsRequest{HTTPTokens: "optional"},
+			},
+			want: true,
+		},
+		"OnlySetFieldsCompared": {
+			args: args{
+				spec:     &manualv1alpha1.InstanceMetadataOptionsRequest{HTTPTokens: "required"},
+				observed: &manualv1alpha1.InstanceMetadataOptionsRequest{HTTPEndpoint: "enabled", HTTPTokens: "required"},
+			},
+			want: true,
+		},
+		"DifferentTokens": {
+			args: args{
+				spec:     &manualv1alpha1.InstanceMetadataOptionsRequest{HTTPTokens: "required"},
+				observed: &manualv1alpha1.InstanceMetadataOptionsRequest{HTTPTokens: "optional"},
+			},
+			want: false,
+		},
+		"DifferentHopLimit": {
+			args: args{
+				spec:     &manualv1alpha1.InstanceMetadataOptionsRequest{HTTPPutResponseHopLimit: aws.Int32(2)},
+				observed: &manualv1alpha1.InstanceMetadataOptionsRequest{HTTPPutResponseHopLimit: aws.Int32(1)},
+			},
+			want: false,
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got := IsInstanceMetadataOptionsUpToDate(tc.args.spec, tc.args.observed)
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
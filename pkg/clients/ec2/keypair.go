@@ -0,0 +1,114 @@
+/*
+Copyright 2023 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package ec2
+
+import (
+	"context"
+	"errors"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/ec2"
+	ec2types "github.com/aws/aws-sdk-go-v2/service/ec2/types"
+	"github.com/aws/smithy-go"
+
+	"github.com/crossplane-contrib/provider-aws/apis/ec2/manualv1alpha1"
+	awsclients "github.com/crossplane-contrib/provider-aws/pkg/clients"
+)
+
+const (
+	// KeyPairNotFound is the code that is returned by ec2 when the given key pair name is invalid
+	KeyPairNotFound = "InvalidKeyPair.NotFound"
+)
+
+// KeyPairClient is the external client used for KeyPair Custom Resource
+type KeyPairClient interface {
+	CreateKeyPair(ctx context.Context, input *ec2.CreateKeyPairInput, opts ...func(*ec2.Options)) (*ec2.CreateKeyPairOutput, error)
+	ImportKeyPair(ctx context.Context, input *ec2.ImportKeyPairInput, opts ...func(*ec2.Options)) (*ec2.ImportKeyPairOutput, error)
+	DescribeKeyPairs(ctx context.Context, input *ec2.DescribeKeyPairsInput, opts ...func(*ec2.Options)) (*ec2.DescribeKeyPairsOutput, error)
+	DeleteKeyPair(ctx context.Context, input *ec2.DeleteKeyPairInput, opts ...func(*ec2.Options)) (*ec2.DeleteKeyPairOutput, error)
+	CreateTags(ctx context.Context, input *ec2.CreateTagsInput, opts ...func(*ec2.Options)) (*ec2.CreateTagsOutput, error)
+	DeleteTags(ctx context.Context, input *ec2.DeleteTagsInput, opts ...func(*ec2.Options)) (*ec2.DeleteTagsOutput, error)
+}
+
+// NewKeyPairClient returns a new client using AWS credentials as JSON encoded data.
+func NewKeyPairClient(cfg aws.Config) KeyPairClient {
+	return ec2.NewFromConfig(cfg)
+}
+
+// IsKeyPairNotFoundErr returns true if the error is because the key pair doesn't exist
+func IsKeyPairNotFoundErr(err error) bool {
+	var awsErr smithy.APIError
+	return errors.As(err, &awsErr) && awsErr.ErrorCode() == KeyPairNotFound
+}
+
+// GenerateKeyPairObservation is used to produce
+// manualv1alpha1.KeyPairObservation from ec2types.KeyPairInfo.
+func GenerateKeyPairObservation(kp ec2types.KeyPairInfo) manualv1alpha1.KeyPairObservation {
+	return manualv1alpha1.KeyPairObservation{
+		KeyPairID:      aws.ToString(kp.KeyPairId),
+		KeyFingerprint: aws.ToString(kp.KeyFingerprint),
+		KeyType:        string(kp.KeyType),
+	}
+}
+
+// LateInitializeKeyPair fills the empty fields in
+// *manualv1alpha1.KeyPairParameters with the values seen in
+// ec2types.KeyPairInfo.
+func LateInitializeKeyPair(in *manualv1alpha1.KeyPairParameters, kp *ec2types.KeyPairInfo) {
+	if kp == nil {
+		return
+	}
+	// The type of an imported key pair is determined by its public key.
+	if in.PublicKeySecretRef == nil && kp.KeyType != "" {
+		in.KeyType = awsclients.LateInitializeStringPtr(in.KeyType, aws.String(string(kp.KeyType)))
+	}
+	if len(in.Tags) == 0 && len(kp.Tags) != 0 {
+		in.Tags = manualv1alpha1.BuildFromEC2Tags(kp.Tags)
+	}
+}
+
+// GenerateKeyPairTagSpecifications returns the tag specifications to tag the
+// key pair with on creation.
+func GenerateKeyPairTagSpecifications(tags []manualv1alpha1.Tag) []ec2types.TagSpecification {
+	if len(tags) == 0 {
+		return nil
+	}
+	return []ec2types.TagSpecification{{
+		ResourceType: ec2types.ResourceTypeKeyPair,
+		Tags:         manualv1alpha1.GenerateEC2Tags(tags),
+	}}
+}
+
+// GenerateCreateKeyPairInput returns the input to create a key pair with the
+// given name.
+func GenerateCreateKeyPairInput(name string, p manualv1alpha1.KeyPairParameters) *ec2.CreateKeyPairInput {
+	input := &ec2.CreateKeyPairInput{
+		KeyName:           aws.String(name),
+		TagSpecifications: GenerateKeyPairTagSpecifications(p.Tags),
+	}
+	if p.KeyType != nil {
+		input.KeyType = ec2types.KeyType(aws.ToString(p.KeyType))
+	}
+	return input
+}
+
+// IsKeyPairUpToDate checks whether there is a change in any of the
+// modifiable fields.
+func IsKeyPairUpToDate(p manualv1alpha1.KeyPairParameters, kp ec2types.KeyPairInfo) bool {
+	add, remove := awsclients.DiffEC2Tags(manualv1alpha1.GenerateEC2Tags(p.Tags), kp.Tags)
+	return len(add) == 0 && len(remove) == 0
+}
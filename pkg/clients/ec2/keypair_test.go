@@ -0,0 +1,78 @@
+/*
+Copyright 2023 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package ec2
+
+import (
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	ec2types "github.com/aws/aws-sdk-go-v2/service/ec2/types"
+	"github.com/google/go-cmp/cmp"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+
+	"github.com/crossplane-contrib/provider-aws/apis/ec2/manualv1alpha1"
+)
+
+func TestLateInitializeKeyPair(t *testing.T) {
+	type args struct {
+		spec *manualv1alpha1.KeyPairParameters
+		in   *ec2types.KeyPairInfo
+	}
+
+	cases := map[string]struct {
+		args args
+		want *manualv1alpha1.KeyPairParameters
+	}{
+		"AllFilled": {
+			args: args{
+				spec: &manualv1alpha1.KeyPairParameters{KeyType: aws.String("ed25519")},
+				in:   &ec2types.KeyPairInfo{KeyType: ec2types.KeyTypeRsa},
+			},
+			want: &manualv1alpha1.KeyPairParameters{KeyType: aws.String("ed25519")},
+		},
+		"Created": {
+			args: args{
+				spec: &manualv1alpha1.KeyPairParameters{},
+				in: &ec2types.KeyPairInfo{
+					KeyType: ec2types.KeyTypeRsa,
+					Tags:    []ec2types.Tag{{Key: aws.String("k"), Value: aws.String("v")}},
+				},
+			},
+			want: &manualv1alpha1.KeyPairParameters{
+				KeyType: aws.String("rsa"),
+				Tags:    []manualv1alpha1.Tag{{Key: "k", Value: "v"}},
+			},
+		},
+		"Imported": {
+			args: args{
+				spec: &manualv1alpha1.KeyPairParameters{PublicKeySecretRef: &xpv1.SecretKeySelector{Key: "key"}},
+				in:   &ec2types.KeyPairInfo{KeyType: ec2types.KeyTypeEd25519},
+			},
+			want: &manualv1alpha1.KeyPairParameters{PublicKeySecretRef: &xpv1.SecretKeySelector{Key: "key"}},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			LateInitializeKeyPair(tc.args.spec, tc.args.in)
+			if diff := cmp.Diff(tc.want, tc.args.spec); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,285 @@
+/*
+Copyright 2023 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package ec2
+
+import (
+	"context"
+	"errors"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/ec2"
+	ec2types "github.com/aws/aws-sdk-go-v2/service/ec2/types"
+	"github.com/aws/smithy-go"
+
+	"github.com/crossplane-contrib/provider-aws/apis/ec2/manualv1alpha1"
+	awsclients "github.com/crossplane-contrib/provider-aws/pkg/clients"
+)
+
+const (
+	// NetworkACLIDNotFound is the code that is returned by ec2 when the given NetworkACLID is invalid
+	NetworkACLIDNotFound = "InvalidNetworkAclID.NotFound"
+
+	// NetworkACLEntryNotFound is the code that is returned when the given network ACL entry is not found
+	NetworkACLEntryNotFound = "InvalidNetworkAclEntry.NotFound"
+)
+
+// NetworkACLClient is the external client used for NetworkACL Custom Resource
+type NetworkACLClient interface {
+	CreateNetworkAcl(ctx context.Context, input *ec2.CreateNetworkAclInput, opts ...func(*ec2.Options)) (*ec2.CreateNetworkAclOutput, error)
+	DeleteNetworkAcl(ctx context.Context, input *ec2.DeleteNetworkAclInput, opts ...func(*ec2.Options)) (*ec2.DeleteNetworkAclOutput, error)
+	DescribeNetworkAcls(ctx context.Context, input *ec2.DescribeNetworkAclsInput, opts ...func(*ec2.Options)) (*ec2.DescribeNetworkAclsOutput, error)
+	CreateNetworkAclEntry(ctx context.Context, input *ec2.CreateNetworkAclEntryInput, opts ...func(*ec2.Options)) (*ec2.CreateNetworkAclEntryOutput, error)
+	ReplaceNetworkAclEntry(ctx context.Context, input *ec2.ReplaceNetworkAclEntryInput, opts ...func(*ec2.Options)) (*ec2.ReplaceNetworkAclEntryOutput, error)
+	DeleteNetworkAclEntry(ctx context.Context, input *ec2.DeleteNetworkAclEntryInput, opts ...func(*ec2.Options)) (*ec2.DeleteNetworkAclEntryOutput, error)
+	ReplaceNetworkAclAssociation(ctx context.Context, input *ec2.ReplaceNetworkAclAssociationInput, opts ...func(*ec2.Options)) (*ec2.ReplaceNetworkAclAssociationOutput, error)
+	CreateTags(ctx context.Context, input *ec2.CreateTagsInput, opts ...func(*ec2.Options)) (*ec2.CreateTagsOutput, error)
+	DeleteTags(ctx context.Context, input *ec2.DeleteTagsInput, opts ...func(*ec2.Options)) (*ec2.DeleteTagsOutput, error)
+}
+
+// NewNetworkACLClient returns a new client using AWS credentials as JSON encoded data.
+func NewNetworkACLClient(cfg aws.Config) NetworkACLClient {
+	return ec2.NewFromConfig(cfg)
+}
+
+// NetworkACLEntryClient is the external client used for NetworkACLEntry Custom Resource
+type NetworkACLEntryClient interface {
+	DescribeNetworkAcls(ctx context.Context, input *ec2.DescribeNetworkAclsInput, opts ...func(*ec2.Options)) (*ec2.DescribeNetworkAclsOutput, error)
+	CreateNetworkAclEntry(ctx context.Context, input *ec2.CreateNetworkAclEntryInput, opts ...func(*ec2.Options)) (*ec2.CreateNetworkAclEntryOutput, error)
+	ReplaceNetworkAclEntry(ctx context.Context, input *ec2.ReplaceNetworkAclEntryInput, opts ...func(*ec2.Options)) (*ec2.ReplaceNetworkAclEntryOutput, error)
+	DeleteNetworkAclEntry(ctx context.Context, input *ec2.DeleteNetworkAclEntryInput, opts ...func(*ec2.Options)) (*ec2.DeleteNetworkAclEntryOutput, error)
+}
+
+// NewNetworkACLEntryClient returns a new client using AWS credentials as JSON encoded data.
+func NewNetworkACLEntryClient(cfg aws.Config) NetworkACLEntryClient {
+	return ec2.NewFromConfig(cfg)
+}
+
+// IsNetworkACLNotFoundErr returns true if the error is because the network ACL doesn't exist
+func IsNetworkACLNotFoundErr(err error) bool {
+	var awsErr smithy.APIError
+	return errors.As(err, &awsErr) && awsErr.ErrorCode() == NetworkACLIDNotFound
+}
+
+// IsNetworkACLEntryNotFoundErr returns true if the error is because the network ACL entry doesn't exist
+func IsNetworkACLEntryNotFoundErr(err error) bool {
+	var awsErr smithy.APIError
+	return errors.As(err, &awsErr) && awsErr.ErrorCode() == NetworkACLEntryNotFound
+}
+
+// GenerateNetworkACLObservation is used to produce
+// manualv1alpha1.NetworkACLObservation from ec2types.NetworkAcl.
+func GenerateNetworkACLObservation(acl ec2types.NetworkAcl) manualv1alpha1.NetworkACLObservation {
+	o := manualv1alpha1.NetworkACLObservation{
+		NetworkACLID: aws.ToString(acl.NetworkAclId),
+		OwnerID:      aws.ToString(acl.OwnerId),
+		IsDefault:    aws.ToBool(acl.IsDefault),
+	}
+
+	if len(acl.Entries) > 0 {
+		o.Entries = make([]manualv1alpha1.NetworkACLEntryState, len(acl.Entries))
+		for i, e := range acl.Entries {
+			o.Entries[i] = GenerateNetworkACLEntryObservation(e)
+		}
+	}
+
+	if len(acl.Associations) > 0 {
+		o.Associations = make([]manualv1alpha1.NetworkACLAssociationState, len(acl.Associations))
+		for i, a := range acl.Associations {
+			o.Associations[i] = manualv1alpha1.NetworkACLAssociationState{
+				AssociationID: aws.ToString(a.NetworkAclAssociationId),
+				SubnetID:      aws.ToString(a.SubnetId),
+			}
+		}
+	}
+
+	return o
+}
+
+// GenerateNetworkACLEntryObservation is used to produce
+// manualv1alpha1.NetworkACLEntryState from ec2types.NetworkAclEntry.
+func GenerateNetworkACLEntryObservation(e ec2types.NetworkAclEntry) manualv1alpha1.NetworkACLEntryState {
+	return manualv1alpha1.NetworkACLEntryState{
+		RuleNumber:    aws.ToInt32(e.RuleNumber),
+		Egress:        aws.ToBool(e.Egress),
+		Protocol:      aws.ToString(e.Protocol),
+		RuleAction:    string(e.RuleAction),
+		CIDRBlock:     aws.ToString(e.CidrBlock),
+		IPv6CIDRBlock: aws.ToString(e.Ipv6CidrBlock),
+	}
+}
+
+// LateInitializeNetworkACL fills the empty fields in
+// *manualv1alpha1.NetworkACLParameters with the values seen in
+// ec2types.NetworkAcl.
+func LateInitializeNetworkACL(in *manualv1alpha1.NetworkACLParameters, acl *ec2types.NetworkAcl) {
+	if acl == nil {
+		return
+	}
+	in.VPCID = awsclients.LateInitializeStringPtr(in.VPCID, acl.VpcId)
+
+	if len(in.Tags) == 0 && len(acl.Tags) != 0 {
+		in.Tags = manualv1alpha1.BuildFromEC2Tags(acl.Tags)
+	}
+}
+
+func generateNetworkACLEntry(egress bool, ruleNumber int32, protocol, action string, cidr, ipv6CIDR *string, fromPort, toPort, icmpType, icmpCode *int32) ec2types.NetworkAclEntry {
+	e := ec2types.NetworkAclEntry{
+		Egress:        aws.Bool(egress),
+		RuleNumber:    aws.Int32(ruleNumber),
+		Protocol:      aws.String(NormalizeNetworkACLProtocol(protocol)),
+		RuleAction:    ec2types.RuleAction(action),
+		CidrBlock:     cidr,
+		Ipv6CidrBlock: ipv6CIDR,
+	}
+	if fromPort != nil || toPort != nil {
+		e.PortRange = &ec2types.PortRange{From: fromPort, To: toPort}
+	}
+	if icmpType != nil || icmpCode != nil {
+		e.IcmpTypeCode = &ec2types.IcmpTypeCode{Type: icmpType, Code: icmpCode}
+	}
+	return e
+}
+
+// GenerateNetworkACLEntries returns the entries of the given inline rules.
+func GenerateNetworkACLEntries(rules []manualv1alpha1.NetworkACLRule, egress bool) []ec2types.NetworkAclEntry {
+	if len(rules) == 0 {
+		return nil
+	}
+	entries := make([]ec2types.NetworkAclEntry, len(rules))
+	for i, r := range rules {
+		entries[i] = generateNetworkACLEntry(egress, r.RuleNumber, r.Protocol, r.RuleAction, r.CIDRBlock, r.IPv6CIDRBlock, r.FromPort, r.ToPort, r.ICMPType, r.ICMPCode)
+	}
+	return entries
+}
+
+// GenerateNetworkACLEntry returns the entry of the given standalone entry
+// parameters.
+func GenerateNetworkACLEntry(p manualv1alpha1.NetworkACLEntryParameters) ec2types.NetworkAclEntry {
+	return generateNetworkACLEntry(aws.ToBool(p.Egress), p.RuleNumber, p.Protocol, p.RuleAction, p.CIDRBlock, p.IPv6CIDRBlock, p.FromPort, p.ToPort, p.ICMPType, p.ICMPCode)
+}
+
+// FindNetworkACLEntry returns the entry of the network ACL with the given
+// direction and rule number, or nil if there is none.
+func FindNetworkACLEntry(acl ec2types.NetworkAcl, egress bool, ruleNumber int32) *ec2types.NetworkAclEntry {
+	for i := range acl.Entries {
+		if aws.ToBool(acl.Entries[i].Egress) == egress && aws.ToInt32(acl.Entries[i].RuleNumber) == ruleNumber {
+			return &acl.Entries[i]
+		}
+	}
+	return nil
+}
+
+// FilterNetworkACLEntries returns the entries of the given direction.
+func FilterNetworkACLEntries(entries []ec2types.NetworkAclEntry, egress bool) []ec2types.NetworkAclEntry {
+	var ret []ec2types.NetworkAclEntry
+	for _, e := range entries {
+		if aws.ToBool(e.Egress) == egress {
+			ret = append(ret, e)
+		}
+	}
+	return ret
+}
+
+// DiffNetworkACL returns the entries to create, replace and delete to make
+// the entries of the network ACL match the desired ones. The directions
+// that are ignored in the parameters are left untouched.
+func DiffNetworkACL(p manualv1alpha1.NetworkACLParameters, acl ec2types.NetworkAcl) (create, replace, remove []ec2types.NetworkAclEntry) {
+	var want, have []ec2types.NetworkAclEntry
+	if !aws.ToBool(p.IgnoreIngress) {
+		want = append(want, GenerateNetworkACLEntries(p.Ingress, false)...)
+		have = append(have, FilterNetworkACLEntries(acl.Entries, false)...)
+	}
+	if !aws.ToBool(p.IgnoreEgress) {
+		want = append(want, GenerateNetworkACLEntries(p.Egress, true)...)
+		have = append(have, FilterNetworkACLEntries(acl.Entries, true)...)
+	}
+	return DiffNetworkACLEntries(want, have)
+}
+
+// DiffNetworkACLAssociations returns the IDs of the subnets to associate with
+// and to disassociate from the network ACL.
+func DiffNetworkACLAssociations(desired []manualv1alpha1.NetworkACLAssociation, observed []ec2types.NetworkAclAssociation) (associate, disassociate []string) {
+	want := make(map[string]bool, len(desired))
+	for _, a := range desired {
+		if a.SubnetID != nil {
+			want[aws.ToString(a.SubnetID)] = true
+		}
+	}
+	have := make(map[string]bool, len(observed))
+	for _, a := range observed {
+		id := aws.ToString(a.SubnetId)
+		have[id] = true
+		if !want[id] {
+			disassociate = append(disassociate, id)
+		}
+	}
+	for _, a := range desired {
+		id := aws.ToString(a.SubnetID)
+		if a.SubnetID != nil && !have[id] {
+			associate = append(associate, id)
+			have[id] = true
+		}
+	}
+	return associate, disassociate
+}
+
+// IsNetworkACLUpToDate checks whether there is a change in any of the
+// modifiable fields.
+func IsNetworkACLUpToDate(p manualv1alpha1.NetworkACLParameters, acl ec2types.NetworkAcl) bool {
+	addTags, removeTags := awsclients.DiffEC2Tags(manualv1alpha1.GenerateEC2Tags(p.Tags), acl.Tags)
+	if len(addTags) != 0 || len(removeTags) != 0 {
+		return false
+	}
+	create, replace, remove := DiffNetworkACL(p, acl)
+	if len(create) != 0 || len(replace) != 0 || len(remove) != 0 {
+		return false
+	}
+	associate, disassociate := DiffNetworkACLAssociations(p.Associations, acl.Associations)
+	return len(associate) == 0 && len(disassociate) == 0
+}
+
+// GenerateCreateNetworkACLEntryInput returns the input to create the given
+// entry in the network ACL.
+func GenerateCreateNetworkACLEntryInput(aclID string, e ec2types.NetworkAclEntry) *ec2.CreateNetworkAclEntryInput {
+	return &ec2.CreateNetworkAclEntryInput{
+		NetworkAclId:  aws.String(aclID),
+		Egress:        e.Egress,
+		RuleNumber:    e.RuleNumber,
+		Protocol:      e.Protocol,
+		RuleAction:    e.RuleAction,
+		CidrBlock:     e.CidrBlock,
+		Ipv6CidrBlock: e.Ipv6CidrBlock,
+		PortRange:     e.PortRange,
+		IcmpTypeCode:  e.IcmpTypeCode,
+	}
+}
+
+// GenerateReplaceNetworkACLEntryInput returns the input to replace the entry
+// with the same direction and rule number in the network ACL.
+func GenerateReplaceNetworkACLEntryInput(aclID string, e ec2types.NetworkAclEntry) *ec2.ReplaceNetworkAclEntryInput {
+	return &ec2.ReplaceNetworkAclEntryInput{
+		NetworkAclId:  aws.String(aclID),
+		Egress:        e.Egress,
+		RuleNumber:    e.RuleNumber,
+		Protocol:      e.Protocol,
+		RuleAction:    e.RuleAction,
+		CidrBlock:     e.CidrBlock,
+		Ipv6CidrBlock: e.Ipv6CidrBlock,
+		PortRange:     e.PortRange,
+		IcmpTypeCode:  e.IcmpTypeCode,
+	}
+}
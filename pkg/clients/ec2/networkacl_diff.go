@@ -0,0 +1,161 @@
+/*
+Copyright 2023 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package ec2
+
+import (
+	"sort"
+	"strings"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	ec2types "github.com/aws/aws-sdk-go-v2/service/ec2/types"
+)
+
+// MaxNetworkACLRuleNumber is the highest rule number of an entry that can
+// be managed. The entries above it are the default deny entries of a network
+// ACL, which can neither be changed nor deleted.
+const MaxNetworkACLRuleNumber = 32766
+
+// networkACLProtocols maps the protocol names accepted in the spec to the
+// protocol numbers returned by the API.
+var networkACLProtocols = map[string]string{
+	"all":    "-1",
+	"icmp":   "1",
+	"tcp":    "6",
+	"udp":    "17",
+	"icmpv6": "58",
+}
+
+// NormalizeNetworkACLProtocol returns the protocol number of the given
+// protocol name or number.
+func NormalizeNetworkACLProtocol(protocol string) string {
+	if n, ok := networkACLProtocols[strings.ToLower(protocol)]; ok {
+		return n
+	}
+	return protocol
+}
+
+// aclEntryKey represents the unique tuple (direction, rule number) of a
+// network ACL entry in a format supported as a map key
+type aclEntryKey struct {
+	egress     bool
+	ruleNumber int32
+}
+
+func getACLEntryKey(e ec2types.NetworkAclEntry) aclEntryKey {
+	return aclEntryKey{
+		egress:     aws.ToBool(e.Egress),
+		ruleNumber: aws.ToInt32(e.RuleNumber),
+	}
+}
+
+// hasPortRange returns true if the port range of an entry is used by the
+// protocol. It is ignored by the API otherwise.
+func hasPortRange(protocol string) bool {
+	return protocol == "6" || protocol == "17"
+}
+
+// hasICMPTypeCode returns true if the ICMP type and code of an entry are
+// used by the protocol. They are ignored by the API otherwise.
+func hasICMPTypeCode(protocol string) bool {
+	return protocol == "1" || protocol == "58"
+}
+
+// IsNetworkACLEntryEqual returns true if both entries allow or deny the same
+// traffic.
+func IsNetworkACLEntryEqual(a, b ec2types.NetworkAclEntry) bool { // nolint:gocyclo
+	protocol := NormalizeNetworkACLProtocol(aws.ToString(a.Protocol))
+	if protocol != NormalizeNetworkACLProtocol(aws.ToString(b.Protocol)) ||
+		a.RuleAction != b.RuleAction ||
+		aws.ToString(a.CidrBlock) != aws.ToString(b.CidrBlock) ||
+		aws.ToString(a.Ipv6CidrBlock) != aws.ToString(b.Ipv6CidrBlock) {
+		return false
+	}
+	if hasPortRange(protocol) {
+		var ap, bp ec2types.PortRange
+		if a.PortRange != nil {
+			ap = *a.PortRange
+		}
+		if b.PortRange != nil {
+			bp = *b.PortRange
+		}
+		if aws.ToInt32(ap.From) != aws.ToInt32(bp.From) || aws.ToInt32(ap.To) != aws.ToInt32(bp.To) {
+			return false
+		}
+	}
+	if hasICMPTypeCode(protocol) {
+		var ai, bi ec2types.IcmpTypeCode
+		if a.IcmpTypeCode != nil {
+			ai = *a.IcmpTypeCode
+		}
+		if b.IcmpTypeCode != nil {
+			bi = *b.IcmpTypeCode
+		}
+		if aws.ToInt32(ai.Type) != aws.ToInt32(bi.Type) || aws.ToInt32(ai.Code) != aws.ToInt32(bi.Code) {
+			return false
+		}
+	}
+	return true
+}
+
+func convertToEntryMap(entries []ec2types.NetworkAclEntry) map[aclEntryKey]ec2types.NetworkAclEntry {
+	ret := make(map[aclEntryKey]ec2types.NetworkAclEntry, len(entries))
+	for _, e := range entries {
+		if aws.ToInt32(e.RuleNumber) > MaxNetworkACLRuleNumber {
+			continue
+		}
+		ret[getACLEntryKey(e)] = e
+	}
+	return ret
+}
+
+// DiffNetworkACLEntries compares two sets of network ACL entries, and
+// returns the entries to create, replace and delete to make them identical.
+// Entries are matched by their direction and rule number, and the default
+// entries of a network ACL are ignored.
+func DiffNetworkACLEntries(want, have []ec2types.NetworkAclEntry) (create, replace, remove []ec2types.NetworkAclEntry) {
+	wantMap := convertToEntryMap(want)
+	haveMap := convertToEntryMap(have)
+
+	for key, h := range haveMap {
+		w, ok := wantMap[key]
+		switch {
+		case !ok:
+			remove = append(remove, h)
+		case !IsNetworkACLEntryEqual(w, h):
+			replace = append(replace, w)
+		}
+	}
+
+	for key, w := range wantMap {
+		if _, ok := haveMap[key]; !ok {
+			create = append(create, w)
+		}
+	}
+
+	// Return the entries in a stable order.
+	for _, s := range [][]ec2types.NetworkAclEntry{create, replace, remove} {
+		sort.Slice(s, func(i, j int) bool {
+			ki, kj := getACLEntryKey(s[i]), getACLEntryKey(s[j])
+			if ki.egress != kj.egress {
+				return !ki.egress
+			}
+			return ki.ruleNumber < kj.ruleNumber
+		})
+	}
+
+	return create, replace, remove
+}
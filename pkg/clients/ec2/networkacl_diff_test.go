@@ -0,0 +1,123 @@
+/*
+Copyright 2023 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package ec2
+
+import (
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	ec2types "github.com/aws/aws-sdk-go-v2/service/ec2/types"
+	"github.com/aws/smithy-go/document"
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+)
+
+func aclEntry(egress bool, rule int32, protocol string, action ec2types.RuleAction, cidr string, port int32) ec2types.NetworkAclEntry {
+	e := ec2types.NetworkAclEntry{
+		Egress:     aws.Bool(egress),
+		RuleNumber: aws.Int32(rule),
+		Protocol:   aws.String(protocol),
+		RuleAction: action,
+		CidrBlock:  aws.String(cidr),
+	}
+	if port != 0 {
+		e.PortRange = &ec2types.PortRange{From: aws.Int32(port), To: aws.Int32(port)}
+	}
+	return e
+}
+
+func TestDiffNetworkACLEntries(t *testing.T) {
+	type args struct {
+		want []ec2types.NetworkAclEntry
+		have []ec2types.NetworkAclEntry
+	}
+	type want struct {
+		create  []ec2types.NetworkAclEntry
+		replace []ec2types.NetworkAclEntry
+		remove  []ec2types.NetworkAclEntry
+	}
+
+	cases := map[string]struct {
+		args args
+		want want
+	}{
+		"Same": {
+			args: args{
+				want: []ec2types.NetworkAclEntry{aclEntry(false, 100, "tcp", ec2types.RuleActionAllow, cidr, 443)},
+				have: []ec2types.NetworkAclEntry{aclEntry(false, 100, "6", ec2types.RuleActionAllow, cidr, 443)},
+			},
+		},
+		"IgnoreDefaultEntries": {
+			args: args{
+				have: []ec2types.NetworkAclEntry{
+					aclEntry(false, 32767, "-1", ec2types.RuleActionDeny, "0.0.0.0/0", 0),
+					aclEntry(true, 32767, "-1", ec2types.RuleActionDeny, "0.0.0.0/0", 0),
+				},
+			},
+		},
+		"IgnorePortsOfOtherProtocols": {
+			args: args{
+				want: []ec2types.NetworkAclEntry{aclEntry(false, 100, "-1", ec2types.RuleActionAllow, cidr, 443)},
+				have: []ec2types.NetworkAclEntry{aclEntry(false, 100, "-1", ec2types.RuleActionAllow, cidr, 0)},
+			},
+		},
+		"SameRuleNumberOtherDirection": {
+			args: args{
+				want: []ec2types.NetworkAclEntry{aclEntry(true, 100, "6", ec2types.RuleActionAllow, cidr, 443)},
+				have: []ec2types.NetworkAclEntry{aclEntry(false, 100, "6", ec2types.RuleActionAllow, cidr, 443)},
+			},
+			want: want{
+				create: []ec2types.NetworkAclEntry{aclEntry(true, 100, "6", ec2types.RuleActionAllow, cidr, 443)},
+				remove: []ec2types.NetworkAclEntry{aclEntry(false, 100, "6", ec2types.RuleActionAllow, cidr, 443)},
+			},
+		},
+		"Replace": {
+			args: args{
+				want: []ec2types.NetworkAclEntry{
+					aclEntry(false, 100, "6", ec2types.RuleActionDeny, cidr, 443),
+					aclEntry(false, 110, "6", ec2types.RuleActionAllow, cidr, 80),
+				},
+				have: []ec2types.NetworkAclEntry{
+					aclEntry(false, 100, "6", ec2types.RuleActionAllow, cidr, 443),
+					aclEntry(false, 110, "6", ec2types.RuleActionAllow, cidr, 8080),
+				},
+			},
+			want: want{
+				replace: []ec2types.NetworkAclEntry{
+					aclEntry(false, 100, "6", ec2types.RuleActionDeny, cidr, 443),
+					aclEntry(false, 110, "6", ec2types.RuleActionAllow, cidr, 80),
+				},
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			create, replace, remove := DiffNetworkACLEntries(tc.args.want, tc.args.have)
+			opts := []cmp.Option{cmpopts.EquateEmpty(), cmpopts.IgnoreTypes(document.NoSerde{})}
+			if diff := cmp.Diff(tc.want.create, create, opts...); diff != "" {
+				t.Errorf("create: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.replace, replace, opts...); diff != "" {
+				t.Errorf("replace: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.remove, remove, opts...); diff != "" {
+				t.Errorf("remove: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
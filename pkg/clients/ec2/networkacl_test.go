@@ -0,0 +1,131 @@
+/*
+Copyright 2023 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package ec2
+
+import (
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	ec2types "github.com/aws/aws-sdk-go-v2/service/ec2/types"
+	"github.com/aws/smithy-go/document"
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+
+	"github.com/crossplane-contrib/provider-aws/apis/ec2/manualv1alpha1"
+)
+
+func TestDiffNetworkACL(t *testing.T) {
+	acl := ec2types.NetworkAcl{
+		Entries: []ec2types.NetworkAclEntry{
+			aclEntry(false, 100, "6", ec2types.RuleActionAllow, cidr, 443),
+			aclEntry(true, 100, "-1", ec2types.RuleActionAllow, "0.0.0.0/0", 0),
+		},
+	}
+
+	type want struct {
+		create []ec2types.NetworkAclEntry
+		remove []ec2types.NetworkAclEntry
+	}
+
+	cases := map[string]struct {
+		p    manualv1alpha1.NetworkACLParameters
+		want want
+	}{
+		"RemoveUnknownEntries": {
+			p: manualv1alpha1.NetworkACLParameters{},
+			want: want{
+				remove: acl.Entries,
+			},
+		},
+		"IgnoreEgress": {
+			p: manualv1alpha1.NetworkACLParameters{
+				Ingress: []manualv1alpha1.NetworkACLRule{{
+					RuleNumber: 100,
+					Protocol:   "tcp",
+					RuleAction: "allow",
+					CIDRBlock:  aws.String(cidr),
+					FromPort:   aws.Int32(443),
+					ToPort:     aws.Int32(443),
+				}},
+				IgnoreEgress: aws.Bool(true),
+			},
+		},
+		"CreateIngress": {
+			p: manualv1alpha1.NetworkACLParameters{
+				Ingress: []manualv1alpha1.NetworkACLRule{
+					{RuleNumber: 100, Protocol: "tcp", RuleAction: "allow", CIDRBlock: aws.String(cidr), FromPort: aws.Int32(443), ToPort: aws.Int32(443)},
+					{RuleNumber: 200, Protocol: "tcp", RuleAction: "allow", CIDRBlock: aws.String(cidr), FromPort: aws.Int32(80), ToPort: aws.Int32(80)},
+				},
+				IgnoreEgress: aws.Bool(true),
+			},
+			want: want{
+				create: []ec2types.NetworkAclEntry{aclEntry(false, 200, "6", ec2types.RuleActionAllow, cidr, 80)},
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			create, _, remove := DiffNetworkACL(tc.p, acl)
+			opts := []cmp.Option{cmpopts.EquateEmpty(), cmpopts.IgnoreTypes(document.NoSerde{})}
+			if diff := cmp.Diff(tc.want.create, create, opts...); diff != "" {
+				t.Errorf("create: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.remove, remove, opts...); diff != "" {
+				t.Errorf("remove: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestDiffNetworkACLAssociations(t *testing.T) {
+	type want struct {
+		associate    []string
+		disassociate []string
+	}
+
+	cases := map[string]struct {
+		desired  []manualv1alpha1.NetworkACLAssociation
+		observed []ec2types.NetworkAclAssociation
+		want     want
+	}{
+		"UpToDate": {
+			desired:  []manualv1alpha1.NetworkACLAssociation{{SubnetID: aws.String("subnet-a")}},
+			observed: []ec2types.NetworkAclAssociation{{SubnetId: aws.String("subnet-a")}},
+		},
+		"AddAndRemove": {
+			desired:  []manualv1alpha1.NetworkACLAssociation{{SubnetID: aws.String("subnet-a")}, {SubnetID: aws.String("subnet-b")}},
+			observed: []ec2types.NetworkAclAssociation{{SubnetId: aws.String("subnet-a")}, {SubnetId: aws.String("subnet-c")}},
+			want: want{
+				associate:    []string{"subnet-b"},
+				disassociate: []string{"subnet-c"},
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			associate, disassociate := DiffNetworkACLAssociations(tc.desired, tc.observed)
+			if diff := cmp.Diff(tc.want.associate, associate, cmpopts.EquateEmpty()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.disassociate, disassociate, cmpopts.EquateEmpty()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
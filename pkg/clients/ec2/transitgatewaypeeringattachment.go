@@ -0,0 +1,139 @@
+/*
+Copyright 2023 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package ec2
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/ec2"
+	ec2types "github.com/aws/aws-sdk-go-v2/service/ec2/types"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+
+	"github.com/crossplane-contrib/provider-aws/apis/ec2/v1alpha1"
+	awsclients "github.com/crossplane-contrib/provider-aws/pkg/clients"
+)
+
+// TransitGatewayPeeringAttachmentClient is the external client used for
+// TransitGatewayPeeringAttachment and TransitGatewayPeeringAttachmentAccepter
+// Custom Resources
+type TransitGatewayPeeringAttachmentClient interface {
+	CreateTransitGatewayPeeringAttachment(ctx context.Context, input *ec2.CreateTransitGatewayPeeringAttachmentInput, opts ...func(*ec2.Options)) (*ec2.CreateTransitGatewayPeeringAttachmentOutput, error)
+	AcceptTransitGatewayPeeringAttachment(ctx context.Context, input *ec2.AcceptTransitGatewayPeeringAttachmentInput, opts ...func(*ec2.Options)) (*ec2.AcceptTransitGatewayPeeringAttachmentOutput, error)
+	DescribeTransitGatewayPeeringAttachments(ctx context.Context, input *ec2.DescribeTransitGatewayPeeringAttachmentsInput, opts ...func(*ec2.Options)) (*ec2.DescribeTransitGatewayPeeringAttachmentsOutput, error)
+	DeleteTransitGatewayPeeringAttachment(ctx context.Context, input *ec2.DeleteTransitGatewayPeeringAttachmentInput, opts ...func(*ec2.Options)) (*ec2.DeleteTransitGatewayPeeringAttachmentOutput, error)
+	CreateTags(ctx context.Context, input *ec2.CreateTagsInput, opts ...func(*ec2.Options)) (*ec2.CreateTagsOutput, error)
+	DeleteTags(ctx context.Context, input *ec2.DeleteTagsInput, opts ...func(*ec2.Options)) (*ec2.DeleteTagsOutput, error)
+}
+
+// NewTransitGatewayPeeringAttachmentClient returns a new client using AWS
+// credentials as JSON encoded data.
+func NewTransitGatewayPeeringAttachmentClient(cfg aws.Config) TransitGatewayPeeringAttachmentClient {
+	return ec2.NewFromConfig(cfg)
+}
+
+// GenerateTransitGatewayTags generates a tag array with type that EC2 client
+// expects.
+func GenerateTransitGatewayTags(tags []v1alpha1.Tag) []ec2types.Tag {
+	res := make([]ec2types.Tag, len(tags))
+	for i, t := range tags {
+		res[i] = ec2types.Tag{Key: t.Key, Value: t.Value}
+	}
+	return res
+}
+
+// generatePeeringTGWInfo converts ec2types.PeeringTgwInfo into its
+// v1alpha1 counterpart.
+func generatePeeringTGWInfo(in *ec2types.PeeringTgwInfo) *v1alpha1.PeeringTgwInfo {
+	if in == nil {
+		return nil
+	}
+	return &v1alpha1.PeeringTgwInfo{
+		OwnerID:          in.OwnerId,
+		Region:           in.Region,
+		TransitGatewayID: in.TransitGatewayId,
+	}
+}
+
+// GenerateTransitGatewayPeeringAttachmentObservation is used to produce
+// v1alpha1.TransitGatewayPeeringAttachmentObservation from
+// ec2types.TransitGatewayPeeringAttachment.
+func GenerateTransitGatewayPeeringAttachmentObservation(a ec2types.TransitGatewayPeeringAttachment) v1alpha1.TransitGatewayPeeringAttachmentObservation {
+	o := v1alpha1.TransitGatewayPeeringAttachmentObservation{
+		TransitGatewayAttachmentID: aws.ToString(a.TransitGatewayAttachmentId),
+		State:                      string(a.State),
+		RequesterTGWInfo:           generatePeeringTGWInfo(a.RequesterTgwInfo),
+		AccepterTGWInfo:            generatePeeringTGWInfo(a.AccepterTgwInfo),
+	}
+	if a.Status != nil {
+		o.Status = &v1alpha1.PeeringAttachmentStatus{
+			Code:    a.Status.Code,
+			Message: a.Status.Message,
+		}
+	}
+	if a.CreationTime != nil {
+		t := metav1.NewTime(*a.CreationTime)
+		o.CreationTime = &t
+	}
+	return o
+}
+
+// GenerateCreateTransitGatewayPeeringAttachmentInput returns the input to
+// request a peering attachment with the given parameters.
+func GenerateCreateTransitGatewayPeeringAttachmentInput(p v1alpha1.TransitGatewayPeeringAttachmentParameters) *ec2.CreateTransitGatewayPeeringAttachmentInput {
+	input := &ec2.CreateTransitGatewayPeeringAttachmentInput{
+		TransitGatewayId:     p.TransitGatewayID,
+		PeerTransitGatewayId: p.PeerTransitGatewayID,
+		PeerAccountId:        aws.String(p.PeerAccountID),
+		PeerRegion:           aws.String(p.PeerRegion),
+	}
+	if len(p.Tags) != 0 {
+		input.TagSpecifications = []ec2types.TagSpecification{{
+			ResourceType: ec2types.ResourceTypeTransitGatewayAttachment,
+			Tags:         GenerateTransitGatewayTags(p.Tags),
+		}}
+	}
+	return input
+}
+
+// IsTransitGatewayPeeringAttachmentUpToDate checks whether the tags of the
+// attachment, which are the only modifiable fields, are up to date.
+func IsTransitGatewayPeeringAttachmentUpToDate(tags []v1alpha1.Tag, a ec2types.TransitGatewayPeeringAttachment) bool {
+	add, remove := awsclients.DiffEC2Tags(GenerateTransitGatewayTags(tags), a.Tags)
+	return len(add) == 0 && len(remove) == 0
+}
+
+// TransitGatewayPeeringAttachmentCondition returns the condition that
+// reflects the given state of a peering attachment.
+func TransitGatewayPeeringAttachmentCondition(state ec2types.TransitGatewayAttachmentState) xpv1.Condition {
+	switch state {
+	case ec2types.TransitGatewayAttachmentStateAvailable:
+		return xpv1.Available()
+	case ec2types.TransitGatewayAttachmentStateInitiating,
+		ec2types.TransitGatewayAttachmentStateInitiatingRequest,
+		ec2types.TransitGatewayAttachmentStatePendingAcceptance,
+		ec2types.TransitGatewayAttachmentStatePending:
+		return xpv1.Creating()
+	case ec2types.TransitGatewayAttachmentStateDeleting,
+		ec2types.TransitGatewayAttachmentStateDeleted:
+		return xpv1.Deleting()
+	default:
+		return xpv1.Unavailable()
+	}
+}
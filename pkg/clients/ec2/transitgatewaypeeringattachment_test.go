@@ -0,0 +1,107 @@
+/*
+Copyright 2023 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package ec2
+
+import (
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	ec2types "github.com/aws/aws-sdk-go-v2/service/ec2/types"
+	"github.com/google/go-cmp/cmp"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+
+	"github.com/crossplane-contrib/provider-aws/apis/ec2/v1alpha1"
+)
+
+func TestGenerateTransitGatewayPeeringAttachmentObservation(t *testing.T) {
+	created := time.Date(2023, 1, 2, 3, 4, 5, 0, time.UTC)
+	createdTime := metav1.NewTime(created)
+
+	cases := map[string]struct {
+		in   ec2types.TransitGatewayPeeringAttachment
+		want v1alpha1.TransitGatewayPeeringAttachmentObservation
+	}{
+		"AllFilled": {
+			in: ec2types.TransitGatewayPeeringAttachment{
+				TransitGatewayAttachmentId: aws.String("tgw-attach-123"),
+				State:                      ec2types.TransitGatewayAttachmentStatePendingAcceptance,
+				Status:                     &ec2types.PeeringAttachmentStatus{Code: aws.String("pendingAcceptance"), Message: aws.String("Pending Acceptance")},
+				RequesterTgwInfo:           &ec2types.PeeringTgwInfo{OwnerId: aws.String("123"), Region: aws.String("us-east-1"), TransitGatewayId: aws.String("tgw-123")},
+				AccepterTgwInfo:            &ec2types.PeeringTgwInfo{OwnerId: aws.String("456"), Region: aws.String("eu-west-1"), TransitGatewayId: aws.String("tgw-456")},
+				CreationTime:               &created,
+			},
+			want: v1alpha1.TransitGatewayPeeringAttachmentObservation{
+				TransitGatewayAttachmentID: "tgw-attach-123",
+				State:                      "pendingAcceptance",
+				Status:                     &v1alpha1.PeeringAttachmentStatus{Code: aws.String("pendingAcceptance"), Message: aws.String("Pending Acceptance")},
+				RequesterTGWInfo:           &v1alpha1.PeeringTgwInfo{OwnerID: aws.String("123"), Region: aws.String("us-east-1"), TransitGatewayID: aws.String("tgw-123")},
+				AccepterTGWInfo:            &v1alpha1.PeeringTgwInfo{OwnerID: aws.String("456"), Region: aws.String("eu-west-1"), TransitGatewayID: aws.String("tgw-456")},
+				CreationTime:               &createdTime,
+			},
+		},
+		"Empty": {
+			in:   ec2types.TransitGatewayPeeringAttachment{},
+			want: v1alpha1.TransitGatewayPeeringAttachmentObservation{},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got := GenerateTransitGatewayPeeringAttachmentObservation(tc.in)
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestTransitGatewayPeeringAttachmentCondition(t *testing.T) {
+	cases := map[string]struct {
+		state ec2types.TransitGatewayAttachmentState
+		want  xpv1.Condition
+	}{
+		"Available": {
+			state: ec2types.TransitGatewayAttachmentStateAvailable,
+			want:  xpv1.Available(),
+		},
+		"PendingAcceptance": {
+			state: ec2types.TransitGatewayAttachmentStatePendingAcceptance,
+			want:  xpv1.Creating(),
+		},
+		"Deleting": {
+			state: ec2types.TransitGatewayAttachmentStateDeleting,
+			want:  xpv1.Deleting(),
+		},
+		"Rejected": {
+			state: ec2types.TransitGatewayAttachmentStateRejected,
+			want:  xpv1.Unavailable(),
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got := TransitGatewayPeeringAttachmentCondition(tc.state)
+			if diff := cmp.Diff(tc.want, got, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,316 @@
+/*
+Copyright 2023 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package ec2
+
+import (
+	"context"
+	"errors"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/ec2"
+	ec2types "github.com/aws/aws-sdk-go-v2/service/ec2/types"
+	"github.com/aws/smithy-go"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+
+	"github.com/crossplane-contrib/provider-aws/apis/ec2/v1alpha1"
+)
+
+const (
+	// TransitGatewayRouteTableNotFound is the code that is returned by ec2 when the given transit gateway route table is invalid
+	TransitGatewayRouteTableNotFound = "InvalidRouteTableID.NotFound"
+
+	// TransitGatewayAttachmentNotFound is the code that is returned by ec2 when the given transit gateway attachment is invalid
+	TransitGatewayAttachmentNotFound = "InvalidTransitGatewayAttachmentID.NotFound"
+
+	// transitGatewayAttachmentIDFilter is the name of the filter that selects
+	// the associations and propagations of a route table by attachment.
+	transitGatewayAttachmentIDFilter = "transit-gateway-attachment-id"
+)
+
+// TransitGatewayRouteTableAssociationClient is the external client used for
+// TransitGatewayRouteTableAssociation Custom Resource
+type TransitGatewayRouteTableAssociationClient interface {
+	AssociateTransitGatewayRouteTable(ctx context.Context, input *ec2.AssociateTransitGatewayRouteTableInput, opts ...func(*ec2.Options)) (*ec2.AssociateTransitGatewayRouteTableOutput, error)
+	DisassociateTransitGatewayRouteTable(ctx context.Context, input *ec2.DisassociateTransitGatewayRouteTableInput, opts ...func(*ec2.Options)) (*ec2.DisassociateTransitGatewayRouteTableOutput, error)
+	GetTransitGatewayRouteTableAssociations(ctx context.Context, input *ec2.GetTransitGatewayRouteTableAssociationsInput, opts ...func(*ec2.Options)) (*ec2.GetTransitGatewayRouteTableAssociationsOutput, error)
+}
+
+// NewTransitGatewayRouteTableAssociationClient returns a new client using AWS
+// credentials as JSON encoded data.
+func NewTransitGatewayRouteTableAssociationClient(cfg aws.Config) TransitGatewayRouteTableAssociationClient {
+	return ec2.NewFromConfig(cfg)
+}
+
+// TransitGatewayRouteTablePropagationClient is the external client used for
+// TransitGatewayRouteTablePropagation Custom Resource
+type TransitGatewayRouteTablePropagationClient interface {
+	EnableTransitGatewayRouteTablePropagation(ctx context.Context, input *ec2.EnableTransitGatewayRouteTablePropagationInput, opts ...func(*ec2.Options)) (*ec2.EnableTransitGatewayRouteTablePropagationOutput, error)
+	DisableTransitGatewayRouteTablePropagation(ctx context.Context, input *ec2.DisableTransitGatewayRouteTablePropagationInput, opts ...func(*ec2.Options)) (*ec2.DisableTransitGatewayRouteTablePropagationOutput, error)
+	GetTransitGatewayRouteTablePropagations(ctx context.Context, input *ec2.GetTransitGatewayRouteTablePropagationsInput, opts ...func(*ec2.Options)) (*ec2.GetTransitGatewayRouteTablePropagationsOutput, error)
+}
+
+// NewTransitGatewayRouteTablePropagationClient returns a new client using AWS
+// credentials as JSON encoded data.
+func NewTransitGatewayRouteTablePropagationClient(cfg aws.Config) TransitGatewayRouteTablePropagationClient {
+	return ec2.NewFromConfig(cfg)
+}
+
+// IsTransitGatewayRouteTableNotFoundErr returns true if the error is because
+// the transit gateway route table doesn't exist
+func IsTransitGatewayRouteTableNotFoundErr(err error) bool {
+	var awsErr smithy.APIError
+	return errors.As(err, &awsErr) && awsErr.ErrorCode() == TransitGatewayRouteTableNotFound
+}
+
+// IsTransitGatewayAttachmentNotFoundErr returns true if the error is because
+// the transit gateway attachment doesn't exist
+func IsTransitGatewayAttachmentNotFoundErr(err error) bool {
+	var awsErr smithy.APIError
+	return errors.As(err, &awsErr) && awsErr.ErrorCode() == TransitGatewayAttachmentNotFound
+}
+
+// GenerateTransitGatewayAttachmentFilters returns the filters that select the
+// associations or propagations of the given attachment.
+func GenerateTransitGatewayAttachmentFilters(attachmentID string) []ec2types.Filter {
+	return []ec2types.Filter{{
+		Name:   aws.String(transitGatewayAttachmentIDFilter),
+		Values: []string{attachmentID},
+	}}
+}
+
+// TransitGatewayRouteTableLink is the association or the propagation of a
+// transit gateway attachment with a transit gateway route table.
+type TransitGatewayRouteTableLink struct {
+	ResourceID   string
+	ResourceType string
+	State        string
+}
+
+// TransitGatewayRouteTableLinkStates are the values of the state enum of
+// either the associations or the propagations.
+type TransitGatewayRouteTableLinkStates struct {
+	Available string
+	Pending   string
+	Deleting  string
+	Deleted   string
+}
+
+// TransitGatewayRouteTableLinkClient creates, describes and deletes either the
+// associations or the propagations of transit gateway route tables.
+type TransitGatewayRouteTableLinkClient interface {
+	GetLink(ctx context.Context, routeTableID, attachmentID *string) (*TransitGatewayRouteTableLink, error)
+	CreateLink(ctx context.Context, routeTableID, attachmentID *string) error
+	DeleteLink(ctx context.Context, routeTableID, attachmentID *string) error
+	States() TransitGatewayRouteTableLinkStates
+}
+
+// NewTransitGatewayRouteTableAssociationLinkClient returns a
+// TransitGatewayRouteTableLinkClient that manages associations.
+func NewTransitGatewayRouteTableAssociationLinkClient(c TransitGatewayRouteTableAssociationClient) TransitGatewayRouteTableLinkClient {
+	return &associationLinkClient{client: c}
+}
+
+type associationLinkClient struct {
+	client TransitGatewayRouteTableAssociationClient
+}
+
+func (c *associationLinkClient) GetLink(ctx context.Context, routeTableID, attachmentID *string) (*TransitGatewayRouteTableLink, error) {
+	response, err := c.client.GetTransitGatewayRouteTableAssociations(ctx, &ec2.GetTransitGatewayRouteTableAssociationsInput{
+		TransitGatewayRouteTableId: routeTableID,
+		Filters:                    GenerateTransitGatewayAttachmentFilters(aws.ToString(attachmentID)),
+	})
+	if err != nil {
+		return nil, err
+	}
+	a := FindTransitGatewayRouteTableAssociation(response.Associations, aws.ToString(attachmentID))
+	if a == nil {
+		return nil, nil
+	}
+	return &TransitGatewayRouteTableLink{
+		ResourceID:   aws.ToString(a.ResourceId),
+		ResourceType: string(a.ResourceType),
+		State:        string(a.State),
+	}, nil
+}
+
+func (c *associationLinkClient) CreateLink(ctx context.Context, routeTableID, attachmentID *string) error {
+	_, err := c.client.AssociateTransitGatewayRouteTable(ctx, &ec2.AssociateTransitGatewayRouteTableInput{
+		TransitGatewayRouteTableId: routeTableID,
+		TransitGatewayAttachmentId: attachmentID,
+	})
+	return err
+}
+
+func (c *associationLinkClient) DeleteLink(ctx context.Context, routeTableID, attachmentID *string) error {
+	_, err := c.client.DisassociateTransitGatewayRouteTable(ctx, &ec2.DisassociateTransitGatewayRouteTableInput{
+		TransitGatewayRouteTableId: routeTableID,
+		TransitGatewayAttachmentId: attachmentID,
+	})
+	return err
+}
+
+func (c *associationLinkClient) States() TransitGatewayRouteTableLinkStates {
+	return TransitGatewayRouteTableLinkStates{
+		Available: string(ec2types.TransitGatewayAssociationStateAssociated),
+		Pending:   string(ec2types.TransitGatewayAssociationStateAssociating),
+		Deleting:  string(ec2types.TransitGatewayAssociationStateDisassociating),
+		Deleted:   string(ec2types.TransitGatewayAssociationStateDisassociated),
+	}
+}
+
+// NewTransitGatewayRouteTablePropagationLinkClient returns a
+// TransitGatewayRouteTableLinkClient that manages propagations.
+func NewTransitGatewayRouteTablePropagationLinkClient(c TransitGatewayRouteTablePropagationClient) TransitGatewayRouteTableLinkClient {
+	return &propagationLinkClient{client: c}
+}
+
+type propagationLinkClient struct {
+	client TransitGatewayRouteTablePropagationClient
+}
+
+func (c *propagationLinkClient) GetLink(ctx context.Context, routeTableID, attachmentID *string) (*TransitGatewayRouteTableLink, error) {
+	response, err := c.client.GetTransitGatewayRouteTablePropagations(ctx, &ec2.GetTransitGatewayRouteTablePropagationsInput{
+		TransitGatewayRouteTableId: routeTableID,
+		Filters:                    GenerateTransitGatewayAttachmentFilters(aws.ToString(attachmentID)),
+	})
+	if err != nil {
+		return nil, err
+	}
+	p := FindTransitGatewayRouteTablePropagation(response.TransitGatewayRouteTablePropagations, aws.ToString(attachmentID))
+	if p == nil {
+		return nil, nil
+	}
+	return &TransitGatewayRouteTableLink{
+		ResourceID:   aws.ToString(p.ResourceId),
+		ResourceType: string(p.ResourceType),
+		State:        string(p.State),
+	}, nil
+}
+
+func (c *propagationLinkClient) CreateLink(ctx context.Context, routeTableID, attachmentID *string) error {
+	_, err := c.client.EnableTransitGatewayRouteTablePropagation(ctx, &ec2.EnableTransitGatewayRouteTablePropagationInput{
+		TransitGatewayRouteTableId: routeTableID,
+		TransitGatewayAttachmentId: attachmentID,
+	})
+	return err
+}
+
+func (c *propagationLinkClient) DeleteLink(ctx context.Context, routeTableID, attachmentID *string) error {
+	_, err := c.client.DisableTransitGatewayRouteTablePropagation(ctx, &ec2.DisableTransitGatewayRouteTablePropagationInput{
+		TransitGatewayRouteTableId: routeTableID,
+		TransitGatewayAttachmentId: attachmentID,
+	})
+	return err
+}
+
+func (c *propagationLinkClient) States() TransitGatewayRouteTableLinkStates {
+	return TransitGatewayRouteTableLinkStates{
+		Available: string(ec2types.TransitGatewayPropagationStateEnabled),
+		Pending:   string(ec2types.TransitGatewayPropagationStateEnabling),
+		Deleting:  string(ec2types.TransitGatewayPropagationStateDisabling),
+		Deleted:   string(ec2types.TransitGatewayPropagationStateDisabled),
+	}
+}
+
+// ObserveTransitGatewayRouteTableLink returns the association or the
+// propagation of the given attachment with the given route table and sets the
+// conditions of mg from its state. It returns nil if there is none, if it is
+// deleted or if the route table does not exist.
+func ObserveTransitGatewayRouteTableLink(ctx context.Context, c TransitGatewayRouteTableLinkClient, mg resource.Managed, routeTableID, attachmentID *string) (*TransitGatewayRouteTableLink, error) {
+	link, err := c.GetLink(ctx, routeTableID, attachmentID)
+	if err != nil {
+		if IsTransitGatewayRouteTableNotFoundErr(err) {
+			return nil, nil
+		}
+		return nil, err
+	}
+	states := c.States()
+	if link == nil || link.State == states.Deleted {
+		return nil, nil
+	}
+
+	switch link.State {
+	case states.Available:
+		mg.SetConditions(xpv1.Available())
+	case states.Pending:
+		mg.SetConditions(xpv1.Creating())
+	case states.Deleting:
+		mg.SetConditions(xpv1.Deleting())
+	}
+	return link, nil
+}
+
+// DeleteTransitGatewayRouteTableLink deletes the association or the
+// propagation of the given attachment with the given route table unless its
+// observed state shows that it is already being deleted. It ignores the errors
+// returned when the route table or the attachment does not exist anymore.
+func DeleteTransitGatewayRouteTableLink(ctx context.Context, c TransitGatewayRouteTableLinkClient, routeTableID, attachmentID *string, state string) error {
+	if state == c.States().Deleting {
+		return nil
+	}
+	err := c.DeleteLink(ctx, routeTableID, attachmentID)
+	if IsTransitGatewayRouteTableNotFoundErr(err) || IsTransitGatewayAttachmentNotFoundErr(err) {
+		return nil
+	}
+	return err
+}
+
+// FindTransitGatewayRouteTableAssociation returns the association of the
+// given attachment, or nil if there is none.
+func FindTransitGatewayRouteTableAssociation(associations []ec2types.TransitGatewayRouteTableAssociation, attachmentID string) *ec2types.TransitGatewayRouteTableAssociation {
+	for i := range associations {
+		if aws.ToString(associations[i].TransitGatewayAttachmentId) == attachmentID {
+			return &associations[i]
+		}
+	}
+	return nil
+}
+
+// FindTransitGatewayRouteTablePropagation returns the propagation of the
+// given attachment, or nil if there is none.
+func FindTransitGatewayRouteTablePropagation(propagations []ec2types.TransitGatewayRouteTablePropagation, attachmentID string) *ec2types.TransitGatewayRouteTablePropagation {
+	for i := range propagations {
+		if aws.ToString(propagations[i].TransitGatewayAttachmentId) == attachmentID {
+			return &propagations[i]
+		}
+	}
+	return nil
+}
+
+// GenerateTransitGatewayRouteTableAssociationObservation is used to produce
+// v1alpha1.TransitGatewayRouteTableAssociationObservation from
+// TransitGatewayRouteTableLink.
+func GenerateTransitGatewayRouteTableAssociationObservation(l TransitGatewayRouteTableLink) v1alpha1.TransitGatewayRouteTableAssociationObservation {
+	return v1alpha1.TransitGatewayRouteTableAssociationObservation{
+		ResourceID:   l.ResourceID,
+		ResourceType: l.ResourceType,
+		State:        l.State,
+	}
+}
+
+// GenerateTransitGatewayRouteTablePropagationObservation is used to produce
+// v1alpha1.TransitGatewayRouteTablePropagationObservation from
+// TransitGatewayRouteTableLink.
+func GenerateTransitGatewayRouteTablePropagationObservation(l TransitGatewayRouteTableLink) v1alpha1.TransitGatewayRouteTablePropagationObservation {
+	return v1alpha1.TransitGatewayRouteTablePropagationObservation{
+		ResourceID:   l.ResourceID,
+		ResourceType: l.ResourceType,
+		State:        l.State,
+	}
+}
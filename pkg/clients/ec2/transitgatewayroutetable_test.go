@@ -0,0 +1,272 @@
+/*
+Copyright 2023 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package ec2_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	awsec2 "github.com/aws/aws-sdk-go-v2/service/ec2"
+	awsec2types "github.com/aws/aws-sdk-go-v2/service/ec2/types"
+	"github.com/aws/smithy-go"
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+	"github.com/pkg/errors"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+	"github.com/crossplane/crossplane-runtime/pkg/resource/fake"
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+
+	"github.com/crossplane-contrib/provider-aws/pkg/clients/ec2"
+	ec2fake "github.com/crossplane-contrib/provider-aws/pkg/clients/ec2/fake"
+)
+
+const (
+	routeTableID = "tgw-rtb-123"
+	attachmentID = "tgw-attach-123"
+	vpcID        = "vpc-123"
+)
+
+var errBoom = errors.New("boom")
+
+type linkOp func(ctx context.Context, c ec2.TransitGatewayRouteTableLinkClient, mg resource.Managed) (*ec2.TransitGatewayRouteTableLink, error)
+
+func observe(ctx context.Context, c ec2.TransitGatewayRouteTableLinkClient, mg resource.Managed) (*ec2.TransitGatewayRouteTableLink, error) {
+	return ec2.ObserveTransitGatewayRouteTableLink(ctx, c, mg, aws.String(routeTableID), aws.String(attachmentID))
+}
+
+func create(ctx context.Context, c ec2.TransitGatewayRouteTableLinkClient, _ resource.Managed) (*ec2.TransitGatewayRouteTableLink, error) {
+	return nil, c.CreateLink(ctx, aws.String(routeTableID), aws.String(attachmentID))
+}
+
+func remove(state string) linkOp {
+	return func(ctx context.Context, c ec2.TransitGatewayRouteTableLinkClient, _ resource.Managed) (*ec2.TransitGatewayRouteTableLink, error) {
+		return nil, ec2.DeleteTransitGatewayRouteTableLink(ctx, c, aws.String(routeTableID), aws.String(attachmentID), state)
+	}
+}
+
+func getAssociations(state awsec2types.TransitGatewayAssociationState) func(context.Context, *awsec2.GetTransitGatewayRouteTableAssociationsInput, []func(*awsec2.Options)) (*awsec2.GetTransitGatewayRouteTableAssociationsOutput, error) {
+	return func(_ context.Context, _ *awsec2.GetTransitGatewayRouteTableAssociationsInput, _ []func(*awsec2.Options)) (*awsec2.GetTransitGatewayRouteTableAssociationsOutput, error) {
+		if state == "" {
+			return &awsec2.GetTransitGatewayRouteTableAssociationsOutput{}, nil
+		}
+		return &awsec2.GetTransitGatewayRouteTableAssociationsOutput{
+			Associations: []awsec2types.TransitGatewayRouteTableAssociation{{
+				TransitGatewayAttachmentId: aws.String(attachmentID),
+				ResourceId:                 aws.String(vpcID),
+				ResourceType:               awsec2types.TransitGatewayAttachmentResourceTypeVpc,
+				State:                      state,
+			}},
+		}, nil
+	}
+}
+
+func getPropagations(state awsec2types.TransitGatewayPropagationState) func(context.Context, *awsec2.GetTransitGatewayRouteTablePropagationsInput, []func(*awsec2.Options)) (*awsec2.GetTransitGatewayRouteTablePropagationsOutput, error) {
+	return func(_ context.Context, _ *awsec2.GetTransitGatewayRouteTablePropagationsInput, _ []func(*awsec2.Options)) (*awsec2.GetTransitGatewayRouteTablePropagationsOutput, error) {
+		if state == "" {
+			return &awsec2.GetTransitGatewayRouteTablePropagationsOutput{}, nil
+		}
+		return &awsec2.GetTransitGatewayRouteTablePropagationsOutput{
+			TransitGatewayRouteTablePropagations: []awsec2types.TransitGatewayRouteTablePropagation{{
+				TransitGatewayAttachmentId: aws.String(attachmentID),
+				ResourceId:                 aws.String(vpcID),
+				ResourceType:               awsec2types.TransitGatewayAttachmentResourceTypeVpc,
+				State:                      state,
+			}},
+		}, nil
+	}
+}
+
+func TestTransitGatewayRouteTableLink(t *testing.T) {
+	type args struct {
+		client ec2.TransitGatewayRouteTableLinkClient
+		op     linkOp
+	}
+	type want struct {
+		link       *ec2.TransitGatewayRouteTableLink
+		conditions []xpv1.Condition
+		err        error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"AssociationAvailable": {
+			args: args{
+				client: ec2.NewTransitGatewayRouteTableAssociationLinkClient(&ec2fake.MockTransitGatewayRouteTableAssociationClient{
+					MockGetAssociations: getAssociations(awsec2types.TransitGatewayAssociationStateAssociated),
+				}),
+				op: observe,
+			},
+			want: want{
+				link:       &ec2.TransitGatewayRouteTableLink{ResourceID: vpcID, ResourceType: "vpc", State: "associated"},
+				conditions: []xpv1.Condition{xpv1.Available()},
+			},
+		},
+		"PropagationPending": {
+			args: args{
+				client: ec2.NewTransitGatewayRouteTablePropagationLinkClient(&ec2fake.MockTransitGatewayRouteTablePropagationClient{
+					MockGetPropagations: getPropagations(awsec2types.TransitGatewayPropagationStateEnabling),
+				}),
+				op: observe,
+			},
+			want: want{
+				link:       &ec2.TransitGatewayRouteTableLink{ResourceID: vpcID, ResourceType: "vpc", State: "enabling"},
+				conditions: []xpv1.Condition{xpv1.Creating()},
+			},
+		},
+		"PropagationDeleting": {
+			args: args{
+				client: ec2.NewTransitGatewayRouteTablePropagationLinkClient(&ec2fake.MockTransitGatewayRouteTablePropagationClient{
+					MockGetPropagations: getPropagations(awsec2types.TransitGatewayPropagationStateDisabling),
+				}),
+				op: observe,
+			},
+			want: want{
+				link:       &ec2.TransitGatewayRouteTableLink{ResourceID: vpcID, ResourceType: "vpc", State: "disabling"},
+				conditions: []xpv1.Condition{xpv1.Deleting()},
+			},
+		},
+		"AssociationDeleted": {
+			args: args{
+				client: ec2.NewTransitGatewayRouteTableAssociationLinkClient(&ec2fake.MockTransitGatewayRouteTableAssociationClient{
+					MockGetAssociations: getAssociations(awsec2types.TransitGatewayAssociationStateDisassociated),
+				}),
+				op: observe,
+			},
+		},
+		"PropagationNotFound": {
+			args: args{
+				client: ec2.NewTransitGatewayRouteTablePropagationLinkClient(&ec2fake.MockTransitGatewayRouteTablePropagationClient{
+					MockGetPropagations: getPropagations(""),
+				}),
+				op: observe,
+			},
+		},
+		"RouteTableNotFound": {
+			args: args{
+				client: ec2.NewTransitGatewayRouteTableAssociationLinkClient(&ec2fake.MockTransitGatewayRouteTableAssociationClient{
+					MockGetAssociations: func(_ context.Context, _ *awsec2.GetTransitGatewayRouteTableAssociationsInput, _ []func(*awsec2.Options)) (*awsec2.GetTransitGatewayRouteTableAssociationsOutput, error) {
+						return nil, &smithy.GenericAPIError{Code: ec2.TransitGatewayRouteTableNotFound}
+					},
+				}),
+				op: observe,
+			},
+		},
+		"GetFailed": {
+			args: args{
+				client: ec2.NewTransitGatewayRouteTablePropagationLinkClient(&ec2fake.MockTransitGatewayRouteTablePropagationClient{
+					MockGetPropagations: func(_ context.Context, _ *awsec2.GetTransitGatewayRouteTablePropagationsInput, _ []func(*awsec2.Options)) (*awsec2.GetTransitGatewayRouteTablePropagationsOutput, error) {
+						return nil, errBoom
+					},
+				}),
+				op: observe,
+			},
+			want: want{
+				err: errBoom,
+			},
+		},
+		"AssociationCreate": {
+			args: args{
+				client: ec2.NewTransitGatewayRouteTableAssociationLinkClient(&ec2fake.MockTransitGatewayRouteTableAssociationClient{
+					MockAssociate: func(_ context.Context, input *awsec2.AssociateTransitGatewayRouteTableInput, _ []func(*awsec2.Options)) (*awsec2.AssociateTransitGatewayRouteTableOutput, error) {
+						if diff := cmp.Diff(&awsec2.AssociateTransitGatewayRouteTableInput{TransitGatewayRouteTableId: aws.String(routeTableID), TransitGatewayAttachmentId: aws.String(attachmentID)}, input, cmpopts.IgnoreUnexported(awsec2.AssociateTransitGatewayRouteTableInput{})); diff != "" {
+							t.Errorf("r: -want, +got:\n%s", diff)
+						}
+						return &awsec2.AssociateTransitGatewayRouteTableOutput{}, nil
+					},
+				}),
+				op: create,
+			},
+		},
+		"PropagationCreateFailed": {
+			args: args{
+				client: ec2.NewTransitGatewayRouteTablePropagationLinkClient(&ec2fake.MockTransitGatewayRouteTablePropagationClient{
+					MockEnable: func(_ context.Context, _ *awsec2.EnableTransitGatewayRouteTablePropagationInput, _ []func(*awsec2.Options)) (*awsec2.EnableTransitGatewayRouteTablePropagationOutput, error) {
+						return nil, errBoom
+					},
+				}),
+				op: create,
+			},
+			want: want{
+				err: errBoom,
+			},
+		},
+		"PropagationDelete": {
+			args: args{
+				client: ec2.NewTransitGatewayRouteTablePropagationLinkClient(&ec2fake.MockTransitGatewayRouteTablePropagationClient{
+					MockDisable: func(_ context.Context, input *awsec2.DisableTransitGatewayRouteTablePropagationInput, _ []func(*awsec2.Options)) (*awsec2.DisableTransitGatewayRouteTablePropagationOutput, error) {
+						if diff := cmp.Diff(&awsec2.DisableTransitGatewayRouteTablePropagationInput{TransitGatewayRouteTableId: aws.String(routeTableID), TransitGatewayAttachmentId: aws.String(attachmentID)}, input, cmpopts.IgnoreUnexported(awsec2.DisableTransitGatewayRouteTablePropagationInput{})); diff != "" {
+							t.Errorf("r: -want, +got:\n%s", diff)
+						}
+						return &awsec2.DisableTransitGatewayRouteTablePropagationOutput{}, nil
+					},
+				}),
+				op: remove("enabled"),
+			},
+		},
+		"AssociationAlreadyDeleting": {
+			args: args{
+				client: ec2.NewTransitGatewayRouteTableAssociationLinkClient(&ec2fake.MockTransitGatewayRouteTableAssociationClient{}),
+				op:     remove("disassociating"),
+			},
+		},
+		"AssociationAttachmentNotFound": {
+			args: args{
+				client: ec2.NewTransitGatewayRouteTableAssociationLinkClient(&ec2fake.MockTransitGatewayRouteTableAssociationClient{
+					MockDisassociate: func(_ context.Context, _ *awsec2.DisassociateTransitGatewayRouteTableInput, _ []func(*awsec2.Options)) (*awsec2.DisassociateTransitGatewayRouteTableOutput, error) {
+						return nil, &smithy.GenericAPIError{Code: ec2.TransitGatewayAttachmentNotFound}
+					},
+				}),
+				op: remove("associated"),
+			},
+		},
+		"PropagationDeleteFailed": {
+			args: args{
+				client: ec2.NewTransitGatewayRouteTablePropagationLinkClient(&ec2fake.MockTransitGatewayRouteTablePropagationClient{
+					MockDisable: func(_ context.Context, _ *awsec2.DisableTransitGatewayRouteTablePropagationInput, _ []func(*awsec2.Options)) (*awsec2.DisableTransitGatewayRouteTablePropagationOutput, error) {
+						return nil, errBoom
+					},
+				}),
+				op: remove("enabled"),
+			},
+			want: want{
+				err: errBoom,
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			mg := &fake.Managed{}
+			link, err := tc.args.op(context.Background(), tc.args.client, mg)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.link, link); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.conditions, mg.Conditions, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
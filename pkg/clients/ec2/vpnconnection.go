@@ -0,0 +1,253 @@
+/*
+Copyright 2023 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package ec2
+
+import (
+	"context"
+	"encoding/xml"
+	"errors"
+	"fmt"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/ec2"
+	ec2types "github.com/aws/aws-sdk-go-v2/service/ec2/types"
+	"github.com/aws/smithy-go"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+
+	"github.com/crossplane-contrib/provider-aws/apis/ec2/manualv1alpha1"
+	awsclients "github.com/crossplane-contrib/provider-aws/pkg/clients"
+)
+
+const (
+	// VPNConnectionNotFound is the code that is returned by ec2 when the
+	// given VPN connection ID is invalid
+	VPNConnectionNotFound = "InvalidVpnConnectionID.NotFound"
+)
+
+const (
+	// vpnCustomerGatewayConfigurationKey is the key of the
+	// configuration of the customer gateway device in the connection secret.
+	vpnCustomerGatewayConfigurationKey = "customerGatewayConfiguration"
+
+	// vpnTunnelAddressKeyFmt and vpnTunnelPreSharedKeyKeyFmt are the formats
+	// of the keys of the outside IP address and the pre-shared key of the
+	// n-th tunnel in the connection secret, e.g. tunnel1Address.
+	vpnTunnelAddressKeyFmt      = "tunnel%dAddress"
+	vpnTunnelPreSharedKeyKeyFmt = "tunnel%dPreSharedKey"
+)
+
+// VPNConnectionClient is the external client used for VPNConnection Custom Resource
+type VPNConnectionClient interface {
+	CreateVpnConnection(ctx context.Context, input *ec2.CreateVpnConnectionInput, opts ...func(*ec2.Options)) (*ec2.CreateVpnConnectionOutput, error)
+	DescribeVpnConnections(ctx context.Context, input *ec2.DescribeVpnConnectionsInput, opts ...func(*ec2.Options)) (*ec2.DescribeVpnConnectionsOutput, error)
+	DeleteVpnConnection(ctx context.Context, input *ec2.DeleteVpnConnectionInput, opts ...func(*ec2.Options)) (*ec2.DeleteVpnConnectionOutput, error)
+	CreateVpnConnectionRoute(ctx context.Context, input *ec2.CreateVpnConnectionRouteInput, opts ...func(*ec2.Options)) (*ec2.CreateVpnConnectionRouteOutput, error)
+	DeleteVpnConnectionRoute(ctx context.Context, input *ec2.DeleteVpnConnectionRouteInput, opts ...func(*ec2.Options)) (*ec2.DeleteVpnConnectionRouteOutput, error)
+	CreateTags(ctx context.Context, input *ec2.CreateTagsInput, opts ...func(*ec2.Options)) (*ec2.CreateTagsOutput, error)
+	DeleteTags(ctx context.Context, input *ec2.DeleteTagsInput, opts ...func(*ec2.Options)) (*ec2.DeleteTagsOutput, error)
+}
+
+// NewVPNConnectionClient returns a new client using AWS credentials as JSON encoded data.
+func NewVPNConnectionClient(cfg aws.Config) VPNConnectionClient {
+	return ec2.NewFromConfig(cfg)
+}
+
+// IsVPNConnectionNotFoundErr returns true if the error is because the VPN
+// connection doesn't exist
+func IsVPNConnectionNotFoundErr(err error) bool {
+	var awsErr smithy.APIError
+	return errors.As(err, &awsErr) && awsErr.ErrorCode() == VPNConnectionNotFound
+}
+
+// GenerateCreateVPNConnectionInput returns the input to create a VPN
+// connection.
+func GenerateCreateVPNConnectionInput(p manualv1alpha1.VPNConnectionParameters) *ec2.CreateVpnConnectionInput {
+	input := &ec2.CreateVpnConnectionInput{
+		Type:              aws.String(p.Type),
+		CustomerGatewayId: p.CustomerGatewayID,
+		VpnGatewayId:      p.VPNGatewayID,
+		TransitGatewayId:  p.TransitGatewayID,
+		TagSpecifications: generateVPNTagSpecifications(ec2types.ResourceTypeVpnConnection, p.Tags),
+	}
+	if p.StaticRoutesOnly != nil {
+		input.Options = &ec2types.VpnConnectionOptionsSpecification{
+			StaticRoutesOnly: p.StaticRoutesOnly,
+		}
+	}
+	return input
+}
+
+// GenerateVPNConnectionObservation is used to produce
+// manualv1alpha1.VPNConnectionObservation from ec2types.VpnConnection.
+func GenerateVPNConnectionObservation(c ec2types.VpnConnection) manualv1alpha1.VPNConnectionObservation {
+	o := manualv1alpha1.VPNConnectionObservation{
+		VPNConnectionID: aws.ToString(c.VpnConnectionId),
+		State:           string(c.State),
+		Category:        aws.ToString(c.Category),
+	}
+	for _, r := range c.Routes {
+		o.Routes = append(o.Routes, manualv1alpha1.VPNStaticRoute{
+			DestinationCIDRBlock: aws.ToString(r.DestinationCidrBlock),
+			State:                string(r.State),
+		})
+	}
+	for _, t := range c.VgwTelemetry {
+		tunnel := manualv1alpha1.VPNTunnelTelemetry{
+			OutsideIPAddress:   aws.ToString(t.OutsideIpAddress),
+			Status:             string(t.Status),
+			StatusMessage:      aws.ToString(t.StatusMessage),
+			AcceptedRouteCount: aws.ToInt32(t.AcceptedRouteCount),
+		}
+		if t.LastStatusChange != nil {
+			tunnel.LastStatusChange = &metav1.Time{Time: *t.LastStatusChange}
+		}
+		o.Tunnels = append(o.Tunnels, tunnel)
+	}
+	return o
+}
+
+// LateInitializeVPNConnection fills the empty fields in
+// *manualv1alpha1.VPNConnectionParameters with the values seen in
+// ec2types.VpnConnection.
+func LateInitializeVPNConnection(in *manualv1alpha1.VPNConnectionParameters, c *ec2types.VpnConnection) {
+	if c == nil {
+		return
+	}
+	if c.Options != nil {
+		in.StaticRoutesOnly = awsclients.LateInitializeBoolPtr(in.StaticRoutesOnly, c.Options.StaticRoutesOnly)
+	}
+	if len(in.Tags) == 0 && len(c.Tags) != 0 {
+		in.Tags = manualv1alpha1.BuildFromEC2Tags(c.Tags)
+	}
+}
+
+// DiffVPNConnectionRoutes returns the destination CIDR blocks of the static
+// routes that have to be created and deleted. Routes that are already being
+// deleted are ignored.
+func DiffVPNConnectionRoutes(desired []string, observed []ec2types.VpnStaticRoute) (create, remove []string) {
+	current := map[string]bool{}
+	for _, r := range observed {
+		if r.State == ec2types.VpnStateDeleting || r.State == ec2types.VpnStateDeleted {
+			continue
+		}
+		current[aws.ToString(r.DestinationCidrBlock)] = true
+	}
+	d := make(map[string]bool, len(desired))
+	for _, cidr := range desired {
+		d[cidr] = true
+		if !current[cidr] {
+			create = append(create, cidr)
+		}
+	}
+	for _, r := range observed {
+		cidr := aws.ToString(r.DestinationCidrBlock)
+		if current[cidr] && !d[cidr] {
+			remove = append(remove, cidr)
+			current[cidr] = false
+		}
+	}
+	return create, remove
+}
+
+// IsVPNConnectionUpToDate checks whether there is a change in any of the
+// modifiable fields.
+func IsVPNConnectionUpToDate(p manualv1alpha1.VPNConnectionParameters, c ec2types.VpnConnection) bool {
+	add, remove := awsclients.DiffEC2Tags(manualv1alpha1.GenerateEC2Tags(p.Tags), c.Tags)
+	if len(add) != 0 || len(remove) != 0 {
+		return false
+	}
+	create, del := DiffVPNConnectionRoutes(p.StaticRoutes, c.Routes)
+	return len(create) == 0 && len(del) == 0
+}
+
+// vpnTunnel holds the details of a VPN tunnel that are published to the
+// connection secret.
+type vpnTunnel struct {
+	OutsideIPAddress string `xml:"vpn_gateway>tunnel_outside_address>ip_address"`
+	PreSharedKey     string `xml:"ike>pre_shared_key"`
+}
+
+// customerGatewayConfiguration is the part of the XML configuration of the
+// customer gateway device that describes the tunnels.
+type customerGatewayConfiguration struct {
+	Tunnels []vpnTunnel `xml:"ipsec_tunnel"`
+}
+
+// generateVPNTunnels returns the outside IP addresses and the pre-shared keys
+// of the tunnels of the given VPN connection. The pre-shared keys that are
+// missing from the tunnel options are taken from the configuration of the
+// customer gateway device.
+func generateVPNTunnels(c ec2types.VpnConnection) []vpnTunnel {
+	var tunnels []vpnTunnel
+	complete := true
+	if c.Options != nil {
+		for _, t := range c.Options.TunnelOptions {
+			tunnels = append(tunnels, vpnTunnel{
+				OutsideIPAddress: aws.ToString(t.OutsideIpAddress),
+				PreSharedKey:     aws.ToString(t.PreSharedKey),
+			})
+			complete = complete && t.PreSharedKey != nil
+		}
+	}
+	if (len(tunnels) > 0 && complete) || aws.ToString(c.CustomerGatewayConfiguration) == "" {
+		return tunnels
+	}
+	cfg := customerGatewayConfiguration{}
+	if err := xml.Unmarshal([]byte(aws.ToString(c.CustomerGatewayConfiguration)), &cfg); err != nil {
+		return tunnels
+	}
+	if len(tunnels) == 0 {
+		return cfg.Tunnels
+	}
+	for i := range tunnels {
+		if tunnels[i].PreSharedKey != "" {
+			continue
+		}
+		for _, t := range cfg.Tunnels {
+			if t.OutsideIPAddress == tunnels[i].OutsideIPAddress {
+				tunnels[i].PreSharedKey = t.PreSharedKey
+				break
+			}
+		}
+	}
+	return tunnels
+}
+
+// GetVPNConnectionDetails extracts managed.ConnectionDetails out of
+// ec2types.VpnConnection. The outside IP address and the pre-shared key of
+// the n-th tunnel are published as tunnel<n>Address and
+// tunnel<n>PreSharedKey.
+func GetVPNConnectionDetails(c ec2types.VpnConnection) managed.ConnectionDetails {
+	cd := managed.ConnectionDetails{}
+	for i, t := range generateVPNTunnels(c) {
+		if t.OutsideIPAddress != "" {
+			cd[fmt.Sprintf(vpnTunnelAddressKeyFmt, i+1)] = []byte(t.OutsideIPAddress)
+		}
+		if t.PreSharedKey != "" {
+			cd[fmt.Sprintf(vpnTunnelPreSharedKeyKeyFmt, i+1)] = []byte(t.PreSharedKey)
+		}
+	}
+	if cfg := aws.ToString(c.CustomerGatewayConfiguration); cfg != "" {
+		cd[vpnCustomerGatewayConfigurationKey] = []byte(cfg)
+	}
+	if len(cd) == 0 {
+		return nil
+	}
+	return cd
+}
@@ -0,0 +1,208 @@
+/*
+Copyright 2023 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package ec2
+
+import (
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	ec2types "github.com/aws/aws-sdk-go-v2/service/ec2/types"
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+
+	"github.com/crossplane-contrib/provider-aws/apis/ec2/manualv1alpha1"
+)
+
+const testCustomerGatewayConfiguration = `<?xml version="1.0" encoding="UTF-8"?>
+<vpn_connection id="vpn-1">
+  <ipsec_tunnel>
+    <vpn_gateway>
+      <tunnel_outside_address>
+        <ip_address>1.1.1.1</ip_address>
+      </tunnel_outside_address>
+    </vpn_gateway>
+    <ike>
+      <pre_shared_key>key1</pre_shared_key>
+    </ike>
+  </ipsec_tunnel>
+  <ipsec_tunnel>
+    <vpn_gateway>
+      <tunnel_outside_address>
+        <ip_address>2.2.2.2</ip_address>
+      </tunnel_outside_address>
+    </vpn_gateway>
+    <ike>
+      <pre_shared_key>key2</pre_shared_key>
+    </ike>
+  </ipsec_tunnel>
+</vpn_connection>`
+
+func TestGetVPNConnectionDetails(t *testing.T) {
+	cases := map[string]struct {
+		in   ec2types.VpnConnection
+		want managed.ConnectionDetails
+	}{
+		"Empty": {
+			in: ec2types.VpnConnection{},
+		},
+		"TunnelOptions": {
+			in: ec2types.VpnConnection{
+				Options: &ec2types.VpnConnectionOptions{
+					TunnelOptions: []ec2types.TunnelOption{
+						{OutsideIpAddress: aws.String("1.1.1.1"), PreSharedKey: aws.String("key1")},
+						{OutsideIpAddress: aws.String("2.2.2.2"), PreSharedKey: aws.String("key2")},
+					},
+				},
+			},
+			want: managed.ConnectionDetails{
+				"tunnel1Address":      []byte("1.1.1.1"),
+				"tunnel1PreSharedKey": []byte("key1"),
+				"tunnel2Address":      []byte("2.2.2.2"),
+				"tunnel2PreSharedKey": []byte("key2"),
+			},
+		},
+		"PreSharedKeysFromConfiguration": {
+			in: ec2types.VpnConnection{
+				CustomerGatewayConfiguration: aws.String(testCustomerGatewayConfiguration),
+				Options: &ec2types.VpnConnectionOptions{
+					TunnelOptions: []ec2types.TunnelOption{
+						{OutsideIpAddress: aws.String("2.2.2.2")},
+						{OutsideIpAddress: aws.String("1.1.1.1")},
+					},
+				},
+			},
+			want: managed.ConnectionDetails{
+				"tunnel1Address":               []byte("2.2.2.2"),
+				"tunnel1PreSharedKey":          []byte("key2"),
+				"tunnel2Address":               []byte("1.1.1.1"),
+				"tunnel2PreSharedKey":          []byte("key1"),
+				"customerGatewayConfiguration": []byte(testCustomerGatewayConfiguration),
+			},
+		},
+		"OnlyConfiguration": {
+			in: ec2types.VpnConnection{
+				CustomerGatewayConfiguration: aws.String(testCustomerGatewayConfiguration),
+			},
+			want: managed.ConnectionDetails{
+				"tunnel1Address":               []byte("1.1.1.1"),
+				"tunnel1PreSharedKey":          []byte("key1"),
+				"tunnel2Address":               []byte("2.2.2.2"),
+				"tunnel2PreSharedKey":          []byte("key2"),
+				"customerGatewayConfiguration": []byte(testCustomerGatewayConfiguration),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got := GetVPNConnectionDetails(tc.in)
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestDiffVPNConnectionRoutes(t *testing.T) {
+	type want struct {
+		create []string
+		remove []string
+	}
+
+	cases := map[string]struct {
+		desired  []string
+		observed []ec2types.VpnStaticRoute
+		want     want
+	}{
+		"UpToDate": {
+			desired: []string{"10.0.0.0/16"},
+			observed: []ec2types.VpnStaticRoute{
+				{DestinationCidrBlock: aws.String("10.0.0.0/16"), State: ec2types.VpnStateAvailable},
+			},
+		},
+		"CreateAndRemove": {
+			desired: []string{"10.0.0.0/16", "10.1.0.0/16"},
+			observed: []ec2types.VpnStaticRoute{
+				{DestinationCidrBlock: aws.String("10.0.0.0/16"), State: ec2types.VpnStateAvailable},
+				{DestinationCidrBlock: aws.String("10.2.0.0/16"), State: ec2types.VpnStatePending},
+			},
+			want: want{
+				create: []string{"10.1.0.0/16"},
+				remove: []string{"10.2.0.0/16"},
+			},
+		},
+		"RecreateDeleting": {
+			desired: []string{"10.0.0.0/16"},
+			observed: []ec2types.VpnStaticRoute{
+				{DestinationCidrBlock: aws.String("10.0.0.0/16"), State: ec2types.VpnStateDeleting},
+				{DestinationCidrBlock: aws.String("10.2.0.0/16"), State: ec2types.VpnStateDeleted},
+			},
+			want: want{
+				create: []string{"10.0.0.0/16"},
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			create, remove := DiffVPNConnectionRoutes(tc.desired, tc.observed)
+			if diff := cmp.Diff(tc.want.create, create); diff != "" {
+				t.Errorf("create: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.remove, remove); diff != "" {
+				t.Errorf("remove: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestLateInitializeVPNConnection(t *testing.T) {
+	cases := map[string]struct {
+		spec *manualv1alpha1.VPNConnectionParameters
+		in   *ec2types.VpnConnection
+		want *manualv1alpha1.VPNConnectionParameters
+	}{
+		"AllFilled": {
+			spec: &manualv1alpha1.VPNConnectionParameters{StaticRoutesOnly: aws.Bool(true)},
+			in: &ec2types.VpnConnection{
+				Options: &ec2types.VpnConnectionOptions{StaticRoutesOnly: aws.Bool(false)},
+			},
+			want: &manualv1alpha1.VPNConnectionParameters{StaticRoutesOnly: aws.Bool(true)},
+		},
+		"Empty": {
+			spec: &manualv1alpha1.VPNConnectionParameters{},
+			in: &ec2types.VpnConnection{
+				Options: &ec2types.VpnConnectionOptions{StaticRoutesOnly: aws.Bool(false)},
+				Tags:    []ec2types.Tag{{Key: aws.String("k"), Value: aws.String("v")}},
+			},
+			want: &manualv1alpha1.VPNConnectionParameters{
+				StaticRoutesOnly: aws.Bool(false),
+				Tags:             []manualv1alpha1.Tag{{Key: "k", Value: "v"}},
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			LateInitializeVPNConnection(tc.spec, tc.in)
+			if diff := cmp.Diff(tc.want, tc.spec); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
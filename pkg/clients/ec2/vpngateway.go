@@ -0,0 +1,185 @@
+/*
+Copyright 2023 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package ec2
+
+import (
+	"context"
+	"errors"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/ec2"
+	ec2types "github.com/aws/aws-sdk-go-v2/service/ec2/types"
+	"github.com/aws/smithy-go"
+
+	"github.com/crossplane-contrib/provider-aws/apis/ec2/manualv1alpha1"
+	awsclients "github.com/crossplane-contrib/provider-aws/pkg/clients"
+)
+
+const (
+	// VPNGatewayNotFound is the code that is returned by ec2 when the given
+	// virtual private gateway ID is invalid
+	VPNGatewayNotFound = "InvalidVpnGatewayID.NotFound"
+)
+
+// VPNGatewayClient is the external client used for VPNGateway Custom Resource
+type VPNGatewayClient interface {
+	CreateVpnGateway(ctx context.Context, input *ec2.CreateVpnGatewayInput, opts ...func(*ec2.Options)) (*ec2.CreateVpnGatewayOutput, error)
+	DescribeVpnGateways(ctx context.Context, input *ec2.DescribeVpnGatewaysInput, opts ...func(*ec2.Options)) (*ec2.DescribeVpnGatewaysOutput, error)
+	DeleteVpnGateway(ctx context.Context, input *ec2.DeleteVpnGatewayInput, opts ...func(*ec2.Options)) (*ec2.DeleteVpnGatewayOutput, error)
+	AttachVpnGateway(ctx context.Context, input *ec2.AttachVpnGatewayInput, opts ...func(*ec2.Options)) (*ec2.AttachVpnGatewayOutput, error)
+	DetachVpnGateway(ctx context.Context, input *ec2.DetachVpnGatewayInput, opts ...func(*ec2.Options)) (*ec2.DetachVpnGatewayOutput, error)
+	DescribeRouteTables(ctx context.Context, input *ec2.DescribeRouteTablesInput, opts ...func(*ec2.Options)) (*ec2.DescribeRouteTablesOutput, error)
+	EnableVgwRoutePropagation(ctx context.Context, input *ec2.EnableVgwRoutePropagationInput, opts ...func(*ec2.Options)) (*ec2.EnableVgwRoutePropagationOutput, error)
+	DisableVgwRoutePropagation(ctx context.Context, input *ec2.DisableVgwRoutePropagationInput, opts ...func(*ec2.Options)) (*ec2.DisableVgwRoutePropagationOutput, error)
+	CreateTags(ctx context.Context, input *ec2.CreateTagsInput, opts ...func(*ec2.Options)) (*ec2.CreateTagsOutput, error)
+	DeleteTags(ctx context.Context, input *ec2.DeleteTagsInput, opts ...func(*ec2.Options)) (*ec2.DeleteTagsOutput, error)
+}
+
+// NewVPNGatewayClient returns a new client using AWS credentials as JSON encoded data.
+func NewVPNGatewayClient(cfg aws.Config) VPNGatewayClient {
+	return ec2.NewFromConfig(cfg)
+}
+
+// IsVPNGatewayNotFoundErr returns true if the error is because the virtual
+// private gateway doesn't exist
+func IsVPNGatewayNotFoundErr(err error) bool {
+	var awsErr smithy.APIError
+	return errors.As(err, &awsErr) && awsErr.ErrorCode() == VPNGatewayNotFound
+}
+
+// GenerateCreateVPNGatewayInput returns the input to create a virtual
+// private gateway.
+func GenerateCreateVPNGatewayInput(p manualv1alpha1.VPNGatewayParameters) *ec2.CreateVpnGatewayInput {
+	return &ec2.CreateVpnGatewayInput{
+		Type:              ec2types.GatewayType(p.Type),
+		AmazonSideAsn:     p.AmazonSideASN,
+		AvailabilityZone:  p.AvailabilityZone,
+		TagSpecifications: generateVPNTagSpecifications(ec2types.ResourceTypeVpnGateway, p.Tags),
+	}
+}
+
+// FindVPNGatewayVPCAttachment returns the attachment of the virtual private
+// gateway that is not detached yet, if any. A virtual private gateway can
+// only be attached to a single VPC at a time.
+func FindVPNGatewayVPCAttachment(gw ec2types.VpnGateway) *ec2types.VpcAttachment {
+	for i := range gw.VpcAttachments {
+		if gw.VpcAttachments[i].State != ec2types.AttachmentStatusDetached {
+			return &gw.VpcAttachments[i]
+		}
+	}
+	return nil
+}
+
+// FindPropagatingRouteTables returns the IDs of the given route tables that
+// the routes of the given virtual private gateway are propagated to.
+func FindPropagatingRouteTables(gatewayID string, rts []ec2types.RouteTable) []string {
+	var ids []string
+	for _, rt := range rts {
+		for _, vgw := range rt.PropagatingVgws {
+			if aws.ToString(vgw.GatewayId) == gatewayID {
+				ids = append(ids, aws.ToString(rt.RouteTableId))
+				break
+			}
+		}
+	}
+	return ids
+}
+
+// DiffVPNGatewayRoutePropagation returns the IDs of the route tables that
+// route propagation has to be enabled and disabled for.
+func DiffVPNGatewayRoutePropagation(desired, current []string) (enable, disable []string) {
+	c := make(map[string]bool, len(current))
+	for _, id := range current {
+		c[id] = true
+	}
+	d := make(map[string]bool, len(desired))
+	for _, id := range desired {
+		d[id] = true
+		if !c[id] {
+			enable = append(enable, id)
+		}
+	}
+	for _, id := range current {
+		if !d[id] {
+			disable = append(disable, id)
+		}
+	}
+	return enable, disable
+}
+
+// GenerateVPNGatewayObservation is used to produce
+// manualv1alpha1.VPNGatewayObservation from ec2types.VpnGateway and the IDs
+// of the route tables its routes are propagated to.
+func GenerateVPNGatewayObservation(gw ec2types.VpnGateway, propagating []string) manualv1alpha1.VPNGatewayObservation {
+	o := manualv1alpha1.VPNGatewayObservation{
+		VPNGatewayID:             aws.ToString(gw.VpnGatewayId),
+		AmazonSideASN:            gw.AmazonSideAsn,
+		State:                    string(gw.State),
+		PropagatingRouteTableIDs: propagating,
+	}
+	for _, a := range gw.VpcAttachments {
+		o.VPCAttachments = append(o.VPCAttachments, manualv1alpha1.VPNGatewayVPCAttachment{
+			VPCID: aws.ToString(a.VpcId),
+			State: string(a.State),
+		})
+	}
+	return o
+}
+
+// LateInitializeVPNGateway fills the empty fields in
+// *manualv1alpha1.VPNGatewayParameters with the values seen in
+// ec2types.VpnGateway.
+func LateInitializeVPNGateway(in *manualv1alpha1.VPNGatewayParameters, gw *ec2types.VpnGateway) {
+	if gw == nil {
+		return
+	}
+	in.AmazonSideASN = awsclients.LateInitializeInt64Ptr(in.AmazonSideASN, gw.AmazonSideAsn)
+	in.AvailabilityZone = awsclients.LateInitializeStringPtr(in.AvailabilityZone, gw.AvailabilityZone)
+	if len(in.Tags) == 0 && len(gw.Tags) != 0 {
+		in.Tags = manualv1alpha1.BuildFromEC2Tags(gw.Tags)
+	}
+}
+
+// IsVPNGatewayVPCAttachmentUpToDate returns true if the virtual private
+// gateway is attached to the desired VPC, or is being detached from any VPC
+// if there is none.
+func IsVPNGatewayVPCAttachmentUpToDate(p manualv1alpha1.VPNGatewayParameters, gw ec2types.VpnGateway) bool {
+	a := FindVPNGatewayVPCAttachment(gw)
+	if p.VPCID == nil {
+		return a == nil || a.State == ec2types.AttachmentStatusDetaching
+	}
+	return a != nil && aws.ToString(a.VpcId) == aws.ToString(p.VPCID) &&
+		(a.State == ec2types.AttachmentStatusAttaching || a.State == ec2types.AttachmentStatusAttached)
+}
+
+// IsVPNGatewayUpToDate checks whether there is a change in any of the
+// modifiable fields. Route propagation is only compared once the virtual
+// private gateway is attached to the desired VPC.
+func IsVPNGatewayUpToDate(p manualv1alpha1.VPNGatewayParameters, gw ec2types.VpnGateway, propagating []string) bool {
+	add, remove := awsclients.DiffEC2Tags(manualv1alpha1.GenerateEC2Tags(p.Tags), gw.Tags)
+	if len(add) != 0 || len(remove) != 0 {
+		return false
+	}
+	if !IsVPNGatewayVPCAttachmentUpToDate(p, gw) {
+		return false
+	}
+	if a := FindVPNGatewayVPCAttachment(gw); a == nil || a.State != ec2types.AttachmentStatusAttached {
+		return true
+	}
+	enable, disable := DiffVPNGatewayRoutePropagation(p.RouteTableIDs, propagating)
+	return len(enable) == 0 && len(disable) == 0
+}
@@ -0,0 +1,133 @@
+/*
+Copyright 2023 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package ec2
+
+import (
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	ec2types "github.com/aws/aws-sdk-go-v2/service/ec2/types"
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/crossplane-contrib/provider-aws/apis/ec2/manualv1alpha1"
+)
+
+func TestIsVPNGatewayUpToDate(t *testing.T) {
+	type args struct {
+		p           manualv1alpha1.VPNGatewayParameters
+		gw          ec2types.VpnGateway
+		propagating []string
+	}
+
+	cases := map[string]struct {
+		args args
+		want bool
+	}{
+		"Detached": {
+			args: args{
+				gw: ec2types.VpnGateway{
+					VpcAttachments: []ec2types.VpcAttachment{{VpcId: aws.String("vpc-1"), State: ec2types.AttachmentStatusDetached}},
+				},
+			},
+			want: true,
+		},
+		"Detaching": {
+			args: args{
+				gw: ec2types.VpnGateway{
+					VpcAttachments: []ec2types.VpcAttachment{{VpcId: aws.String("vpc-1"), State: ec2types.AttachmentStatusDetaching}},
+				},
+			},
+			want: true,
+		},
+		"NotAttached": {
+			args: args{
+				p: manualv1alpha1.VPNGatewayParameters{VPCID: aws.String("vpc-1")},
+			},
+			want: false,
+		},
+		"AttachedToOtherVPC": {
+			args: args{
+				p: manualv1alpha1.VPNGatewayParameters{VPCID: aws.String("vpc-2")},
+				gw: ec2types.VpnGateway{
+					VpcAttachments: []ec2types.VpcAttachment{{VpcId: aws.String("vpc-1"), State: ec2types.AttachmentStatusAttached}},
+				},
+			},
+			want: false,
+		},
+		"Attaching": {
+			args: args{
+				p: manualv1alpha1.VPNGatewayParameters{VPCID: aws.String("vpc-1"), RouteTableIDs: []string{"rtb-1"}},
+				gw: ec2types.VpnGateway{
+					VpcAttachments: []ec2types.VpcAttachment{{VpcId: aws.String("vpc-1"), State: ec2types.AttachmentStatusAttaching}},
+				},
+			},
+			want: true,
+		},
+		"PropagationMissing": {
+			args: args{
+				p: manualv1alpha1.VPNGatewayParameters{VPCID: aws.String("vpc-1"), RouteTableIDs: []string{"rtb-1", "rtb-2"}},
+				gw: ec2types.VpnGateway{
+					VpcAttachments: []ec2types.VpcAttachment{{VpcId: aws.String("vpc-1"), State: ec2types.AttachmentStatusAttached}},
+				},
+				propagating: []string{"rtb-1"},
+			},
+			want: false,
+		},
+		"UpToDate": {
+			args: args{
+				p: manualv1alpha1.VPNGatewayParameters{
+					VPCID:         aws.String("vpc-1"),
+					RouteTableIDs: []string{"rtb-2", "rtb-1"},
+					Tags:          []manualv1alpha1.Tag{{Key: "k", Value: "v"}},
+				},
+				gw: ec2types.VpnGateway{
+					Tags:           []ec2types.Tag{{Key: aws.String("k"), Value: aws.String("v")}},
+					VpcAttachments: []ec2types.VpcAttachment{{VpcId: aws.String("vpc-1"), State: ec2types.AttachmentStatusAttached}},
+				},
+				propagating: []string{"rtb-1", "rtb-2"},
+			},
+			want: true,
+		},
+		"TagsChanged": {
+			args: args{
+				p: manualv1alpha1.VPNGatewayParameters{Tags: []manualv1alpha1.Tag{{Key: "k", Value: "v"}}},
+			},
+			want: false,
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got := IsVPNGatewayUpToDate(tc.args.p, tc.args.gw, tc.args.propagating)
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestFindPropagatingRouteTables(t *testing.T) {
+	rts := []ec2types.RouteTable{
+		{RouteTableId: aws.String("rtb-1"), PropagatingVgws: []ec2types.PropagatingVgw{{GatewayId: aws.String("vgw-1")}}},
+		{RouteTableId: aws.String("rtb-2"), PropagatingVgws: []ec2types.PropagatingVgw{{GatewayId: aws.String("vgw-2")}}},
+		{RouteTableId: aws.String("rtb-3")},
+	}
+	want := []string{"rtb-1"}
+	if diff := cmp.Diff(want, FindPropagatingRouteTables("vgw-1", rts)); diff != "" {
+		t.Errorf("r: -want, +got:\n%s", diff)
+	}
+}
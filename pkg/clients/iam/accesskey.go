@@ -18,20 +18,77 @@ package iam
 
 import (
 	"context"
+	"time"
 
 	"github.com/aws/aws-sdk-go-v2/aws"
 	"github.com/aws/aws-sdk-go-v2/service/iam"
+	iamtypes "github.com/aws/aws-sdk-go-v2/service/iam/types"
+
+	"github.com/crossplane-contrib/provider-aws/apis/iam/v1beta1"
 )
 
+// DefaultAccessKeyOverlapPeriod is the time the previous access key stays
+// active after a rotation if no overlap period is configured.
+const DefaultAccessKeyOverlapPeriod = 24 * time.Hour
+
 // AccessClient is the external client used for AccessKey Custom Resource
 type AccessClient interface {
 	CreateAccessKey(ctx context.Context, input *iam.CreateAccessKeyInput, opts ...func(*iam.Options)) (*iam.CreateAccessKeyOutput, error)
 	DeleteAccessKey(ctx context.Context, input *iam.DeleteAccessKeyInput, opts ...func(*iam.Options)) (*iam.DeleteAccessKeyOutput, error)
 	ListAccessKeys(ctx context.Context, input *iam.ListAccessKeysInput, opts ...func(*iam.Options)) (*iam.ListAccessKeysOutput, error)
 	UpdateAccessKey(ctx context.Context, input *iam.UpdateAccessKeyInput, opts ...func(*iam.Options)) (*iam.UpdateAccessKeyOutput, error)
+	GetAccessKeyLastUsed(ctx context.Context, input *iam.GetAccessKeyLastUsedInput, opts ...func(*iam.Options)) (*iam.GetAccessKeyLastUsedOutput, error)
 }
 
 // NewAccessClient returns a new client using AWS credentials as JSON encoded data.
 func NewAccessClient(conf aws.Config) AccessClient {
 	return iam.NewFromConfig(conf)
 }
+
+// IsAccessKeyRotationDue returns true if the current access key has reached
+// the rotation period. No rotation is due while the previous access key has
+// not been deleted yet.
+func IsAccessKeyRotationDue(r *v1beta1.AccessKeyRotation, obs v1beta1.AccessKeyObservation, now time.Time) bool {
+	if r == nil || r.RotationPeriod.Duration <= 0 || obs.CreateDate == nil || obs.PreviousAccessKeyID != "" {
+		return false
+	}
+	return now.Sub(obs.CreateDate.Time) >= r.RotationPeriod.Duration
+}
+
+// IsPreviousAccessKeyExpired returns true if there is a previous access key
+// whose overlap period has passed.
+func IsPreviousAccessKeyExpired(r *v1beta1.AccessKeyRotation, obs v1beta1.AccessKeyObservation, now time.Time) bool {
+	if obs.PreviousAccessKeyID == "" {
+		return false
+	}
+	// NOTE: A previous access key is only recorded by a rotation, which also
+	// creates the current access key, so its creation date stands in for a
+	// rotation time that got lost.
+	rotated := obs.LastRotationTime
+	if rotated == nil {
+		rotated = obs.CreateDate
+	}
+	if rotated == nil {
+		return true
+	}
+	overlap := DefaultAccessKeyOverlapPeriod
+	if r != nil && r.OverlapPeriod != nil {
+		overlap = r.OverlapPeriod.Duration
+	}
+	return now.Sub(rotated.Time) >= overlap
+}
+
+// PreviousAccessKeyID returns the recorded ID of the access key that was
+// replaced by the last rotation if the user still has it, or an empty string
+// otherwise. Other access keys of the user are never returned.
+func PreviousAccessKeyID(keys []iamtypes.AccessKeyMetadata, current, recorded string) string {
+	if recorded == "" || recorded == current {
+		return ""
+	}
+	for _, k := range keys {
+		if aws.ToString(k.AccessKeyId) == recorded {
+			return recorded
+		}
+	}
+	return ""
+}
@@ -0,0 +1,195 @@
+package iam
+
+import (
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	iamtypes "github.com/aws/aws-sdk-go-v2/service/iam/types"
+	"github.com/google/go-cmp/cmp"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/crossplane-contrib/provider-aws/apis/iam/v1beta1"
+)
+
+var (
+	rotationNow = time.Date(2023, 4, 1, 0, 0, 0, 0, time.UTC)
+	rotation    = &v1beta1.AccessKeyRotation{
+		RotationPeriod: metav1.Duration{Duration: 30 * 24 * time.Hour},
+		OverlapPeriod:  &metav1.Duration{Duration: time.Hour},
+	}
+)
+
+func daysAgo(d int) *metav1.Time {
+	return &metav1.Time{Time: rotationNow.AddDate(0, 0, -d)}
+}
+
+func TestIsAccessKeyRotationDue(t *testing.T) {
+	type args struct {
+		r   *v1beta1.AccessKeyRotation
+		obs v1beta1.AccessKeyObservation
+	}
+
+	cases := map[string]struct {
+		args args
+		want bool
+	}{
+		"NoRotation": {
+			args: args{
+				obs: v1beta1.AccessKeyObservation{CreateDate: daysAgo(365)},
+			},
+			want: false,
+		},
+		"NotDue": {
+			args: args{
+				r:   rotation,
+				obs: v1beta1.AccessKeyObservation{CreateDate: daysAgo(29)},
+			},
+			want: false,
+		},
+		"Due": {
+			args: args{
+				r:   rotation,
+				obs: v1beta1.AccessKeyObservation{CreateDate: daysAgo(30)},
+			},
+			want: true,
+		},
+		"PreviousAccessKeyNotDeleted": {
+			args: args{
+				r:   rotation,
+				obs: v1beta1.AccessKeyObservation{CreateDate: daysAgo(30), PreviousAccessKeyID: "previous"},
+			},
+			want: false,
+		},
+		"ZeroRotationPeriod": {
+			args: args{
+				r:   &v1beta1.AccessKeyRotation{},
+				obs: v1beta1.AccessKeyObservation{CreateDate: daysAgo(1)},
+			},
+			want: false,
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got := IsAccessKeyRotationDue(tc.args.r, tc.args.obs, rotationNow)
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestIsPreviousAccessKeyExpired(t *testing.T) {
+	type args struct {
+		r   *v1beta1.AccessKeyRotation
+		obs v1beta1.AccessKeyObservation
+	}
+
+	cases := map[string]struct {
+		args args
+		want bool
+	}{
+		"NoPreviousAccessKey": {
+			args: args{
+				r:   rotation,
+				obs: v1beta1.AccessKeyObservation{LastRotationTime: daysAgo(1)},
+			},
+			want: false,
+		},
+		"InOverlapPeriod": {
+			args: args{
+				r:   rotation,
+				obs: v1beta1.AccessKeyObservation{PreviousAccessKeyID: "previous", LastRotationTime: &metav1.Time{Time: rotationNow.Add(-time.Minute)}},
+			},
+			want: false,
+		},
+		"OverlapPeriodPassed": {
+			args: args{
+				r:   rotation,
+				obs: v1beta1.AccessKeyObservation{PreviousAccessKeyID: "previous", LastRotationTime: &metav1.Time{Time: rotationNow.Add(-time.Hour)}},
+			},
+			want: true,
+		},
+		"DefaultOverlapPeriod": {
+			args: args{
+				obs: v1beta1.AccessKeyObservation{PreviousAccessKeyID: "previous", LastRotationTime: &metav1.Time{Time: rotationNow.Add(-time.Hour)}},
+			},
+			want: false,
+		},
+		"RotationTimeFromCreateDate": {
+			args: args{
+				r:   rotation,
+				obs: v1beta1.AccessKeyObservation{PreviousAccessKeyID: "previous", CreateDate: &metav1.Time{Time: rotationNow.Add(-time.Minute)}},
+			},
+			want: false,
+		},
+		"UnknownRotationTime": {
+			args: args{
+				r:   rotation,
+				obs: v1beta1.AccessKeyObservation{PreviousAccessKeyID: "previous"},
+			},
+			want: true,
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got := IsPreviousAccessKeyExpired(tc.args.r, tc.args.obs, rotationNow)
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestPreviousAccessKeyID(t *testing.T) {
+	type args struct {
+		keys     []iamtypes.AccessKeyMetadata
+		current  string
+		recorded string
+	}
+
+	cases := map[string]struct {
+		args args
+		want string
+	}{
+		"NotRecorded": {
+			args: args{
+				keys:    []iamtypes.AccessKeyMetadata{{AccessKeyId: aws.String("current")}, {AccessKeyId: aws.String("other")}},
+				current: "current",
+			},
+		},
+		"Recorded": {
+			args: args{
+				keys:     []iamtypes.AccessKeyMetadata{{AccessKeyId: aws.String("current")}, {AccessKeyId: aws.String("previous")}},
+				current:  "current",
+				recorded: "previous",
+			},
+			want: "previous",
+		},
+		"RecordedOtherKey": {
+			args: args{
+				keys:     []iamtypes.AccessKeyMetadata{{AccessKeyId: aws.String("current")}, {AccessKeyId: aws.String("other")}},
+				current:  "current",
+				recorded: "previous",
+			},
+		},
+		"RecordedCurrent": {
+			args: args{
+				keys:     []iamtypes.AccessKeyMetadata{{AccessKeyId: aws.String("current")}},
+				current:  "current",
+				recorded: "current",
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got := PreviousAccessKeyID(tc.args.keys, tc.args.current, tc.args.recorded)
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
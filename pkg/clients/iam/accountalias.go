@@ -0,0 +1,36 @@
+/*
+Copyright 2023 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package iam
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/iam"
+)
+
+// AccountAliasClient is the external client used for AccountAlias Custom Resource
+type AccountAliasClient interface {
+	ListAccountAliases(ctx context.Context, input *iam.ListAccountAliasesInput, opts ...func(*iam.Options)) (*iam.ListAccountAliasesOutput, error)
+	CreateAccountAlias(ctx context.Context, input *iam.CreateAccountAliasInput, opts ...func(*iam.Options)) (*iam.CreateAccountAliasOutput, error)
+	DeleteAccountAlias(ctx context.Context, input *iam.DeleteAccountAliasInput, opts ...func(*iam.Options)) (*iam.DeleteAccountAliasOutput, error)
+}
+
+// NewAccountAliasClient returns a new client given an aws config
+func NewAccountAliasClient(conf aws.Config) AccountAliasClient {
+	return iam.NewFromConfig(conf)
+}
@@ -0,0 +1,97 @@
+/*
+Copyright 2023 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package iam
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/iam"
+	iamtypes "github.com/aws/aws-sdk-go-v2/service/iam/types"
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/crossplane-contrib/provider-aws/apis/iam/v1beta1"
+	awsclients "github.com/crossplane-contrib/provider-aws/pkg/clients"
+)
+
+// AccountPasswordPolicyClient is the external client used for AccountPasswordPolicy Custom Resource
+type AccountPasswordPolicyClient interface {
+	GetAccountPasswordPolicy(ctx context.Context, input *iam.GetAccountPasswordPolicyInput, opts ...func(*iam.Options)) (*iam.GetAccountPasswordPolicyOutput, error)
+	UpdateAccountPasswordPolicy(ctx context.Context, input *iam.UpdateAccountPasswordPolicyInput, opts ...func(*iam.Options)) (*iam.UpdateAccountPasswordPolicyOutput, error)
+	DeleteAccountPasswordPolicy(ctx context.Context, input *iam.DeleteAccountPasswordPolicyInput, opts ...func(*iam.Options)) (*iam.DeleteAccountPasswordPolicyOutput, error)
+}
+
+// NewAccountPasswordPolicyClient returns a new client given an aws config
+func NewAccountPasswordPolicyClient(conf aws.Config) AccountPasswordPolicyClient {
+	return iam.NewFromConfig(conf)
+}
+
+// GenerateUpdateAccountPasswordPolicyInput returns the input of an
+// UpdateAccountPasswordPolicy request for the supplied parameters.
+func GenerateUpdateAccountPasswordPolicyInput(in v1beta1.AccountPasswordPolicyParameters) *iam.UpdateAccountPasswordPolicyInput {
+	return &iam.UpdateAccountPasswordPolicyInput{
+		AllowUsersToChangePassword: aws.ToBool(in.AllowUsersToChangePassword),
+		HardExpiry:                 in.HardExpiry,
+		MaxPasswordAge:             in.MaxPasswordAge,
+		MinimumPasswordLength:      in.MinimumPasswordLength,
+		PasswordReusePrevention:    in.PasswordReusePrevention,
+		RequireLowercaseCharacters: aws.ToBool(in.RequireLowercaseCharacters),
+		RequireNumbers:             aws.ToBool(in.RequireNumbers),
+		RequireSymbols:             aws.ToBool(in.RequireSymbols),
+		RequireUppercaseCharacters: aws.ToBool(in.RequireUppercaseCharacters),
+	}
+}
+
+// LateInitializeAccountPasswordPolicy fills the empty fields in
+// *v1beta1.AccountPasswordPolicyParameters with the values seen in
+// iamtypes.PasswordPolicy.
+func LateInitializeAccountPasswordPolicy(in *v1beta1.AccountPasswordPolicyParameters, p *iamtypes.PasswordPolicy) {
+	if p == nil {
+		return
+	}
+	in.AllowUsersToChangePassword = awsclients.LateInitializeBoolPtr(in.AllowUsersToChangePassword, aws.Bool(p.AllowUsersToChangePassword))
+	in.HardExpiry = awsclients.LateInitializeBoolPtr(in.HardExpiry, p.HardExpiry)
+	in.MaxPasswordAge = awsclients.LateInitializeInt32Ptr(in.MaxPasswordAge, p.MaxPasswordAge)
+	in.MinimumPasswordLength = awsclients.LateInitializeInt32Ptr(in.MinimumPasswordLength, p.MinimumPasswordLength)
+	in.PasswordReusePrevention = awsclients.LateInitializeInt32Ptr(in.PasswordReusePrevention, p.PasswordReusePrevention)
+	in.RequireLowercaseCharacters = awsclients.LateInitializeBoolPtr(in.RequireLowercaseCharacters, aws.Bool(p.RequireLowercaseCharacters))
+	in.RequireNumbers = awsclients.LateInitializeBoolPtr(in.RequireNumbers, aws.Bool(p.RequireNumbers))
+	in.RequireSymbols = awsclients.LateInitializeBoolPtr(in.RequireSymbols, aws.Bool(p.RequireSymbols))
+	in.RequireUppercaseCharacters = awsclients.LateInitializeBoolPtr(in.RequireUppercaseCharacters, aws.Bool(p.RequireUppercaseCharacters))
+}
+
+// GenerateAccountPasswordPolicyObservation is used to produce
+// v1beta1.AccountPasswordPolicyObservation from iamtypes.PasswordPolicy.
+func GenerateAccountPasswordPolicyObservation(p iamtypes.PasswordPolicy) v1beta1.AccountPasswordPolicyObservation {
+	return v1beta1.AccountPasswordPolicyObservation{
+		ExpirePasswords: p.ExpirePasswords,
+	}
+}
+
+// IsAccountPasswordPolicyUpToDate checks whether there is a change in any of
+// the modifiable fields of the password policy. Fields that are not set in
+// the parameters are ignored.
+func IsAccountPasswordPolicyUpToDate(in v1beta1.AccountPasswordPolicyParameters, observed iamtypes.PasswordPolicy) (bool, string) {
+	current := v1beta1.AccountPasswordPolicyParameters{}
+	LateInitializeAccountPasswordPolicy(&current, &observed)
+
+	desired := in.DeepCopy()
+	LateInitializeAccountPasswordPolicy(desired, &observed)
+
+	diff := cmp.Diff(desired, &current)
+	return diff == "", diff
+}
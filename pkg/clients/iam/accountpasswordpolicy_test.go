@@ -0,0 +1,100 @@
+package iam
+
+import (
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	iamtypes "github.com/aws/aws-sdk-go-v2/service/iam/types"
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/crossplane-contrib/provider-aws/apis/iam/v1beta1"
+)
+
+var passwordPolicy = iamtypes.PasswordPolicy{
+	AllowUsersToChangePassword: true,
+	MinimumPasswordLength:      aws.Int32(14),
+	PasswordReusePrevention:    aws.Int32(24),
+	RequireNumbers:             true,
+	RequireSymbols:             true,
+}
+
+func TestLateInitializeAccountPasswordPolicy(t *testing.T) {
+	cases := map[string]struct {
+		in       v1beta1.AccountPasswordPolicyParameters
+		observed *iamtypes.PasswordPolicy
+		want     v1beta1.AccountPasswordPolicyParameters
+	}{
+		"NilObserved": {
+			in:   v1beta1.AccountPasswordPolicyParameters{MinimumPasswordLength: aws.Int32(8)},
+			want: v1beta1.AccountPasswordPolicyParameters{MinimumPasswordLength: aws.Int32(8)},
+		},
+		"FillsEmptyFields": {
+			in:       v1beta1.AccountPasswordPolicyParameters{MinimumPasswordLength: aws.Int32(8), RequireSymbols: aws.Bool(false)},
+			observed: &passwordPolicy,
+			want: v1beta1.AccountPasswordPolicyParameters{
+				AllowUsersToChangePassword: aws.Bool(true),
+				MinimumPasswordLength:      aws.Int32(8),
+				PasswordReusePrevention:    aws.Int32(24),
+				RequireLowercaseCharacters: aws.Bool(false),
+				RequireNumbers:             aws.Bool(true),
+				RequireSymbols:             aws.Bool(false),
+				RequireUppercaseCharacters: aws.Bool(false),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			LateInitializeAccountPasswordPolicy(&tc.in, tc.observed)
+			if diff := cmp.Diff(tc.want, tc.in); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestIsAccountPasswordPolicyUpToDate(t *testing.T) {
+	cases := map[string]struct {
+		in   v1beta1.AccountPasswordPolicyParameters
+		want bool
+	}{
+		"Empty": {
+			want: true,
+		},
+		"SameValues": {
+			in: v1beta1.AccountPasswordPolicyParameters{
+				MinimumPasswordLength: aws.Int32(14),
+				RequireNumbers:        aws.Bool(true),
+				RequireSymbols:        aws.Bool(true),
+			},
+			want: true,
+		},
+		"DifferentLength": {
+			in: v1beta1.AccountPasswordPolicyParameters{
+				MinimumPasswordLength: aws.Int32(8),
+			},
+			want: false,
+		},
+		"DifferentBool": {
+			in: v1beta1.AccountPasswordPolicyParameters{
+				RequireUppercaseCharacters: aws.Bool(true),
+			},
+			want: false,
+		},
+		"MaxPasswordAgeAdded": {
+			in: v1beta1.AccountPasswordPolicyParameters{
+				MaxPasswordAge: aws.Int32(90),
+			},
+			want: false,
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got, _ := IsAccountPasswordPolicyUpToDate(tc.in, passwordPolicy)
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
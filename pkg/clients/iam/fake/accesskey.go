@@ -29,10 +29,11 @@ var _ clientset.AccessClient = (*MockAccessClient)(nil)
 
 // MockAccessClient is a type that implements all the methods for AccessClient interface
 type MockAccessClient struct {
-	MockCreateAccessKey func(ctx context.Context, input *iam.CreateAccessKeyInput, opts []func(*iam.Options)) (*iam.CreateAccessKeyOutput, error)
-	MockDeleteAccessKey func(ctx context.Context, input *iam.DeleteAccessKeyInput, opts []func(*iam.Options)) (*iam.DeleteAccessKeyOutput, error)
-	MockListAccessKeys  func(ctx context.Context, input *iam.ListAccessKeysInput, opts []func(*iam.Options)) (*iam.ListAccessKeysOutput, error)
-	MockUpdateAccessKey func(ctx context.Context, input *iam.UpdateAccessKeyInput, opts []func(*iam.Options)) (*iam.UpdateAccessKeyOutput, error)
+	MockCreateAccessKey      func(ctx context.Context, input *iam.CreateAccessKeyInput, opts []func(*iam.Options)) (*iam.CreateAccessKeyOutput, error)
+	MockDeleteAccessKey      func(ctx context.Context, input *iam.DeleteAccessKeyInput, opts []func(*iam.Options)) (*iam.DeleteAccessKeyOutput, error)
+	MockListAccessKeys       func(ctx context.Context, input *iam.ListAccessKeysInput, opts []func(*iam.Options)) (*iam.ListAccessKeysOutput, error)
+	MockUpdateAccessKey      func(ctx context.Context, input *iam.UpdateAccessKeyInput, opts []func(*iam.Options)) (*iam.UpdateAccessKeyOutput, error)
+	MockGetAccessKeyLastUsed func(ctx context.Context, input *iam.GetAccessKeyLastUsedInput, opts []func(*iam.Options)) (*iam.GetAccessKeyLastUsedOutput, error)
 }
 
 // UpdateAccessKey mocks UpdateAccessKey method
@@ -54,3 +55,8 @@ func (m MockAccessClient) CreateAccessKey(ctx context.Context, input *iam.Create
 func (m MockAccessClient) DeleteAccessKey(ctx context.Context, input *iam.DeleteAccessKeyInput, opts ...func(*iam.Options)) (*iam.DeleteAccessKeyOutput, error) {
 	return m.MockDeleteAccessKey(ctx, input, opts)
 }
+
+// GetAccessKeyLastUsed mocks GetAccessKeyLastUsed method
+func (m MockAccessClient) GetAccessKeyLastUsed(ctx context.Context, input *iam.GetAccessKeyLastUsedInput, opts ...func(*iam.Options)) (*iam.GetAccessKeyLastUsedOutput, error) {
+	return m.MockGetAccessKeyLastUsed(ctx, input, opts)
+}
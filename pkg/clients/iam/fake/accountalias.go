@@ -0,0 +1,50 @@
+/*
+Copyright 2023 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package fake
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go-v2/service/iam"
+
+	clientset "github.com/crossplane-contrib/provider-aws/pkg/clients/iam"
+)
+
+// this ensures that the mock implements the client interface
+var _ clientset.AccountAliasClient = (*MockAccountAliasClient)(nil)
+
+// MockAccountAliasClient is a type that implements all the methods for AccountAliasClient interface
+type MockAccountAliasClient struct {
+	MockListAccountAliases func(ctx context.Context, input *iam.ListAccountAliasesInput, opts []func(*iam.Options)) (*iam.ListAccountAliasesOutput, error)
+	MockCreateAccountAlias func(ctx context.Context, input *iam.CreateAccountAliasInput, opts []func(*iam.Options)) (*iam.CreateAccountAliasOutput, error)
+	MockDeleteAccountAlias func(ctx context.Context, input *iam.DeleteAccountAliasInput, opts []func(*iam.Options)) (*iam.DeleteAccountAliasOutput, error)
+}
+
+// ListAccountAliases mocks ListAccountAliases method
+func (m *MockAccountAliasClient) ListAccountAliases(ctx context.Context, input *iam.ListAccountAliasesInput, opts ...func(*iam.Options)) (*iam.ListAccountAliasesOutput, error) {
+	return m.MockListAccountAliases(ctx, input, opts)
+}
+
+// CreateAccountAlias mocks CreateAccountAlias method
+func (m *MockAccountAliasClient) CreateAccountAlias(ctx context.Context, input *iam.CreateAccountAliasInput, opts ...func(*iam.Options)) (*iam.CreateAccountAliasOutput, error) {
+	return m.MockCreateAccountAlias(ctx, input, opts)
+}
+
+// DeleteAccountAlias mocks DeleteAccountAlias method
+func (m *MockAccountAliasClient) DeleteAccountAlias(ctx context.Context, input *iam.DeleteAccountAliasInput, opts ...func(*iam.Options)) (*iam.DeleteAccountAliasOutput, error) {
+	return m.MockDeleteAccountAlias(ctx, input, opts)
+}
@@ -0,0 +1,56 @@
+/*
+Copyright 2023 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package fake
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go-v2/service/iam"
+
+	clientset "github.com/crossplane-contrib/provider-aws/pkg/clients/iam"
+)
+
+// this ensures that the mock implements the client interface
+var _ clientset.GroupPolicyClient = (*MockGroupPolicyClient)(nil)
+
+// MockGroupPolicyClient is a type that implements all the methods for GroupPolicyClient interface
+type MockGroupPolicyClient struct {
+	MockGetGroupPolicy    func(ctx context.Context, input *iam.GetGroupPolicyInput, opts []func(*iam.Options)) (*iam.GetGroupPolicyOutput, error)
+	MockPutGroupPolicy    func(ctx context.Context, input *iam.PutGroupPolicyInput, opts []func(*iam.Options)) (*iam.PutGroupPolicyOutput, error)
+	MockDeleteGroupPolicy func(ctx context.Context, input *iam.DeleteGroupPolicyInput, opts []func(*iam.Options)) (*iam.DeleteGroupPolicyOutput, error)
+	MockListGroupPolicies func(ctx context.Context, input *iam.ListGroupPoliciesInput, opts []func(*iam.Options)) (*iam.ListGroupPoliciesOutput, error)
+}
+
+// GetGroupPolicy mocks GetGroupPolicy method
+func (m *MockGroupPolicyClient) GetGroupPolicy(ctx context.Context, input *iam.GetGroupPolicyInput, opts ...func(*iam.Options)) (*iam.GetGroupPolicyOutput, error) {
+	return m.MockGetGroupPolicy(ctx, input, opts)
+}
+
+// PutGroupPolicy mocks PutGroupPolicy method
+func (m *MockGroupPolicyClient) PutGroupPolicy(ctx context.Context, input *iam.PutGroupPolicyInput, opts ...func(*iam.Options)) (*iam.PutGroupPolicyOutput, error) {
+	return m.MockPutGroupPolicy(ctx, input, opts)
+}
+
+// DeleteGroupPolicy mocks DeleteGroupPolicy method
+func (m *MockGroupPolicyClient) DeleteGroupPolicy(ctx context.Context, input *iam.DeleteGroupPolicyInput, opts ...func(*iam.Options)) (*iam.DeleteGroupPolicyOutput, error) {
+	return m.MockDeleteGroupPolicy(ctx, input, opts)
+}
+
+// ListGroupPolicies mocks ListGroupPolicies method
+func (m *MockGroupPolicyClient) ListGroupPolicies(ctx context.Context, input *iam.ListGroupPoliciesInput, opts ...func(*iam.Options)) (*iam.ListGroupPoliciesOutput, error) {
+	return m.MockListGroupPolicies(ctx, input, opts)
+}
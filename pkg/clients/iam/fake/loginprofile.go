@@ -0,0 +1,56 @@
+/*
+Copyright 2023 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package fake
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go-v2/service/iam"
+
+	clientset "github.com/crossplane-contrib/provider-aws/pkg/clients/iam"
+)
+
+// this ensures that the mock implements the client interface
+var _ clientset.LoginProfileClient = (*MockLoginProfileClient)(nil)
+
+// MockLoginProfileClient is a type that implements all the methods for LoginProfileClient interface
+type MockLoginProfileClient struct {
+	MockGetLoginProfile    func(ctx context.Context, input *iam.GetLoginProfileInput, opts []func(*iam.Options)) (*iam.GetLoginProfileOutput, error)
+	MockCreateLoginProfile func(ctx context.Context, input *iam.CreateLoginProfileInput, opts []func(*iam.Options)) (*iam.CreateLoginProfileOutput, error)
+	MockUpdateLoginProfile func(ctx context.Context, input *iam.UpdateLoginProfileInput, opts []func(*iam.Options)) (*iam.UpdateLoginProfileOutput, error)
+	MockDeleteLoginProfile func(ctx context.Context, input *iam.DeleteLoginProfileInput, opts []func(*iam.Options)) (*iam.DeleteLoginProfileOutput, error)
+}
+
+// GetLoginProfile mocks GetLoginProfile method
+func (m *MockLoginProfileClient) GetLoginProfile(ctx context.Context, input *iam.GetLoginProfileInput, opts ...func(*iam.Options)) (*iam.GetLoginProfileOutput, error) {
+	return m.MockGetLoginProfile(ctx, input, opts)
+}
+
+// CreateLoginProfile mocks CreateLoginProfile method
+func (m *MockLoginProfileClient) CreateLoginProfile(ctx context.Context, input *iam.CreateLoginProfileInput, opts ...func(*iam.Options)) (*iam.CreateLoginProfileOutput, error) {
+	return m.MockCreateLoginProfile(ctx, input, opts)
+}
+
+// UpdateLoginProfile mocks UpdateLoginProfile method
+func (m *MockLoginProfileClient) UpdateLoginProfile(ctx context.Context, input *iam.UpdateLoginProfileInput, opts ...func(*iam.Options)) (*iam.UpdateLoginProfileOutput, error) {
+	return m.MockUpdateLoginProfile(ctx, input, opts)
+}
+
+// DeleteLoginProfile mocks DeleteLoginProfile method
+func (m *MockLoginProfileClient) DeleteLoginProfile(ctx context.Context, input *iam.DeleteLoginProfileInput, opts ...func(*iam.Options)) (*iam.DeleteLoginProfileOutput, error) {
+	return m.MockDeleteLoginProfile(ctx, input, opts)
+}
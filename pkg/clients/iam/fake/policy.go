@@ -38,16 +38,17 @@ type MockPolicyInput struct {
 
 // MockPolicyClient is a type that implements all the methods for PolicyClient interface
 type MockPolicyClient struct {
-	MockPolicyInput         MockPolicyInput
-	MockGetPolicy           func(ctx context.Context, input *iam.GetPolicyInput, opts []func(*iam.Options)) (*iam.GetPolicyOutput, error)
-	MockCreatePolicy        func(ctx context.Context, input *iam.CreatePolicyInput, opts []func(*iam.Options)) (*iam.CreatePolicyOutput, error)
-	MockDeletePolicy        func(ctx context.Context, input *iam.DeletePolicyInput, opts []func(*iam.Options)) (*iam.DeletePolicyOutput, error)
-	MockGetPolicyVersion    func(ctx context.Context, input *iam.GetPolicyVersionInput, opts []func(*iam.Options)) (*iam.GetPolicyVersionOutput, error)
-	MockCreatePolicyVersion func(ctx context.Context, input *iam.CreatePolicyVersionInput, opts []func(*iam.Options)) (*iam.CreatePolicyVersionOutput, error)
-	MockListPolicyVersions  func(ctx context.Context, input *iam.ListPolicyVersionsInput, opts []func(*iam.Options)) (*iam.ListPolicyVersionsOutput, error)
-	MockDeletePolicyVersion func(ctx context.Context, input *iam.DeletePolicyVersionInput, opts []func(*iam.Options)) (*iam.DeletePolicyVersionOutput, error)
-	MockTagPolicy           func(ctx context.Context, input *iam.TagPolicyInput, opts []func(*iam.Options)) (*iam.TagPolicyOutput, error)
-	MockUntagPolicy         func(ctx context.Context, input *iam.UntagPolicyInput, opts []func(*iam.Options)) (*iam.UntagPolicyOutput, error)
+	MockPolicyInput             MockPolicyInput
+	MockGetPolicy               func(ctx context.Context, input *iam.GetPolicyInput, opts []func(*iam.Options)) (*iam.GetPolicyOutput, error)
+	MockCreatePolicy            func(ctx context.Context, input *iam.CreatePolicyInput, opts []func(*iam.Options)) (*iam.CreatePolicyOutput, error)
+	MockDeletePolicy            func(ctx context.Context, input *iam.DeletePolicyInput, opts []func(*iam.Options)) (*iam.DeletePolicyOutput, error)
+	MockGetPolicyVersion        func(ctx context.Context, input *iam.GetPolicyVersionInput, opts []func(*iam.Options)) (*iam.GetPolicyVersionOutput, error)
+	MockCreatePolicyVersion     func(ctx context.Context, input *iam.CreatePolicyVersionInput, opts []func(*iam.Options)) (*iam.CreatePolicyVersionOutput, error)
+	MockListPolicyVersions      func(ctx context.Context, input *iam.ListPolicyVersionsInput, opts []func(*iam.Options)) (*iam.ListPolicyVersionsOutput, error)
+	MockDeletePolicyVersion     func(ctx context.Context, input *iam.DeletePolicyVersionInput, opts []func(*iam.Options)) (*iam.DeletePolicyVersionOutput, error)
+	MockSetDefaultPolicyVersion func(ctx context.Context, input *iam.SetDefaultPolicyVersionInput, opts []func(*iam.Options)) (*iam.SetDefaultPolicyVersionOutput, error)
+	MockTagPolicy               func(ctx context.Context, input *iam.TagPolicyInput, opts []func(*iam.Options)) (*iam.TagPolicyOutput, error)
+	MockUntagPolicy             func(ctx context.Context, input *iam.UntagPolicyInput, opts []func(*iam.Options)) (*iam.UntagPolicyOutput, error)
 }
 
 // MockSTSClient mock sts client
@@ -96,6 +97,11 @@ func (m *MockPolicyClient) DeletePolicyVersion(ctx context.Context, input *iam.D
 	return m.MockDeletePolicyVersion(ctx, input, opts)
 }
 
+// SetDefaultPolicyVersion mocks SetDefaultPolicyVersion method
+func (m *MockPolicyClient) SetDefaultPolicyVersion(ctx context.Context, input *iam.SetDefaultPolicyVersionInput, opts ...func(*iam.Options)) (*iam.SetDefaultPolicyVersionOutput, error) {
+	return m.MockSetDefaultPolicyVersion(ctx, input, opts)
+}
+
 // TagPolicy mocks TagPolicy method
 func (m *MockPolicyClient) TagPolicy(ctx context.Context, input *iam.TagPolicyInput, opts ...func(*iam.Options)) (*iam.TagPolicyOutput, error) {
 	m.MockPolicyInput.TagPolicyInput = input
@@ -0,0 +1,56 @@
+/*
+Copyright 2023 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package fake
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go-v2/service/iam"
+
+	clientset "github.com/crossplane-contrib/provider-aws/pkg/clients/iam"
+)
+
+// this ensures that the mock implements the client interface
+var _ clientset.UserPolicyClient = (*MockUserPolicyClient)(nil)
+
+// MockUserPolicyClient is a type that implements all the methods for UserPolicyClient interface
+type MockUserPolicyClient struct {
+	MockGetUserPolicy    func(ctx context.Context, input *iam.GetUserPolicyInput, opts []func(*iam.Options)) (*iam.GetUserPolicyOutput, error)
+	MockPutUserPolicy    func(ctx context.Context, input *iam.PutUserPolicyInput, opts []func(*iam.Options)) (*iam.PutUserPolicyOutput, error)
+	MockDeleteUserPolicy func(ctx context.Context, input *iam.DeleteUserPolicyInput, opts []func(*iam.Options)) (*iam.DeleteUserPolicyOutput, error)
+	MockListUserPolicies func(ctx context.Context, input *iam.ListUserPoliciesInput, opts []func(*iam.Options)) (*iam.ListUserPoliciesOutput, error)
+}
+
+// GetUserPolicy mocks GetUserPolicy method
+func (m *MockUserPolicyClient) GetUserPolicy(ctx context.Context, input *iam.GetUserPolicyInput, opts ...func(*iam.Options)) (*iam.GetUserPolicyOutput, error) {
+	return m.MockGetUserPolicy(ctx, input, opts)
+}
+
+// PutUserPolicy mocks PutUserPolicy method
+func (m *MockUserPolicyClient) PutUserPolicy(ctx context.Context, input *iam.PutUserPolicyInput, opts ...func(*iam.Options)) (*iam.PutUserPolicyOutput, error) {
+	return m.MockPutUserPolicy(ctx, input, opts)
+}
+
+// DeleteUserPolicy mocks DeleteUserPolicy method
+func (m *MockUserPolicyClient) DeleteUserPolicy(ctx context.Context, input *iam.DeleteUserPolicyInput, opts ...func(*iam.Options)) (*iam.DeleteUserPolicyOutput, error) {
+	return m.MockDeleteUserPolicy(ctx, input, opts)
+}
+
+// ListUserPolicies mocks ListUserPolicies method
+func (m *MockUserPolicyClient) ListUserPolicies(ctx context.Context, input *iam.ListUserPoliciesInput, opts ...func(*iam.Options)) (*iam.ListUserPoliciesOutput, error) {
+	return m.MockListUserPolicies(ctx, input, opts)
+}
@@ -0,0 +1,310 @@
+/*
+Copyright 2023 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package iam
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/iam"
+	"github.com/pkg/errors"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+
+	awsclient "github.com/crossplane-contrib/provider-aws/pkg/clients"
+)
+
+const (
+	errGetInlinePolicy    = "failed to get the inline policy"
+	errPutInlinePolicy    = "failed to put the inline policy"
+	errDeleteInlinePolicy = "failed to delete the inline policy"
+	errInlinePolicyDiff   = "cannot check whether the inline policy is up-to-date"
+	errListInlinePolicies = "failed to list the inline policies"
+	errListPolicyMRs      = "cannot list the inline policy resources"
+)
+
+// RolePolicyClient is the external client used for RolePolicy Custom Resource
+type RolePolicyClient interface {
+	GetRolePolicy(ctx context.Context, input *iam.GetRolePolicyInput, opts ...func(*iam.Options)) (*iam.GetRolePolicyOutput, error)
+	PutRolePolicy(ctx context.Context, input *iam.PutRolePolicyInput, opts ...func(*iam.Options)) (*iam.PutRolePolicyOutput, error)
+	DeleteRolePolicy(ctx context.Context, input *iam.DeleteRolePolicyInput, opts ...func(*iam.Options)) (*iam.DeleteRolePolicyOutput, error)
+	ListRolePolicies(ctx context.Context, input *iam.ListRolePoliciesInput, opts ...func(*iam.Options)) (*iam.ListRolePoliciesOutput, error)
+}
+
+// NewRolePolicyClient returns a new client given an aws config
+func NewRolePolicyClient(conf aws.Config) RolePolicyClient {
+	return iam.NewFromConfig(conf)
+}
+
+// UserPolicyClient is the external client used for UserPolicy Custom Resource
+type UserPolicyClient interface {
+	GetUserPolicy(ctx context.Context, input *iam.GetUserPolicyInput, opts ...func(*iam.Options)) (*iam.GetUserPolicyOutput, error)
+	PutUserPolicy(ctx context.Context, input *iam.PutUserPolicyInput, opts ...func(*iam.Options)) (*iam.PutUserPolicyOutput, error)
+	DeleteUserPolicy(ctx context.Context, input *iam.DeleteUserPolicyInput, opts ...func(*iam.Options)) (*iam.DeleteUserPolicyOutput, error)
+	ListUserPolicies(ctx context.Context, input *iam.ListUserPoliciesInput, opts ...func(*iam.Options)) (*iam.ListUserPoliciesOutput, error)
+}
+
+// NewUserPolicyClient returns a new client given an aws config
+func NewUserPolicyClient(conf aws.Config) UserPolicyClient {
+	return iam.NewFromConfig(conf)
+}
+
+// GroupPolicyClient is the external client used for GroupPolicy Custom Resource
+type GroupPolicyClient interface {
+	GetGroupPolicy(ctx context.Context, input *iam.GetGroupPolicyInput, opts ...func(*iam.Options)) (*iam.GetGroupPolicyOutput, error)
+	PutGroupPolicy(ctx context.Context, input *iam.PutGroupPolicyInput, opts ...func(*iam.Options)) (*iam.PutGroupPolicyOutput, error)
+	DeleteGroupPolicy(ctx context.Context, input *iam.DeleteGroupPolicyInput, opts ...func(*iam.Options)) (*iam.DeleteGroupPolicyOutput, error)
+	ListGroupPolicies(ctx context.Context, input *iam.ListGroupPoliciesInput, opts ...func(*iam.Options)) (*iam.ListGroupPoliciesOutput, error)
+}
+
+// NewGroupPolicyClient returns a new client given an aws config
+func NewGroupPolicyClient(conf aws.Config) GroupPolicyClient {
+	return iam.NewFromConfig(conf)
+}
+
+// InlinePolicyClient gets, puts and deletes the inline policies embedded in
+// one kind of IAM identity, i.e. a role, a user or a group.
+type InlinePolicyClient interface {
+	// GetPolicyDocument returns the URL encoded document of the inline
+	// policy.
+	GetPolicyDocument(ctx context.Context, identity, policy string) (*string, error)
+	PutPolicyDocument(ctx context.Context, identity, policy, document string) error
+	DeletePolicy(ctx context.Context, identity, policy string) error
+	// ListPolicyNames returns the names of all inline policies of the
+	// identity.
+	ListPolicyNames(ctx context.Context, identity string) ([]string, error)
+}
+
+// NewRoleInlinePolicyClient returns an InlinePolicyClient for the inline
+// policies of roles.
+func NewRoleInlinePolicyClient(c RolePolicyClient) InlinePolicyClient {
+	return &roleInlinePolicyClient{client: c}
+}
+
+type roleInlinePolicyClient struct {
+	client RolePolicyClient
+}
+
+func (c *roleInlinePolicyClient) GetPolicyDocument(ctx context.Context, identity, policy string) (*string, error) {
+	o, err := c.client.GetRolePolicy(ctx, &iam.GetRolePolicyInput{RoleName: aws.String(identity), PolicyName: aws.String(policy)})
+	if err != nil {
+		return nil, err
+	}
+	return o.PolicyDocument, nil
+}
+
+func (c *roleInlinePolicyClient) PutPolicyDocument(ctx context.Context, identity, policy, document string) error {
+	_, err := c.client.PutRolePolicy(ctx, &iam.PutRolePolicyInput{RoleName: aws.String(identity), PolicyName: aws.String(policy), PolicyDocument: aws.String(document)})
+	return err
+}
+
+func (c *roleInlinePolicyClient) DeletePolicy(ctx context.Context, identity, policy string) error {
+	_, err := c.client.DeleteRolePolicy(ctx, &iam.DeleteRolePolicyInput{RoleName: aws.String(identity), PolicyName: aws.String(policy)})
+	return err
+}
+
+func (c *roleInlinePolicyClient) ListPolicyNames(ctx context.Context, identity string) ([]string, error) {
+	var names []string
+	input := &iam.ListRolePoliciesInput{RoleName: aws.String(identity)}
+	for {
+		o, err := c.client.ListRolePolicies(ctx, input)
+		if err != nil {
+			return nil, err
+		}
+		names = append(names, o.PolicyNames...)
+		if !o.IsTruncated {
+			return names, nil
+		}
+		input.Marker = o.Marker
+	}
+}
+
+// NewUserInlinePolicyClient returns an InlinePolicyClient for the inline
+// policies of users.
+func NewUserInlinePolicyClient(c UserPolicyClient) InlinePolicyClient {
+	return &userInlinePolicyClient{client: c}
+}
+
+type userInlinePolicyClient struct {
+	client UserPolicyClient
+}
+
+func (c *userInlinePolicyClient) GetPolicyDocument(ctx context.Context, identity, policy string) (*string, error) {
+	o, err := c.client.GetUserPolicy(ctx, &iam.GetUserPolicyInput{UserName: aws.String(identity), PolicyName: aws.String(policy)})
+	if err != nil {
+		return nil, err
+	}
+	return o.PolicyDocument, nil
+}
+
+func (c *userInlinePolicyClient) PutPolicyDocument(ctx context.Context, identity, policy, document string) error {
+	_, err := c.client.PutUserPolicy(ctx, &iam.PutUserPolicyInput{UserName: aws.String(identity), PolicyName: aws.String(policy), PolicyDocument: aws.String(document)})
+	return err
+}
+
+func (c *userInlinePolicyClient) DeletePolicy(ctx context.Context, identity, policy string) error {
+	_, err := c.client.DeleteUserPolicy(ctx, &iam.DeleteUserPolicyInput{UserName: aws.String(identity), PolicyName: aws.String(policy)})
+	return err
+}
+
+func (c *userInlinePolicyClient) ListPolicyNames(ctx context.Context, identity string) ([]string, error) {
+	var names []string
+	input := &iam.ListUserPoliciesInput{UserName: aws.String(identity)}
+	for {
+		o, err := c.client.ListUserPolicies(ctx, input)
+		if err != nil {
+			return nil, err
+		}
+		names = append(names, o.PolicyNames...)
+		if !o.IsTruncated {
+			return names, nil
+		}
+		input.Marker = o.Marker
+	}
+}
+
+// NewGroupInlinePolicyClient returns an InlinePolicyClient for the inline
+// policies of groups.
+func NewGroupInlinePolicyClient(c GroupPolicyClient) InlinePolicyClient {
+	return &groupInlinePolicyClient{client: c}
+}
+
+type groupInlinePolicyClient struct {
+	client GroupPolicyClient
+}
+
+func (c *groupInlinePolicyClient) GetPolicyDocument(ctx context.Context, identity, policy string) (*string, error) {
+	o, err := c.client.GetGroupPolicy(ctx, &iam.GetGroupPolicyInput{GroupName: aws.String(identity), PolicyName: aws.String(policy)})
+	if err != nil {
+		return nil, err
+	}
+	return o.PolicyDocument, nil
+}
+
+func (c *groupInlinePolicyClient) PutPolicyDocument(ctx context.Context, identity, policy, document string) error {
+	_, err := c.client.PutGroupPolicy(ctx, &iam.PutGroupPolicyInput{GroupName: aws.String(identity), PolicyName: aws.String(policy), PolicyDocument: aws.String(document)})
+	return err
+}
+
+func (c *groupInlinePolicyClient) DeletePolicy(ctx context.Context, identity, policy string) error {
+	_, err := c.client.DeleteGroupPolicy(ctx, &iam.DeleteGroupPolicyInput{GroupName: aws.String(identity), PolicyName: aws.String(policy)})
+	return err
+}
+
+func (c *groupInlinePolicyClient) ListPolicyNames(ctx context.Context, identity string) ([]string, error) {
+	var names []string
+	input := &iam.ListGroupPoliciesInput{GroupName: aws.String(identity)}
+	for {
+		o, err := c.client.ListGroupPolicies(ctx, input)
+		if err != nil {
+			return nil, err
+		}
+		names = append(names, o.PolicyNames...)
+		if !o.IsTruncated {
+			return names, nil
+		}
+		input.Marker = o.Marker
+	}
+}
+
+// ObserveInlinePolicy observes the inline policy of the supplied identity and
+// compares its document with the desired one.
+func ObserveInlinePolicy(ctx context.Context, c InlinePolicyClient, identity, policy, document string) (managed.ExternalObservation, error) {
+	observed, err := c.GetPolicyDocument(ctx, identity, policy)
+	if err != nil {
+		return managed.ExternalObservation{}, awsclient.Wrap(resource.Ignore(IsErrorNotFound, err), errGetInlinePolicy)
+	}
+	upToDate, diff, err := IsPolicyDocumentUpToDate(document, observed)
+	if err != nil {
+		return managed.ExternalObservation{}, errors.Wrap(err, errInlinePolicyDiff)
+	}
+	return managed.ExternalObservation{
+		ResourceExists:   true,
+		ResourceUpToDate: upToDate,
+		Diff:             diff,
+	}, nil
+}
+
+// PutInlinePolicy creates or replaces the inline policy of the supplied
+// identity.
+func PutInlinePolicy(ctx context.Context, c InlinePolicyClient, identity, policy, document string) error {
+	return awsclient.Wrap(c.PutPolicyDocument(ctx, identity, policy, document), errPutInlinePolicy)
+}
+
+// DeleteInlinePolicy deletes the inline policy of the supplied identity. A
+// policy that does not exist anymore is not an error.
+func DeleteInlinePolicy(ctx context.Context, c InlinePolicyClient, identity, policy string) error {
+	return awsclient.Wrap(resource.Ignore(IsErrorNotFound, c.DeletePolicy(ctx, identity, policy)), errDeleteInlinePolicy)
+}
+
+// FindUnmanagedInlinePolicies returns the sorted names of the inline policies
+// of the supplied identity resource that are not managed by any of the inline
+// policy resources of the supplied list kind. The identityName function
+// returns the name of the identity an inline policy resource refers to.
+func FindUnmanagedInlinePolicies(ctx context.Context, kube client.Client, c InlinePolicyClient, identity resource.Managed, l resource.ManagedList, identityName func(resource.Managed) string) ([]string, error) {
+	observed, err := c.ListPolicyNames(ctx, meta.GetExternalName(identity))
+	if err != nil {
+		return nil, awsclient.Wrap(err, errListInlinePolicies)
+	}
+	if err := kube.List(ctx, l); err != nil {
+		return nil, errors.Wrap(err, errListPolicyMRs)
+	}
+	var names []string
+	for _, p := range l.GetItems() {
+		if IsInlinePolicyOf(p, identityName(p), identity) {
+			names = append(names, meta.GetExternalName(p))
+		}
+	}
+	return UnmanagedInlinePolicies(observed, names), nil
+}
+
+// RemoveUnmanagedInlinePolicies deletes the inline policies of the supplied
+// identity resource that FindUnmanagedInlinePolicies returns.
+func RemoveUnmanagedInlinePolicies(ctx context.Context, kube client.Client, c InlinePolicyClient, identity resource.Managed, l resource.ManagedList, identityName func(resource.Managed) string) error {
+	unmanaged, err := FindUnmanagedInlinePolicies(ctx, kube, c, identity, l, identityName)
+	if err != nil {
+		return err
+	}
+	for _, p := range unmanaged {
+		if err := DeleteInlinePolicy(ctx, c, meta.GetExternalName(identity), p); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// IsInlinePolicyOf returns true if the inline policy resource, which refers
+// to the identity with the supplied name, manages a policy of the supplied
+// identity resource. Both have to use the same ProviderConfig because the same
+// name may exist in several accounts. Policies whose identity name is not
+// resolved yet belong to no identity.
+func IsInlinePolicyOf(policy resource.Managed, identityName string, identity resource.Managed) bool {
+	if identityName == "" || identityName != meta.GetExternalName(identity) {
+		return false
+	}
+	return providerConfigName(policy) == providerConfigName(identity)
+}
+
+func providerConfigName(mg resource.Managed) string {
+	if ref := mg.GetProviderConfigReference(); ref != nil {
+		return ref.Name
+	}
+	return ""
+}
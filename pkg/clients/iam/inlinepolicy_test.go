@@ -0,0 +1,273 @@
+/*
+Copyright 2023 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package iam_test
+
+import (
+	"context"
+	"net/url"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	awsiam "github.com/aws/aws-sdk-go-v2/service/iam"
+	awsiamtypes "github.com/aws/aws-sdk-go-v2/service/iam/types"
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+	"github.com/pkg/errors"
+
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+
+	awsclient "github.com/crossplane-contrib/provider-aws/pkg/clients"
+	"github.com/crossplane-contrib/provider-aws/pkg/clients/iam"
+	"github.com/crossplane-contrib/provider-aws/pkg/clients/iam/fake"
+)
+
+const (
+	identity      = "some-identity"
+	policyName    = "some-policy"
+	policyDoc     = `{"Version":"2012-10-17","Statement":[{"Effect":"Allow","Action":"s3:GetObject","Resource":"*"}]}`
+	reordered     = `{"Statement":[{"Resource":["*"],"Action":["s3:getobject"],"Effect":"Allow"}],"Version":"2012-10-17"}`
+	otherDocument = `{"Version":"2012-10-17","Statement":[{"Effect":"Deny","Action":"s3:GetObject","Resource":"*"}]}`
+
+	errGet    = "failed to get the inline policy"
+	errPut    = "failed to put the inline policy"
+	errDelete = "failed to delete the inline policy"
+)
+
+var errBoom = errors.New("boom")
+
+type inlinePolicyOp func(ctx context.Context, c iam.InlinePolicyClient) (managed.ExternalObservation, error)
+
+func observe(ctx context.Context, c iam.InlinePolicyClient) (managed.ExternalObservation, error) {
+	return iam.ObserveInlinePolicy(ctx, c, identity, policyName, policyDoc)
+}
+
+func put(ctx context.Context, c iam.InlinePolicyClient) (managed.ExternalObservation, error) {
+	return managed.ExternalObservation{}, iam.PutInlinePolicy(ctx, c, identity, policyName, policyDoc)
+}
+
+func remove(ctx context.Context, c iam.InlinePolicyClient) (managed.ExternalObservation, error) {
+	return managed.ExternalObservation{}, iam.DeleteInlinePolicy(ctx, c, identity, policyName)
+}
+
+func TestInlinePolicy(t *testing.T) {
+	type args struct {
+		client iam.InlinePolicyClient
+		op     inlinePolicyOp
+	}
+	type want struct {
+		obs managed.ExternalObservation
+		err error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"RoleUpToDate": {
+			args: args{
+				client: iam.NewRoleInlinePolicyClient(&fake.MockRolePolicyClient{
+					MockGetRolePolicy: func(_ context.Context, input *awsiam.GetRolePolicyInput, _ []func(*awsiam.Options)) (*awsiam.GetRolePolicyOutput, error) {
+						if diff := cmp.Diff(&awsiam.GetRolePolicyInput{RoleName: aws.String(identity), PolicyName: aws.String(policyName)}, input, cmpopts.IgnoreUnexported(awsiam.GetRolePolicyInput{})); diff != "" {
+							t.Errorf("r: -want, +got:\n%s", diff)
+						}
+						return &awsiam.GetRolePolicyOutput{PolicyDocument: aws.String(url.QueryEscape(reordered))}, nil
+					},
+				}),
+				op: observe,
+			},
+			want: want{
+				obs: managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: true},
+			},
+		},
+		"UserNotUpToDate": {
+			args: args{
+				client: iam.NewUserInlinePolicyClient(&fake.MockUserPolicyClient{
+					MockGetUserPolicy: func(_ context.Context, input *awsiam.GetUserPolicyInput, _ []func(*awsiam.Options)) (*awsiam.GetUserPolicyOutput, error) {
+						if diff := cmp.Diff(&awsiam.GetUserPolicyInput{UserName: aws.String(identity), PolicyName: aws.String(policyName)}, input, cmpopts.IgnoreUnexported(awsiam.GetUserPolicyInput{})); diff != "" {
+							t.Errorf("r: -want, +got:\n%s", diff)
+						}
+						return &awsiam.GetUserPolicyOutput{PolicyDocument: aws.String(url.QueryEscape(otherDocument))}, nil
+					},
+				}),
+				op: observe,
+			},
+			want: want{
+				obs: managed.ExternalObservation{ResourceExists: true},
+			},
+		},
+		"GroupNotFound": {
+			args: args{
+				client: iam.NewGroupInlinePolicyClient(&fake.MockGroupPolicyClient{
+					MockGetGroupPolicy: func(_ context.Context, _ *awsiam.GetGroupPolicyInput, _ []func(*awsiam.Options)) (*awsiam.GetGroupPolicyOutput, error) {
+						return nil, &awsiamtypes.NoSuchEntityException{}
+					},
+				}),
+				op: observe,
+			},
+		},
+		"GetFailed": {
+			args: args{
+				client: iam.NewRoleInlinePolicyClient(&fake.MockRolePolicyClient{
+					MockGetRolePolicy: func(_ context.Context, _ *awsiam.GetRolePolicyInput, _ []func(*awsiam.Options)) (*awsiam.GetRolePolicyOutput, error) {
+						return nil, errBoom
+					},
+				}),
+				op: observe,
+			},
+			want: want{
+				err: awsclient.Wrap(errBoom, errGet),
+			},
+		},
+		"RolePut": {
+			args: args{
+				client: iam.NewRoleInlinePolicyClient(&fake.MockRolePolicyClient{
+					MockPutRolePolicy: func(_ context.Context, input *awsiam.PutRolePolicyInput, _ []func(*awsiam.Options)) (*awsiam.PutRolePolicyOutput, error) {
+						if diff := cmp.Diff(&awsiam.PutRolePolicyInput{RoleName: aws.String(identity), PolicyName: aws.String(policyName), PolicyDocument: aws.String(policyDoc)}, input, cmpopts.IgnoreUnexported(awsiam.PutRolePolicyInput{})); diff != "" {
+							t.Errorf("r: -want, +got:\n%s", diff)
+						}
+						return &awsiam.PutRolePolicyOutput{}, nil
+					},
+				}),
+				op: put,
+			},
+		},
+		"UserPutFailed": {
+			args: args{
+				client: iam.NewUserInlinePolicyClient(&fake.MockUserPolicyClient{
+					MockPutUserPolicy: func(_ context.Context, _ *awsiam.PutUserPolicyInput, _ []func(*awsiam.Options)) (*awsiam.PutUserPolicyOutput, error) {
+						return nil, errBoom
+					},
+				}),
+				op: put,
+			},
+			want: want{
+				err: awsclient.Wrap(errBoom, errPut),
+			},
+		},
+		"GroupPut": {
+			args: args{
+				client: iam.NewGroupInlinePolicyClient(&fake.MockGroupPolicyClient{
+					MockPutGroupPolicy: func(_ context.Context, input *awsiam.PutGroupPolicyInput, _ []func(*awsiam.Options)) (*awsiam.PutGroupPolicyOutput, error) {
+						if diff := cmp.Diff(&awsiam.PutGroupPolicyInput{GroupName: aws.String(identity), PolicyName: aws.String(policyName), PolicyDocument: aws.String(policyDoc)}, input, cmpopts.IgnoreUnexported(awsiam.PutGroupPolicyInput{})); diff != "" {
+							t.Errorf("r: -want, +got:\n%s", diff)
+						}
+						return &awsiam.PutGroupPolicyOutput{}, nil
+					},
+				}),
+				op: put,
+			},
+		},
+		"RoleDelete": {
+			args: args{
+				client: iam.NewRoleInlinePolicyClient(&fake.MockRolePolicyClient{
+					MockDeleteRolePolicy: func(_ context.Context, input *awsiam.DeleteRolePolicyInput, _ []func(*awsiam.Options)) (*awsiam.DeleteRolePolicyOutput, error) {
+						if diff := cmp.Diff(&awsiam.DeleteRolePolicyInput{RoleName: aws.String(identity), PolicyName: aws.String(policyName)}, input, cmpopts.IgnoreUnexported(awsiam.DeleteRolePolicyInput{})); diff != "" {
+							t.Errorf("r: -want, +got:\n%s", diff)
+						}
+						return &awsiam.DeleteRolePolicyOutput{}, nil
+					},
+				}),
+				op: remove,
+			},
+		},
+		"UserDeleteNotFound": {
+			args: args{
+				client: iam.NewUserInlinePolicyClient(&fake.MockUserPolicyClient{
+					MockDeleteUserPolicy: func(_ context.Context, _ *awsiam.DeleteUserPolicyInput, _ []func(*awsiam.Options)) (*awsiam.DeleteUserPolicyOutput, error) {
+						return nil, &awsiamtypes.NoSuchEntityException{}
+					},
+				}),
+				op: remove,
+			},
+		},
+		"GroupDeleteFailed": {
+			args: args{
+				client: iam.NewGroupInlinePolicyClient(&fake.MockGroupPolicyClient{
+					MockDeleteGroupPolicy: func(_ context.Context, _ *awsiam.DeleteGroupPolicyInput, _ []func(*awsiam.Options)) (*awsiam.DeleteGroupPolicyOutput, error) {
+						return nil, errBoom
+					},
+				}),
+				op: remove,
+			},
+			want: want{
+				err: awsclient.Wrap(errBoom, errDelete),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			obs, err := tc.args.op(context.Background(), tc.args.client)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.obs, obs, cmpopts.IgnoreFields(managed.ExternalObservation{}, "Diff")); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestListPolicyNames(t *testing.T) {
+	type want struct {
+		names []string
+		err   error
+	}
+
+	cases := map[string]struct {
+		client iam.InlinePolicyClient
+		want
+	}{
+		"GroupPaginated": {
+			client: iam.NewGroupInlinePolicyClient(&fake.MockGroupPolicyClient{
+				MockListGroupPolicies: func(_ context.Context, input *awsiam.ListGroupPoliciesInput, _ []func(*awsiam.Options)) (*awsiam.ListGroupPoliciesOutput, error) {
+					if input.Marker == nil {
+						return &awsiam.ListGroupPoliciesOutput{PolicyNames: []string{"a"}, IsTruncated: true, Marker: aws.String("next")}, nil
+					}
+					return &awsiam.ListGroupPoliciesOutput{PolicyNames: []string{"b"}}, nil
+				},
+			}),
+			want: want{
+				names: []string{"a", "b"},
+			},
+		},
+		"UserFailed": {
+			client: iam.NewUserInlinePolicyClient(&fake.MockUserPolicyClient{
+				MockListUserPolicies: func(_ context.Context, _ *awsiam.ListUserPoliciesInput, _ []func(*awsiam.Options)) (*awsiam.ListUserPoliciesOutput, error) {
+					return nil, errBoom
+				},
+			}),
+			want: want{
+				err: errBoom,
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			names, err := tc.client.ListPolicyNames(context.Background(), identity)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.names, names); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
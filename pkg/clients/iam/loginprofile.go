@@ -0,0 +1,72 @@
+/*
+Copyright 2023 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package iam
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/aws/arn"
+	"github.com/aws/aws-sdk-go-v2/service/iam"
+	iamtypes "github.com/aws/aws-sdk-go-v2/service/iam/types"
+
+	"github.com/crossplane-contrib/provider-aws/apis/iam/v1beta1"
+	awsclient "github.com/crossplane-contrib/provider-aws/pkg/clients"
+)
+
+// LoginProfileClient is the external client used for LoginProfile Custom Resource
+type LoginProfileClient interface {
+	GetLoginProfile(ctx context.Context, input *iam.GetLoginProfileInput, opts ...func(*iam.Options)) (*iam.GetLoginProfileOutput, error)
+	CreateLoginProfile(ctx context.Context, input *iam.CreateLoginProfileInput, opts ...func(*iam.Options)) (*iam.CreateLoginProfileOutput, error)
+	UpdateLoginProfile(ctx context.Context, input *iam.UpdateLoginProfileInput, opts ...func(*iam.Options)) (*iam.UpdateLoginProfileOutput, error)
+	DeleteLoginProfile(ctx context.Context, input *iam.DeleteLoginProfileInput, opts ...func(*iam.Options)) (*iam.DeleteLoginProfileOutput, error)
+}
+
+// NewLoginProfileClient returns a new client given an aws config
+func NewLoginProfileClient(conf aws.Config) LoginProfileClient {
+	return iam.NewFromConfig(conf)
+}
+
+// GenerateLoginProfileObservation is used to produce v1beta1.LoginProfileObservation
+// from iamtypes.LoginProfile.
+func GenerateLoginProfileObservation(p iamtypes.LoginProfile) v1beta1.LoginProfileObservation {
+	return v1beta1.LoginProfileObservation{
+		CreateDate:            awsclient.TimeToMetaTime(p.CreateDate),
+		PasswordResetRequired: p.PasswordResetRequired,
+	}
+}
+
+// IsLoginProfileUpToDate checks whether the observed login profile matches
+// the desired one. The password is not part of the observation and has to
+// be checked separately.
+func IsLoginProfileUpToDate(in v1beta1.LoginProfileParameters, observed iamtypes.LoginProfile) bool {
+	return in.PasswordResetRequired == nil || *in.PasswordResetRequired == observed.PasswordResetRequired
+}
+
+// SignInURL returns the URL of the AWS Management Console sign-in page of
+// the account of the supplied caller identity.
+func SignInURL(caller arn.ARN) string {
+	domain := "signin.aws.amazon.com"
+	switch caller.Partition {
+	case "aws-cn":
+		domain = "signin.amazonaws.cn"
+	case "aws-us-gov":
+		domain = "signin.amazonaws-us-gov.com"
+	}
+	return fmt.Sprintf("https://%s.%s/console", caller.AccountID, domain)
+}
@@ -0,0 +1,86 @@
+package iam
+
+import (
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/aws/arn"
+	iamtypes "github.com/aws/aws-sdk-go-v2/service/iam/types"
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/crossplane-contrib/provider-aws/apis/iam/v1beta1"
+)
+
+func TestIsLoginProfileUpToDate(t *testing.T) {
+	type args struct {
+		in       v1beta1.LoginProfileParameters
+		observed iamtypes.LoginProfile
+	}
+
+	cases := map[string]struct {
+		args args
+		want bool
+	}{
+		"PasswordResetRequiredNotSet": {
+			args: args{
+				observed: iamtypes.LoginProfile{PasswordResetRequired: true},
+			},
+			want: true,
+		},
+		"PasswordResetRequiredSame": {
+			args: args{
+				in:       v1beta1.LoginProfileParameters{PasswordResetRequired: aws.Bool(true)},
+				observed: iamtypes.LoginProfile{PasswordResetRequired: true},
+			},
+			want: true,
+		},
+		"PasswordResetRequiredDifferent": {
+			args: args{
+				in:       v1beta1.LoginProfileParameters{PasswordResetRequired: aws.Bool(false)},
+				observed: iamtypes.LoginProfile{PasswordResetRequired: true},
+			},
+			want: false,
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got := IsLoginProfileUpToDate(tc.args.in, tc.args.observed)
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestSignInURL(t *testing.T) {
+	cases := map[string]struct {
+		caller string
+		want   string
+	}{
+		"Commercial": {
+			caller: "arn:aws:iam::123456789012:user/crossplane",
+			want:   "https://123456789012.signin.aws.amazon.com/console",
+		},
+		"China": {
+			caller: "arn:aws-cn:iam::123456789012:user/crossplane",
+			want:   "https://123456789012.signin.amazonaws.cn/console",
+		},
+		"GovCloud": {
+			caller: "arn:aws-us-gov:sts::123456789012:assumed-role/crossplane/session",
+			want:   "https://123456789012.signin.amazonaws-us-gov.com/console",
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			caller, err := arn.Parse(tc.caller)
+			if err != nil {
+				t.Fatalf("arn.Parse(...): %s", err)
+			}
+			if diff := cmp.Diff(tc.want, SignInURL(caller)); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
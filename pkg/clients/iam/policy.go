@@ -3,6 +3,7 @@ package iam
 import (
 	"context"
 	"net/url"
+	"sort"
 
 	"github.com/crossplane-contrib/provider-aws/apis/iam/v1beta1"
 
@@ -11,10 +12,14 @@ import (
 	iamtypes "github.com/aws/aws-sdk-go-v2/service/iam/types"
 	"github.com/aws/aws-sdk-go-v2/service/sts"
 
-	awsclients "github.com/crossplane-contrib/provider-aws/pkg/clients"
+	awsclient "github.com/crossplane-contrib/provider-aws/pkg/clients"
 	policyutils "github.com/crossplane-contrib/provider-aws/pkg/utils/policy"
 )
 
+// MaxPolicyVersions is the maximum number of versions IAM allows for a
+// managed policy.
+const MaxPolicyVersions = 5
+
 // PolicyClient is the external client used for Policy Custom Resource
 type PolicyClient interface {
 	GetPolicy(ctx context.Context, input *iam.GetPolicyInput, opts ...func(*iam.Options)) (*iam.GetPolicyOutput, error)
@@ -24,6 +29,7 @@ type PolicyClient interface {
 	CreatePolicyVersion(ctx context.Context, input *iam.CreatePolicyVersionInput, opts ...func(*iam.Options)) (*iam.CreatePolicyVersionOutput, error)
 	ListPolicyVersions(ctx context.Context, input *iam.ListPolicyVersionsInput, opts ...func(*iam.Options)) (*iam.ListPolicyVersionsOutput, error)
 	DeletePolicyVersion(ctx context.Context, input *iam.DeletePolicyVersionInput, opts ...func(*iam.Options)) (*iam.DeletePolicyVersionOutput, error)
+	SetDefaultPolicyVersion(ctx context.Context, input *iam.SetDefaultPolicyVersionInput, opts ...func(*iam.Options)) (*iam.SetDefaultPolicyVersionOutput, error)
 	TagPolicy(ctx context.Context, input *iam.TagPolicyInput, opts ...func(*iam.Options)) (*iam.TagPolicyOutput, error)
 	UntagPolicy(ctx context.Context, input *iam.UntagPolicyInput, opts ...func(*iam.Options)) (*iam.UntagPolicyOutput, error)
 }
@@ -45,12 +51,71 @@ func NewSTSClient(cfg aws.Config) STSClient {
 
 // IsPolicyUpToDate checks whether there is a change in any of the modifiable fields in policy.
 func IsPolicyUpToDate(in v1beta1.PolicyParameters, policy iamtypes.PolicyVersion) (bool, string, error) {
-	externalPolicyRaw := awsclients.StringValue(policy.Document)
-	if externalPolicyRaw == "" || in.Document == "" {
-		return false, "", nil
+	return IsPolicyDocumentUpToDate(in.Document, policy.Document)
+}
+
+// GeneratePolicyVersions returns the observed versions of a policy.
+func GeneratePolicyVersions(versions []iamtypes.PolicyVersion) []v1beta1.PolicyVersion {
+	if len(versions) == 0 {
+		return nil
+	}
+	res := make([]v1beta1.PolicyVersion, len(versions))
+	for i, v := range versions {
+		res[i] = v1beta1.PolicyVersion{
+			VersionID:        aws.ToString(v.VersionId),
+			CreateDate:       awsclient.TimeToMetaTime(v.CreateDate),
+			IsDefaultVersion: v.IsDefaultVersion,
+		}
+	}
+	return res
+}
+
+// PolicyVersionRetention returns the retention strategy and the maximum number
+// of versions of the policy, applying the defaults if they are not set.
+func PolicyVersionRetention(in *v1beta1.PolicyVersionRetention) (string, int) {
+	strategy, max := v1beta1.PolicyVersionRetentionKeepLatest, MaxPolicyVersions
+	if in == nil {
+		return strategy, max
+	}
+	if in.Strategy != "" {
+		strategy = in.Strategy
 	}
+	if in.MaxVersions != nil && int(*in.MaxVersions) < MaxPolicyVersions {
+		max = int(*in.MaxVersions)
+	}
+	return strategy, max
+}
 
-	unescapedPolicy, err := url.QueryUnescape(aws.ToString(policy.Document))
+// PolicyVersionsToPrune returns the oldest non-default versions that have to
+// be deleted so that no more than keep versions remain. The default version
+// is never pruned.
+func PolicyVersionsToPrune(versions []iamtypes.PolicyVersion, keep int) []iamtypes.PolicyVersion {
+	var candidates []iamtypes.PolicyVersion
+	for _, v := range versions {
+		if !v.IsDefaultVersion {
+			candidates = append(candidates, v)
+		}
+	}
+	n := len(versions) - keep
+	if n <= 0 {
+		return nil
+	}
+	if n > len(candidates) {
+		n = len(candidates)
+	}
+	sort.SliceStable(candidates, func(i, j int) bool {
+		return aws.ToTime(candidates[i].CreateDate).Before(aws.ToTime(candidates[j].CreateDate))
+	})
+	return candidates[:n]
+}
+
+// IsPolicyDocumentUpToDate checks whether the observed policy document, which
+// IAM returns URL encoded, is semantically equal to the desired document.
+func IsPolicyDocumentUpToDate(document string, observed *string) (bool, string, error) {
+	if document == "" || aws.ToString(observed) == "" {
+		return false, "", nil
+	}
+	unescapedPolicy, err := url.QueryUnescape(aws.ToString(observed))
 	if err != nil {
 		return false, "", err
 	}
@@ -58,11 +123,27 @@ func IsPolicyUpToDate(in v1beta1.PolicyParameters, policy iamtypes.PolicyVersion
 	if err != nil {
 		return false, "", err
 	}
-	specPolicy, err := policyutils.ParsePolicyString(in.Document)
+	specPolicy, err := policyutils.ParsePolicyString(document)
 	if err != nil {
 		return false, "", err
 	}
-
 	areEqual, diff := policyutils.ArePoliciesEqal(&specPolicy, &externpolicy)
 	return areEqual, diff, nil
 }
+
+// UnmanagedInlinePolicies returns the sorted names of the observed inline
+// policies that are not among the managed ones.
+func UnmanagedInlinePolicies(observed, managed []string) []string {
+	m := make(map[string]bool, len(managed))
+	for _, n := range managed {
+		m[n] = true
+	}
+	var res []string
+	for _, n := range observed {
+		if !m[n] {
+			res = append(res, n)
+		}
+	}
+	sort.Strings(res)
+	return res
+}
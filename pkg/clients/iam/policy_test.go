@@ -2,7 +2,9 @@ package iam
 
 import (
 	"testing"
+	"time"
 
+	"github.com/aws/aws-sdk-go-v2/aws"
 	iamtypes "github.com/aws/aws-sdk-go-v2/service/iam/types"
 	"github.com/crossplane/crossplane-runtime/pkg/test"
 	"github.com/google/go-cmp/cmp"
@@ -131,3 +133,130 @@ func TestIsPolicyUpToDate(t *testing.T) {
 		})
 	}
 }
+
+func TestUnmanagedInlinePolicies(t *testing.T) {
+	type args struct {
+		observed []string
+		managed  []string
+	}
+
+	cases := map[string]struct {
+		args args
+		want []string
+	}{
+		"AllManaged": {
+			args: args{
+				observed: []string{"a", "b"},
+				managed:  []string{"b", "a", "c"},
+			},
+		},
+		"SomeUnmanaged": {
+			args: args{
+				observed: []string{"d", "a", "c", "b"},
+				managed:  []string{"a"},
+			},
+			want: []string{"b", "c", "d"},
+		},
+		"NoneManaged": {
+			args: args{
+				observed: []string{"a"},
+			},
+			want: []string{"a"},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got := UnmanagedInlinePolicies(tc.args.observed, tc.args.managed)
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestPolicyVersionRetention(t *testing.T) {
+	type want struct {
+		strategy    string
+		maxVersions int
+	}
+
+	cases := map[string]struct {
+		in   *v1beta1.PolicyVersionRetention
+		want want
+	}{
+		"Defaults": {
+			want: want{
+				strategy:    v1beta1.PolicyVersionRetentionKeepLatest,
+				maxVersions: MaxPolicyVersions,
+			},
+		},
+		"Never": {
+			in: &v1beta1.PolicyVersionRetention{
+				Strategy:    v1beta1.PolicyVersionRetentionNever,
+				MaxVersions: aws.Int32(2),
+			},
+			want: want{
+				strategy:    v1beta1.PolicyVersionRetentionNever,
+				maxVersions: 2,
+			},
+		},
+		"AboveLimit": {
+			in: &v1beta1.PolicyVersionRetention{
+				MaxVersions: aws.Int32(10),
+			},
+			want: want{
+				strategy:    v1beta1.PolicyVersionRetentionKeepLatest,
+				maxVersions: MaxPolicyVersions,
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			strategy, maxVersions := PolicyVersionRetention(tc.in)
+			if diff := cmp.Diff(tc.want, want{strategy: strategy, maxVersions: maxVersions}, cmp.AllowUnexported(want{})); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestPolicyVersionsToPrune(t *testing.T) {
+	now := time.Now()
+	versions := []iamtypes.PolicyVersion{
+		{VersionId: aws.String("v2"), CreateDate: aws.Time(now.Add(-2 * time.Hour))},
+		{VersionId: aws.String("v4"), CreateDate: aws.Time(now), IsDefaultVersion: true},
+		{VersionId: aws.String("v1"), CreateDate: aws.Time(now.Add(-3 * time.Hour))},
+		{VersionId: aws.String("v3"), CreateDate: aws.Time(now.Add(-time.Hour))},
+	}
+
+	cases := map[string]struct {
+		keep int
+		want []string
+	}{
+		"EnoughRoom": {
+			keep: 4,
+		},
+		"OldestFirst": {
+			keep: 2,
+			want: []string{"v1", "v2"},
+		},
+		"DefaultIsKept": {
+			keep: 0,
+			want: []string{"v1", "v2", "v3"},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			var got []string
+			for _, v := range PolicyVersionsToPrune(versions, tc.keep) {
+				got = append(got, aws.ToString(v.VersionId))
+			}
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,84 @@
+/*
+Copyright 2023 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package iam
+
+import (
+	"context"
+	"strings"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/iam"
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+
+	"github.com/crossplane-contrib/provider-aws/apis/iam/v1beta1"
+	awsclients "github.com/crossplane-contrib/provider-aws/pkg/clients"
+)
+
+// SAMLProviderClient is the external client used for IAM SAMLProvider Custom Resource
+type SAMLProviderClient interface {
+	GetSAMLProvider(ctx context.Context, input *iam.GetSAMLProviderInput, opts ...func(*iam.Options)) (*iam.GetSAMLProviderOutput, error)
+	CreateSAMLProvider(ctx context.Context, input *iam.CreateSAMLProviderInput, opts ...func(*iam.Options)) (*iam.CreateSAMLProviderOutput, error)
+	UpdateSAMLProvider(ctx context.Context, input *iam.UpdateSAMLProviderInput, opts ...func(*iam.Options)) (*iam.UpdateSAMLProviderOutput, error)
+	DeleteSAMLProvider(ctx context.Context, input *iam.DeleteSAMLProviderInput, opts ...func(*iam.Options)) (*iam.DeleteSAMLProviderOutput, error)
+	ListSAMLProviders(ctx context.Context, input *iam.ListSAMLProvidersInput, opts ...func(*iam.Options)) (*iam.ListSAMLProvidersOutput, error)
+	TagSAMLProvider(ctx context.Context, input *iam.TagSAMLProviderInput, opts ...func(*iam.Options)) (*iam.TagSAMLProviderOutput, error)
+	UntagSAMLProvider(ctx context.Context, input *iam.UntagSAMLProviderInput, opts ...func(*iam.Options)) (*iam.UntagSAMLProviderOutput, error)
+}
+
+// NewSAMLProviderClient returns a new client using AWS credentials as JSON encoded data.
+func NewSAMLProviderClient(cfg aws.Config) SAMLProviderClient {
+	return iam.NewFromConfig(cfg)
+}
+
+// GenerateSAMLProviderObservation is used to produce v1beta1.SAMLProviderObservation
+// from iam.GetSAMLProviderOutput
+func GenerateSAMLProviderObservation(observed iam.GetSAMLProviderOutput) v1beta1.SAMLProviderObservation {
+	return v1beta1.SAMLProviderObservation{
+		CreateDate: awsclients.TimeToMetaTime(observed.CreateDate),
+		ValidUntil: awsclients.TimeToMetaTime(observed.ValidUntil),
+	}
+}
+
+// IsSAMLMetadataDocumentUpToDate checks whether the observed SAML metadata
+// document matches the desired one, ignoring leading and trailing white
+// space.
+func IsSAMLMetadataDocumentUpToDate(in v1beta1.SAMLProviderParameters, observed iam.GetSAMLProviderOutput) bool {
+	return strings.TrimSpace(in.SAMLMetadataDocument) == strings.TrimSpace(aws.ToString(observed.SAMLMetadataDocument))
+}
+
+// IsSAMLProviderUpToDate checks whether there is a change in any of the modifiable fields in SAMLProvider.
+func IsSAMLProviderUpToDate(in v1beta1.SAMLProviderParameters, observed iam.GetSAMLProviderOutput) bool {
+	if !IsSAMLMetadataDocumentUpToDate(in, observed) {
+		return false
+	}
+	cmpTags := make([]v1beta1.Tag, len(observed.Tags))
+	for i := range observed.Tags {
+		cmpTags[i] = v1beta1.Tag{Key: aws.ToString(observed.Tags[i].Key), Value: aws.ToString(observed.Tags[i].Value)}
+	}
+	sortSliceTags := cmpopts.SortSlices(func(x, y v1beta1.Tag) bool {
+		return x.Key < y.Key
+	})
+	return cmp.Equal(in.Tags, cmpTags, sortSliceTags, cmpopts.EquateEmpty())
+}
+
+// SAMLProviderName returns the name of the SAML provider with the supplied
+// ARN, i.e. arn:aws:iam::123456789012:saml-provider/<name>.
+func SAMLProviderName(arn string) string {
+	_, name, _ := strings.Cut(arn, ":saml-provider/")
+	return name
+}
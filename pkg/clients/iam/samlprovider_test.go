@@ -0,0 +1,100 @@
+package iam
+
+import (
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/iam"
+	iamtypes "github.com/aws/aws-sdk-go-v2/service/iam/types"
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/crossplane-contrib/provider-aws/apis/iam/v1beta1"
+)
+
+func TestIsSAMLProviderUpToDate(t *testing.T) {
+	type args struct {
+		in       v1beta1.SAMLProviderParameters
+		observed iam.GetSAMLProviderOutput
+	}
+
+	cases := map[string]struct {
+		args args
+		want bool
+	}{
+		"SameDocument": {
+			args: args{
+				in:       v1beta1.SAMLProviderParameters{SAMLMetadataDocument: "<EntityDescriptor/>\n"},
+				observed: iam.GetSAMLProviderOutput{SAMLMetadataDocument: aws.String("<EntityDescriptor/>")},
+			},
+			want: true,
+		},
+		"DifferentDocument": {
+			args: args{
+				in:       v1beta1.SAMLProviderParameters{SAMLMetadataDocument: "<EntityDescriptor/>"},
+				observed: iam.GetSAMLProviderOutput{SAMLMetadataDocument: aws.String("<EntityDescriptor entityID=\"idp\"/>")},
+			},
+			want: false,
+		},
+		"SameTags": {
+			args: args{
+				in: v1beta1.SAMLProviderParameters{
+					SAMLMetadataDocument: "<EntityDescriptor/>",
+					Tags:                 []v1beta1.Tag{{Key: "a", Value: "1"}, {Key: "b", Value: "2"}},
+				},
+				observed: iam.GetSAMLProviderOutput{
+					SAMLMetadataDocument: aws.String("<EntityDescriptor/>"),
+					Tags: []iamtypes.Tag{
+						{Key: aws.String("b"), Value: aws.String("2")},
+						{Key: aws.String("a"), Value: aws.String("1")},
+					},
+				},
+			},
+			want: true,
+		},
+		"DifferentTags": {
+			args: args{
+				in: v1beta1.SAMLProviderParameters{
+					SAMLMetadataDocument: "<EntityDescriptor/>",
+					Tags:                 []v1beta1.Tag{{Key: "a", Value: "1"}},
+				},
+				observed: iam.GetSAMLProviderOutput{
+					SAMLMetadataDocument: aws.String("<EntityDescriptor/>"),
+				},
+			},
+			want: false,
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got := IsSAMLProviderUpToDate(tc.args.in, tc.args.observed)
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestSAMLProviderName(t *testing.T) {
+	cases := map[string]struct {
+		arn  string
+		want string
+	}{
+		"ValidARN": {
+			arn:  "arn:aws:iam::123456789012:saml-provider/okta",
+			want: "okta",
+		},
+		"InvalidARN": {
+			arn:  "arn:aws:iam::123456789012:oidc-provider/example.com",
+			want: "",
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			if diff := cmp.Diff(tc.want, SAMLProviderName(tc.arn)); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
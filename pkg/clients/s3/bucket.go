@@ -18,7 +18,6 @@ package s3
 
 import (
 	"context"
-	"errors"
 	"fmt"
 	"sort"
 
@@ -27,11 +26,20 @@ import (
 	s3types "github.com/aws/aws-sdk-go-v2/service/s3/types"
 	"github.com/aws/smithy-go"
 	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/pkg/errors"
 
 	"github.com/crossplane-contrib/provider-aws/apis/s3/v1beta1"
 	awsclient "github.com/crossplane-contrib/provider-aws/pkg/clients"
 )
 
+const (
+	errListObjectVersions   = "cannot list object versions"
+	errDeleteObjects        = "cannot delete objects"
+	errObjectsNotDeleted    = "cannot delete objects: %d objects could not be deleted, e.g. %s (version %s): %s"
+	errListMultipartUploads = "cannot list multipart uploads"
+	errAbortMultipartUpload = "cannot abort multipart upload"
+)
+
 // See - https://docs.aws.amazon.com/AmazonS3/latest/API/ErrorResponses.html#RESTErrorResponses
 var (
 	// BucketNotFoundErrCode is the error code sent by AWS when a bucket does not exist
@@ -50,6 +58,10 @@ var (
 	TaggingNotFoundErrCode = "NoSuchTagSet"
 	// WebsiteNotFoundErrCode is the error code sent by AWS when the website config does not exist
 	WebsiteNotFoundErrCode = "NoSuchWebsiteConfiguration"
+	// ObjectLockNotFoundErrCode is the error code sent by AWS when the object lock config does not exist
+	ObjectLockNotFoundErrCode = "ObjectLockConfigurationNotFoundError"
+	// OwnershipControlsNotFoundErrCode is the error code sent by AWS when the ownership controls do not exist
+	OwnershipControlsNotFoundErrCode = "OwnershipControlsNotFoundError"
 
 	// MethodNotAllowed is the error code sent by AWS when the request method for an object is not allowed
 	MethodNotAllowed = "MethodNotAllowed"
@@ -63,6 +75,11 @@ type BucketClient interface {
 	CreateBucket(ctx context.Context, input *s3.CreateBucketInput, opts ...func(*s3.Options)) (*s3.CreateBucketOutput, error)
 	DeleteBucket(ctx context.Context, input *s3.DeleteBucketInput, opts ...func(*s3.Options)) (*s3.DeleteBucketOutput, error)
 
+	ListObjectVersions(ctx context.Context, input *s3.ListObjectVersionsInput, opts ...func(*s3.Options)) (*s3.ListObjectVersionsOutput, error)
+	DeleteObjects(ctx context.Context, input *s3.DeleteObjectsInput, opts ...func(*s3.Options)) (*s3.DeleteObjectsOutput, error)
+	ListMultipartUploads(ctx context.Context, input *s3.ListMultipartUploadsInput, opts ...func(*s3.Options)) (*s3.ListMultipartUploadsOutput, error)
+	AbortMultipartUpload(ctx context.Context, input *s3.AbortMultipartUploadInput, opts ...func(*s3.Options)) (*s3.AbortMultipartUploadOutput, error)
+
 	PutBucketEncryption(ctx context.Context, input *s3.PutBucketEncryptionInput, opts ...func(*s3.Options)) (*s3.PutBucketEncryptionOutput, error)
 	GetBucketEncryption(ctx context.Context, input *s3.GetBucketEncryptionInput, opts ...func(*s3.Options)) (*s3.GetBucketEncryptionOutput, error)
 	DeleteBucketEncryption(ctx context.Context, input *s3.DeleteBucketEncryptionInput, opts ...func(*s3.Options)) (*s3.DeleteBucketEncryptionOutput, error)
@@ -97,6 +114,23 @@ type BucketClient interface {
 
 	PutBucketAnalyticsConfiguration(ctx context.Context, input *s3.PutBucketAnalyticsConfigurationInput, opts ...func(*s3.Options)) (*s3.PutBucketAnalyticsConfigurationOutput, error)
 	GetBucketAnalyticsConfiguration(ctx context.Context, input *s3.GetBucketAnalyticsConfigurationInput, opts ...func(*s3.Options)) (*s3.GetBucketAnalyticsConfigurationOutput, error)
+	ListBucketAnalyticsConfigurations(ctx context.Context, input *s3.ListBucketAnalyticsConfigurationsInput, opts ...func(*s3.Options)) (*s3.ListBucketAnalyticsConfigurationsOutput, error)
+	DeleteBucketAnalyticsConfiguration(ctx context.Context, input *s3.DeleteBucketAnalyticsConfigurationInput, opts ...func(*s3.Options)) (*s3.DeleteBucketAnalyticsConfigurationOutput, error)
+
+	PutBucketIntelligentTieringConfiguration(ctx context.Context, input *s3.PutBucketIntelligentTieringConfigurationInput, opts ...func(*s3.Options)) (*s3.PutBucketIntelligentTieringConfigurationOutput, error)
+	ListBucketIntelligentTieringConfigurations(ctx context.Context, input *s3.ListBucketIntelligentTieringConfigurationsInput, opts ...func(*s3.Options)) (*s3.ListBucketIntelligentTieringConfigurationsOutput, error)
+	DeleteBucketIntelligentTieringConfiguration(ctx context.Context, input *s3.DeleteBucketIntelligentTieringConfigurationInput, opts ...func(*s3.Options)) (*s3.DeleteBucketIntelligentTieringConfigurationOutput, error)
+
+	PutBucketInventoryConfiguration(ctx context.Context, input *s3.PutBucketInventoryConfigurationInput, opts ...func(*s3.Options)) (*s3.PutBucketInventoryConfigurationOutput, error)
+	ListBucketInventoryConfigurations(ctx context.Context, input *s3.ListBucketInventoryConfigurationsInput, opts ...func(*s3.Options)) (*s3.ListBucketInventoryConfigurationsOutput, error)
+	DeleteBucketInventoryConfiguration(ctx context.Context, input *s3.DeleteBucketInventoryConfigurationInput, opts ...func(*s3.Options)) (*s3.DeleteBucketInventoryConfigurationOutput, error)
+
+	PutBucketMetricsConfiguration(ctx context.Context, input *s3.PutBucketMetricsConfigurationInput, opts ...func(*s3.Options)) (*s3.PutBucketMetricsConfigurationOutput, error)
+	ListBucketMetricsConfigurations(ctx context.Context, input *s3.ListBucketMetricsConfigurationsInput, opts ...func(*s3.Options)) (*s3.ListBucketMetricsConfigurationsOutput, error)
+	DeleteBucketMetricsConfiguration(ctx context.Context, input *s3.DeleteBucketMetricsConfigurationInput, opts ...func(*s3.Options)) (*s3.DeleteBucketMetricsConfigurationOutput, error)
+
+	GetObjectLockConfiguration(ctx context.Context, input *s3.GetObjectLockConfigurationInput, opts ...func(*s3.Options)) (*s3.GetObjectLockConfigurationOutput, error)
+	PutObjectLockConfiguration(ctx context.Context, input *s3.PutObjectLockConfigurationInput, opts ...func(*s3.Options)) (*s3.PutObjectLockConfigurationOutput, error)
 
 	PutBucketLifecycleConfiguration(ctx context.Context, input *s3.PutBucketLifecycleConfigurationInput, opts ...func(*s3.Options)) (*s3.PutBucketLifecycleConfigurationOutput, error)
 	GetBucketLifecycleConfiguration(ctx context.Context, input *s3.GetBucketLifecycleConfigurationInput, opts ...func(*s3.Options)) (*s3.GetBucketLifecycleConfigurationOutput, error)
@@ -203,6 +237,18 @@ func WebsiteConfigurationNotFound(err error) bool {
 	return errors.As(err, &awsErr) && awsErr.ErrorCode() == WebsiteNotFoundErrCode
 }
 
+// ObjectLockConfigurationNotFound is parses the aws Error and validates if the object lock configuration does not exist
+func ObjectLockConfigurationNotFound(err error) bool {
+	var awsErr smithy.APIError
+	return errors.As(err, &awsErr) && awsErr.ErrorCode() == ObjectLockNotFoundErrCode
+}
+
+// OwnershipControlsNotFound is parses the aws Error and validates if the ownership controls do not exist
+func OwnershipControlsNotFound(err error) bool {
+	var awsErr smithy.APIError
+	return errors.As(err, &awsErr) && awsErr.ErrorCode() == OwnershipControlsNotFoundErrCode
+}
+
 // MethodNotSupported is parses the aws Error and validates if the method is allowed for a request
 func MethodNotSupported(err error) bool {
 	var awsErr smithy.APIError
@@ -235,30 +281,6 @@ func BucketHasACLsDisabled(bucket *v1beta1.Bucket) bool {
 	return s3types.ObjectOwnership(aws.ToString(bucket.Spec.ForProvider.ObjectOwnership)) == s3types.ObjectOwnershipBucketOwnerEnforced
 }
 
-// UpdateBucketOwnershipControls creates the OwnershipContolsInput, sends the request to put an ObjectOwnership based on the bucket
-func UpdateBucketOwnershipControls(ctx context.Context, client BucketClient, bucket *v1beta1.Bucket) error {
-	objectOwnership := bucket.Spec.ForProvider.ObjectOwnership
-	if objectOwnership == nil {
-		config := &s3.DeleteBucketOwnershipControlsInput{
-			Bucket: aws.String(meta.GetExternalName(bucket)),
-		}
-		_, err := client.DeleteBucketOwnershipControls(ctx, config)
-		return err
-	}
-	config := &s3.PutBucketOwnershipControlsInput{
-		Bucket: aws.String(meta.GetExternalName(bucket)),
-		OwnershipControls: &s3types.OwnershipControls{
-			Rules: []s3types.OwnershipControlsRule{
-				{
-					ObjectOwnership: s3types.ObjectOwnership(aws.ToString(bucket.Spec.ForProvider.ObjectOwnership)),
-				},
-			},
-		},
-	}
-	_, err := client.PutBucketOwnershipControls(ctx, config)
-	return err
-}
-
 // CopyTags converts a list of local v1beta.Tags to S3 Tags
 func CopyTags(tags []v1beta1.Tag) []s3types.Tag {
 	out := make([]s3types.Tag, 0)
@@ -286,3 +308,59 @@ func SortS3TagSet(tags []s3types.Tag) []s3types.Tag {
 	})
 	return outTags
 }
+
+// EmptyBucket deletes at most maxBatches batches of object versions and delete
+// markers as well as all incomplete multipart uploads from the bucket and adds
+// the number of deleted items to the supplied status. It returns true if the
+// bucket is empty afterwards. Object versions under a governance-mode Object
+// Lock are deleted too, while those under a compliance-mode Object Lock or a
+// legal hold are reported as an error.
+func EmptyBucket(ctx context.Context, client BucketClient, name string, status *v1beta1.BucketForceDestroyStatus, maxBatches int) (bool, error) {
+	uploads, err := client.ListMultipartUploads(ctx, &s3.ListMultipartUploadsInput{Bucket: aws.String(name)})
+	if err != nil {
+		return false, awsclient.Wrap(err, errListMultipartUploads)
+	}
+	for _, u := range uploads.Uploads {
+		if _, err := client.AbortMultipartUpload(ctx, &s3.AbortMultipartUploadInput{
+			Bucket:   aws.String(name),
+			Key:      u.Key,
+			UploadId: u.UploadId,
+		}); err != nil {
+			return false, awsclient.Wrap(err, errAbortMultipartUpload)
+		}
+		status.AbortedMultipartUploads++
+	}
+
+	for i := 0; i < maxBatches; i++ {
+		// NOTE: Deleted versions are no longer listed, so we always list
+		// the first page instead of paginating.
+		versions, err := client.ListObjectVersions(ctx, &s3.ListObjectVersionsInput{Bucket: aws.String(name)})
+		if err != nil {
+			return false, awsclient.Wrap(err, errListObjectVersions)
+		}
+		objects := make([]s3types.ObjectIdentifier, 0, len(versions.Versions)+len(versions.DeleteMarkers))
+		for _, v := range versions.Versions {
+			objects = append(objects, s3types.ObjectIdentifier{Key: v.Key, VersionId: v.VersionId})
+		}
+		for _, m := range versions.DeleteMarkers {
+			objects = append(objects, s3types.ObjectIdentifier{Key: m.Key, VersionId: m.VersionId})
+		}
+		if len(objects) == 0 {
+			return !uploads.IsTruncated, nil
+		}
+		out, err := client.DeleteObjects(ctx, &s3.DeleteObjectsInput{
+			Bucket:                    aws.String(name),
+			Delete:                    &s3types.Delete{Objects: objects, Quiet: true},
+			BypassGovernanceRetention: true,
+		})
+		if err != nil {
+			return false, awsclient.Wrap(err, errDeleteObjects)
+		}
+		status.DeletedObjects += int64(len(objects) - len(out.Errors))
+		if len(out.Errors) > 0 {
+			e := out.Errors[0]
+			return false, errors.Errorf(errObjectsNotDeleted, len(out.Errors), aws.ToString(e.Key), aws.ToString(e.VersionId), aws.ToString(e.Message))
+		}
+	}
+	return false, nil
+}
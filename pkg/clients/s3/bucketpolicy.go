@@ -25,7 +25,6 @@ import (
 	"github.com/aws/aws-sdk-go-v2/aws"
 	"github.com/aws/aws-sdk-go-v2/service/s3"
 	"github.com/aws/smithy-go"
-	"github.com/google/go-cmp/cmp"
 	errors2 "github.com/pkg/errors"
 
 	"github.com/crossplane-contrib/provider-aws/apis/s3/common"
@@ -79,7 +78,8 @@ func DiffParsedPolicies(spec *common.BucketPolicyBody, external *string) (string
 	if err != nil {
 		return "", errors2.Wrap(err, policyParseExternal)
 	}
-	return cmp.Diff(specParsed, externalParsed), nil
+	_, diff := policyutils.ArePoliciesEqal(&specParsed, &externalParsed)
+	return diff, nil
 }
 
 // FormatPolicy parses and formats the BucketPolicyBody struct
@@ -33,6 +33,11 @@ type MockBucketClient struct {
 	MockCreateBucket func(ctx context.Context, input *s3.CreateBucketInput, opts []func(*s3.Options)) (*s3.CreateBucketOutput, error)
 	MockDeleteBucket func(ctx context.Context, input *s3.DeleteBucketInput, opts []func(*s3.Options)) (*s3.DeleteBucketOutput, error)
 
+	MockListObjectVersions   func(ctx context.Context, input *s3.ListObjectVersionsInput, opts []func(*s3.Options)) (*s3.ListObjectVersionsOutput, error)
+	MockDeleteObjects        func(ctx context.Context, input *s3.DeleteObjectsInput, opts []func(*s3.Options)) (*s3.DeleteObjectsOutput, error)
+	MockListMultipartUploads func(ctx context.Context, input *s3.ListMultipartUploadsInput, opts []func(*s3.Options)) (*s3.ListMultipartUploadsOutput, error)
+	MockAbortMultipartUpload func(ctx context.Context, input *s3.AbortMultipartUploadInput, opts []func(*s3.Options)) (*s3.AbortMultipartUploadOutput, error)
+
 	MockPutBucketEncryption    func(ctx context.Context, input *s3.PutBucketEncryptionInput, opts []func(*s3.Options)) (*s3.PutBucketEncryptionOutput, error)
 	MockGetBucketEncryption    func(ctx context.Context, input *s3.GetBucketEncryptionInput, opts []func(*s3.Options)) (*s3.GetBucketEncryptionOutput, error)
 	MockDeleteBucketEncryption func(ctx context.Context, input *s3.DeleteBucketEncryptionInput, opts []func(*s3.Options)) (*s3.DeleteBucketEncryptionOutput, error)
@@ -86,6 +91,24 @@ type MockBucketClient struct {
 	MockPutBucketOwnershipControls    func(ctx context.Context, input *s3.PutBucketOwnershipControlsInput, opts []func(*s3.Options)) (*s3.PutBucketOwnershipControlsOutput, error)
 	MockDeleteBucketOwnershipControls func(ctx context.Context, input *s3.DeleteBucketOwnershipControlsInput, opts []func(*s3.Options)) (*s3.DeleteBucketOwnershipControlsOutput, error)
 
+	MockListBucketAnalyticsConfigurations  func(ctx context.Context, input *s3.ListBucketAnalyticsConfigurationsInput, opts []func(*s3.Options)) (*s3.ListBucketAnalyticsConfigurationsOutput, error)
+	MockDeleteBucketAnalyticsConfiguration func(ctx context.Context, input *s3.DeleteBucketAnalyticsConfigurationInput, opts []func(*s3.Options)) (*s3.DeleteBucketAnalyticsConfigurationOutput, error)
+
+	MockPutBucketIntelligentTieringConfiguration    func(ctx context.Context, input *s3.PutBucketIntelligentTieringConfigurationInput, opts []func(*s3.Options)) (*s3.PutBucketIntelligentTieringConfigurationOutput, error)
+	MockListBucketIntelligentTieringConfigurations  func(ctx context.Context, input *s3.ListBucketIntelligentTieringConfigurationsInput, opts []func(*s3.Options)) (*s3.ListBucketIntelligentTieringConfigurationsOutput, error)
+	MockDeleteBucketIntelligentTieringConfiguration func(ctx context.Context, input *s3.DeleteBucketIntelligentTieringConfigurationInput, opts []func(*s3.Options)) (*s3.DeleteBucketIntelligentTieringConfigurationOutput, error)
+
+	MockPutBucketInventoryConfiguration    func(ctx context.Context, input *s3.PutBucketInventoryConfigurationInput, opts []func(*s3.Options)) (*s3.PutBucketInventoryConfigurationOutput, error)
+	MockListBucketInventoryConfigurations  func(ctx context.Context, input *s3.ListBucketInventoryConfigurationsInput, opts []func(*s3.Options)) (*s3.ListBucketInventoryConfigurationsOutput, error)
+	MockDeleteBucketInventoryConfiguration func(ctx context.Context, input *s3.DeleteBucketInventoryConfigurationInput, opts []func(*s3.Options)) (*s3.DeleteBucketInventoryConfigurationOutput, error)
+
+	MockPutBucketMetricsConfiguration    func(ctx context.Context, input *s3.PutBucketMetricsConfigurationInput, opts []func(*s3.Options)) (*s3.PutBucketMetricsConfigurationOutput, error)
+	MockListBucketMetricsConfigurations  func(ctx context.Context, input *s3.ListBucketMetricsConfigurationsInput, opts []func(*s3.Options)) (*s3.ListBucketMetricsConfigurationsOutput, error)
+	MockDeleteBucketMetricsConfiguration func(ctx context.Context, input *s3.DeleteBucketMetricsConfigurationInput, opts []func(*s3.Options)) (*s3.DeleteBucketMetricsConfigurationOutput, error)
+
+	MockGetObjectLockConfiguration func(ctx context.Context, input *s3.GetObjectLockConfigurationInput, opts []func(*s3.Options)) (*s3.GetObjectLockConfigurationOutput, error)
+	MockPutObjectLockConfiguration func(ctx context.Context, input *s3.PutObjectLockConfigurationInput, opts []func(*s3.Options)) (*s3.PutObjectLockConfigurationOutput, error)
+
 	MockBucketPolicyClient
 }
 
@@ -104,6 +127,26 @@ func (m MockBucketClient) DeleteBucket(ctx context.Context, input *s3.DeleteBuck
 	return m.MockDeleteBucket(ctx, input, opts)
 }
 
+// ListObjectVersions is the fake method call to invoke the internal mock method
+func (m MockBucketClient) ListObjectVersions(ctx context.Context, input *s3.ListObjectVersionsInput, opts ...func(*s3.Options)) (*s3.ListObjectVersionsOutput, error) {
+	return m.MockListObjectVersions(ctx, input, opts)
+}
+
+// DeleteObjects is the fake method call to invoke the internal mock method
+func (m MockBucketClient) DeleteObjects(ctx context.Context, input *s3.DeleteObjectsInput, opts ...func(*s3.Options)) (*s3.DeleteObjectsOutput, error) {
+	return m.MockDeleteObjects(ctx, input, opts)
+}
+
+// ListMultipartUploads is the fake method call to invoke the internal mock method
+func (m MockBucketClient) ListMultipartUploads(ctx context.Context, input *s3.ListMultipartUploadsInput, opts ...func(*s3.Options)) (*s3.ListMultipartUploadsOutput, error) {
+	return m.MockListMultipartUploads(ctx, input, opts)
+}
+
+// AbortMultipartUpload is the fake method call to invoke the internal mock method
+func (m MockBucketClient) AbortMultipartUpload(ctx context.Context, input *s3.AbortMultipartUploadInput, opts ...func(*s3.Options)) (*s3.AbortMultipartUploadOutput, error) {
+	return m.MockAbortMultipartUpload(ctx, input, opts)
+}
+
 // PutBucketEncryption is the fake method call to invoke the internal mock method
 func (m MockBucketClient) PutBucketEncryption(ctx context.Context, input *s3.PutBucketEncryptionInput, opts ...func(*s3.Options)) (*s3.PutBucketEncryptionOutput, error) {
 	return m.MockPutBucketEncryption(ctx, input, opts)
@@ -293,3 +336,68 @@ func (m MockBucketClient) PutBucketOwnershipControls(ctx context.Context, input
 func (m MockBucketClient) DeleteBucketOwnershipControls(ctx context.Context, input *s3.DeleteBucketOwnershipControlsInput, opts ...func(*s3.Options)) (*s3.DeleteBucketOwnershipControlsOutput, error) {
 	return m.MockDeleteBucketOwnershipControls(ctx, input, opts)
 }
+
+// ListBucketAnalyticsConfigurations is the fake method call to invoke the internal mock method
+func (m MockBucketClient) ListBucketAnalyticsConfigurations(ctx context.Context, input *s3.ListBucketAnalyticsConfigurationsInput, opts ...func(*s3.Options)) (*s3.ListBucketAnalyticsConfigurationsOutput, error) {
+	return m.MockListBucketAnalyticsConfigurations(ctx, input, opts)
+}
+
+// DeleteBucketAnalyticsConfiguration is the fake method call to invoke the internal mock method
+func (m MockBucketClient) DeleteBucketAnalyticsConfiguration(ctx context.Context, input *s3.DeleteBucketAnalyticsConfigurationInput, opts ...func(*s3.Options)) (*s3.DeleteBucketAnalyticsConfigurationOutput, error) {
+	return m.MockDeleteBucketAnalyticsConfiguration(ctx, input, opts)
+}
+
+// PutBucketIntelligentTieringConfiguration is the fake method call to invoke the internal mock method
+func (m MockBucketClient) PutBucketIntelligentTieringConfiguration(ctx context.Context, input *s3.PutBucketIntelligentTieringConfigurationInput, opts ...func(*s3.Options)) (*s3.PutBucketIntelligentTieringConfigurationOutput, error) {
+	return m.MockPutBucketIntelligentTieringConfiguration(ctx, input, opts)
+}
+
+// ListBucketIntelligentTieringConfigurations is the fake method call to invoke the internal mock method
+func (m MockBucketClient) ListBucketIntelligentTieringConfigurations(ctx context.Context, input *s3.ListBucketIntelligentTieringConfigurationsInput, opts ...func(*s3.Options)) (*s3.ListBucketIntelligentTieringConfigurationsOutput, error) {
+	return m.MockListBucketIntelligentTieringConfigurations(ctx, input, opts)
+}
+
+// DeleteBucketIntelligentTieringConfiguration is the fake method call to invoke the internal mock method
+func (m MockBucketClient) DeleteBucketIntelligentTieringConfiguration(ctx context.Context, input *s3.DeleteBucketIntelligentTieringConfigurationInput, opts ...func(*s3.Options)) (*s3.DeleteBucketIntelligentTieringConfigurationOutput, error) {
+	return m.MockDeleteBucketIntelligentTieringConfiguration(ctx, input, opts)
+}
+
+// PutBucketInventoryConfiguration is the fake method call to invoke the internal mock method
+func (m MockBucketClient) PutBucketInventoryConfiguration(ctx context.Context, input *s3.PutBucketInventoryConfigurationInput, opts ...func(*s3.Options)) (*s3.PutBucketInventoryConfigurationOutput, error) {
+	return m.MockPutBucketInventoryConfiguration(ctx, input, opts)
+}
+
+// ListBucketInventoryConfigurations is the fake method call to invoke the internal mock method
+func (m MockBucketClient) ListBucketInventoryConfigurations(ctx context.Context, input *s3.ListBucketInventoryConfigurationsInput, opts ...func(*s3.Options)) (*s3.ListBucketInventoryConfigurationsOutput, error) {
+	return m.MockListBucketInventoryConfigurations(ctx, input, opts)
+}
+
+// DeleteBucketInventoryConfiguration is the fake method call to invoke the internal mock method
+func (m MockBucketClient) DeleteBucketInventoryConfiguration(ctx context.Context, input *s3.DeleteBucketInventoryConfigurationInput, opts ...func(*s3.Options)) (*s3.DeleteBucketInventoryConfigurationOutput, error) {
+	return m.MockDeleteBucketInventoryConfiguration(ctx, input, opts)
+}
+
+// PutBucketMetricsConfiguration is the fake method call to invoke the internal mock method
+func (m MockBucketClient) PutBucketMetricsConfiguration(ctx context.Context, input *s3.PutBucketMetricsConfigurationInput, opts ...func(*s3.Options)) (*s3.PutBucketMetricsConfigurationOutput, error) {
+	return m.MockPutBucketMetricsConfiguration(ctx, input, opts)
+}
+
+// ListBucketMetricsConfigurations is the fake method call to invoke the internal mock method
+func (m MockBucketClient) ListBucketMetricsConfigurations(ctx context.Context, input *s3.ListBucketMetricsConfigurationsInput, opts ...func(*s3.Options)) (*s3.ListBucketMetricsConfigurationsOutput, error) {
+	return m.MockListBucketMetricsConfigurations(ctx, input, opts)
+}
+
+// DeleteBucketMetricsConfiguration is the fake method call to invoke the internal mock method
+func (m MockBucketClient) DeleteBucketMetricsConfiguration(ctx context.Context, input *s3.DeleteBucketMetricsConfigurationInput, opts ...func(*s3.Options)) (*s3.DeleteBucketMetricsConfigurationOutput, error) {
+	return m.MockDeleteBucketMetricsConfiguration(ctx, input, opts)
+}
+
+// GetObjectLockConfiguration is the fake method call to invoke the internal mock method
+func (m MockBucketClient) GetObjectLockConfiguration(ctx context.Context, input *s3.GetObjectLockConfigurationInput, opts ...func(*s3.Options)) (*s3.GetObjectLockConfigurationOutput, error) {
+	return m.MockGetObjectLockConfiguration(ctx, input, opts)
+}
+
+// PutObjectLockConfiguration is the fake method call to invoke the internal mock method
+func (m MockBucketClient) PutObjectLockConfiguration(ctx context.Context, input *s3.PutObjectLockConfigurationInput, opts ...func(*s3.Options)) (*s3.PutObjectLockConfigurationOutput, error) {
+	return m.MockPutObjectLockConfiguration(ctx, input, opts)
+}
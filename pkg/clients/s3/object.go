@@ -0,0 +1,165 @@
+/*
+Copyright 2023 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package s3
+
+import (
+	"bytes"
+	"context"
+	"crypto/md5" //nolint:gosec // MD5 is what S3 uses for ETags and Content-MD5.
+	"encoding/base64"
+	"encoding/hex"
+	"errors"
+	"net/url"
+	"strings"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/s3"
+	s3types "github.com/aws/aws-sdk-go-v2/service/s3/types"
+	"github.com/aws/smithy-go"
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+
+	"github.com/crossplane-contrib/provider-aws/apis/s3/v1alpha1"
+	awsclient "github.com/crossplane-contrib/provider-aws/pkg/clients"
+)
+
+// ObjectNotFoundErrCode is the error code sent by AWS when the object does
+// not exist
+const ObjectNotFoundErrCode = "NotFound"
+
+// ObjectClient is the external client used for Object Custom Resource
+type ObjectClient interface {
+	HeadObject(ctx context.Context, input *s3.HeadObjectInput, opts ...func(*s3.Options)) (*s3.HeadObjectOutput, error)
+	PutObject(ctx context.Context, input *s3.PutObjectInput, opts ...func(*s3.Options)) (*s3.PutObjectOutput, error)
+	DeleteObject(ctx context.Context, input *s3.DeleteObjectInput, opts ...func(*s3.Options)) (*s3.DeleteObjectOutput, error)
+	GetObjectTagging(ctx context.Context, input *s3.GetObjectTaggingInput, opts ...func(*s3.Options)) (*s3.GetObjectTaggingOutput, error)
+	PutObjectTagging(ctx context.Context, input *s3.PutObjectTaggingInput, opts ...func(*s3.Options)) (*s3.PutObjectTaggingOutput, error)
+	DeleteObjectTagging(ctx context.Context, input *s3.DeleteObjectTaggingInput, opts ...func(*s3.Options)) (*s3.DeleteObjectTaggingOutput, error)
+}
+
+// NewObjectClient returns a new client given an aws config
+func NewObjectClient(cfg aws.Config) ObjectClient {
+	return s3.NewFromConfig(cfg)
+}
+
+// IsErrorObjectNotFound returns true if the error code indicates that the
+// object was not found
+func IsErrorObjectNotFound(err error) bool {
+	var awsErr smithy.APIError
+	return errors.As(err, &awsErr) && awsErr.ErrorCode() == ObjectNotFoundErrCode
+}
+
+// ContentMD5 returns the base64 encoded MD5 digest of the supplied content,
+// as it is sent in the Content-MD5 header.
+func ContentMD5(content []byte) string {
+	sum := md5.Sum(content) //nolint:gosec // MD5 is what S3 uses for ETags and Content-MD5.
+	return base64.StdEncoding.EncodeToString(sum[:])
+}
+
+// contentETag returns the ETag that S3 assigns to an object with the
+// supplied content, if it is uploaded in a single part without SSE-KMS.
+func contentETag(content []byte) string {
+	sum := md5.Sum(content) //nolint:gosec // MD5 is what S3 uses for ETags and Content-MD5.
+	return `"` + hex.EncodeToString(sum[:]) + `"`
+}
+
+// GeneratePutObjectInput returns the input for uploading the supplied content
+// as the Object.
+func GeneratePutObjectInput(p v1alpha1.ObjectParameters, content []byte) *s3.PutObjectInput {
+	input := &s3.PutObjectInput{
+		Bucket:      p.BucketName,
+		Key:         awsclient.String(p.Key),
+		Body:        bytes.NewReader(content),
+		ContentMD5:  awsclient.String(ContentMD5(content)),
+		ContentType: p.ContentType,
+		SSEKMSKeyId: p.SSEKMSKeyID,
+		Metadata:    p.Metadata,
+	}
+	if p.ServerSideEncryption != nil {
+		input.ServerSideEncryption = s3types.ServerSideEncryption(*p.ServerSideEncryption)
+	}
+	if len(p.Tags) != 0 {
+		v := url.Values{}
+		for k, t := range p.Tags {
+			v.Set(k, t)
+		}
+		input.Tagging = awsclient.String(v.Encode())
+	}
+	return input
+}
+
+// GenerateObjectTagging returns the tag set of the Object.
+func GenerateObjectTagging(tags map[string]string) *s3types.Tagging {
+	res := &s3types.Tagging{TagSet: make([]s3types.Tag, 0, len(tags))}
+	for k, v := range tags {
+		res.TagSet = append(res.TagSet, s3types.Tag{Key: awsclient.String(k), Value: awsclient.String(v)})
+	}
+	res.TagSet = SortS3TagSet(res.TagSet)
+	return res
+}
+
+// IsObjectUpToDate checks whether the observed Object matches the desired
+// content and attributes. Since the ETag of an Object is only the MD5 digest
+// of its content for single part uploads without SSE-KMS, the content is
+// also considered up to date if neither the content nor the Object changed
+// since the controller last uploaded it.
+func IsObjectUpToDate(p v1alpha1.ObjectParameters, obs v1alpha1.ObjectObservation, content []byte, head *s3.HeadObjectOutput) bool {
+	etag := awsclient.StringValue(head.ETag)
+	if etag != contentETag(content) && (etag != obs.ETag || obs.ContentMD5 != ContentMD5(content)) {
+		return false
+	}
+	if p.ContentType != nil && awsclient.StringValue(p.ContentType) != awsclient.StringValue(head.ContentType) {
+		return false
+	}
+	if p.ServerSideEncryption != nil && awsclient.StringValue(p.ServerSideEncryption) != string(head.ServerSideEncryption) {
+		return false
+	}
+	if !isSSEKMSKeyIDUpToDate(p.SSEKMSKeyID, head.SSEKMSKeyId) {
+		return false
+	}
+	metadata := make(map[string]string, len(p.Metadata))
+	for k, v := range p.Metadata {
+		metadata[strings.ToLower(k)] = v
+	}
+	return cmp.Equal(metadata, head.Metadata, cmpopts.EquateEmpty())
+}
+
+// isSSEKMSKeyIDUpToDate checks whether the desired KMS key, given by its ID,
+// ARN or alias, is the observed one, which is always given by its ARN.
+func isSSEKMSKeyIDUpToDate(desired, observed *string) bool {
+	d, o := awsclient.StringValue(desired), awsclient.StringValue(observed)
+	switch {
+	case d == "" || d == o:
+		return true
+	case strings.HasPrefix(d, "alias/") || strings.Contains(d, ":alias/"):
+		// NOTE: Aliases cannot be resolved without calling KMS, so they are
+		// not compared.
+		return true
+	default:
+		return strings.HasSuffix(o, ":key/"+d)
+	}
+}
+
+// AreObjectTagsUpToDate checks whether the observed tags of the Object are
+// the desired ones.
+func AreObjectTagsUpToDate(tags map[string]string, observed []s3types.Tag) bool {
+	o := make(map[string]string, len(observed))
+	for _, t := range observed {
+		o[awsclient.StringValue(t.Key)] = awsclient.StringValue(t.Value)
+	}
+	return cmp.Equal(tags, o, cmpopts.EquateEmpty())
+}
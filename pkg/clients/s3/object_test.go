@@ -0,0 +1,118 @@
+/*
+Copyright 2023 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package s3
+
+import (
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/service/s3"
+	s3types "github.com/aws/aws-sdk-go-v2/service/s3/types"
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/crossplane-contrib/provider-aws/apis/s3/v1alpha1"
+	awsclient "github.com/crossplane-contrib/provider-aws/pkg/clients"
+)
+
+func TestIsObjectUpToDate(t *testing.T) {
+	content := []byte("hello")
+	etag := `"5d41402abc4b2a76b9719d911017c592"`
+	keyARN := "arn:aws:kms:us-east-1:123456789012:key/1234abcd-12ab-34cd-56ef-1234567890ab"
+
+	type args struct {
+		p       v1alpha1.ObjectParameters
+		obs     v1alpha1.ObjectObservation
+		content []byte
+		head    *s3.HeadObjectOutput
+	}
+
+	cases := map[string]struct {
+		args args
+		want bool
+	}{
+		"SameContent": {
+			args: args{
+				content: content,
+				head:    &s3.HeadObjectOutput{ETag: awsclient.String(etag)},
+			},
+			want: true,
+		},
+		"DifferentContent": {
+			args: args{
+				content: []byte("world"),
+				head:    &s3.HeadObjectOutput{ETag: awsclient.String(etag)},
+			},
+			want: false,
+		},
+		"EncryptedContentUnchangedSinceUpload": {
+			args: args{
+				p: v1alpha1.ObjectParameters{
+					ServerSideEncryption: awsclient.String("aws:kms"),
+					SSEKMSKeyID:          awsclient.String("1234abcd-12ab-34cd-56ef-1234567890ab"),
+				},
+				obs:     v1alpha1.ObjectObservation{ETag: `"kms"`, ContentMD5: ContentMD5(content)},
+				content: content,
+				head: &s3.HeadObjectOutput{
+					ETag:                 awsclient.String(`"kms"`),
+					ServerSideEncryption: s3types.ServerSideEncryptionAwsKms,
+					SSEKMSKeyId:          awsclient.String(keyARN),
+				},
+			},
+			want: true,
+		},
+		"EncryptedObjectChangedSinceUpload": {
+			args: args{
+				obs:     v1alpha1.ObjectObservation{ETag: `"kms"`, ContentMD5: ContentMD5(content)},
+				content: content,
+				head:    &s3.HeadObjectOutput{ETag: awsclient.String(`"other"`)},
+			},
+			want: false,
+		},
+		"DifferentKMSKey": {
+			args: args{
+				p:       v1alpha1.ObjectParameters{SSEKMSKeyID: awsclient.String("other")},
+				content: content,
+				head:    &s3.HeadObjectOutput{ETag: awsclient.String(etag), SSEKMSKeyId: awsclient.String(keyARN)},
+			},
+			want: false,
+		},
+		"MetadataKeysAreCaseInsensitive": {
+			args: args{
+				p:       v1alpha1.ObjectParameters{Metadata: map[string]string{"Owner": "platform"}},
+				content: content,
+				head:    &s3.HeadObjectOutput{ETag: awsclient.String(etag), Metadata: map[string]string{"owner": "platform"}},
+			},
+			want: true,
+		},
+		"DifferentContentType": {
+			args: args{
+				p:       v1alpha1.ObjectParameters{ContentType: awsclient.String("text/plain")},
+				content: content,
+				head:    &s3.HeadObjectOutput{ETag: awsclient.String(etag), ContentType: awsclient.String("binary/octet-stream")},
+			},
+			want: false,
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got := IsObjectUpToDate(tc.args.p, tc.args.obs, tc.args.content, tc.args.head)
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
@@ -30,6 +30,13 @@ type MockSecretsManagerClient struct {
 	MockDescribeSecretWithContext    func(*secretsmanager.DescribeSecretInput) (*secretsmanager.DescribeSecretOutput, error)
 	MockGetSecretValueWithContext    func(*secretsmanager.GetSecretValueInput) (*secretsmanager.GetSecretValueOutput, error)
 	MockGetResourcePolicyWithContext func(*secretsmanager.GetResourcePolicyInput) (*secretsmanager.GetResourcePolicyOutput, error)
+
+	MockDeleteResourcePolicyWithContext         func(*secretsmanager.DeleteResourcePolicyInput) (*secretsmanager.DeleteResourcePolicyOutput, error)
+	MockUpdateSecretWithContext                 func(*secretsmanager.UpdateSecretInput) (*secretsmanager.UpdateSecretOutput, error)
+	MockRotateSecretWithContext                 func(*secretsmanager.RotateSecretInput) (*secretsmanager.RotateSecretOutput, error)
+	MockCancelRotateSecretWithContext           func(*secretsmanager.CancelRotateSecretInput) (*secretsmanager.CancelRotateSecretOutput, error)
+	MockReplicateSecretToRegionsWithContext     func(*secretsmanager.ReplicateSecretToRegionsInput) (*secretsmanager.ReplicateSecretToRegionsOutput, error)
+	MockRemoveRegionsFromReplicationWithContext func(*secretsmanager.RemoveRegionsFromReplicationInput) (*secretsmanager.RemoveRegionsFromReplicationOutput, error)
 }
 
 // DescribeSecretWithContext calls c.MockDescribeSecretWithContext
@@ -46,3 +53,33 @@ func (c *MockSecretsManagerClient) GetSecretValueWithContext(_ aws.Context, in *
 func (c *MockSecretsManagerClient) GetResourcePolicyWithContext(_ aws.Context, in *secretsmanager.GetResourcePolicyInput, _ ...request.Option) (*secretsmanager.GetResourcePolicyOutput, error) {
 	return c.MockGetResourcePolicyWithContext(in)
 }
+
+// DeleteResourcePolicyWithContext calls c.MockDeleteResourcePolicyWithContext
+func (c *MockSecretsManagerClient) DeleteResourcePolicyWithContext(_ aws.Context, in *secretsmanager.DeleteResourcePolicyInput, _ ...request.Option) (*secretsmanager.DeleteResourcePolicyOutput, error) {
+	return c.MockDeleteResourcePolicyWithContext(in)
+}
+
+// UpdateSecretWithContext calls c.MockUpdateSecretWithContext
+func (c *MockSecretsManagerClient) UpdateSecretWithContext(_ aws.Context, in *secretsmanager.UpdateSecretInput, _ ...request.Option) (*secretsmanager.UpdateSecretOutput, error) {
+	return c.MockUpdateSecretWithContext(in)
+}
+
+// RotateSecretWithContext calls c.MockRotateSecretWithContext
+func (c *MockSecretsManagerClient) RotateSecretWithContext(_ aws.Context, in *secretsmanager.RotateSecretInput, _ ...request.Option) (*secretsmanager.RotateSecretOutput, error) {
+	return c.MockRotateSecretWithContext(in)
+}
+
+// CancelRotateSecretWithContext calls c.MockCancelRotateSecretWithContext
+func (c *MockSecretsManagerClient) CancelRotateSecretWithContext(_ aws.Context, in *secretsmanager.CancelRotateSecretInput, _ ...request.Option) (*secretsmanager.CancelRotateSecretOutput, error) {
+	return c.MockCancelRotateSecretWithContext(in)
+}
+
+// ReplicateSecretToRegionsWithContext calls c.MockReplicateSecretToRegionsWithContext
+func (c *MockSecretsManagerClient) ReplicateSecretToRegionsWithContext(_ aws.Context, in *secretsmanager.ReplicateSecretToRegionsInput, _ ...request.Option) (*secretsmanager.ReplicateSecretToRegionsOutput, error) {
+	return c.MockReplicateSecretToRegionsWithContext(in)
+}
+
+// RemoveRegionsFromReplicationWithContext calls c.MockRemoveRegionsFromReplicationWithContext
+func (c *MockSecretsManagerClient) RemoveRegionsFromReplicationWithContext(_ aws.Context, in *secretsmanager.RemoveRegionsFromReplicationInput, _ ...request.Option) (*secretsmanager.RemoveRegionsFromReplicationOutput, error) {
+	return c.MockRemoveRegionsFromReplicationWithContext(in)
+}
@@ -181,6 +181,15 @@ func LateInitialize(in *v1beta1.QueueParameters, attributes map[string]string, t
 	}
 }
 
+// isPolicyUpToDate compares the desired and the observed queue policy
+// semantically. No policy and an empty policy are considered equal.
+func isPolicyUpToDate(desired *string, observed string) bool {
+	if aws.ToString(desired) == "" || observed == "" {
+		return aws.ToString(desired) == observed
+	}
+	return awsclients.IsPolicyUpToDate(desired, &observed)
+}
+
 // IsUpToDate checks whether there is a change in any of the modifiable fields.
 func IsUpToDate(p v1beta1.QueueParameters, attributes map[string]string, tags map[string]string) bool { // nolint:gocyclo
 	if len(p.Tags) != len(tags) {
@@ -215,7 +224,7 @@ func IsUpToDate(p v1beta1.QueueParameters, attributes map[string]string, tags ma
 	if !cmp.Equal(aws.ToString(p.KMSMasterKeyID), attributes[v1beta1.AttributeKmsMasterKeyID]) {
 		return false
 	}
-	if !cmp.Equal(aws.ToString(p.Policy), attributes[v1beta1.AttributePolicy]) {
+	if !isPolicyUpToDate(p.Policy, attributes[v1beta1.AttributePolicy]) {
 		return false
 	}
 	if attributes[v1beta1.AttributeContentBasedDeduplication] != "" && strconv.FormatBool(aws.ToBool(p.ContentBasedDeduplication)) != attributes[v1beta1.AttributeContentBasedDeduplication] {
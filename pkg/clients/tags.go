@@ -0,0 +1,93 @@
+/*
+Copyright 2023 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package aws
+
+import (
+	"context"
+
+	"github.com/pkg/errors"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+
+	"github.com/crossplane-contrib/provider-aws/apis/v1beta1"
+)
+
+const (
+	errGetProviderConfigForTags = "cannot get referenced ProviderConfig to determine default tags"
+)
+
+// GetDefaultTags returns the tags that should be added to the supplied
+// managed resource: the default tags declared on its ProviderConfig and,
+// unless they are disabled there, the crossplane-kind, crossplane-name and
+// crossplane-providerconfig tags.
+func GetDefaultTags(ctx context.Context, c client.Client, mg resource.Managed) (defaults map[string]string, external map[string]string, err error) {
+	defaults = map[string]string{}
+	external = resource.GetExternalTags(mg)
+	ref := mg.GetProviderConfigReference()
+	if ref == nil || ref.Name == "" {
+		return defaults, external, nil
+	}
+	pc := &v1beta1.ProviderConfig{}
+	if err := c.Get(ctx, types.NamespacedName{Name: ref.Name}, pc); err != nil {
+		return nil, nil, errors.Wrap(err, errGetProviderConfigForTags)
+	}
+	if pc.Spec.DefaultTags == nil {
+		return defaults, external, nil
+	}
+	for k, v := range pc.Spec.DefaultTags.Tags {
+		defaults[k] = v
+	}
+	if pc.Spec.DefaultTags.DisableCrossplaneTags {
+		external = map[string]string{}
+	}
+	return defaults, external, nil
+}
+
+// AddDefaultTags adds the tags returned by GetDefaultTags to the supplied tag
+// map. Tags that are already set on the managed resource take precedence
+// over the default tags of the ProviderConfig, whereas the Crossplane tags
+// always override any existing value.
+func AddDefaultTags(ctx context.Context, c client.Client, mg resource.Managed, tags map[string]string) error {
+	_, err := MergeDefaultTags(ctx, c, mg, tags)
+	return err
+}
+
+// MergeDefaultTags adds the default tags to the supplied tag map as
+// AddDefaultTags does and reports whether any tag was added or changed, so
+// that callers only need to update the managed resource if it was.
+func MergeDefaultTags(ctx context.Context, c client.Client, mg resource.Managed, tags map[string]string) (bool, error) {
+	defaults, external, err := GetDefaultTags(ctx, c, mg)
+	if err != nil {
+		return false, err
+	}
+	changed := false
+	for k, v := range defaults {
+		if _, ok := tags[k]; !ok {
+			tags[k] = v
+			changed = true
+		}
+	}
+	for k, v := range external {
+		if cur, ok := tags[k]; !ok || cur != v {
+			tags[k] = v
+			changed = true
+		}
+	}
+	return changed, nil
+}
@@ -0,0 +1,159 @@
+/*
+Copyright 2023 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package aws
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+	"github.com/crossplane/crossplane-runtime/pkg/resource/fake"
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+
+	"github.com/crossplane-contrib/provider-aws/apis/v1beta1"
+)
+
+func TestMergeDefaultTags(t *testing.T) {
+	errBoom := errors.New("boom")
+	mg := &fake.Managed{
+		ObjectMeta:               metav1.ObjectMeta{Name: "some-resource"},
+		ProviderConfigReferencer: fake.ProviderConfigReferencer{Ref: &xpv1.Reference{Name: "some-config"}},
+	}
+	withDefaultTags := func(dt *v1beta1.DefaultTags) client.Client {
+		return &test.MockClient{
+			MockGet: test.NewMockGetFn(nil, func(obj client.Object) error {
+				obj.(*v1beta1.ProviderConfig).Spec.DefaultTags = dt
+				return nil
+			}),
+		}
+	}
+	type want struct {
+		tags    map[string]string
+		changed bool
+		err     error
+	}
+	cases := map[string]struct {
+		kube client.Client
+		mg   resource.Managed
+		tags map[string]string
+		want want
+	}{
+		"NoProviderConfigReference": {
+			mg:   &fake.Managed{ObjectMeta: metav1.ObjectMeta{Name: "some-resource"}},
+			tags: map[string]string{"foo": "bar"},
+			want: want{tags: map[string]string{
+				"foo":                               "bar",
+				resource.ExternalResourceTagKeyKind: "",
+				resource.ExternalResourceTagKeyName: "some-resource",
+			}, changed: true},
+		},
+		"NoDefaultTags": {
+			kube: withDefaultTags(nil),
+			mg:   mg,
+			tags: map[string]string{},
+			want: want{tags: map[string]string{
+				resource.ExternalResourceTagKeyKind:     "",
+				resource.ExternalResourceTagKeyName:     "some-resource",
+				resource.ExternalResourceTagKeyProvider: "some-config",
+			}, changed: true},
+		},
+		"ResourceTagsTakePrecedence": {
+			kube: withDefaultTags(&v1beta1.DefaultTags{
+				Tags: map[string]string{"cost-center": "default", "owner": "finance"},
+			}),
+			mg:   mg,
+			tags: map[string]string{"cost-center": "1234"},
+			want: want{tags: map[string]string{
+				"cost-center":                           "1234",
+				"owner":                                 "finance",
+				resource.ExternalResourceTagKeyKind:     "",
+				resource.ExternalResourceTagKeyName:     "some-resource",
+				resource.ExternalResourceTagKeyProvider: "some-config",
+			}, changed: true},
+		},
+		"DisableCrossplaneTags": {
+			kube: withDefaultTags(&v1beta1.DefaultTags{
+				Tags:                  map[string]string{"owner": "finance"},
+				DisableCrossplaneTags: true,
+			}),
+			mg:   mg,
+			tags: map[string]string{},
+			want: want{tags: map[string]string{"owner": "finance"}, changed: true},
+		},
+		"AlreadyTagged": {
+			kube: withDefaultTags(&v1beta1.DefaultTags{
+				Tags: map[string]string{"owner": "finance"},
+			}),
+			mg: mg,
+			tags: map[string]string{
+				"owner":                                 "accounting",
+				resource.ExternalResourceTagKeyKind:     "",
+				resource.ExternalResourceTagKeyName:     "some-resource",
+				resource.ExternalResourceTagKeyProvider: "some-config",
+			},
+			want: want{tags: map[string]string{
+				"owner":                                 "accounting",
+				resource.ExternalResourceTagKeyKind:     "",
+				resource.ExternalResourceTagKeyName:     "some-resource",
+				resource.ExternalResourceTagKeyProvider: "some-config",
+			}},
+		},
+		"CrossplaneTagsOverride": {
+			kube: withDefaultTags(nil),
+			mg:   mg,
+			tags: map[string]string{
+				resource.ExternalResourceTagKeyKind:     "",
+				resource.ExternalResourceTagKeyName:     "other-resource",
+				resource.ExternalResourceTagKeyProvider: "some-config",
+			},
+			want: want{tags: map[string]string{
+				resource.ExternalResourceTagKeyKind:     "",
+				resource.ExternalResourceTagKeyName:     "some-resource",
+				resource.ExternalResourceTagKeyProvider: "some-config",
+			}, changed: true},
+		},
+		"GetProviderConfigError": {
+			kube: &test.MockClient{MockGet: test.NewMockGetFn(errBoom)},
+			mg:   mg,
+			tags: map[string]string{},
+			want: want{
+				tags: map[string]string{},
+				err:  errors.Wrap(errBoom, errGetProviderConfigForTags),
+			},
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			changed, err := MergeDefaultTags(context.Background(), tc.kube, tc.mg, tc.tags)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.changed, changed); diff != "" {
+				t.Errorf("changed: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.tags, tc.tags); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
@@ -22,6 +22,7 @@ import (
 	"github.com/aws/aws-sdk-go-v2/aws"
 	awsacm "github.com/aws/aws-sdk-go-v2/service/acm"
 	awsacmtypes "github.com/aws/aws-sdk-go-v2/service/acm/types"
+	route53types "github.com/aws/aws-sdk-go-v2/service/route53/types"
 	"github.com/google/go-cmp/cmp"
 	"github.com/pkg/errors"
 	ctrl "sigs.k8s.io/controller-runtime"
@@ -36,9 +37,11 @@ import (
 	"github.com/crossplane/crossplane-runtime/pkg/resource"
 
 	"github.com/crossplane-contrib/provider-aws/apis/acm/v1beta1"
+	route53v1alpha1 "github.com/crossplane-contrib/provider-aws/apis/route53/v1alpha1"
 	"github.com/crossplane-contrib/provider-aws/apis/v1alpha1"
 	awsclient "github.com/crossplane-contrib/provider-aws/pkg/clients"
 	"github.com/crossplane-contrib/provider-aws/pkg/clients/acm"
+	"github.com/crossplane-contrib/provider-aws/pkg/clients/resourcerecordset"
 	"github.com/crossplane-contrib/provider-aws/pkg/features"
 )
 
@@ -55,6 +58,13 @@ const (
 	errAddTagsFailed    = "cannot add tags to Certificate"
 	errListTagsFailed   = "failed to list tags for Certificate"
 	errRemoveTagsFailed = "failed to remove tags for Certificate"
+
+	errNoHostedZone            = "no hosted zone specified for the DNS validation of the Certificate"
+	errGetValidationRecords    = "cannot get DNS validation records of Certificate"
+	errUpsertValidationRecords = "cannot create DNS validation records of Certificate"
+	errDeleteValidationRecords = "cannot delete DNS validation records of Certificate"
+	errListCertificates        = "cannot list Certificates to find shared DNS validation records"
+	errDNSValidationMethod     = "dnsValidation cannot be used with the EMAIL validation method"
 )
 
 // SetupCertificate adds a controller that reconciles Certificates.
@@ -73,7 +83,7 @@ func SetupCertificate(mgr ctrl.Manager, o controller.Options) error {
 		For(&v1beta1.Certificate{}).
 		Complete(managed.NewReconciler(mgr,
 			resource.ManagedKind(v1beta1.CertificateGroupVersionKind),
-			managed.WithExternalConnecter(&connector{client: mgr.GetClient(), newClientFn: acm.NewClient}),
+			managed.WithExternalConnecter(&connector{client: mgr.GetClient(), newClientFn: acm.NewClient, newDNSClientFn: resourcerecordset.NewClient}),
 			managed.WithConnectionPublishers(),
 			managed.WithPollInterval(o.PollInterval),
 			managed.WithReferenceResolver(managed.NewAPISimpleReferenceResolver(mgr.GetClient())),
@@ -84,8 +94,9 @@ func SetupCertificate(mgr ctrl.Manager, o controller.Options) error {
 }
 
 type connector struct {
-	client      client.Client
-	newClientFn func(aws.Config) acm.Client
+	client         client.Client
+	newClientFn    func(aws.Config) acm.Client
+	newDNSClientFn func(aws.Config) resourcerecordset.Client
 }
 
 func (c *connector) Connect(ctx context.Context, mg resource.Managed) (managed.ExternalClient, error) {
@@ -97,11 +108,12 @@ func (c *connector) Connect(ctx context.Context, mg resource.Managed) (managed.E
 	if err != nil {
 		return nil, err
 	}
-	return &external{c.newClientFn(*cfg), c.client}, nil
+	return &external{client: c.newClientFn(*cfg), dns: c.newDNSClientFn(*cfg), kube: c.client}, nil
 }
 
 type external struct {
 	client acm.Client
+	dns    resourcerecordset.Client
 	kube   client.Client
 }
 
@@ -110,6 +122,9 @@ func (e *external) Observe(ctx context.Context, mgd resource.Managed) (managed.E
 	if !ok {
 		return managed.ExternalObservation{}, errors.New(errUnexpectedObject)
 	}
+	if err := checkValidationMethod(cr.Spec.ForProvider); err != nil {
+		return managed.ExternalObservation{}, err
+	}
 
 	if meta.GetExternalName(cr) == "" {
 		return managed.ExternalObservation{
@@ -148,8 +163,19 @@ func (e *external) Observe(ctx context.Context, mgd resource.Managed) (managed.E
 	// TODO(muvaf): We can possibly call `GetCertificate` and publish the actual
 	// certificate in connection details.
 
+	upToDate := acm.IsCertificateUpToDate(cr.Spec.ForProvider, certificate, tags.Tags)
+	if cr.Spec.ForProvider.DNSValidation != nil {
+		cr.Status.AtProvider.ValidationRecords = acm.GenerateValidationRecords(certificate)
+		cr.SetConditions(validationCondition(certificate.Status))
+		missing, err := e.missingValidationRecords(ctx, cr)
+		if err != nil {
+			return managed.ExternalObservation{}, err
+		}
+		upToDate = upToDate && len(missing) == 0
+	}
+
 	return managed.ExternalObservation{
-		ResourceUpToDate:        acm.IsCertificateUpToDate(cr.Spec.ForProvider, certificate, tags.Tags),
+		ResourceUpToDate:        upToDate,
 		ResourceExists:          true,
 		ResourceLateInitialized: !cmp.Equal(current, &cr.Spec.ForProvider),
 	}, nil
@@ -160,6 +186,9 @@ func (e *external) Create(ctx context.Context, mgd resource.Managed) (managed.Ex
 	if !ok {
 		return managed.ExternalCreation{}, errors.New(errUnexpectedObject)
 	}
+	if err := checkValidationMethod(cr.Spec.ForProvider); err != nil {
+		return managed.ExternalCreation{}, err
+	}
 	response, err := e.client.RequestCertificate(ctx, acm.GenerateCreateCertificateInput(cr.Spec.ForProvider))
 	if err != nil {
 		return managed.ExternalCreation{}, awsclient.Wrap(err, errCreate)
@@ -211,6 +240,20 @@ func (e *external) Update(ctx context.Context, mgd resource.Managed) (managed.Ex
 			return managed.ExternalUpdate{}, awsclient.Wrap(err, errUpdate)
 		}
 	}
+
+	if cr.Spec.ForProvider.DNSValidation != nil {
+		missing, err := e.missingValidationRecords(ctx, cr)
+		if err != nil {
+			return managed.ExternalUpdate{}, err
+		}
+		if len(missing) != 0 {
+			_, err := e.dns.ChangeResourceRecordSets(ctx,
+				acm.GenerateChangeValidationRecordsInput(*cr.Spec.ForProvider.DNSValidation, missing, route53types.ChangeActionUpsert))
+			if err != nil {
+				return managed.ExternalUpdate{}, awsclient.Wrap(err, errUpsertValidationRecords)
+			}
+		}
+	}
 	return managed.ExternalUpdate{}, nil
 }
 
@@ -220,6 +263,10 @@ func (e *external) Delete(ctx context.Context, mgd resource.Managed) error {
 		return errors.New(errUnexpectedObject)
 	}
 
+	if err := e.deleteValidationRecords(ctx, cr); err != nil {
+		return err
+	}
+
 	_, err := e.client.DeleteCertificate(ctx, &awsacm.DeleteCertificateInput{
 		CertificateArn: aws.String(meta.GetExternalName(cr)),
 	})
@@ -227,6 +274,134 @@ func (e *external) Delete(ctx context.Context, mgd resource.Managed) error {
 	return awsclient.Wrap(resource.Ignore(acm.IsErrorNotFound, err), errDelete)
 }
 
+// missingValidationRecords returns the DNS validation records of the
+// Certificate that do not exist in the hosted zone or have a different value
+// or TTL.
+func (e *external) missingValidationRecords(ctx context.Context, cr *v1beta1.Certificate) ([]v1beta1.ResourceRecord, error) {
+	existing, err := e.existingValidationRecords(ctx, cr)
+	if err != nil {
+		return nil, err
+	}
+	ttl := acm.ValidationRecordTTL(*cr.Spec.ForProvider.DNSValidation)
+	var missing []v1beta1.ResourceRecord
+	for _, r := range cr.Status.AtProvider.ValidationRecords {
+		rrs, ok := existing[aws.ToString(r.Name)]
+		if !ok || aws.ToInt64(rrs.TTL) != ttl {
+			missing = append(missing, r)
+		}
+	}
+	return missing, nil
+}
+
+// existingValidationRecords returns the observed record sets of the DNS
+// validation records of the Certificate that exist in the hosted zone with the
+// expected value, keyed by their name.
+func (e *external) existingValidationRecords(ctx context.Context, cr *v1beta1.Certificate) (map[string]route53types.ResourceRecordSet, error) {
+	zoneID := cr.Spec.ForProvider.DNSValidation.HostedZoneID
+	if aws.ToString(zoneID) == "" {
+		return nil, errors.New(errNoHostedZone)
+	}
+	existing := map[string]route53types.ResourceRecordSet{}
+	for _, r := range cr.Status.AtProvider.ValidationRecords {
+		rrs, err := resourcerecordset.GetResourceRecordSet(ctx, aws.ToString(r.Name), route53v1alpha1.ResourceRecordSetParameters{
+			ZoneID: zoneID,
+			Type:   aws.ToString(r.Type),
+		}, e.dns)
+		if resourcerecordset.IsNotFound(err) {
+			continue
+		}
+		if err != nil {
+			return nil, awsclient.Wrap(err, errGetValidationRecords)
+		}
+		if len(rrs.ResourceRecords) == 1 && aws.ToString(rrs.ResourceRecords[0].Value) == aws.ToString(r.Value) {
+			existing[aws.ToString(r.Name)] = *rrs
+		}
+	}
+	return existing, nil
+}
+
+// deleteValidationRecords deletes the DNS validation records of the
+// Certificate, if it has DNS validation enabled. ACM uses the same validation
+// record for a domain name in all certificates of an account, so records that
+// are validation records of another Certificate in the same hosted zone are
+// kept.
+func (e *external) deleteValidationRecords(ctx context.Context, cr *v1beta1.Certificate) error {
+	if cr.Spec.ForProvider.DNSValidation == nil || len(cr.Status.AtProvider.ValidationRecords) == 0 {
+		return nil
+	}
+	existing, err := e.existingValidationRecords(ctx, cr)
+	if err != nil {
+		return err
+	}
+	shared, err := e.sharedValidationRecords(ctx, cr)
+	if err != nil {
+		return err
+	}
+	for name := range shared {
+		delete(existing, name)
+	}
+	// NOTE: Route53 rejects the whole change batch if a record to delete does
+	// not exist or differs from the observed one, so only the existing records
+	// are deleted, exactly as they were observed.
+	var sets []route53types.ResourceRecordSet
+	for _, r := range cr.Status.AtProvider.ValidationRecords {
+		if rrs, ok := existing[aws.ToString(r.Name)]; ok {
+			sets = append(sets, rrs)
+		}
+	}
+	if len(sets) == 0 {
+		return nil
+	}
+	_, err = e.dns.ChangeResourceRecordSets(ctx,
+		acm.GenerateDeleteValidationRecordsInput(cr.Spec.ForProvider.DNSValidation.HostedZoneID, sets))
+	return awsclient.Wrap(err, errDeleteValidationRecords)
+}
+
+// sharedValidationRecords returns the names of the DNS validation records of
+// the other Certificates, which are not being deleted, that create their
+// validation records in the same hosted zone as the supplied Certificate.
+func (e *external) sharedValidationRecords(ctx context.Context, cr *v1beta1.Certificate) (map[string]bool, error) {
+	l := &v1beta1.CertificateList{}
+	if err := e.kube.List(ctx, l); err != nil {
+		return nil, errors.Wrap(err, errListCertificates)
+	}
+	zoneID := aws.ToString(cr.Spec.ForProvider.DNSValidation.HostedZoneID)
+	shared := map[string]bool{}
+	for i := range l.Items {
+		c := &l.Items[i]
+		if c.GetName() == cr.GetName() || meta.WasDeleted(c) || c.Spec.ForProvider.DNSValidation == nil ||
+			aws.ToString(c.Spec.ForProvider.DNSValidation.HostedZoneID) != zoneID {
+			continue
+		}
+		for _, r := range c.Status.AtProvider.ValidationRecords {
+			shared[aws.ToString(r.Name)] = true
+		}
+	}
+	return shared, nil
+}
+
+// checkValidationMethod returns an error if the supplied parameters enable
+// DNS validation for a certificate that is validated by email.
+func checkValidationMethod(p v1beta1.CertificateParameters) error {
+	if p.DNSValidation != nil && p.ValidationMethod == string(awsacmtypes.ValidationMethodEmail) {
+		return errors.New(errDNSValidationMethod)
+	}
+	return nil
+}
+
+// validationCondition returns the condition that reports the validation of a
+// Certificate with the supplied status.
+func validationCondition(status awsacmtypes.CertificateStatus) xpv1.Condition {
+	switch status { // nolint:exhaustive
+	case awsacmtypes.CertificateStatusIssued:
+		return v1beta1.Issued()
+	case awsacmtypes.CertificateStatusPendingValidation:
+		return v1beta1.PendingValidation()
+	default:
+		return v1beta1.ValidationFailed(string(status))
+	}
+}
+
 type tagger struct {
 	kube client.Client
 }
@@ -241,7 +416,14 @@ func (t *tagger) Initialize(ctx context.Context, mgd resource.Managed) error {
 	for _, t := range cr.Spec.ForProvider.Tags {
 		tagMap[t.Key] = t.Value
 	}
-	for k, v := range resource.GetExternalTags(mgd) {
+	tags := make(map[string]string, len(tagMap))
+	for k, v := range tagMap {
+		tags[k] = v
+	}
+	if err := awsclient.AddDefaultTags(ctx, t.kube, mgd, tags); err != nil {
+		return err
+	}
+	for k, v := range tags {
 		if tagMap[k] != v {
 			cr.Spec.ForProvider.Tags = append(cr.Spec.ForProvider.Tags, v1beta1.Tag{Key: k, Value: v})
 			added = true
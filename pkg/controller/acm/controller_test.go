@@ -23,8 +23,11 @@ import (
 	"github.com/aws/aws-sdk-go-v2/aws"
 	awsacm "github.com/aws/aws-sdk-go-v2/service/acm"
 	awsacmtype "github.com/aws/aws-sdk-go-v2/service/acm/types"
+	"github.com/aws/aws-sdk-go-v2/service/route53"
+	route53types "github.com/aws/aws-sdk-go-v2/service/route53/types"
 	"github.com/google/go-cmp/cmp"
 	"github.com/pkg/errors"
+	"sigs.k8s.io/controller-runtime/pkg/client"
 
 	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
 	"github.com/crossplane/crossplane-runtime/pkg/meta"
@@ -36,6 +39,8 @@ import (
 	awsclient "github.com/crossplane-contrib/provider-aws/pkg/clients"
 	"github.com/crossplane-contrib/provider-aws/pkg/clients/acm"
 	"github.com/crossplane-contrib/provider-aws/pkg/clients/acm/fake"
+	"github.com/crossplane-contrib/provider-aws/pkg/clients/resourcerecordset"
+	dnsfake "github.com/crossplane-contrib/provider-aws/pkg/clients/resourcerecordset/fake"
 )
 
 var (
@@ -43,13 +48,18 @@ var (
 	unexpectedItem resource.Managed
 	domainName     = "some.site"
 	certificateArn = "somearn"
+	hostedZoneID   = "Z0123456789"
+	recordName     = "_a79865eb4cd1a6ab990a45779b4e0b96.some.site."
+	recordValue    = "_424c7224e9b0146f9a8808af955727d0.acm-validations.aws."
 
 	errBoom = errors.New("boom")
 )
 
 type args struct {
-	acm acm.Client
-	cr  resource.Managed
+	acm  acm.Client
+	dns  resourcerecordset.Client
+	kube client.Client
+	cr   resource.Managed
 }
 
 type certificateModifier func(*v1beta1.Certificate)
@@ -104,6 +114,61 @@ func withStatus(status string) certificateModifier {
 	}
 }
 
+func withDNSValidation() certificateModifier {
+	return func(r *v1beta1.Certificate) {
+		r.Spec.ForProvider.DNSValidation = &v1beta1.DNSValidation{HostedZoneID: aws.String(hostedZoneID)}
+	}
+}
+
+func withValidationMethod(m awsacmtype.ValidationMethod) certificateModifier {
+	return func(r *v1beta1.Certificate) {
+		r.Spec.ForProvider.ValidationMethod = string(m)
+	}
+}
+
+func withValidationRecords() certificateModifier {
+	return func(r *v1beta1.Certificate) {
+		r.Status.AtProvider.ValidationRecords = []v1beta1.ResourceRecord{{
+			Name:  aws.String(recordName),
+			Type:  aws.String("CNAME"),
+			Value: aws.String(recordValue),
+		}}
+	}
+}
+
+func listValidationRecords(ttl int64, values ...string) func(ctx context.Context, input *route53.ListResourceRecordSetsInput, opts []func(*route53.Options)) (*route53.ListResourceRecordSetsOutput, error) {
+	return func(ctx context.Context, input *route53.ListResourceRecordSetsInput, opts []func(*route53.Options)) (*route53.ListResourceRecordSetsOutput, error) {
+		out := &route53.ListResourceRecordSetsOutput{}
+		for _, v := range values {
+			out.ResourceRecordSets = append(out.ResourceRecordSets, route53types.ResourceRecordSet{
+				Name:            aws.String(recordName),
+				Type:            route53types.RRTypeCname,
+				TTL:             aws.Int64(ttl),
+				ResourceRecords: []route53types.ResourceRecord{{Value: aws.String(v)}},
+			})
+		}
+		return out, nil
+	}
+}
+
+func changeValidationRecords(t *testing.T, action route53types.ChangeAction, ttl int64) func(ctx context.Context, input *route53.ChangeResourceRecordSetsInput, opts []func(*route53.Options)) (*route53.ChangeResourceRecordSetsOutput, error) {
+	return func(ctx context.Context, input *route53.ChangeResourceRecordSetsInput, opts []func(*route53.Options)) (*route53.ChangeResourceRecordSetsOutput, error) {
+		if diff := cmp.Diff(hostedZoneID, aws.ToString(input.HostedZoneId)); diff != "" {
+			t.Errorf("r: -want, +got:\n%s", diff)
+		}
+		if diff := cmp.Diff(1, len(input.ChangeBatch.Changes)); diff != "" {
+			t.Fatalf("r: -want, +got:\n%s", diff)
+		}
+		if diff := cmp.Diff(action, input.ChangeBatch.Changes[0].Action); diff != "" {
+			t.Errorf("r: -want, +got:\n%s", diff)
+		}
+		if diff := cmp.Diff(ttl, aws.ToInt64(input.ChangeBatch.Changes[0].ResourceRecordSet.TTL)); diff != "" {
+			t.Errorf("r: -want, +got:\n%s", diff)
+		}
+		return &route53.ChangeResourceRecordSetsOutput{}, nil
+	}
+}
+
 func certificate(m ...certificateModifier) *v1beta1.Certificate {
 	cr := &v1beta1.Certificate{}
 	meta.SetExternalName(cr, certificateArn)
@@ -154,6 +219,136 @@ func TestObserve(t *testing.T) {
 				},
 			},
 		},
+		"DNSValidationRecordMissing": {
+			args: args{
+				acm: &fake.MockCertificateClient{
+					MockDescribeCertificate: func(ctx context.Context, input *awsacm.DescribeCertificateInput, opts []func(*awsacm.Options)) (*awsacm.DescribeCertificateOutput, error) {
+						return &awsacm.DescribeCertificateOutput{
+							Certificate: &awsacmtype.CertificateDetail{
+								CertificateArn: aws.String(certificateArn),
+								Options:        &awsacmtype.CertificateOptions{CertificateTransparencyLoggingPreference: awsacmtype.CertificateTransparencyLoggingPreferenceDisabled},
+								Status:         awsacmtype.CertificateStatusPendingValidation,
+								Type:           awsacmtype.CertificateTypeAmazonIssued,
+								DomainValidationOptions: []awsacmtype.DomainValidation{
+									{
+										DomainName:     aws.String(domainName),
+										ResourceRecord: &awsacmtype.ResourceRecord{Name: aws.String(recordName), Type: awsacmtype.RecordTypeCname, Value: aws.String(recordValue)},
+									},
+									{
+										DomainName:     aws.String("*." + domainName),
+										ResourceRecord: &awsacmtype.ResourceRecord{Name: aws.String(recordName), Type: awsacmtype.RecordTypeCname, Value: aws.String(recordValue)},
+									},
+								},
+							},
+						}, nil
+					},
+					MockListTagsForCertificate: func(ctx context.Context, input *awsacm.ListTagsForCertificateInput, opts []func(*awsacm.Options)) (*awsacm.ListTagsForCertificateOutput, error) {
+						return &awsacm.ListTagsForCertificateOutput{}, nil
+					},
+				},
+				dns: &dnsfake.MockResourceRecordSetClient{
+					MockListResourceRecordSets: listValidationRecords(acm.DefaultValidationRecordTTL),
+				},
+				cr: certificate(withCertificateArn(), withDNSValidation()),
+			},
+			want: want{
+				cr: certificate(withCertificateArn(), withDNSValidation(), withValidationRecords(),
+					withStatus(string(awsacmtype.CertificateStatusPendingValidation)),
+					withConditions(v1beta1.PendingValidation()),
+					func(r *v1beta1.Certificate) {
+						r.Status.AtProvider.Type = string(awsacmtype.CertificateTypeAmazonIssued)
+						r.Status.AtProvider.ResourceRecord = &r.Status.AtProvider.ValidationRecords[0]
+						r.Spec.ForProvider.DomainValidationOptions = []*v1beta1.DomainValidationOption{{DomainName: domainName}, {DomainName: "*." + domainName}}
+					}),
+				result: managed.ExternalObservation{
+					ResourceExists:          true,
+					ResourceUpToDate:        false,
+					ResourceLateInitialized: true,
+				},
+			},
+		},
+		"DNSValidationIssued": {
+			args: args{
+				acm: &fake.MockCertificateClient{
+					MockDescribeCertificate: func(ctx context.Context, input *awsacm.DescribeCertificateInput, opts []func(*awsacm.Options)) (*awsacm.DescribeCertificateOutput, error) {
+						return &awsacm.DescribeCertificateOutput{
+							Certificate: &awsacmtype.CertificateDetail{
+								CertificateArn: aws.String(certificateArn),
+								Options:        &awsacmtype.CertificateOptions{CertificateTransparencyLoggingPreference: awsacmtype.CertificateTransparencyLoggingPreferenceDisabled},
+								Status:         awsacmtype.CertificateStatusIssued,
+								DomainValidationOptions: []awsacmtype.DomainValidation{{
+									DomainName:     aws.String(domainName),
+									ResourceRecord: &awsacmtype.ResourceRecord{Name: aws.String(recordName), Type: awsacmtype.RecordTypeCname, Value: aws.String(recordValue)},
+								}},
+							},
+						}, nil
+					},
+					MockListTagsForCertificate: func(ctx context.Context, input *awsacm.ListTagsForCertificateInput, opts []func(*awsacm.Options)) (*awsacm.ListTagsForCertificateOutput, error) {
+						return &awsacm.ListTagsForCertificateOutput{}, nil
+					},
+				},
+				dns: &dnsfake.MockResourceRecordSetClient{
+					MockListResourceRecordSets: listValidationRecords(acm.DefaultValidationRecordTTL, recordValue),
+				},
+				cr: certificate(withCertificateArn(), withDNSValidation(),
+					func(r *v1beta1.Certificate) {
+						r.Spec.ForProvider.DomainValidationOptions = []*v1beta1.DomainValidationOption{{DomainName: domainName}}
+					}),
+			},
+			want: want{
+				cr: certificate(withCertificateArn(), withDNSValidation(), withValidationRecords(),
+					withStatus(string(awsacmtype.CertificateStatusIssued)),
+					withConditions(xpv1.Available(), v1beta1.Issued()),
+					func(r *v1beta1.Certificate) {
+						r.Spec.ForProvider.DomainValidationOptions = []*v1beta1.DomainValidationOption{{DomainName: domainName}}
+					}),
+				result: managed.ExternalObservation{
+					ResourceExists:   true,
+					ResourceUpToDate: true,
+				},
+			},
+		},
+		"DNSValidationRecordTTLOutdated": {
+			args: args{
+				acm: &fake.MockCertificateClient{
+					MockDescribeCertificate: func(ctx context.Context, input *awsacm.DescribeCertificateInput, opts []func(*awsacm.Options)) (*awsacm.DescribeCertificateOutput, error) {
+						return &awsacm.DescribeCertificateOutput{
+							Certificate: &awsacmtype.CertificateDetail{
+								CertificateArn: aws.String(certificateArn),
+								Options:        &awsacmtype.CertificateOptions{CertificateTransparencyLoggingPreference: awsacmtype.CertificateTransparencyLoggingPreferenceDisabled},
+								Status:         awsacmtype.CertificateStatusIssued,
+								DomainValidationOptions: []awsacmtype.DomainValidation{{
+									DomainName:     aws.String(domainName),
+									ResourceRecord: &awsacmtype.ResourceRecord{Name: aws.String(recordName), Type: awsacmtype.RecordTypeCname, Value: aws.String(recordValue)},
+								}},
+							},
+						}, nil
+					},
+					MockListTagsForCertificate: func(ctx context.Context, input *awsacm.ListTagsForCertificateInput, opts []func(*awsacm.Options)) (*awsacm.ListTagsForCertificateOutput, error) {
+						return &awsacm.ListTagsForCertificateOutput{}, nil
+					},
+				},
+				dns: &dnsfake.MockResourceRecordSetClient{
+					MockListResourceRecordSets: listValidationRecords(60, recordValue),
+				},
+				cr: certificate(withCertificateArn(), withDNSValidation(),
+					func(r *v1beta1.Certificate) {
+						r.Spec.ForProvider.DomainValidationOptions = []*v1beta1.DomainValidationOption{{DomainName: domainName}}
+					}),
+			},
+			want: want{
+				cr: certificate(withCertificateArn(), withDNSValidation(), withValidationRecords(),
+					withStatus(string(awsacmtype.CertificateStatusIssued)),
+					withConditions(xpv1.Available(), v1beta1.Issued()),
+					func(r *v1beta1.Certificate) {
+						r.Spec.ForProvider.DomainValidationOptions = []*v1beta1.DomainValidationOption{{DomainName: domainName}}
+					}),
+				result: managed.ExternalObservation{
+					ResourceExists:   true,
+					ResourceUpToDate: false,
+				},
+			},
+		},
 		"InValidInput": {
 			args: args{
 				cr: unexpectedItem,
@@ -163,6 +358,15 @@ func TestObserve(t *testing.T) {
 				err: errors.New(errUnexpectedObject),
 			},
 		},
+		"DNSValidationWithEmailValidationMethod": {
+			args: args{
+				cr: certificate(withDNSValidation(), withValidationMethod(awsacmtype.ValidationMethodEmail)),
+			},
+			want: want{
+				cr:  certificate(withDNSValidation(), withValidationMethod(awsacmtype.ValidationMethodEmail)),
+				err: errors.New(errDNSValidationMethod),
+			},
+		},
 		"ClientError": {
 			args: args{
 				acm: &fake.MockCertificateClient{
@@ -196,6 +400,7 @@ func TestObserve(t *testing.T) {
 		t.Run(name, func(t *testing.T) {
 			e := &external{
 				client: tc.acm,
+				dns:    tc.dns,
 				kube: &test.MockClient{
 					MockUpdate: test.NewMockUpdateFn(nil),
 				},
@@ -254,6 +459,15 @@ func TestCreate(t *testing.T) {
 				err: errors.New(errUnexpectedObject),
 			},
 		},
+		"DNSValidationWithEmailValidationMethod": {
+			args: args{
+				cr: certificate(withDNSValidation(), withValidationMethod(awsacmtype.ValidationMethodEmail)),
+			},
+			want: want{
+				cr:  certificate(withDNSValidation(), withValidationMethod(awsacmtype.ValidationMethodEmail)),
+				err: errors.New(errDNSValidationMethod),
+			},
+		},
 		"ClientError": {
 			args: args{
 				acm: &fake.MockCertificateClient{
@@ -339,6 +553,46 @@ func TestUpdate(t *testing.T) {
 				err: errors.New(errUnexpectedObject),
 			},
 		},
+		"DNSValidationUpsertsMissingRecords": {
+			args: args{
+				acm: &fake.MockCertificateClient{
+					MockUpdateCertificateOptions: func(ctx context.Context, input *awsacm.UpdateCertificateOptionsInput, opts []func(*awsacm.Options)) (*awsacm.UpdateCertificateOptionsOutput, error) {
+						return &awsacm.UpdateCertificateOptionsOutput{}, nil
+					},
+					MockListTagsForCertificate: func(ctx context.Context, input *awsacm.ListTagsForCertificateInput, opts []func(*awsacm.Options)) (*awsacm.ListTagsForCertificateOutput, error) {
+						return &awsacm.ListTagsForCertificateOutput{}, nil
+					},
+				},
+				dns: &dnsfake.MockResourceRecordSetClient{
+					MockListResourceRecordSets:   listValidationRecords(acm.DefaultValidationRecordTTL, "outdated"),
+					MockChangeResourceRecordSets: changeValidationRecords(t, route53types.ChangeActionUpsert, acm.DefaultValidationRecordTTL),
+				},
+				cr: certificate(withDNSValidation(), withValidationRecords()),
+			},
+			want: want{
+				cr: certificate(withDNSValidation(), withValidationRecords()),
+			},
+		},
+		"DNSValidationUpsertError": {
+			args: args{
+				acm: &fake.MockCertificateClient{
+					MockListTagsForCertificate: func(ctx context.Context, input *awsacm.ListTagsForCertificateInput, opts []func(*awsacm.Options)) (*awsacm.ListTagsForCertificateOutput, error) {
+						return &awsacm.ListTagsForCertificateOutput{}, nil
+					},
+				},
+				dns: &dnsfake.MockResourceRecordSetClient{
+					MockListResourceRecordSets: listValidationRecords(acm.DefaultValidationRecordTTL),
+					MockChangeResourceRecordSets: func(ctx context.Context, input *route53.ChangeResourceRecordSetsInput, opts []func(*route53.Options)) (*route53.ChangeResourceRecordSetsOutput, error) {
+						return nil, errBoom
+					},
+				},
+				cr: certificate(withDNSValidation(), withValidationRecords()),
+			},
+			want: want{
+				cr:  certificate(withDNSValidation(), withValidationRecords()),
+				err: awsclient.Wrap(errBoom, errUpsertValidationRecords),
+			},
+		},
 		"ClientUpdateCertificateOptionsError": {
 			args: args{
 				acm: &fake.MockCertificateClient{
@@ -395,7 +649,7 @@ func TestUpdate(t *testing.T) {
 
 	for name, tc := range cases {
 		t.Run(name, func(t *testing.T) {
-			e := &external{client: tc.acm}
+			e := &external{client: tc.acm, dns: tc.dns}
 			o, err := e.Update(context.Background(), tc.args.cr)
 
 			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
@@ -458,6 +712,98 @@ func TestDelete(t *testing.T) {
 				err: awsclient.Wrap(errBoom, errDelete),
 			},
 		},
+		"DNSValidationDeletesRecords": {
+			args: args{
+				acm: &fake.MockCertificateClient{
+					MockDeleteCertificate: func(ctx context.Context, input *awsacm.DeleteCertificateInput, opts []func(*awsacm.Options)) (*awsacm.DeleteCertificateOutput, error) {
+						return &awsacm.DeleteCertificateOutput{}, nil
+					},
+				},
+				dns: &dnsfake.MockResourceRecordSetClient{
+					MockListResourceRecordSets:   listValidationRecords(acm.DefaultValidationRecordTTL, recordValue),
+					MockChangeResourceRecordSets: changeValidationRecords(t, route53types.ChangeActionDelete, acm.DefaultValidationRecordTTL),
+				},
+				cr: certificate(withDNSValidation(), withValidationRecords()),
+			},
+			want: want{
+				cr: certificate(withDNSValidation(), withValidationRecords()),
+			},
+		},
+		"DNSValidationDeletesObservedRecords": {
+			args: args{
+				acm: &fake.MockCertificateClient{
+					MockDeleteCertificate: func(ctx context.Context, input *awsacm.DeleteCertificateInput, opts []func(*awsacm.Options)) (*awsacm.DeleteCertificateOutput, error) {
+						return &awsacm.DeleteCertificateOutput{}, nil
+					},
+				},
+				dns: &dnsfake.MockResourceRecordSetClient{
+					MockListResourceRecordSets:   listValidationRecords(60, recordValue),
+					MockChangeResourceRecordSets: changeValidationRecords(t, route53types.ChangeActionDelete, 60),
+				},
+				cr: certificate(withDNSValidation(), withValidationRecords()),
+			},
+			want: want{
+				cr: certificate(withDNSValidation(), withValidationRecords()),
+			},
+		},
+		"DNSValidationKeepsSharedRecords": {
+			args: args{
+				acm: &fake.MockCertificateClient{
+					MockDeleteCertificate: func(ctx context.Context, input *awsacm.DeleteCertificateInput, opts []func(*awsacm.Options)) (*awsacm.DeleteCertificateOutput, error) {
+						return &awsacm.DeleteCertificateOutput{}, nil
+					},
+				},
+				dns: &dnsfake.MockResourceRecordSetClient{
+					MockListResourceRecordSets: listValidationRecords(acm.DefaultValidationRecordTTL, recordValue),
+					MockChangeResourceRecordSets: func(ctx context.Context, input *route53.ChangeResourceRecordSetsInput, opts []func(*route53.Options)) (*route53.ChangeResourceRecordSetsOutput, error) {
+						return nil, errBoom
+					},
+				},
+				kube: &test.MockClient{
+					MockList: test.NewMockListFn(nil, func(obj client.ObjectList) error {
+						other := certificate(withDNSValidation(), withValidationRecords())
+						other.SetName("other")
+						obj.(*v1beta1.CertificateList).Items = []v1beta1.Certificate{*other}
+						return nil
+					}),
+				},
+				cr: certificate(withDNSValidation(), withValidationRecords()),
+			},
+			want: want{
+				cr: certificate(withDNSValidation(), withValidationRecords()),
+			},
+		},
+		"DNSValidationListCertificatesError": {
+			args: args{
+				dns: &dnsfake.MockResourceRecordSetClient{
+					MockListResourceRecordSets: listValidationRecords(acm.DefaultValidationRecordTTL, recordValue),
+				},
+				kube: &test.MockClient{
+					MockList: test.NewMockListFn(errBoom),
+				},
+				cr: certificate(withDNSValidation(), withValidationRecords()),
+			},
+			want: want{
+				cr:  certificate(withDNSValidation(), withValidationRecords()),
+				err: errors.Wrap(errBoom, errListCertificates),
+			},
+		},
+		"DNSValidationRecordsAlreadyDeleted": {
+			args: args{
+				acm: &fake.MockCertificateClient{
+					MockDeleteCertificate: func(ctx context.Context, input *awsacm.DeleteCertificateInput, opts []func(*awsacm.Options)) (*awsacm.DeleteCertificateOutput, error) {
+						return &awsacm.DeleteCertificateOutput{}, nil
+					},
+				},
+				dns: &dnsfake.MockResourceRecordSetClient{
+					MockListResourceRecordSets: listValidationRecords(acm.DefaultValidationRecordTTL),
+				},
+				cr: certificate(withDNSValidation(), withValidationRecords()),
+			},
+			want: want{
+				cr: certificate(withDNSValidation(), withValidationRecords()),
+			},
+		},
 		"ResourceDoesNotExist": {
 			args: args{
 				acm: &fake.MockCertificateClient{
@@ -475,7 +821,11 @@ func TestDelete(t *testing.T) {
 
 	for name, tc := range cases {
 		t.Run(name, func(t *testing.T) {
-			e := &external{client: tc.acm}
+			kube := tc.kube
+			if kube == nil {
+				kube = &test.MockClient{MockList: test.NewMockListFn(nil)}
+			}
+			e := &external{client: tc.acm, dns: tc.dns, kube: kube}
 			err := e.Delete(context.Background(), tc.args.cr)
 
 			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
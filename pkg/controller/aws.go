@@ -76,20 +76,28 @@ import (
 	"github.com/crossplane-contrib/provider-aws/pkg/controller/dynamodb/globaltable"
 	"github.com/crossplane-contrib/provider-aws/pkg/controller/dynamodb/table"
 	"github.com/crossplane-contrib/provider-aws/pkg/controller/ec2/address"
+	"github.com/crossplane-contrib/provider-aws/pkg/controller/ec2/customergateway"
 	"github.com/crossplane-contrib/provider-aws/pkg/controller/ec2/flowlog"
 	"github.com/crossplane-contrib/provider-aws/pkg/controller/ec2/instance"
 	"github.com/crossplane-contrib/provider-aws/pkg/controller/ec2/internetgateway"
+	"github.com/crossplane-contrib/provider-aws/pkg/controller/ec2/keypair"
 	"github.com/crossplane-contrib/provider-aws/pkg/controller/ec2/launchtemplate"
 	"github.com/crossplane-contrib/provider-aws/pkg/controller/ec2/launchtemplateversion"
 	"github.com/crossplane-contrib/provider-aws/pkg/controller/ec2/natgateway"
+	"github.com/crossplane-contrib/provider-aws/pkg/controller/ec2/networkacl"
+	"github.com/crossplane-contrib/provider-aws/pkg/controller/ec2/networkaclentry"
 	ec2route "github.com/crossplane-contrib/provider-aws/pkg/controller/ec2/route"
 	"github.com/crossplane-contrib/provider-aws/pkg/controller/ec2/routetable"
 	"github.com/crossplane-contrib/provider-aws/pkg/controller/ec2/securitygroup"
 	"github.com/crossplane-contrib/provider-aws/pkg/controller/ec2/securitygrouprule"
 	"github.com/crossplane-contrib/provider-aws/pkg/controller/ec2/subnet"
 	transitgateway "github.com/crossplane-contrib/provider-aws/pkg/controller/ec2/transitgateway"
+	"github.com/crossplane-contrib/provider-aws/pkg/controller/ec2/transitgatewaypeeringattachment"
+	"github.com/crossplane-contrib/provider-aws/pkg/controller/ec2/transitgatewaypeeringattachmentaccepter"
 	transitgatewayroute "github.com/crossplane-contrib/provider-aws/pkg/controller/ec2/transitgatewayroute"
 	transitgatewayroutetable "github.com/crossplane-contrib/provider-aws/pkg/controller/ec2/transitgatewayroutetable"
+	"github.com/crossplane-contrib/provider-aws/pkg/controller/ec2/transitgatewayroutetableassociation"
+	"github.com/crossplane-contrib/provider-aws/pkg/controller/ec2/transitgatewayroutetablepropagation"
 	transitgatewayvpcattachment "github.com/crossplane-contrib/provider-aws/pkg/controller/ec2/transitgatewayvpcattachment"
 	"github.com/crossplane-contrib/provider-aws/pkg/controller/ec2/volume"
 	"github.com/crossplane-contrib/provider-aws/pkg/controller/ec2/vpc"
@@ -97,6 +105,8 @@ import (
 	"github.com/crossplane-contrib/provider-aws/pkg/controller/ec2/vpcendpoint"
 	"github.com/crossplane-contrib/provider-aws/pkg/controller/ec2/vpcendpointserviceconfiguration"
 	"github.com/crossplane-contrib/provider-aws/pkg/controller/ec2/vpcpeeringconnection"
+	"github.com/crossplane-contrib/provider-aws/pkg/controller/ec2/vpnconnection"
+	"github.com/crossplane-contrib/provider-aws/pkg/controller/ec2/vpngateway"
 	"github.com/crossplane-contrib/provider-aws/pkg/controller/ecr/lifecyclepolicy"
 	"github.com/crossplane-contrib/provider-aws/pkg/controller/ecr/repository"
 	"github.com/crossplane-contrib/provider-aws/pkg/controller/ecr/repositorypolicy"
@@ -127,16 +137,23 @@ import (
 	gluejob "github.com/crossplane-contrib/provider-aws/pkg/controller/glue/job"
 	gluesecurityconfiguration "github.com/crossplane-contrib/provider-aws/pkg/controller/glue/securityconfiguration"
 	"github.com/crossplane-contrib/provider-aws/pkg/controller/iam/accesskey"
+	"github.com/crossplane-contrib/provider-aws/pkg/controller/iam/accountalias"
+	"github.com/crossplane-contrib/provider-aws/pkg/controller/iam/accountpasswordpolicy"
 	"github.com/crossplane-contrib/provider-aws/pkg/controller/iam/group"
+	"github.com/crossplane-contrib/provider-aws/pkg/controller/iam/grouppolicy"
 	"github.com/crossplane-contrib/provider-aws/pkg/controller/iam/grouppolicyattachment"
 	"github.com/crossplane-contrib/provider-aws/pkg/controller/iam/groupusermembership"
 	"github.com/crossplane-contrib/provider-aws/pkg/controller/iam/instanceprofile"
+	"github.com/crossplane-contrib/provider-aws/pkg/controller/iam/loginprofile"
 	"github.com/crossplane-contrib/provider-aws/pkg/controller/iam/openidconnectprovider"
 	"github.com/crossplane-contrib/provider-aws/pkg/controller/iam/policy"
 	"github.com/crossplane-contrib/provider-aws/pkg/controller/iam/role"
+	"github.com/crossplane-contrib/provider-aws/pkg/controller/iam/rolepolicy"
 	"github.com/crossplane-contrib/provider-aws/pkg/controller/iam/rolepolicyattachment"
+	"github.com/crossplane-contrib/provider-aws/pkg/controller/iam/samlprovider"
 	"github.com/crossplane-contrib/provider-aws/pkg/controller/iam/servicelinkedrole"
 	"github.com/crossplane-contrib/provider-aws/pkg/controller/iam/user"
+	"github.com/crossplane-contrib/provider-aws/pkg/controller/iam/userpolicy"
 	"github.com/crossplane-contrib/provider-aws/pkg/controller/iam/userpolicyattachment"
 	iotpolicy "github.com/crossplane-contrib/provider-aws/pkg/controller/iot/policy"
 	"github.com/crossplane-contrib/provider-aws/pkg/controller/iot/thing"
@@ -172,6 +189,7 @@ import (
 	"github.com/crossplane-contrib/provider-aws/pkg/controller/route53resolver/resolverruleassociation"
 	"github.com/crossplane-contrib/provider-aws/pkg/controller/s3"
 	"github.com/crossplane-contrib/provider-aws/pkg/controller/s3/bucketpolicy"
+	s3object "github.com/crossplane-contrib/provider-aws/pkg/controller/s3/object"
 	s3control "github.com/crossplane-contrib/provider-aws/pkg/controller/s3control/accesspoint"
 	"github.com/crossplane-contrib/provider-aws/pkg/controller/secretsmanager/secret"
 	"github.com/crossplane-contrib/provider-aws/pkg/controller/servicediscovery/httpnamespace"
@@ -190,180 +208,218 @@ import (
 	transferuser "github.com/crossplane-contrib/provider-aws/pkg/controller/transfer/user"
 )
 
+// controllers are the managed resource controllers of this provider, along
+// with the short name of the API group and the kind of the managed resources
+// they reconcile.
+var controllers = []struct {
+	group string
+	kind  string
+	setup func(ctrl.Manager, controller.Options) error
+}{
+	{"cache", "ReplicationGroup", cache.SetupReplicationGroup},
+	{"cache", "CacheSubnetGroup", cachesubnetgroup.SetupCacheSubnetGroup},
+	{"elasticache", "CacheParameterGroup", cacheparametergroup.SetupCacheParameterGroup},
+	{"cache", "CacheCluster", cluster.SetupCacheCluster},
+	{"database", "RDSInstance", database.SetupRDSInstance},
+	{"dax", "Cluster", daxcluster.SetupCluster},
+	{"dax", "ParameterGroup", daxparametergroup.SetupParameterGroup},
+	{"dax", "SubnetGroup", daxsubnetgroup.SetupSubnetGroup},
+	{"cloudsearch", "Domain", domain.SetupDomain},
+	{"docdb", "DBInstance", docdbinstance.SetupDBInstance},
+	{"docdb", "DBCluster", docdbcluster.SetupDBCluster},
+	{"docdb", "DBClusterParameterGroup", docdbclusterparametergroup.SetupDBClusterParameterGroup},
+	{"docdb", "DBSubnetGroup", docdbsubnetgroup.SetupDBSubnetGroup},
+	{"ecs", "Cluster", ecscluster.SetupCluster},
+	{"ecs", "Service", ecsservice.SetupService},
+	{"ecs", "TaskDefinition", ecstask.SetupTaskDefinition},
+	{"eks", "Cluster", eks.SetupCluster},
+	{"eks", "Addon", eksaddon.SetupAddon},
+	{"eks", "IdentityProviderConfig", identityproviderconfig.SetupIdentityProviderConfig},
+	{"iam", "InstanceProfile", instanceprofile.SetupInstanceProfile},
+	{"elasticloadbalancing", "ELB", elb.SetupELB},
+	{"elasticloadbalancing", "ELBAttachment", elbattachment.SetupELBAttachment},
+	{"eks", "NodeGroup", nodegroup.SetupNodeGroup},
+	{"s3", "Bucket", s3.SetupBucket},
+	{"s3", "BucketPolicy", bucketpolicy.SetupBucketPolicy},
+	{"s3", "Object", s3object.SetupObject},
+	{"iam", "AccessKey", accesskey.SetupAccessKey},
+	{"iam", "User", user.SetupUser},
+	{"iam", "Group", group.SetupGroup},
+	{"iam", "Policy", policy.SetupPolicy},
+	{"iam", "Role", role.SetupRole},
+	{"iam", "GroupUserMembership", groupusermembership.SetupGroupUserMembership},
+	{"iam", "UserPolicyAttachment", userpolicyattachment.SetupUserPolicyAttachment},
+	{"iam", "GroupPolicyAttachment", grouppolicyattachment.SetupGroupPolicyAttachment},
+	{"iam", "RolePolicyAttachment", rolepolicyattachment.SetupRolePolicyAttachment},
+	{"iam", "RolePolicy", rolepolicy.SetupRolePolicy},
+	{"iam", "UserPolicy", userpolicy.SetupUserPolicy},
+	{"iam", "GroupPolicy", grouppolicy.SetupGroupPolicy},
+	{"iam", "LoginProfile", loginprofile.SetupLoginProfile},
+	{"iam", "AccountPasswordPolicy", accountpasswordpolicy.SetupAccountPasswordPolicy},
+	{"iam", "AccountAlias", accountalias.SetupAccountAlias},
+	{"iam", "SAMLProvider", samlprovider.SetupSAMLProvider},
+	{"ec2", "VPC", vpc.SetupVPC},
+	{"ec2", "Subnet", subnet.SetupSubnet},
+	{"ec2", "SecurityGroup", securitygroup.SetupSecurityGroup},
+	{"ec2", "SecurityGroupRule", securitygrouprule.SetupSecurityGroupRule},
+	{"ec2", "InternetGateway", internetgateway.SetupInternetGateway},
+	{"ec2", "LaunchTemplate", launchtemplate.SetupLaunchTemplate},
+	{"ec2", "LaunchTemplateVersion", launchtemplateversion.SetupLaunchTemplateVersion},
+	{"ec2", "NATGateway", natgateway.SetupNatGateway},
+	{"ec2", "RouteTable", routetable.SetupRouteTable},
+	{"ec2", "NetworkACL", networkacl.SetupNetworkACL},
+	{"ec2", "NetworkACLEntry", networkaclentry.SetupNetworkACLEntry},
+	{"database", "DBSubnetGroup", dbsubnetgroup.SetupDBSubnetGroup},
+	{"acmpca", "CertificateAuthority", certificateauthority.SetupCertificateAuthority},
+	{"acmpca", "CertificateAuthorityPermission", certificateauthoritypermission.SetupCertificateAuthorityPermission},
+	{"acm", "Certificate", acm.SetupCertificate},
+	{"route53", "ResourceRecordSet", resourcerecordset.SetupResourceRecordSet},
+	{"route53", "HostedZone", hostedzone.SetupHostedZone},
+	{"secretsmanager", "Secret", secret.SetupSecret},
+	{"sns", "Topic", topic.SetupSNSTopic},
+	{"sns", "Subscription", subscription.SetupSubscription},
+	{"sqs", "Queue", queue.SetupQueue},
+	{"redshift", "Cluster", redshift.SetupCluster},
+	{"ec2", "Address", address.SetupAddress},
+	{"ecr", "Repository", repository.SetupRepository},
+	{"ecr", "RepositoryPolicy", repositorypolicy.SetupRepositoryPolicy},
+	{"ecr", "LifecyclePolicy", lifecyclepolicy.SetupLifecyclePolicy},
+	{"apigatewayv2", "API", api.SetupAPI},
+	{"apigatewayv2", "Stage", stage.SetupStage},
+	{"apigatewayv2", "Route", route.SetupRoute},
+	{"apigatewayv2", "Authorizer", authorizer.SetupAuthorizer},
+	{"apigatewayv2", "Integration", integration.SetupIntegration},
+	{"apigatewayv2", "Deployment", deployment.SetupDeployment},
+	{"apigatewayv2", "DomainName", domainname.SetupDomainName},
+	{"apigatewayv2", "IntegrationResponse", integrationresponse.SetupIntegrationResponse},
+	{"apigatewayv2", "Model", model.SetupModel},
+	{"apigatewayv2", "APIMapping", apimapping.SetupAPIMapping},
+	{"apigatewayv2", "RouteResponse", routeresponse.SetupRouteResponse},
+	{"apigatewayv2", "VPCLink", vpclink.SetupVPCLink},
+	{"eks", "FargateProfile", fargateprofile.SetupFargateProfile},
+	{"sfn", "Activity", activity.SetupActivity},
+	{"sfn", "StateMachine", statemachine.SetupStateMachine},
+	{"dynamodb", "Table", table.SetupTable},
+	{"dynamodb", "Backup", backup.SetupBackup},
+	{"dynamodb", "GlobalTable", globaltable.SetupGlobalTable},
+	{"kms", "Key", key.SetupKey},
+	{"kms", "Alias", alias.SetupAlias},
+	{"efs", "AccessPoint", accesspoint.SetupAccessPoint},
+	{"efs", "FileSystem", filesystem.SetupFileSystem},
+	{"rds", "DBCluster", dbcluster.SetupDBCluster},
+	{"rds", "DBClusterParameterGroup", dbclusterparametergroup.SetupDBClusterParameterGroup},
+	{"rds", "DBInstance", dbinstance.SetupDBInstance},
+	{"rds", "DBInstanceRoleAssociation", dbinstanceroleassociation.SetupDBInstanceRoleAssociation},
+	{"rds", "DBParameterGroup", dbparametergroup.SetupDBParameterGroup},
+	{"rds", "GlobalCluster", globalcluster.SetupGlobalCluster},
+	{"ec2", "VPCCIDRBlock", vpccidrblock.SetupVPCCIDRBlock},
+	{"servicediscovery", "PrivateDNSNamespace", privatednsnamespace.SetupPrivateDNSNamespace},
+	{"servicediscovery", "PublicDNSNamespace", publicdnsnamespace.SetupPublicDNSNamespace},
+	{"servicediscovery", "HTTPNamespace", httpnamespace.SetupHTTPNamespace},
+	{"lambda", "Function", lambdafunction.SetupFunction},
+	{"lambda", "Permission", lambdapermission.SetupPermission},
+	{"lambda", "FunctionURLConfig", lambdaurlconfig.SetupFunctionURL},
+	{"iam", "OpenIDConnectProvider", openidconnectprovider.SetupOpenIDConnectProvider},
+	{"cloudfront", "Distribution", distribution.SetupDistribution},
+	{"cloudfront", "CachePolicy", cachepolicy.SetupCachePolicy},
+	{"cloudfront", "CloudFrontOriginAccessIdentity", cloudfrontorginaccessidentity.SetupCloudFrontOriginAccessIdentity},
+	{"cloudfront", "ResponseHeadersPolicy", cloudfrontresponseheaderspolicy.SetupResponseHeadersPolicy},
+	{"route53resolver", "ResolverEndpoint", resolverendpoint.SetupResolverEndpoint},
+	{"route53resolver", "ResolverRule", resolverrule.SetupResolverRule},
+	{"ec2", "VPCPeeringConnection", vpcpeeringconnection.SetupVPCPeeringConnection},
+	{"ec2", "VPCEndpoint", vpcendpoint.SetupVPCEndpoint},
+	{"kafka", "Cluster", kafkacluster.SetupCluster},
+	{"efs", "MountTarget", efsmounttarget.SetupMountTarget},
+	{"transfer", "Server", transferserver.SetupServer},
+	{"transfer", "User", transferuser.SetupUser},
+	{"ec2", "Instance", instance.SetupInstance},
+	{"ec2", "KeyPair", keypair.SetupKeyPair},
+	{"ec2", "CustomerGateway", customergateway.SetupCustomerGateway},
+	{"ec2", "VPNGateway", vpngateway.SetupVPNGateway},
+	{"ec2", "VPNConnection", vpnconnection.SetupVPNConnection},
+	{"glue", "Job", gluejob.SetupJob},
+	{"glue", "SecurityConfiguration", gluesecurityconfiguration.SetupSecurityConfiguration},
+	{"glue", "Connection", glueconnection.SetupConnection},
+	{"glue", "Database", glueDatabase.SetupDatabase},
+	{"glue", "Crawler", gluecrawler.SetupCrawler},
+	{"glue", "Classifier", glueclassifier.SetupClassifier},
+	{"mq", "Broker", mqbroker.SetupBroker},
+	{"mq", "User", mquser.SetupUser},
+	{"mwaa", "Environment", mwaaenvironment.SetupEnvironment},
+	{"cloudwatchlogs", "LogGroup", cwloggroup.SetupLogGroup},
+	{"ec2", "Volume", volume.SetupVolume},
+	{"ec2", "TransitGateway", transitgateway.SetupTransitGateway},
+	{"ec2", "TransitGatewayVPCAttachment", transitgatewayvpcattachment.SetupTransitGatewayVPCAttachment},
+	{"ec2", "TransitGatewayPeeringAttachment", transitgatewaypeeringattachment.SetupTransitGatewayPeeringAttachment},
+	{"ec2", "TransitGatewayPeeringAttachmentAccepter", transitgatewaypeeringattachmentaccepter.SetupTransitGatewayPeeringAttachmentAccepter},
+	{"iot", "Thing", thing.SetupThing},
+	{"iot", "Policy", iotpolicy.SetupPolicy},
+	{"ec2", "Route", ec2route.SetupRoute},
+	{"athena", "WorkGroup", athenaworkgroup.SetupWorkGroup},
+	{"ram", "ResourceShare", resourceshare.SetupResourceShare},
+	{"kafka", "Configuration", kafkaconfiguration.SetupConfiguration},
+	{"elbv2", "Listener", listener.SetupListener},
+	{"elbv2", "LoadBalancer", loadbalancer.SetupLoadBalancer},
+	{"elbv2", "TargetGroup", targetgroup.SetupTargetGroup},
+	{"elbv2", "Target", target.SetupTarget},
+	{"ec2", "TransitGatewayRoute", transitgatewayroute.SetupTransitGatewayRoute},
+	{"ec2", "TransitGatewayRouteTable", transitgatewayroutetable.SetupTransitGatewayRouteTable},
+	{"ec2", "TransitGatewayRouteTableAssociation", transitgatewayroutetableassociation.SetupTransitGatewayRouteTableAssociation},
+	{"ec2", "TransitGatewayRouteTablePropagation", transitgatewayroutetablepropagation.SetupTransitGatewayRouteTablePropagation},
+	{"ec2", "VPCEndpointServiceConfiguration", vpcendpointserviceconfiguration.SetupVPCEndpointServiceConfiguration},
+	{"kinesis", "Stream", kinesisstream.SetupStream},
+	{"route53resolver", "ResolverRuleAssociation", resolverruleassociation.SetupResolverRuleAssociation},
+	{"cognitoidentityprovider", "UserPool", cognitouserpool.SetupUserPool},
+	{"cognitoidentityprovider", "UserPoolDomain", cognitouserpooldomain.SetupUserPoolDomain},
+	{"cognitoidentityprovider", "Group", cognitogroup.SetupGroup},
+	{"cognitoidentityprovider", "UserPoolClient", cognitouserpoolclient.SetupUserPoolClient},
+	{"cognitoidentityprovider", "IdentityProvider", cognitoidentityprovider.SetupIdentityProvider},
+	{"cognitoidentityprovider", "ResourceServer", cognitoresourceserver.SetupResourceServer},
+	{"cognitoidentityprovider", "GroupUserMembership", cognitogroupusermembership.SetupGroupUserMembership},
+	{"neptune", "DBCluster", neptunecluster.SetupDBCluster},
+	{"sns", "Topic", topic.SetupSNSTopic},
+	{"sns", "Subscription", subscription.SetupSubscription},
+	{"prometheusservice", "Workspace", prometheusserviceworkspace.SetupWorkspace},
+	{"prometheusservice", "RuleGroupsNamespace", prometheusservicerulegroupnamespace.SetupRuleGroupsNamespace},
+	{"prometheusservice", "AlertManagerDefinition", prometheusservicealertmanagerdefinition.SetupAlertManagerDefinition},
+	{"apigateway", "Resource", resource.SetupResource},
+	{"apigateway", "RestAPI", restapi.SetupRestAPI},
+	{"apigateway", "Method", method.SetupMethod},
+	{"cognitoidentity", "IdentityPool", cognitoidentitypool.SetupIdentityPool},
+	{"ec2", "FlowLog", flowlog.SetupFlowLog},
+	{"opensearchservice", "Domain", opensearchdomain.SetupDomain},
+	{"batch", "ComputeEnvironment", computeenvironment.SetupComputeEnvironment},
+	{"batch", "JobQueue", jobqueue.SetupJobQueue},
+	{"batch", "JobDefinition", jobdefinition.SetupJobDefinition},
+	{"batch", "Job", batchjob.SetupJob},
+	{"emrcontainers", "JobRun", emrcontainersjobrun.SetupJobRun},
+	{"emrcontainers", "VirtualCluster", emrcontainersvirtualcluster.SetupVirtualCluster},
+	{"rds", "OptionGroup", optiongroup.SetupOptionGroup},
+	{"autoscaling", "AutoScalingGroup", autoscalinggroup.SetupAutoScalingGroup},
+	{"s3control", "AccessPoint", s3control.SetupAccessPoint},
+	{"servicediscovery", "Service", servicediscoveryservice.SetupService},
+	{"sesv2", "ConfigurationSet", configurationset.SetupConfigurationSet},
+	{"iam", "ServiceLinkedRole", servicelinkedrole.SetupServiceLinkedRole},
+	{"sesv2", "EmailIdentity", emailidentity.SetupEmailIdentity},
+	{"sesv2", "EmailTemplate", emailtemplate.SetupEmailTemplate},
+}
+
 // Setup creates all AWS controllers with the supplied logger and adds them to
 // the supplied manager.
 func Setup(mgr ctrl.Manager, o controller.Options) error {
-	for _, setup := range []func(ctrl.Manager, controller.Options) error{
-		cache.SetupReplicationGroup,
-		cachesubnetgroup.SetupCacheSubnetGroup,
-		cacheparametergroup.SetupCacheParameterGroup,
-		cluster.SetupCacheCluster,
-		database.SetupRDSInstance,
-		daxcluster.SetupCluster,
-		daxparametergroup.SetupParameterGroup,
-		daxsubnetgroup.SetupSubnetGroup,
-		domain.SetupDomain,
-		docdbinstance.SetupDBInstance,
-		docdbcluster.SetupDBCluster,
-		docdbclusterparametergroup.SetupDBClusterParameterGroup,
-		docdbsubnetgroup.SetupDBSubnetGroup,
-		ecscluster.SetupCluster,
-		ecsservice.SetupService,
-		ecstask.SetupTaskDefinition,
-		eks.SetupCluster,
-		eksaddon.SetupAddon,
-		identityproviderconfig.SetupIdentityProviderConfig,
-		instanceprofile.SetupInstanceProfile,
-		elb.SetupELB,
-		elbattachment.SetupELBAttachment,
-		nodegroup.SetupNodeGroup,
-		s3.SetupBucket,
-		bucketpolicy.SetupBucketPolicy,
-		accesskey.SetupAccessKey,
-		user.SetupUser,
-		group.SetupGroup,
-		policy.SetupPolicy,
-		role.SetupRole,
-		groupusermembership.SetupGroupUserMembership,
-		userpolicyattachment.SetupUserPolicyAttachment,
-		grouppolicyattachment.SetupGroupPolicyAttachment,
-		rolepolicyattachment.SetupRolePolicyAttachment,
-		vpc.SetupVPC,
-		subnet.SetupSubnet,
-		securitygroup.SetupSecurityGroup,
-		securitygrouprule.SetupSecurityGroupRule,
-		internetgateway.SetupInternetGateway,
-		launchtemplate.SetupLaunchTemplate,
-		launchtemplateversion.SetupLaunchTemplateVersion,
-		natgateway.SetupNatGateway,
-		routetable.SetupRouteTable,
-		dbsubnetgroup.SetupDBSubnetGroup,
-		certificateauthority.SetupCertificateAuthority,
-		certificateauthoritypermission.SetupCertificateAuthorityPermission,
-		acm.SetupCertificate,
-		resourcerecordset.SetupResourceRecordSet,
-		hostedzone.SetupHostedZone,
-		secret.SetupSecret,
-		topic.SetupSNSTopic,
-		subscription.SetupSubscription,
-		queue.SetupQueue,
-		redshift.SetupCluster,
-		address.SetupAddress,
-		repository.SetupRepository,
-		repositorypolicy.SetupRepositoryPolicy,
-		lifecyclepolicy.SetupLifecyclePolicy,
-		api.SetupAPI,
-		stage.SetupStage,
-		route.SetupRoute,
-		authorizer.SetupAuthorizer,
-		integration.SetupIntegration,
-		deployment.SetupDeployment,
-		domainname.SetupDomainName,
-		integrationresponse.SetupIntegrationResponse,
-		model.SetupModel,
-		apimapping.SetupAPIMapping,
-		routeresponse.SetupRouteResponse,
-		vpclink.SetupVPCLink,
-		fargateprofile.SetupFargateProfile,
-		activity.SetupActivity,
-		statemachine.SetupStateMachine,
-		table.SetupTable,
-		backup.SetupBackup,
-		globaltable.SetupGlobalTable,
-		key.SetupKey,
-		alias.SetupAlias,
-		accesspoint.SetupAccessPoint,
-		filesystem.SetupFileSystem,
-		dbcluster.SetupDBCluster,
-		dbclusterparametergroup.SetupDBClusterParameterGroup,
-		dbinstance.SetupDBInstance,
-		dbinstanceroleassociation.SetupDBInstanceRoleAssociation,
-		dbparametergroup.SetupDBParameterGroup,
-		globalcluster.SetupGlobalCluster,
-		vpccidrblock.SetupVPCCIDRBlock,
-		privatednsnamespace.SetupPrivateDNSNamespace,
-		publicdnsnamespace.SetupPublicDNSNamespace,
-		httpnamespace.SetupHTTPNamespace,
-		lambdafunction.SetupFunction,
-		lambdapermission.SetupPermission,
-		lambdaurlconfig.SetupFunctionURL,
-		openidconnectprovider.SetupOpenIDConnectProvider,
-		distribution.SetupDistribution,
-		cachepolicy.SetupCachePolicy,
-		cloudfrontorginaccessidentity.SetupCloudFrontOriginAccessIdentity,
-		cloudfrontresponseheaderspolicy.SetupResponseHeadersPolicy,
-		resolverendpoint.SetupResolverEndpoint,
-		resolverrule.SetupResolverRule,
-		vpcpeeringconnection.SetupVPCPeeringConnection,
-		vpcendpoint.SetupVPCEndpoint,
-		kafkacluster.SetupCluster,
-		efsmounttarget.SetupMountTarget,
-		transferserver.SetupServer,
-		transferuser.SetupUser,
-		instance.SetupInstance,
-		gluejob.SetupJob,
-		gluesecurityconfiguration.SetupSecurityConfiguration,
-		glueconnection.SetupConnection,
-		glueDatabase.SetupDatabase,
-		gluecrawler.SetupCrawler,
-		glueclassifier.SetupClassifier,
-		mqbroker.SetupBroker,
-		mquser.SetupUser,
-		mwaaenvironment.SetupEnvironment,
-		cwloggroup.SetupLogGroup,
-		volume.SetupVolume,
-		transitgateway.SetupTransitGateway,
-		transitgatewayvpcattachment.SetupTransitGatewayVPCAttachment,
-		thing.SetupThing,
-		iotpolicy.SetupPolicy,
-		ec2route.SetupRoute,
-		athenaworkgroup.SetupWorkGroup,
-		resourceshare.SetupResourceShare,
-		kafkaconfiguration.SetupConfiguration,
-		listener.SetupListener,
-		loadbalancer.SetupLoadBalancer,
-		targetgroup.SetupTargetGroup,
-		target.SetupTarget,
-		transitgatewayroute.SetupTransitGatewayRoute,
-		transitgatewayroutetable.SetupTransitGatewayRouteTable,
-		vpcendpointserviceconfiguration.SetupVPCEndpointServiceConfiguration,
-		kinesisstream.SetupStream,
-		resolverruleassociation.SetupResolverRuleAssociation,
-		cognitouserpool.SetupUserPool,
-		cognitouserpooldomain.SetupUserPoolDomain,
-		cognitogroup.SetupGroup,
-		cognitouserpoolclient.SetupUserPoolClient,
-		cognitoidentityprovider.SetupIdentityProvider,
-		cognitoresourceserver.SetupResourceServer,
-		cognitogroupusermembership.SetupGroupUserMembership,
-		neptunecluster.SetupDBCluster,
-		topic.SetupSNSTopic,
-		subscription.SetupSubscription,
-		prometheusserviceworkspace.SetupWorkspace,
-		prometheusservicerulegroupnamespace.SetupRuleGroupsNamespace,
-		prometheusservicealertmanagerdefinition.SetupAlertManagerDefinition,
-		resource.SetupResource,
-		restapi.SetupRestAPI,
-		method.SetupMethod,
-		cognitoidentitypool.SetupIdentityPool,
-		flowlog.SetupFlowLog,
-		opensearchdomain.SetupDomain,
-		computeenvironment.SetupComputeEnvironment,
-		jobqueue.SetupJobQueue,
-		jobdefinition.SetupJobDefinition,
-		batchjob.SetupJob,
-		emrcontainersjobrun.SetupJobRun,
-		emrcontainersvirtualcluster.SetupVirtualCluster,
-		optiongroup.SetupOptionGroup,
-		autoscalinggroup.SetupAutoScalingGroup,
-		s3control.SetupAccessPoint,
-		servicediscoveryservice.SetupService,
-		configurationset.SetupConfigurationSet,
-		servicelinkedrole.SetupServiceLinkedRole,
-		emailidentity.SetupEmailIdentity,
-		emailtemplate.SetupEmailTemplate,
-	} {
-		if err := setup(mgr, o); err != nil {
+	return SetupWithFilter(mgr, o, &Filter{})
+}
+
+// SetupWithFilter creates the AWS controllers of the managed resources
+// selected by the supplied filter and adds them to the supplied manager.
+// Controllers that are not set up do not watch their managed resources, so
+// no informers are started for them either.
+func SetupWithFilter(mgr ctrl.Manager, o controller.Options, f *Filter) error {
+	for _, c := range controllers {
+		if !f.Selects(c.group, c.kind) {
+			continue
+		}
+		if err := c.setup(mgr, o); err != nil {
 			return err
 		}
 	}
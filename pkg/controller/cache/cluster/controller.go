@@ -19,6 +19,7 @@ package cluster
 import (
 	"context"
 	"reflect"
+	"sort"
 
 	"github.com/aws/aws-sdk-go-v2/aws"
 	elasticacheservice "github.com/aws/aws-sdk-go-v2/service/elasticache"
@@ -69,6 +70,7 @@ func SetupCacheCluster(mgr ctrl.Manager, o controller.Options) error {
 			resource.ManagedKind(cachev1alpha1.CacheClusterGroupVersionKind),
 			managed.WithReferenceResolver(managed.NewAPISimpleReferenceResolver(mgr.GetClient())),
 			managed.WithExternalConnecter(&connector{kube: mgr.GetClient(), newClientFn: elasticache.NewClient}),
+			managed.WithInitializers(managed.NewNameAsExternalName(mgr.GetClient()), &tagger{kube: mgr.GetClient()}),
 			managed.WithPollInterval(o.PollInterval),
 			managed.WithLogger(o.Logger.WithValues("controller", name)),
 			managed.WithRecorder(event.NewAPIRecorder(mgr.GetEventRecorderFor(name))),
@@ -193,3 +195,33 @@ func (e *external) Delete(ctx context.Context, mg resource.Managed) error {
 	})
 	return awsclient.Wrap(resource.Ignore(elasticache.IsClusterNotFound, err), errDeleteCacheCluster)
 }
+
+type tagger struct {
+	kube client.Client
+}
+
+func (t *tagger) Initialize(ctx context.Context, mg resource.Managed) error {
+	cr, ok := mg.(*cachev1alpha1.CacheCluster)
+	if !ok {
+		return errors.New(errNotCacheCluster)
+	}
+	tagMap := map[string]string{}
+	for _, t := range cr.Spec.ForProvider.Tags {
+		tagMap[t.Key] = awsclient.StringValue(t.Value)
+	}
+	changed, err := awsclient.MergeDefaultTags(ctx, t.kube, mg, tagMap)
+	if err != nil {
+		return err
+	}
+	if !changed {
+		return nil
+	}
+	cr.Spec.ForProvider.Tags = make([]cachev1alpha1.Tag, 0, len(tagMap))
+	for k, v := range tagMap {
+		cr.Spec.ForProvider.Tags = append(cr.Spec.ForProvider.Tags, cachev1alpha1.Tag{Key: k, Value: awsclient.String(v, awsclient.FieldRequired)})
+	}
+	sort.Slice(cr.Spec.ForProvider.Tags, func(i, j int) bool {
+		return cr.Spec.ForProvider.Tags[i].Key < cr.Spec.ForProvider.Tags[j].Key
+	})
+	return errors.Wrap(t.kube.Update(ctx, cr), errUpdateCacheClusterCR)
+}
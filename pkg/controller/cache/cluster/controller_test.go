@@ -18,6 +18,7 @@ package cluster
 
 import (
 	"context"
+	"sort"
 	"testing"
 
 	"github.com/aws/aws-sdk-go-v2/aws"
@@ -25,10 +26,12 @@ import (
 	awscachetypes "github.com/aws/aws-sdk-go-v2/service/elasticache/types"
 	"github.com/google/go-cmp/cmp"
 	"github.com/pkg/errors"
+	"sigs.k8s.io/controller-runtime/pkg/client"
 
 	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
 	"github.com/crossplane/crossplane-runtime/pkg/meta"
 	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
 	"github.com/crossplane/crossplane-runtime/pkg/test"
 
 	"github.com/crossplane-contrib/provider-aws/apis/cache/v1alpha1"
@@ -420,3 +423,69 @@ func TestDelete(t *testing.T) {
 		})
 	}
 }
+
+func TestInitialize(t *testing.T) {
+	withTags := func(tagMaps ...map[string]string) clusterModifier {
+		return func(r *v1alpha1.CacheCluster) {
+			tagMap := map[string]string{}
+			for _, m := range tagMaps {
+				for k, v := range m {
+					tagMap[k] = v
+				}
+			}
+			r.Spec.ForProvider.Tags = make([]v1alpha1.Tag, 0, len(tagMap))
+			for k, v := range tagMap {
+				r.Spec.ForProvider.Tags = append(r.Spec.ForProvider.Tags, v1alpha1.Tag{Key: k, Value: awsclient.String(v, awsclient.FieldRequired)})
+			}
+			sort.Slice(r.Spec.ForProvider.Tags, func(i, j int) bool {
+				return r.Spec.ForProvider.Tags[i].Key < r.Spec.ForProvider.Tags[j].Key
+			})
+		}
+	}
+	type args struct {
+		cr   *v1alpha1.CacheCluster
+		kube client.Client
+	}
+	type want struct {
+		cr  *v1alpha1.CacheCluster
+		err error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"Successful": {
+			args: args{
+				cr:   cluster(withTags(map[string]string{"foo": "bar"})),
+				kube: &test.MockClient{MockUpdate: test.NewMockUpdateFn(nil)},
+			},
+			want: want{
+				cr: cluster(withTags(resource.GetExternalTags(cluster()), map[string]string{"foo": "bar"})),
+			},
+		},
+		"AlreadyTagged": {
+			args: args{
+				cr:   cluster(withTags(resource.GetExternalTags(cluster()), map[string]string{"foo": "bar"})),
+				kube: &test.MockClient{MockUpdate: test.NewMockUpdateFn(errBoom)},
+			},
+			want: want{
+				cr: cluster(withTags(resource.GetExternalTags(cluster()), map[string]string{"foo": "bar"})),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &tagger{kube: tc.kube}
+			err := e.Initialize(context.Background(), tc.args.cr)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr); err == nil && diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
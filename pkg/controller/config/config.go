@@ -18,6 +18,8 @@ package config
 
 import (
 	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/builder"
+	"sigs.k8s.io/controller-runtime/pkg/predicate"
 	"sigs.k8s.io/controller-runtime/pkg/source"
 
 	"github.com/crossplane/crossplane-runtime/pkg/controller"
@@ -38,12 +40,33 @@ func Setup(mgr ctrl.Manager, o controller.Options) error {
 		UsageList: v1beta1.ProviderConfigUsageListGroupVersionKind,
 	}
 
-	return ctrl.NewControllerManagedBy(mgr).
+	if err := ctrl.NewControllerManagedBy(mgr).
 		Named(name).
 		WithOptions(o.ForControllerRuntime()).
 		For(&v1beta1.ProviderConfig{}).
 		Watches(&source.Kind{Type: &v1beta1.ProviderConfigUsage{}}, &resource.EnqueueRequestForProviderConfig{}).
 		Complete(providerconfig.NewReconciler(mgr, of,
 			providerconfig.WithLogger(o.Logger.WithValues("controller", name)),
-			providerconfig.WithRecorder(event.NewAPIRecorder(mgr.GetEventRecorderFor(name)))))
+			providerconfig.WithRecorder(event.NewAPIRecorder(mgr.GetEventRecorderFor(name))))); err != nil {
+		return err
+	}
+
+	return SetupHealth(mgr, o)
+}
+
+// SetupHealth adds a controller that periodically validates the credentials
+// of ProviderConfigs.
+func SetupHealth(mgr ctrl.Manager, o controller.Options) error {
+	name := HealthControllerName(v1beta1.ProviderConfigGroupKind)
+
+	// NOTE: Status updates must not trigger a new validation, the
+	// credentials are validated again after the poll interval.
+	return ctrl.NewControllerManagedBy(mgr).
+		Named(name).
+		WithOptions(o.ForControllerRuntime()).
+		For(&v1beta1.ProviderConfig{}, builder.WithPredicates(predicate.GenerationChangedPredicate{})).
+		Complete(NewHealthReconciler(mgr.GetClient(),
+			WithHealthLogger(o.Logger.WithValues("controller", name)),
+			WithHealthRecorder(event.NewAPIRecorder(mgr.GetEventRecorderFor(name))),
+			WithPollInterval(o.PollInterval)))
 }
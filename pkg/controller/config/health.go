@@ -0,0 +1,225 @@
+/*
+Copyright 2023 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package config
+
+import (
+	"context"
+	"strings"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/sts"
+	"github.com/pkg/errors"
+	kerrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/util/retry"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/event"
+	"github.com/crossplane/crossplane-runtime/pkg/logging"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+
+	"github.com/crossplane-contrib/provider-aws/apis/v1beta1"
+	awsclient "github.com/crossplane-contrib/provider-aws/pkg/clients"
+)
+
+const (
+	healthTimeout = 2 * time.Minute
+
+	// defaultHealthCheckRegion is the region whose STS endpoint is used to
+	// validate credentials if the ProviderConfig does not specify one.
+	defaultHealthCheckRegion = "us-east-1"
+
+	errGetPC             = "cannot get ProviderConfig"
+	errResolveConfig     = "cannot resolve AWS config"
+	errGetCallerIdentity = "cannot get caller identity"
+	errUpdateStatus      = "cannot update ProviderConfig status"
+)
+
+// Event reasons.
+const (
+	reasonCredentials event.Reason = "ValidateCredentials"
+)
+
+// HealthControllerName returns the name of the controller that validates the
+// credentials of ProviderConfigs of the supplied kind.
+func HealthControllerName(kind string) string {
+	return "providerconfig-health/" + strings.ToLower(kind)
+}
+
+// A CallerIdentityClient returns the identity of the caller.
+type CallerIdentityClient interface {
+	GetCallerIdentity(ctx context.Context, input *sts.GetCallerIdentityInput, opts ...func(*sts.Options)) (*sts.GetCallerIdentityOutput, error)
+}
+
+// A HealthReconciler periodically validates the credentials of a
+// ProviderConfig by calling sts:GetCallerIdentity with them and reports the
+// result in its status.
+type HealthReconciler struct {
+	client client.Client
+
+	resolve      func(ctx context.Context, c client.Client, pc *v1beta1.ProviderConfig) (*aws.Config, error)
+	newClientFn  func(cfg aws.Config) CallerIdentityClient
+	pollInterval time.Duration
+
+	log    logging.Logger
+	record event.Recorder
+}
+
+// A HealthReconcilerOption configures a HealthReconciler.
+type HealthReconcilerOption func(*HealthReconciler)
+
+// WithHealthLogger specifies how the HealthReconciler should log messages.
+func WithHealthLogger(l logging.Logger) HealthReconcilerOption {
+	return func(r *HealthReconciler) {
+		r.log = l
+	}
+}
+
+// WithHealthRecorder specifies how the HealthReconciler should record events.
+func WithHealthRecorder(er event.Recorder) HealthReconcilerOption {
+	return func(r *HealthReconciler) {
+		r.record = er
+	}
+}
+
+// WithPollInterval specifies how often the HealthReconciler should validate
+// the credentials of a ProviderConfig.
+func WithPollInterval(d time.Duration) HealthReconcilerOption {
+	return func(r *HealthReconciler) {
+		r.pollInterval = d
+	}
+}
+
+// WithConfigResolver specifies how the HealthReconciler should resolve the
+// AWS config of a ProviderConfig.
+func WithConfigResolver(fn func(ctx context.Context, c client.Client, pc *v1beta1.ProviderConfig) (*aws.Config, error)) HealthReconcilerOption {
+	return func(r *HealthReconciler) {
+		r.resolve = fn
+	}
+}
+
+// WithCallerIdentityClient specifies how the HealthReconciler should create
+// the client that is used to validate credentials.
+func WithCallerIdentityClient(fn func(cfg aws.Config) CallerIdentityClient) HealthReconcilerOption {
+	return func(r *HealthReconciler) {
+		r.newClientFn = fn
+	}
+}
+
+// NewHealthReconciler returns a HealthReconciler of ProviderConfigs.
+func NewHealthReconciler(c client.Client, o ...HealthReconcilerOption) *HealthReconciler {
+	r := &HealthReconciler{
+		client: c,
+		resolve: func(ctx context.Context, c client.Client, pc *v1beta1.ProviderConfig) (*aws.Config, error) {
+			return awsclient.GetConfigForProviderConfig(ctx, c, pc, healthCheckRegion(pc))
+		},
+		newClientFn: func(cfg aws.Config) CallerIdentityClient {
+			return sts.NewFromConfig(cfg)
+		},
+		pollInterval: time.Minute,
+		log:          logging.NewNopLogger(),
+		record:       event.NewNopRecorder(),
+	}
+
+	for _, ro := range o {
+		ro(r)
+	}
+
+	return r
+}
+
+// Reconcile a ProviderConfig by validating its credentials and reporting
+// the identity they resolve to.
+func (r *HealthReconciler) Reconcile(ctx context.Context, req reconcile.Request) (reconcile.Result, error) {
+	log := r.log.WithValues("request", req)
+	log.Debug("Reconciling")
+
+	ctx, cancel := context.WithTimeout(ctx, healthTimeout)
+	defer cancel()
+
+	pc := &v1beta1.ProviderConfig{}
+	if err := r.client.Get(ctx, req.NamespacedName, pc); err != nil {
+		log.Debug(errGetPC, "error", err)
+		return reconcile.Result{}, errors.Wrap(resource.IgnoreNotFound(err), errGetPC)
+	}
+	if meta.WasDeleted(pc) {
+		// NOTE: The usage finalizer keeps a deleted ProviderConfig around
+		// until it is no longer used, so we get to see it being deleted and
+		// can drop the credentials that were resolved from it.
+		awsclient.EvictCachedConfigs(pc.GetUID())
+		return reconcile.Result{}, nil
+	}
+
+	id, verr := r.validate(ctx, pc)
+	if verr != nil {
+		log.Debug("Credentials are not valid", "error", verr)
+		r.record.Event(pc, event.Warning(reasonCredentials, verr))
+	}
+	setHealth(pc, id, verr)
+
+	// NOTE: The ProviderConfig usage tracker updates the status of the
+	// ProviderConfig too, so on conflicts we fetch it again and retry.
+	err := retry.RetryOnConflict(retry.DefaultRetry, func() error {
+		err := r.client.Status().Update(ctx, pc)
+		if kerrors.IsConflict(err) {
+			if err := r.client.Get(ctx, types.NamespacedName{Name: pc.GetName()}, pc); err != nil {
+				return err
+			}
+			setHealth(pc, id, verr)
+		}
+		return err
+	})
+	return reconcile.Result{RequeueAfter: r.pollInterval}, errors.Wrap(err, errUpdateStatus)
+}
+
+// setHealth reports the result of validating the credentials of the supplied
+// ProviderConfig in its status.
+func setHealth(pc *v1beta1.ProviderConfig, id *sts.GetCallerIdentityOutput, err error) {
+	if err != nil {
+		pc.Status.AccountID = nil
+		pc.Status.CallerARN = nil
+		pc.Status.SetConditions(xpv1.Unavailable().WithMessage(err.Error()), xpv1.ReconcileError(err))
+		return
+	}
+	pc.Status.AccountID = id.Account
+	pc.Status.CallerARN = id.Arn
+	pc.Status.SetConditions(xpv1.Available(), xpv1.ReconcileSuccess())
+}
+
+// healthCheckRegion returns the region that is used to validate the
+// credentials of the supplied ProviderConfig, taking its STS regional
+// endpoints setting into account.
+func healthCheckRegion(pc *v1beta1.ProviderConfig) string {
+	region := defaultHealthCheckRegion
+	if pc.Spec.HealthCheckRegion != nil {
+		region = *pc.Spec.HealthCheckRegion
+	}
+	return awsclient.STSRegion(pc, region)
+}
+
+func (r *HealthReconciler) validate(ctx context.Context, pc *v1beta1.ProviderConfig) (*sts.GetCallerIdentityOutput, error) {
+	cfg, err := r.resolve(ctx, r.client, pc)
+	if err != nil {
+		return nil, errors.Wrap(err, errResolveConfig)
+	}
+	id, err := r.newClientFn(*cfg).GetCallerIdentity(ctx, &sts.GetCallerIdentityInput{})
+	return id, awsclient.Wrap(err, errGetCallerIdentity)
+}
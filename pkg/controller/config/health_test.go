@@ -0,0 +1,268 @@
+/*
+Copyright 2023 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package config
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/sts"
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+	kerrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+
+	"github.com/crossplane-contrib/provider-aws/apis/v1beta1"
+	awsclient "github.com/crossplane-contrib/provider-aws/pkg/clients"
+)
+
+var (
+	accountID = "123456789012"
+	callerARN = "arn:aws:sts::123456789012:assumed-role/crossplane/session"
+)
+
+type mockCallerIdentityClient struct {
+	out *sts.GetCallerIdentityOutput
+	err error
+}
+
+func (m *mockCallerIdentityClient) GetCallerIdentity(_ context.Context, _ *sts.GetCallerIdentityInput, _ ...func(*sts.Options)) (*sts.GetCallerIdentityOutput, error) {
+	return m.out, m.err
+}
+
+func TestHealthReconcile(t *testing.T) {
+	errBoom := errors.New("boom")
+	pollInterval := 5 * time.Minute
+	errConflict := kerrors.NewConflict(schema.GroupResource{}, "example", errBoom)
+
+	// got is the status of the last successful status update.
+	var got v1beta1.ProviderConfigStatus
+
+	type args struct {
+		kube    client.Client
+		resolve func(ctx context.Context, c client.Client, pc *v1beta1.ProviderConfig) (*aws.Config, error)
+		sts     CallerIdentityClient
+	}
+	type want struct {
+		result reconcile.Result
+		err    error
+		status v1beta1.ProviderConfigStatus
+	}
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"NotFound": {
+			args: args{
+				kube: &test.MockClient{
+					MockGet: test.NewMockGetFn(kerrors.NewNotFound(schema.GroupResource{}, "")),
+				},
+			},
+			want: want{},
+		},
+		"Deleted": {
+			args: args{
+				kube: &test.MockClient{
+					MockGet: func(_ context.Context, _ client.ObjectKey, obj client.Object) error {
+						now := metav1.Now()
+						obj.SetDeletionTimestamp(&now)
+						return nil
+					},
+				},
+			},
+			want: want{},
+		},
+		"ValidCredentials": {
+			args: args{
+				resolve: func(_ context.Context, _ client.Client, _ *v1beta1.ProviderConfig) (*aws.Config, error) {
+					return &aws.Config{}, nil
+				},
+				sts: &mockCallerIdentityClient{out: &sts.GetCallerIdentityOutput{Account: &accountID, Arn: &callerARN}},
+			},
+			want: want{
+				result: reconcile.Result{RequeueAfter: pollInterval},
+				status: func() v1beta1.ProviderConfigStatus {
+					s := v1beta1.ProviderConfigStatus{AccountID: &accountID, CallerARN: &callerARN}
+					s.SetConditions(xpv1.Available(), xpv1.ReconcileSuccess())
+					return s
+				}(),
+			},
+		},
+		"StatusUpdateConflict": {
+			args: args{
+				kube: func() client.Client {
+					gets, updates := 0, 0
+					return &test.MockClient{
+						MockGet: func(_ context.Context, _ client.ObjectKey, obj client.Object) error {
+							gets++
+							if gets > 1 {
+								// The usage tracker updated the status
+								// in the meantime.
+								obj.(*v1beta1.ProviderConfig).Status.Users = 2
+							}
+							return nil
+						},
+						MockStatusUpdate: func(_ context.Context, obj client.Object, _ ...client.SubResourceUpdateOption) error {
+							updates++
+							if updates == 1 {
+								return errConflict
+							}
+							got = obj.(*v1beta1.ProviderConfig).Status
+							return nil
+						},
+					}
+				}(),
+				resolve: func(_ context.Context, _ client.Client, _ *v1beta1.ProviderConfig) (*aws.Config, error) {
+					return &aws.Config{}, nil
+				},
+				sts: &mockCallerIdentityClient{out: &sts.GetCallerIdentityOutput{Account: &accountID, Arn: &callerARN}},
+			},
+			want: want{
+				result: reconcile.Result{RequeueAfter: pollInterval},
+				status: func() v1beta1.ProviderConfigStatus {
+					s := v1beta1.ProviderConfigStatus{AccountID: &accountID, CallerARN: &callerARN}
+					s.Users = 2
+					s.SetConditions(xpv1.Available(), xpv1.ReconcileSuccess())
+					return s
+				}(),
+			},
+		},
+		"StatusUpdateError": {
+			args: args{
+				kube: &test.MockClient{
+					MockGet:          test.NewMockGetFn(nil),
+					MockStatusUpdate: test.NewMockSubResourceUpdateFn(errBoom),
+				},
+				resolve: func(_ context.Context, _ client.Client, _ *v1beta1.ProviderConfig) (*aws.Config, error) {
+					return &aws.Config{}, nil
+				},
+				sts: &mockCallerIdentityClient{out: &sts.GetCallerIdentityOutput{Account: &accountID, Arn: &callerARN}},
+			},
+			want: want{
+				result: reconcile.Result{RequeueAfter: pollInterval},
+				err:    errors.Wrap(errBoom, errUpdateStatus),
+			},
+		},
+		"ResolveError": {
+			args: args{
+				resolve: func(_ context.Context, _ client.Client, _ *v1beta1.ProviderConfig) (*aws.Config, error) {
+					return nil, errBoom
+				},
+			},
+			want: want{
+				result: reconcile.Result{RequeueAfter: pollInterval},
+				status: func() v1beta1.ProviderConfigStatus {
+					err := errors.Wrap(errBoom, errResolveConfig)
+					s := v1beta1.ProviderConfigStatus{}
+					s.SetConditions(xpv1.Unavailable().WithMessage(err.Error()), xpv1.ReconcileError(err))
+					return s
+				}(),
+			},
+		},
+		"GetCallerIdentityError": {
+			args: args{
+				resolve: func(_ context.Context, _ client.Client, _ *v1beta1.ProviderConfig) (*aws.Config, error) {
+					return &aws.Config{}, nil
+				},
+				sts: &mockCallerIdentityClient{err: errBoom},
+			},
+			want: want{
+				result: reconcile.Result{RequeueAfter: pollInterval},
+				status: func() v1beta1.ProviderConfigStatus {
+					err := awsclient.Wrap(errBoom, errGetCallerIdentity)
+					s := v1beta1.ProviderConfigStatus{}
+					s.SetConditions(xpv1.Unavailable().WithMessage(err.Error()), xpv1.ReconcileError(err))
+					return s
+				}(),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got = v1beta1.ProviderConfigStatus{}
+			kube := tc.args.kube
+			if kube == nil {
+				kube = &test.MockClient{
+					MockGet: test.NewMockGetFn(nil),
+					MockStatusUpdate: func(_ context.Context, obj client.Object, _ ...client.SubResourceUpdateOption) error {
+						got = obj.(*v1beta1.ProviderConfig).Status
+						return nil
+					},
+				}
+			}
+			r := NewHealthReconciler(kube,
+				WithPollInterval(pollInterval),
+				WithConfigResolver(tc.args.resolve),
+				WithCallerIdentityClient(func(_ aws.Config) CallerIdentityClient { return tc.args.sts }),
+			)
+			result, err := r.Reconcile(context.Background(), reconcile.Request{NamespacedName: types.NamespacedName{Name: "example"}})
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.result, result); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.status, got, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestHealthCheckRegion(t *testing.T) {
+	cases := map[string]struct {
+		spec v1beta1.ProviderConfigSpec
+		want string
+	}{
+		"Default": {
+			want: defaultHealthCheckRegion,
+		},
+		"HealthCheckRegion": {
+			spec: v1beta1.ProviderConfigSpec{HealthCheckRegion: awsclient.String("cn-north-1")},
+			want: "cn-north-1",
+		},
+		"LegacySTSEndpoints": {
+			spec: v1beta1.ProviderConfigSpec{STSRegionalEndpoints: awsclient.String(awsclient.STSRegionalEndpointsLegacy)},
+			want: awsclient.GlobalRegion,
+		},
+		"LegacySTSEndpointsRegionalOnlyRegion": {
+			spec: v1beta1.ProviderConfigSpec{
+				HealthCheckRegion:    awsclient.String("us-gov-west-1"),
+				STSRegionalEndpoints: awsclient.String(awsclient.STSRegionalEndpointsLegacy),
+			},
+			want: "us-gov-west-1",
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got := healthCheckRegion(&v1beta1.ProviderConfig{Spec: tc.spec})
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
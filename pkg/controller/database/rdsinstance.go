@@ -321,8 +321,8 @@ func (t *tagger) Initialize(ctx context.Context, mg resource.Managed) error {
 	for _, t := range cr.Spec.ForProvider.Tags {
 		tagMap[t.Key] = t.Value
 	}
-	for k, v := range resource.GetExternalTags(mg) {
-		tagMap[k] = v
+	if err := awsclient.AddDefaultTags(ctx, t.kube, mg, tagMap); err != nil {
+		return err
 	}
 	cr.Spec.ForProvider.Tags = make([]v1beta1.Tag, len(tagMap))
 	i := 0
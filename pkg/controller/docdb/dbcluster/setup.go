@@ -486,7 +486,11 @@ func (t *tagger) Initialize(ctx context.Context, mg resource.Managed) error {
 		return errors.New(errNotDBCluster)
 	}
 
-	cr.Spec.ForProvider.Tags = svcutils.AddExternalTags(mg, cr.Spec.ForProvider.Tags)
+	tags, err := svcutils.AddExternalTags(ctx, t.kube, mg, cr.Spec.ForProvider.Tags)
+	if err != nil {
+		return err
+	}
+	cr.Spec.ForProvider.Tags = tags
 	return errors.Wrap(t.kube.Update(ctx, cr), errKubeUpdateFailed)
 }
 
@@ -17,12 +17,14 @@ limitations under the License.
 package docdb
 
 import (
+	"context"
 	"sort"
 
 	svcsdk "github.com/aws/aws-sdk-go/service/docdb"
 	"github.com/aws/aws-sdk-go/service/docdb/docdbiface"
 	"github.com/crossplane/crossplane-runtime/pkg/resource"
 	"github.com/pkg/errors"
+	"sigs.k8s.io/controller-runtime/pkg/client"
 
 	svcapitypes "github.com/crossplane-contrib/provider-aws/apis/docdb/v1alpha1"
 	awsclient "github.com/crossplane-contrib/provider-aws/pkg/clients"
@@ -127,33 +129,43 @@ func DiffTags(spec []*svcapitypes.Tag, current []*svcsdk.Tag) (addTags []*svcsdk
 	return addTags, removeTags
 }
 
-// AddExternalTags to spec if they don't exist
-func AddExternalTags(mg resource.Managed, spec []*svcapitypes.Tag) []*svcapitypes.Tag {
+// AddExternalTags adds the default tags of the ProviderConfig and the
+// Crossplane tags to spec if they don't exist
+func AddExternalTags(ctx context.Context, kube client.Client, mg resource.Managed, spec []*svcapitypes.Tag) ([]*svcapitypes.Tag, error) {
 	tagMap := make(map[string]struct{}, len(spec))
 	for _, t := range spec {
 		tagMap[awsclient.StringValue(t.Key)] = struct{}{}
 	}
 
+	defaultTags := map[string]string{}
+	if err := awsclient.AddDefaultTags(ctx, kube, mg, defaultTags); err != nil {
+		return nil, err
+	}
+
 	tags := spec
-	for _, t := range GetExternalTags(mg) {
+	for _, t := range sortTags(defaultTags) {
 		if _, exists := tagMap[awsclient.StringValue(t.Key)]; !exists {
 			tags = append(tags, t)
 		}
 	}
 
-	return tags
+	return tags, nil
 }
 
 // GetExternalTags is a wrapper around resource.GetExternalTags to return a sorted array instead of a map
 func GetExternalTags(mg resource.Managed) []*svcapitypes.Tag {
-	externalTags := []*svcapitypes.Tag{}
-	for k, v := range resource.GetExternalTags(mg) {
-		externalTags = append(externalTags, &svcapitypes.Tag{Key: awsclient.String(k), Value: awsclient.String(v)})
+	return sortTags(resource.GetExternalTags(mg))
+}
+
+func sortTags(tagMap map[string]string) []*svcapitypes.Tag {
+	tags := make([]*svcapitypes.Tag, 0, len(tagMap))
+	for k, v := range tagMap {
+		tags = append(tags, &svcapitypes.Tag{Key: awsclient.String(k), Value: awsclient.String(v)})
 	}
 
-	sort.Slice(externalTags, func(i, j int) bool {
-		return awsclient.StringValue(externalTags[i].Key) > awsclient.StringValue(externalTags[j].Key)
+	sort.Slice(tags, func(i, j int) bool {
+		return awsclient.StringValue(tags[i].Key) > awsclient.StringValue(tags[j].Key)
 	})
 
-	return externalTags
+	return tags
 }
@@ -180,8 +180,8 @@ func (e *tagger) Initialize(ctx context.Context, mg resource.Managed) error {
 	for _, t := range cr.Spec.ForProvider.Tags {
 		tagMap[aws.StringValue(t.Key)] = aws.StringValue(t.Value)
 	}
-	for k, v := range resource.GetExternalTags(cr) {
-		tagMap[k] = v
+	if err := aws.AddDefaultTags(ctx, e.kube, cr, tagMap); err != nil {
+		return err
 	}
 	tags := make([]*svcapitypes.Tag, 0)
 	for k, v := range tagMap {
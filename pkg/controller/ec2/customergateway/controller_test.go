@@ -0,0 +1,428 @@
+/*
+Copyright 2023 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package customergateway
+
+import (
+	"context"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	awsec2 "github.com/aws/aws-sdk-go-v2/service/ec2"
+	awsec2types "github.com/aws/aws-sdk-go-v2/service/ec2/types"
+	"github.com/aws/smithy-go"
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+
+	"github.com/crossplane-contrib/provider-aws/apis/ec2/manualv1alpha1"
+	awsclient "github.com/crossplane-contrib/provider-aws/pkg/clients"
+	"github.com/crossplane-contrib/provider-aws/pkg/clients/ec2"
+	"github.com/crossplane-contrib/provider-aws/pkg/clients/ec2/fake"
+)
+
+var (
+	cgwID     = "some cgw"
+	ipAddress = "1.2.3.4"
+	cgwType   = "ipsec.1"
+	errBoom   = errors.New("boom")
+)
+
+type args struct {
+	cgw ec2.CustomerGatewayClient
+	cr  *manualv1alpha1.CustomerGateway
+}
+
+type cgwModifier func(*manualv1alpha1.CustomerGateway)
+
+func withExternalName(name string) cgwModifier {
+	return func(r *manualv1alpha1.CustomerGateway) { meta.SetExternalName(r, name) }
+}
+
+func withSpec(p manualv1alpha1.CustomerGatewayParameters) cgwModifier {
+	return func(r *manualv1alpha1.CustomerGateway) { r.Spec.ForProvider = p }
+}
+
+func withStatus(s manualv1alpha1.CustomerGatewayObservation) cgwModifier {
+	return func(r *manualv1alpha1.CustomerGateway) { r.Status.AtProvider = s }
+}
+
+func withConditions(c ...xpv1.Condition) cgwModifier {
+	return func(r *manualv1alpha1.CustomerGateway) { r.Status.ConditionedStatus.Conditions = c }
+}
+
+func cgw(m ...cgwModifier) *manualv1alpha1.CustomerGateway {
+	cr := &manualv1alpha1.CustomerGateway{}
+	for _, f := range m {
+		f(cr)
+	}
+	return cr
+}
+
+var _ managed.ExternalClient = &external{}
+var _ managed.ExternalConnecter = &connector{}
+
+func TestObserve(t *testing.T) {
+	type want struct {
+		cr     *manualv1alpha1.CustomerGateway
+		result managed.ExternalObservation
+		err    error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"SuccessfulAvailable": {
+			args: args{
+				cgw: &fake.MockCustomerGatewayClient{
+					MockDescribe: func(ctx context.Context, input *awsec2.DescribeCustomerGatewaysInput, opts []func(*awsec2.Options)) (*awsec2.DescribeCustomerGatewaysOutput, error) {
+						return &awsec2.DescribeCustomerGatewaysOutput{
+							CustomerGateways: []awsec2types.CustomerGateway{{
+								CustomerGatewayId: aws.String(cgwID),
+								BgpAsn:            aws.String("65000"),
+								IpAddress:         aws.String(ipAddress),
+								State:             aws.String("available"),
+								Type:              aws.String(cgwType),
+							}},
+						}, nil
+					},
+				},
+				cr: cgw(withSpec(manualv1alpha1.CustomerGatewayParameters{
+					Type:      cgwType,
+					IPAddress: aws.String(ipAddress),
+				}), withExternalName(cgwID)),
+			},
+			want: want{
+				cr: cgw(withSpec(manualv1alpha1.CustomerGatewayParameters{
+					Type:      cgwType,
+					IPAddress: aws.String(ipAddress),
+					BGPASN:    aws.Int32(65000),
+				}), withExternalName(cgwID), withStatus(manualv1alpha1.CustomerGatewayObservation{
+					CustomerGatewayID: cgwID,
+					State:             "available",
+				}), withConditions(xpv1.Available())),
+				result: managed.ExternalObservation{
+					ResourceExists:          true,
+					ResourceUpToDate:        true,
+					ResourceLateInitialized: true,
+				},
+			},
+		},
+		"Pending": {
+			args: args{
+				cgw: &fake.MockCustomerGatewayClient{
+					MockDescribe: func(ctx context.Context, input *awsec2.DescribeCustomerGatewaysInput, opts []func(*awsec2.Options)) (*awsec2.DescribeCustomerGatewaysOutput, error) {
+						return &awsec2.DescribeCustomerGatewaysOutput{
+							CustomerGateways: []awsec2types.CustomerGateway{{
+								CustomerGatewayId: aws.String(cgwID),
+								State:             aws.String("pending"),
+							}},
+						}, nil
+					},
+				},
+				cr: cgw(withExternalName(cgwID)),
+			},
+			want: want{
+				cr: cgw(withExternalName(cgwID), withStatus(manualv1alpha1.CustomerGatewayObservation{
+					CustomerGatewayID: cgwID,
+					State:             "pending",
+				}), withConditions(xpv1.Creating())),
+				result: managed.ExternalObservation{
+					ResourceExists:   true,
+					ResourceUpToDate: true,
+				},
+			},
+		},
+		"Deleted": {
+			args: args{
+				cgw: &fake.MockCustomerGatewayClient{
+					MockDescribe: func(ctx context.Context, input *awsec2.DescribeCustomerGatewaysInput, opts []func(*awsec2.Options)) (*awsec2.DescribeCustomerGatewaysOutput, error) {
+						return &awsec2.DescribeCustomerGatewaysOutput{
+							CustomerGateways: []awsec2types.CustomerGateway{{
+								CustomerGatewayId: aws.String(cgwID),
+								State:             aws.String("deleted"),
+							}},
+						}, nil
+					},
+				},
+				cr: cgw(withExternalName(cgwID)),
+			},
+			want: want{
+				cr: cgw(withExternalName(cgwID)),
+			},
+		},
+		"NotFound": {
+			args: args{
+				cgw: &fake.MockCustomerGatewayClient{
+					MockDescribe: func(ctx context.Context, input *awsec2.DescribeCustomerGatewaysInput, opts []func(*awsec2.Options)) (*awsec2.DescribeCustomerGatewaysOutput, error) {
+						return nil, &smithy.GenericAPIError{Code: ec2.CustomerGatewayNotFound}
+					},
+				},
+				cr: cgw(withExternalName(cgwID)),
+			},
+			want: want{
+				cr: cgw(withExternalName(cgwID)),
+			},
+		},
+		"DescribeFail": {
+			args: args{
+				cgw: &fake.MockCustomerGatewayClient{
+					MockDescribe: func(ctx context.Context, input *awsec2.DescribeCustomerGatewaysInput, opts []func(*awsec2.Options)) (*awsec2.DescribeCustomerGatewaysOutput, error) {
+						return nil, errBoom
+					},
+				},
+				cr: cgw(withExternalName(cgwID)),
+			},
+			want: want{
+				cr:  cgw(withExternalName(cgwID)),
+				err: awsclient.Wrap(errBoom, errDescribe),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{client: tc.cgw}
+			o, err := e.Observe(context.Background(), tc.args.cr)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.result, o); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestCreate(t *testing.T) {
+	type want struct {
+		cr     *manualv1alpha1.CustomerGateway
+		result managed.ExternalCreation
+		err    error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"Successful": {
+			args: args{
+				cgw: &fake.MockCustomerGatewayClient{
+					MockCreate: func(ctx context.Context, input *awsec2.CreateCustomerGatewayInput, opts []func(*awsec2.Options)) (*awsec2.CreateCustomerGatewayOutput, error) {
+						if diff := cmp.Diff(ipAddress, aws.ToString(input.PublicIp)); diff != "" {
+							t.Errorf("r: -want, +got:\n%s", diff)
+						}
+						return &awsec2.CreateCustomerGatewayOutput{
+							CustomerGateway: &awsec2types.CustomerGateway{CustomerGatewayId: aws.String(cgwID)},
+						}, nil
+					},
+				},
+				cr: cgw(withSpec(manualv1alpha1.CustomerGatewayParameters{Type: cgwType, IPAddress: aws.String(ipAddress)})),
+			},
+			want: want{
+				cr: cgw(withSpec(manualv1alpha1.CustomerGatewayParameters{Type: cgwType, IPAddress: aws.String(ipAddress)}), withExternalName(cgwID)),
+			},
+		},
+		"CreateFail": {
+			args: args{
+				cgw: &fake.MockCustomerGatewayClient{
+					MockCreate: func(ctx context.Context, input *awsec2.CreateCustomerGatewayInput, opts []func(*awsec2.Options)) (*awsec2.CreateCustomerGatewayOutput, error) {
+						return nil, errBoom
+					},
+				},
+				cr: cgw(),
+			},
+			want: want{
+				cr:  cgw(),
+				err: awsclient.Wrap(errBoom, errCreate),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{client: tc.cgw}
+			o, err := e.Create(context.Background(), tc.args.cr)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.result, o); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestUpdate(t *testing.T) {
+	type want struct {
+		err error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"SuccessfulTags": {
+			args: args{
+				cgw: &fake.MockCustomerGatewayClient{
+					MockDescribe: func(ctx context.Context, input *awsec2.DescribeCustomerGatewaysInput, opts []func(*awsec2.Options)) (*awsec2.DescribeCustomerGatewaysOutput, error) {
+						return &awsec2.DescribeCustomerGatewaysOutput{
+							CustomerGateways: []awsec2types.CustomerGateway{{
+								CustomerGatewayId: aws.String(cgwID),
+								Tags:              []awsec2types.Tag{{Key: aws.String("old"), Value: aws.String("v")}},
+							}},
+						}, nil
+					},
+					MockCreateTags: func(ctx context.Context, input *awsec2.CreateTagsInput, opts []func(*awsec2.Options)) (*awsec2.CreateTagsOutput, error) {
+						if diff := cmp.Diff("new", aws.ToString(input.Tags[0].Key)); diff != "" {
+							t.Errorf("r: -want, +got:\n%s", diff)
+						}
+						return &awsec2.CreateTagsOutput{}, nil
+					},
+					MockDeleteTags: func(ctx context.Context, input *awsec2.DeleteTagsInput, opts []func(*awsec2.Options)) (*awsec2.DeleteTagsOutput, error) {
+						if diff := cmp.Diff("old", aws.ToString(input.Tags[0].Key)); diff != "" {
+							t.Errorf("r: -want, +got:\n%s", diff)
+						}
+						return &awsec2.DeleteTagsOutput{}, nil
+					},
+				},
+				cr: cgw(withSpec(manualv1alpha1.CustomerGatewayParameters{
+					Tags: []manualv1alpha1.Tag{{Key: "new", Value: "v"}},
+				}), withExternalName(cgwID)),
+			},
+		},
+		"CreateTagsFail": {
+			args: args{
+				cgw: &fake.MockCustomerGatewayClient{
+					MockDescribe: func(ctx context.Context, input *awsec2.DescribeCustomerGatewaysInput, opts []func(*awsec2.Options)) (*awsec2.DescribeCustomerGatewaysOutput, error) {
+						return &awsec2.DescribeCustomerGatewaysOutput{
+							CustomerGateways: []awsec2types.CustomerGateway{{CustomerGatewayId: aws.String(cgwID)}},
+						}, nil
+					},
+					MockCreateTags: func(ctx context.Context, input *awsec2.CreateTagsInput, opts []func(*awsec2.Options)) (*awsec2.CreateTagsOutput, error) {
+						return nil, errBoom
+					},
+				},
+				cr: cgw(withSpec(manualv1alpha1.CustomerGatewayParameters{
+					Tags: []manualv1alpha1.Tag{{Key: "new", Value: "v"}},
+				}), withExternalName(cgwID)),
+			},
+			want: want{
+				err: awsclient.Wrap(errBoom, errCreateTags),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{client: tc.cgw}
+			_, err := e.Update(context.Background(), tc.args.cr)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestDelete(t *testing.T) {
+	type want struct {
+		cr  *manualv1alpha1.CustomerGateway
+		err error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"Successful": {
+			args: args{
+				cgw: &fake.MockCustomerGatewayClient{
+					MockDelete: func(ctx context.Context, input *awsec2.DeleteCustomerGatewayInput, opts []func(*awsec2.Options)) (*awsec2.DeleteCustomerGatewayOutput, error) {
+						return &awsec2.DeleteCustomerGatewayOutput{}, nil
+					},
+				},
+				cr: cgw(withExternalName(cgwID)),
+			},
+			want: want{
+				cr: cgw(withExternalName(cgwID), withConditions(xpv1.Deleting())),
+			},
+		},
+		"AlreadyDeleting": {
+			args: args{
+				cgw: &fake.MockCustomerGatewayClient{},
+				cr:  cgw(withExternalName(cgwID), withStatus(manualv1alpha1.CustomerGatewayObservation{State: "deleting"})),
+			},
+			want: want{
+				cr: cgw(withExternalName(cgwID), withStatus(manualv1alpha1.CustomerGatewayObservation{State: "deleting"}), withConditions(xpv1.Deleting())),
+			},
+		},
+		"NotFound": {
+			args: args{
+				cgw: &fake.MockCustomerGatewayClient{
+					MockDelete: func(ctx context.Context, input *awsec2.DeleteCustomerGatewayInput, opts []func(*awsec2.Options)) (*awsec2.DeleteCustomerGatewayOutput, error) {
+						return nil, &smithy.GenericAPIError{Code: ec2.CustomerGatewayNotFound}
+					},
+				},
+				cr: cgw(withExternalName(cgwID)),
+			},
+			want: want{
+				cr: cgw(withExternalName(cgwID), withConditions(xpv1.Deleting())),
+			},
+		},
+		"DeleteFail": {
+			args: args{
+				cgw: &fake.MockCustomerGatewayClient{
+					MockDelete: func(ctx context.Context, input *awsec2.DeleteCustomerGatewayInput, opts []func(*awsec2.Options)) (*awsec2.DeleteCustomerGatewayOutput, error) {
+						return nil, errBoom
+					},
+				},
+				cr: cgw(withExternalName(cgwID)),
+			},
+			want: want{
+				cr:  cgw(withExternalName(cgwID), withConditions(xpv1.Deleting())),
+				err: awsclient.Wrap(errBoom, errDelete),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{client: tc.cgw}
+			err := e.Delete(context.Background(), tc.args.cr)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
@@ -54,6 +54,10 @@ const (
 	errModifyInstanceAttributes = "failed to modify the Instance resource attributes"
 	errCreateTags               = "failed to create tags for the Instance resource"
 	errDelete                   = "failed to delete the Instance resource"
+	errModifyMetadataOptions    = "failed to modify the metadata options of the Instance resource"
+	errStopInstance             = "failed to stop the Instance resource"
+	errStartInstance            = "failed to start the Instance resource"
+	errRequiresStop             = "the Instance resource must be stopped to change its instance type, EBS optimization, kernel, RAM disk or user data, set updateStrategy to StopStart to allow it"
 )
 
 // SetupInstance adds a controller that reconciles Instances.
@@ -142,6 +146,66 @@ func (e *external) Observe(ctx context.Context, mgd resource.Managed) (managed.E
 	// update the CRD spec for any new values from provider
 	current := cr.Spec.ForProvider.DeepCopy()
 
+	o, err := e.describeAttributes(ctx, meta.GetExternalName(cr))
+	if err != nil {
+		return managed.ExternalObservation{}, err
+	}
+
+	ec2.LateInitializeInstance(&cr.Spec.ForProvider, &observed, &o)
+
+	if !cmp.Equal(current, &cr.Spec.ForProvider) {
+		if err := e.kube.Update(ctx, cr); err != nil {
+			return managed.ExternalObservation{}, errors.Wrap(err, errKubeUpdateFailed)
+		}
+	}
+
+	observation := ec2.GenerateInstanceObservation(observed)
+	condition := ec2.GenerateInstanceCondition(observation)
+
+	// An instance that is stopped or started by a disruptive update is not
+	// being deleted or created.
+	disruptive := cr.GetCondition(svcapitypes.TypeDisruptiveUpdate)
+	updating := disruptive.Reason == svcapitypes.ReasonStopping || disruptive.Reason == svcapitypes.ReasonStarting
+
+	switch condition {
+	case ec2.Creating:
+		cr.SetConditions(xpv1.Creating())
+		if updating {
+			cr.SetConditions(xpv1.Unavailable())
+		}
+	case ec2.Available:
+		cr.SetConditions(xpv1.Available())
+		if disruptive.Reason != "" && !ec2.IsInstanceStopRequired(cr.Spec.ForProvider, o) {
+			cr.SetConditions(svcapitypes.Updated())
+			updating = false
+		}
+	case ec2.Deleting:
+		cr.SetConditions(xpv1.Deleting())
+		if updating {
+			cr.SetConditions(xpv1.Unavailable())
+		}
+	case ec2.Deleted:
+		// Terminated instances remain visible on API calls for a time before
+		// being automatically deleted. Rather than having the delete command
+		// hang for that entire time, return an empty ExternalObservation in
+		// this case.
+		//
+		// ref: (https://docs.aws.amazon.com/AWSEC2/latest/UserGuide/terminating-instances.html)
+		return managed.ExternalObservation{}, nil
+	}
+
+	cr.Status.AtProvider = observation
+
+	return managed.ExternalObservation{
+		ResourceExists:          true,
+		ResourceUpToDate:        ec2.IsInstanceUpToDate(cr.Spec.ForProvider, observed, o) && !updating,
+		ResourceLateInitialized: !cmp.Equal(current, &cr.Spec.ForProvider),
+	}, nil
+}
+
+// describeAttributes returns the attributes of the instance with the given id
+// merged into a single output.
+func (e *external) describeAttributes(ctx context.Context, id string) (awsec2.DescribeInstanceAttributeOutput, error) {
 	o := awsec2.DescribeInstanceAttributeOutput{}
 
 	for _, input := range []types.InstanceAttributeName{
@@ -154,12 +218,12 @@ func (e *external) Observe(ctx context.Context, mgd resource.Managed) (managed.E
 		types.InstanceAttributeNameUserData,
 	} {
 		r, err := e.client.DescribeInstanceAttribute(ctx, &awsec2.DescribeInstanceAttributeInput{
-			InstanceId: aws.String(meta.GetExternalName(cr)),
+			InstanceId: aws.String(id),
 			Attribute:  input,
 		})
 
 		if err != nil {
-			return managed.ExternalObservation{}, awsclient.Wrap(err, errDescribe)
+			return o, awsclient.Wrap(err, errDescribe)
 		}
 
 		if r.DisableApiTermination != nil {
@@ -190,42 +254,7 @@ func (e *external) Observe(ctx context.Context, mgd resource.Managed) (managed.E
 			o.UserData = r.UserData
 		}
 	}
-
-	ec2.LateInitializeInstance(&cr.Spec.ForProvider, &observed, &o)
-
-	if !cmp.Equal(current, &cr.Spec.ForProvider) {
-		if err := e.kube.Update(ctx, cr); err != nil {
-			return managed.ExternalObservation{}, errors.Wrap(err, errKubeUpdateFailed)
-		}
-	}
-
-	observation := ec2.GenerateInstanceObservation(observed)
-	condition := ec2.GenerateInstanceCondition(observation)
-
-	switch condition {
-	case ec2.Creating:
-		cr.SetConditions(xpv1.Creating())
-	case ec2.Available:
-		cr.SetConditions(xpv1.Available())
-	case ec2.Deleting:
-		cr.SetConditions(xpv1.Deleting())
-	case ec2.Deleted:
-		// Terminated instances remain visible on API calls for a time before
-		// being automatically deleted. Rather than having the delete command
-		// hang for that entire time, return an empty ExternalObservation in
-		// this case.
-		//
-		// ref: (https://docs.aws.amazon.com/AWSEC2/latest/UserGuide/terminating-instances.html)
-		return managed.ExternalObservation{}, nil
-	}
-
-	cr.Status.AtProvider = observation
-
-	return managed.ExternalObservation{
-		ResourceExists:          true,
-		ResourceUpToDate:        ec2.IsInstanceUpToDate(cr.Spec.ForProvider, observed, o),
-		ResourceLateInitialized: !cmp.Equal(current, &cr.Spec.ForProvider),
-	}, nil
+	return o, nil
 }
 
 func (e *external) Create(ctx context.Context, mgd resource.Managed) (managed.ExternalCreation, error) {
@@ -261,82 +290,105 @@ func (e *external) Update(ctx context.Context, mgd resource.Managed) (managed.Ex
 		return managed.ExternalUpdate{}, errors.New(errUnexpectedObject)
 	}
 
-	if cr.Spec.ForProvider.DisableAPITermination != nil {
-		modifyInput := &awsec2.ModifyInstanceAttributeInput{
-			InstanceId: aws.String(meta.GetExternalName(cr)),
-			DisableApiTermination: &types.AttributeBooleanValue{
-				Value: cr.Spec.ForProvider.DisableAPITermination,
-			},
-		}
-		_, err := e.client.ModifyInstanceAttribute(ctx, modifyInput)
+	o, err := e.describeAttributes(ctx, meta.GetExternalName(cr))
+	if err != nil {
+		return managed.ExternalUpdate{}, err
+	}
 
-		if err != nil {
+	for _, input := range ec2.GenerateInstanceAttributeInputs(meta.GetExternalName(cr), cr.Spec.ForProvider, o) {
+		if _, err := e.client.ModifyInstanceAttribute(ctx, input); err != nil {
 			return managed.ExternalUpdate{}, awsclient.Wrap(err, errModifyInstanceAttributes)
 		}
 	}
 
-	if cr.Spec.ForProvider.InstanceInitiatedShutdownBehavior != "" {
+	if len(cr.Spec.ForProvider.SecurityGroupIDs) > 0 && !svcapitypes.CompareGroupIDs(cr.Spec.ForProvider.SecurityGroupIDs, generateGroupIdentifiers(cr.Status.AtProvider.SecurityGroups)) {
 		modifyInput := &awsec2.ModifyInstanceAttributeInput{
 			InstanceId: aws.String(meta.GetExternalName(cr)),
-			InstanceInitiatedShutdownBehavior: &types.AttributeValue{
-				Value: aws.String(cr.Spec.ForProvider.InstanceInitiatedShutdownBehavior),
-			},
+			Groups:     cr.Spec.ForProvider.SecurityGroupIDs,
 		}
-		_, err := e.client.ModifyInstanceAttribute(ctx, modifyInput)
-
-		if err != nil {
+		if _, err := e.client.ModifyInstanceAttribute(ctx, modifyInput); err != nil {
 			return managed.ExternalUpdate{}, awsclient.Wrap(err, errModifyInstanceAttributes)
 		}
 	}
 
-	if cr.Spec.ForProvider.KernelID != nil {
-		modifyInput := &awsec2.ModifyInstanceAttributeInput{
-			InstanceId: aws.String(meta.GetExternalName(cr)),
-			Kernel: &types.AttributeValue{
-				Value: cr.Spec.ForProvider.KernelID,
-			},
+	if opts := cr.Spec.ForProvider.MetadataOptions; !ec2.IsInstanceMetadataOptionsUpToDate(opts, cr.Status.AtProvider.MetadataOptions) {
+		modifyInput := &awsec2.ModifyInstanceMetadataOptionsInput{
+			InstanceId:              aws.String(meta.GetExternalName(cr)),
+			HttpEndpoint:            types.InstanceMetadataEndpointState(opts.HTTPEndpoint),
+			HttpPutResponseHopLimit: opts.HTTPPutResponseHopLimit,
+			HttpTokens:              types.HttpTokensState(opts.HTTPTokens),
 		}
-		_, err := e.client.ModifyInstanceAttribute(ctx, modifyInput)
+		_, err := e.client.ModifyInstanceMetadataOptions(ctx, modifyInput)
 
 		if err != nil {
-			return managed.ExternalUpdate{}, awsclient.Wrap(err, errModifyInstanceAttributes)
+			return managed.ExternalUpdate{}, awsclient.Wrap(err, errModifyMetadataOptions)
 		}
 	}
 
-	if cr.Spec.ForProvider.RAMDiskID != nil {
-		modifyInput := &awsec2.ModifyInstanceAttributeInput{
-			InstanceId: aws.String(meta.GetExternalName(cr)),
-			Ramdisk: &types.AttributeValue{
-				Value: cr.Spec.ForProvider.RAMDiskID,
-			},
-		}
-		_, err := e.client.ModifyInstanceAttribute(ctx, modifyInput)
-
-		if err != nil {
-			return managed.ExternalUpdate{}, awsclient.Wrap(err, errModifyInstanceAttributes)
-		}
+	if _, err := e.client.CreateTags(ctx, &awsec2.CreateTagsInput{
+		Resources: []string{meta.GetExternalName(cr)},
+		Tags:      svcapitypes.GenerateEC2Tags(cr.Spec.ForProvider.Tags),
+	}); err != nil {
+		return managed.ExternalUpdate{}, awsclient.Wrap(err, errUpdate)
 	}
 
-	if cr.Spec.ForProvider.UserData != nil {
-		modifyInput := &awsec2.ModifyInstanceAttributeInput{
-			InstanceId: aws.String(meta.GetExternalName(cr)),
-			UserData: &types.BlobAttributeValue{
-				Value: []byte(*cr.Spec.ForProvider.UserData),
-			},
-		}
-		_, err := e.client.ModifyInstanceAttribute(ctx, modifyInput)
+	return managed.ExternalUpdate{}, e.updateStopped(ctx, cr, o)
+}
 
-		if err != nil {
-			return managed.ExternalUpdate{}, awsclient.Wrap(err, errModifyInstanceAttributes)
+// updateStopped applies the changes that require the instance to be stopped.
+// Depending on the state of the instance it stops the instance, modifies the
+// attributes that differ from the observed ones, or starts it again. Each step
+// is taken in its own reconciliation and tracked by the DisruptiveUpdate
+// condition.
+func (e *external) updateStopped(ctx context.Context, cr *svcapitypes.Instance, o awsec2.DescribeInstanceAttributeOutput) error {
+	inputs := ec2.GenerateStoppedInstanceAttributeInputs(meta.GetExternalName(cr), cr.Spec.ForProvider, o)
+	reason := cr.GetCondition(svcapitypes.TypeDisruptiveUpdate).Reason
+	updating := reason == svcapitypes.ReasonStopping || reason == svcapitypes.ReasonStarting
+
+	switch types.InstanceStateName(cr.Status.AtProvider.State) {
+	case types.InstanceStateNameStopped:
+		for _, input := range inputs {
+			if _, err := e.client.ModifyInstanceAttribute(ctx, input); err != nil {
+				return awsclient.Wrap(err, errModifyInstanceAttributes)
+			}
+		}
+		// Only instances that were stopped by a disruptive update are
+		// started again.
+		if updating {
+			if _, err := e.client.StartInstances(ctx, &awsec2.StartInstancesInput{
+				InstanceIds: []string{meta.GetExternalName(cr)},
+			}); err != nil {
+				return awsclient.Wrap(err, errStartInstance)
+			}
+			cr.SetConditions(svcapitypes.Starting())
+		}
+	case types.InstanceStateNameRunning:
+		if len(inputs) == 0 {
+			return nil
+		}
+		if awsclient.StringValue(cr.Spec.ForProvider.UpdateStrategy) != svcapitypes.InstanceUpdateStrategyStopStart {
+			cr.SetConditions(svcapitypes.RequiresStop())
+			return errors.New(errRequiresStop)
+		}
+		if _, err := e.client.StopInstances(ctx, &awsec2.StopInstancesInput{
+			InstanceIds: []string{meta.GetExternalName(cr)},
+		}); err != nil {
+			return awsclient.Wrap(err, errStopInstance)
 		}
+		cr.SetConditions(svcapitypes.Stopping())
 	}
+	// Pending and stopping instances are waited for.
+	return nil
+}
 
-	_, err := e.client.CreateTags(ctx, &awsec2.CreateTagsInput{
-		Resources: []string{meta.GetExternalName(cr)},
-		Tags:      svcapitypes.GenerateEC2Tags(cr.Spec.ForProvider.Tags),
-	})
-
-	return managed.ExternalUpdate{}, awsclient.Wrap(err, errUpdate)
+// generateGroupIdentifiers converts the observed security groups into their
+// EC2 representation.
+func generateGroupIdentifiers(groups []svcapitypes.GroupIdentifier) []types.GroupIdentifier {
+	res := make([]types.GroupIdentifier, len(groups))
+	for i, g := range groups {
+		res[i] = types.GroupIdentifier{GroupId: aws.String(g.GroupID), GroupName: aws.String(g.GroupName)}
+	}
+	return res
 }
 
 func (e *external) Delete(ctx context.Context, mgd resource.Managed) error {
@@ -367,8 +419,8 @@ func (t *tagger) Initialize(ctx context.Context, mgd resource.Managed) error {
 	for _, t := range cr.Spec.ForProvider.Tags {
 		tagMap[t.Key] = t.Value
 	}
-	for k, v := range resource.GetExternalTags(mgd) {
-		tagMap[k] = v
+	if err := awsclient.AddDefaultTags(ctx, t.kube, mgd, tagMap); err != nil {
+		return err
 	}
 	cr.Spec.ForProvider.Tags = make([]svcapitypes.Tag, len(tagMap))
 	i := 0
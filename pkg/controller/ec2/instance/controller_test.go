@@ -89,6 +89,12 @@ func instance(m ...instanceModifier) *manualv1alpha1.Instance {
 	return cr
 }
 
+func describeAttributes(o awsec2.DescribeInstanceAttributeOutput) func(context.Context, *awsec2.DescribeInstanceAttributeInput, []func(*awsec2.Options)) (*awsec2.DescribeInstanceAttributeOutput, error) {
+	return func(context.Context, *awsec2.DescribeInstanceAttributeInput, []func(*awsec2.Options)) (*awsec2.DescribeInstanceAttributeOutput, error) {
+		return &o, nil
+	}
+}
+
 var _ managed.ExternalClient = &external{}
 var _ managed.ExternalConnecter = &connector{}
 
@@ -152,6 +158,104 @@ func TestObserve(t *testing.T) {
 				},
 			},
 		},
+		"DisruptiveUpdateStopped": {
+			args: args{
+				kube: &test.MockClient{
+					MockUpdate: test.NewMockClient().Update,
+				},
+				instance: &fake.MockInstanceClient{
+					MockDescribeInstances: func(ctx context.Context, input *awsec2.DescribeInstancesInput, opts []func(*awsec2.Options)) (*awsec2.DescribeInstancesOutput, error) {
+						return &awsec2.DescribeInstancesOutput{
+							Reservations: []types.Reservation{{
+								Instances: []types.Instance{
+									{
+										InstanceId:   &instanceID,
+										InstanceType: types.InstanceTypeM1Small,
+										State: &types.InstanceState{
+											Name: types.InstanceStateNameStopped,
+										},
+									},
+								},
+							}},
+						}, nil
+					},
+					MockDescribeInstanceAttribute: func(ctx context.Context, input *awsec2.DescribeInstanceAttributeInput, opts []func(*awsec2.Options)) (*awsec2.DescribeInstanceAttributeOutput, error) {
+						return &awsec2.DescribeInstanceAttributeOutput{
+							InstanceId: &instanceID,
+							InstanceType: &types.AttributeValue{
+								Value: aws.String(string(types.InstanceTypeM1Small)),
+							},
+						}, nil
+					},
+				},
+				cr: instance(withSpec(manualv1alpha1.InstanceParameters{
+					InstanceType: string(types.InstanceTypeM1Small),
+				}), withExternalName(instanceID), withConditions(manualv1alpha1.Starting())),
+			},
+			want: want{
+				cr: instance(withSpec(manualv1alpha1.InstanceParameters{
+					InstanceType: string(types.InstanceTypeM1Small),
+				}), withStatus(manualv1alpha1.InstanceObservation{
+					InstanceID:   &instanceID,
+					InstanceType: string(types.InstanceTypeM1Small),
+					State:        "stopped",
+				}), withExternalName(instanceID),
+					withConditions(xpv1.Unavailable(), manualv1alpha1.Starting())),
+				result: managed.ExternalObservation{
+					ResourceExists:   true,
+					ResourceUpToDate: false,
+				},
+			},
+		},
+		"DisruptiveUpdateCompleted": {
+			args: args{
+				kube: &test.MockClient{
+					MockUpdate: test.NewMockClient().Update,
+				},
+				instance: &fake.MockInstanceClient{
+					MockDescribeInstances: func(ctx context.Context, input *awsec2.DescribeInstancesInput, opts []func(*awsec2.Options)) (*awsec2.DescribeInstancesOutput, error) {
+						return &awsec2.DescribeInstancesOutput{
+							Reservations: []types.Reservation{{
+								Instances: []types.Instance{
+									{
+										InstanceId:   &instanceID,
+										InstanceType: types.InstanceTypeM1Small,
+										State: &types.InstanceState{
+											Name: types.InstanceStateNameRunning,
+										},
+									},
+								},
+							}},
+						}, nil
+					},
+					MockDescribeInstanceAttribute: func(ctx context.Context, input *awsec2.DescribeInstanceAttributeInput, opts []func(*awsec2.Options)) (*awsec2.DescribeInstanceAttributeOutput, error) {
+						return &awsec2.DescribeInstanceAttributeOutput{
+							InstanceId: &instanceID,
+							InstanceType: &types.AttributeValue{
+								Value: aws.String(string(types.InstanceTypeM1Small)),
+							},
+						}, nil
+					},
+				},
+				cr: instance(withSpec(manualv1alpha1.InstanceParameters{
+					InstanceType: string(types.InstanceTypeM1Small),
+				}), withExternalName(instanceID), withConditions(manualv1alpha1.Starting())),
+			},
+			want: want{
+				cr: instance(withSpec(manualv1alpha1.InstanceParameters{
+					InstanceType: string(types.InstanceTypeM1Small),
+				}), withStatus(manualv1alpha1.InstanceObservation{
+					InstanceID:   &instanceID,
+					InstanceType: string(types.InstanceTypeM1Small),
+					State:        "running",
+				}), withExternalName(instanceID),
+					withConditions(xpv1.Available(), manualv1alpha1.Updated())),
+				result: managed.ExternalObservation{
+					ResourceExists:   true,
+					ResourceUpToDate: true,
+				},
+			},
+		},
 		"MultipleInstances": {
 			args: args{
 				kube: &test.MockClient{
@@ -371,6 +475,7 @@ func TestUpdate(t *testing.T) {
 		"Successful": {
 			args: args{
 				instance: &fake.MockInstanceClient{
+					MockDescribeInstanceAttribute: describeAttributes(awsec2.DescribeInstanceAttributeOutput{}),
 					MockCreateTags: func(ctx context.Context, input *awsec2.CreateTagsInput, opts []func(*awsec2.Options)) (*awsec2.CreateTagsOutput, error) {
 						return &awsec2.CreateTagsOutput{}, nil
 					},
@@ -387,6 +492,7 @@ func TestUpdate(t *testing.T) {
 		"ModifyFailed": {
 			args: args{
 				instance: &fake.MockInstanceClient{
+					MockDescribeInstanceAttribute: describeAttributes(awsec2.DescribeInstanceAttributeOutput{}),
 					MockCreateTags: func(ctx context.Context, input *awsec2.CreateTagsInput, opts []func(*awsec2.Options)) (*awsec2.CreateTagsOutput, error) {
 						return &awsec2.CreateTagsOutput{}, errBoom
 					},
@@ -401,6 +507,234 @@ func TestUpdate(t *testing.T) {
 				err: awsclient.Wrap(errBoom, errUpdate),
 			},
 		},
+		"ModifyMetadataOptions": {
+			args: args{
+				instance: &fake.MockInstanceClient{
+					MockDescribeInstanceAttribute: describeAttributes(awsec2.DescribeInstanceAttributeOutput{}),
+					MockCreateTags: func(ctx context.Context, input *awsec2.CreateTagsInput, opts []func(*awsec2.Options)) (*awsec2.CreateTagsOutput, error) {
+						return &awsec2.CreateTagsOutput{}, nil
+					},
+					MockModifyInstanceMetadataOptions: func(ctx context.Context, input *awsec2.ModifyInstanceMetadataOptionsInput, opts []func(*awsec2.Options)) (*awsec2.ModifyInstanceMetadataOptionsOutput, error) {
+						if diff := cmp.Diff(types.HttpTokensStateRequired, input.HttpTokens); diff != "" {
+							t.Errorf("r: -want, +got:\n%s", diff)
+						}
+						return &awsec2.ModifyInstanceMetadataOptionsOutput{}, nil
+					},
+				},
+				cr: instance(
+					withSpec(manualv1alpha1.InstanceParameters{MetadataOptions: &manualv1alpha1.InstanceMetadataOptionsRequest{HTTPTokens: "required"}}),
+					withStatus(manualv1alpha1.InstanceObservation{MetadataOptions: &manualv1alpha1.InstanceMetadataOptionsRequest{HTTPTokens: "optional"}}),
+				),
+			},
+			want: want{
+				cr: instance(
+					withSpec(manualv1alpha1.InstanceParameters{MetadataOptions: &manualv1alpha1.InstanceMetadataOptionsRequest{HTTPTokens: "required"}}),
+					withStatus(manualv1alpha1.InstanceObservation{MetadataOptions: &manualv1alpha1.InstanceMetadataOptionsRequest{HTTPTokens: "optional"}}),
+				),
+			},
+		},
+		"RequiresStop": {
+			args: args{
+				instance: &fake.MockInstanceClient{
+					MockDescribeInstanceAttribute: describeAttributes(awsec2.DescribeInstanceAttributeOutput{InstanceType: &types.AttributeValue{Value: aws.String("m5.xlarge")}}),
+					MockCreateTags: func(ctx context.Context, input *awsec2.CreateTagsInput, opts []func(*awsec2.Options)) (*awsec2.CreateTagsOutput, error) {
+						return &awsec2.CreateTagsOutput{}, nil
+					},
+				},
+				cr: instance(
+					withSpec(manualv1alpha1.InstanceParameters{InstanceType: "m5.large"}),
+					withStatus(manualv1alpha1.InstanceObservation{InstanceType: "m5.xlarge", State: "running"}),
+				),
+			},
+			want: want{
+				cr: instance(
+					withSpec(manualv1alpha1.InstanceParameters{InstanceType: "m5.large"}),
+					withStatus(manualv1alpha1.InstanceObservation{InstanceType: "m5.xlarge", State: "running"}),
+					withConditions(manualv1alpha1.RequiresStop()),
+				),
+				err: errors.New(errRequiresStop),
+			},
+		},
+		"Stop": {
+			args: args{
+				instance: &fake.MockInstanceClient{
+					MockDescribeInstanceAttribute: describeAttributes(awsec2.DescribeInstanceAttributeOutput{InstanceType: &types.AttributeValue{Value: aws.String("m5.xlarge")}}),
+					MockCreateTags: func(ctx context.Context, input *awsec2.CreateTagsInput, opts []func(*awsec2.Options)) (*awsec2.CreateTagsOutput, error) {
+						return &awsec2.CreateTagsOutput{}, nil
+					},
+					MockStopInstances: func(ctx context.Context, input *awsec2.StopInstancesInput, opts []func(*awsec2.Options)) (*awsec2.StopInstancesOutput, error) {
+						return &awsec2.StopInstancesOutput{}, nil
+					},
+				},
+				cr: instance(
+					withSpec(manualv1alpha1.InstanceParameters{InstanceType: "m5.large", UpdateStrategy: aws.String(manualv1alpha1.InstanceUpdateStrategyStopStart)}),
+					withStatus(manualv1alpha1.InstanceObservation{InstanceType: "m5.xlarge", State: "running"}),
+				),
+			},
+			want: want{
+				cr: instance(
+					withSpec(manualv1alpha1.InstanceParameters{InstanceType: "m5.large", UpdateStrategy: aws.String(manualv1alpha1.InstanceUpdateStrategyStopStart)}),
+					withStatus(manualv1alpha1.InstanceObservation{InstanceType: "m5.xlarge", State: "running"}),
+					withConditions(manualv1alpha1.Stopping()),
+				),
+			},
+		},
+		"StopFailed": {
+			args: args{
+				instance: &fake.MockInstanceClient{
+					MockDescribeInstanceAttribute: describeAttributes(awsec2.DescribeInstanceAttributeOutput{InstanceType: &types.AttributeValue{Value: aws.String("m5.xlarge")}}),
+					MockCreateTags: func(ctx context.Context, input *awsec2.CreateTagsInput, opts []func(*awsec2.Options)) (*awsec2.CreateTagsOutput, error) {
+						return &awsec2.CreateTagsOutput{}, nil
+					},
+					MockStopInstances: func(ctx context.Context, input *awsec2.StopInstancesInput, opts []func(*awsec2.Options)) (*awsec2.StopInstancesOutput, error) {
+						return nil, errBoom
+					},
+				},
+				cr: instance(
+					withSpec(manualv1alpha1.InstanceParameters{InstanceType: "m5.large", UpdateStrategy: aws.String(manualv1alpha1.InstanceUpdateStrategyStopStart)}),
+					withStatus(manualv1alpha1.InstanceObservation{InstanceType: "m5.xlarge", State: "running"}),
+				),
+			},
+			want: want{
+				cr: instance(
+					withSpec(manualv1alpha1.InstanceParameters{InstanceType: "m5.large", UpdateStrategy: aws.String(manualv1alpha1.InstanceUpdateStrategyStopStart)}),
+					withStatus(manualv1alpha1.InstanceObservation{InstanceType: "m5.xlarge", State: "running"}),
+				),
+				err: awsclient.Wrap(errBoom, errStopInstance),
+			},
+		},
+		"ModifyAndStart": {
+			args: args{
+				instance: &fake.MockInstanceClient{
+					MockDescribeInstanceAttribute: describeAttributes(awsec2.DescribeInstanceAttributeOutput{InstanceType: &types.AttributeValue{Value: aws.String("m5.xlarge")}}),
+					MockCreateTags: func(ctx context.Context, input *awsec2.CreateTagsInput, opts []func(*awsec2.Options)) (*awsec2.CreateTagsOutput, error) {
+						return &awsec2.CreateTagsOutput{}, nil
+					},
+					MockModifyInstanceAttribute: func(ctx context.Context, input *awsec2.ModifyInstanceAttributeInput, opts []func(*awsec2.Options)) (*awsec2.ModifyInstanceAttributeOutput, error) {
+						if diff := cmp.Diff(&types.AttributeValue{Value: aws.String("m5.large")}, input.InstanceType, cmpopts.IgnoreUnexported(types.AttributeValue{})); diff != "" {
+							t.Errorf("r: -want, +got:\n%s", diff)
+						}
+						return &awsec2.ModifyInstanceAttributeOutput{}, nil
+					},
+					MockStartInstances: func(ctx context.Context, input *awsec2.StartInstancesInput, opts []func(*awsec2.Options)) (*awsec2.StartInstancesOutput, error) {
+						return &awsec2.StartInstancesOutput{}, nil
+					},
+				},
+				cr: instance(
+					withSpec(manualv1alpha1.InstanceParameters{InstanceType: "m5.large", UpdateStrategy: aws.String(manualv1alpha1.InstanceUpdateStrategyStopStart)}),
+					withStatus(manualv1alpha1.InstanceObservation{InstanceType: "m5.xlarge", State: "stopped"}),
+					withConditions(manualv1alpha1.Stopping()),
+				),
+			},
+			want: want{
+				cr: instance(
+					withSpec(manualv1alpha1.InstanceParameters{InstanceType: "m5.large", UpdateStrategy: aws.String(manualv1alpha1.InstanceUpdateStrategyStopStart)}),
+					withStatus(manualv1alpha1.InstanceObservation{InstanceType: "m5.xlarge", State: "stopped"}),
+					withConditions(manualv1alpha1.Starting()),
+				),
+			},
+		},
+		"DescribeAttributeFailed": {
+			args: args{
+				instance: &fake.MockInstanceClient{
+					MockDescribeInstanceAttribute: func(ctx context.Context, input *awsec2.DescribeInstanceAttributeInput, opts []func(*awsec2.Options)) (*awsec2.DescribeInstanceAttributeOutput, error) {
+						return nil, errBoom
+					},
+				},
+				cr: instance(withSpec(manualv1alpha1.InstanceParameters{})),
+			},
+			want: want{
+				cr:  instance(withSpec(manualv1alpha1.InstanceParameters{})),
+				err: awsclient.Wrap(errBoom, errDescribe),
+			},
+		},
+		"StopWithUnchangedUserData": {
+			args: args{
+				instance: &fake.MockInstanceClient{
+					MockDescribeInstanceAttribute: describeAttributes(awsec2.DescribeInstanceAttributeOutput{
+						InstanceType: &types.AttributeValue{Value: aws.String("m5.xlarge")},
+						UserData:     &types.AttributeValue{Value: aws.String("dGVzdA==")},
+					}),
+					MockModifyInstanceAttribute: func(ctx context.Context, input *awsec2.ModifyInstanceAttributeInput, opts []func(*awsec2.Options)) (*awsec2.ModifyInstanceAttributeOutput, error) {
+						t.Errorf("unexpected ModifyInstanceAttribute call on a running instance")
+						return &awsec2.ModifyInstanceAttributeOutput{}, nil
+					},
+					MockCreateTags: func(ctx context.Context, input *awsec2.CreateTagsInput, opts []func(*awsec2.Options)) (*awsec2.CreateTagsOutput, error) {
+						return &awsec2.CreateTagsOutput{}, nil
+					},
+					MockStopInstances: func(ctx context.Context, input *awsec2.StopInstancesInput, opts []func(*awsec2.Options)) (*awsec2.StopInstancesOutput, error) {
+						return &awsec2.StopInstancesOutput{}, nil
+					},
+				},
+				cr: instance(
+					withSpec(manualv1alpha1.InstanceParameters{InstanceType: "m5.large", UserData: aws.String("dGVzdA=="), UpdateStrategy: aws.String(manualv1alpha1.InstanceUpdateStrategyStopStart)}),
+					withStatus(manualv1alpha1.InstanceObservation{InstanceType: "m5.xlarge", State: "running"}),
+				),
+			},
+			want: want{
+				cr: instance(
+					withSpec(manualv1alpha1.InstanceParameters{InstanceType: "m5.large", UserData: aws.String("dGVzdA=="), UpdateStrategy: aws.String(manualv1alpha1.InstanceUpdateStrategyStopStart)}),
+					withStatus(manualv1alpha1.InstanceObservation{InstanceType: "m5.xlarge", State: "running"}),
+					withConditions(manualv1alpha1.Stopping()),
+				),
+			},
+		},
+		"ModifyUserDataWhenStopped": {
+			args: args{
+				instance: &fake.MockInstanceClient{
+					MockDescribeInstanceAttribute: describeAttributes(awsec2.DescribeInstanceAttributeOutput{
+						InstanceType: &types.AttributeValue{Value: aws.String("m5.large")},
+						UserData:     &types.AttributeValue{Value: aws.String("b3RoZXI=")},
+					}),
+					MockModifyInstanceAttribute: func(ctx context.Context, input *awsec2.ModifyInstanceAttributeInput, opts []func(*awsec2.Options)) (*awsec2.ModifyInstanceAttributeOutput, error) {
+						if diff := cmp.Diff(&types.BlobAttributeValue{Value: []byte("dGVzdA==")}, input.UserData, cmpopts.IgnoreUnexported(types.BlobAttributeValue{})); diff != "" {
+							t.Errorf("r: -want, +got:\n%s", diff)
+						}
+						return &awsec2.ModifyInstanceAttributeOutput{}, nil
+					},
+					MockCreateTags: func(ctx context.Context, input *awsec2.CreateTagsInput, opts []func(*awsec2.Options)) (*awsec2.CreateTagsOutput, error) {
+						return &awsec2.CreateTagsOutput{}, nil
+					},
+					MockStartInstances: func(ctx context.Context, input *awsec2.StartInstancesInput, opts []func(*awsec2.Options)) (*awsec2.StartInstancesOutput, error) {
+						return &awsec2.StartInstancesOutput{}, nil
+					},
+				},
+				cr: instance(
+					withSpec(manualv1alpha1.InstanceParameters{InstanceType: "m5.large", UserData: aws.String("dGVzdA=="), UpdateStrategy: aws.String(manualv1alpha1.InstanceUpdateStrategyStopStart)}),
+					withStatus(manualv1alpha1.InstanceObservation{InstanceType: "m5.large", State: "stopped"}),
+					withConditions(manualv1alpha1.Stopping()),
+				),
+			},
+			want: want{
+				cr: instance(
+					withSpec(manualv1alpha1.InstanceParameters{InstanceType: "m5.large", UserData: aws.String("dGVzdA=="), UpdateStrategy: aws.String(manualv1alpha1.InstanceUpdateStrategyStopStart)}),
+					withStatus(manualv1alpha1.InstanceObservation{InstanceType: "m5.large", State: "stopped"}),
+					withConditions(manualv1alpha1.Starting()),
+				),
+			},
+		},
+		"Stopping": {
+			args: args{
+				instance: &fake.MockInstanceClient{
+					MockDescribeInstanceAttribute: describeAttributes(awsec2.DescribeInstanceAttributeOutput{InstanceType: &types.AttributeValue{Value: aws.String("m5.xlarge")}}),
+					MockCreateTags: func(ctx context.Context, input *awsec2.CreateTagsInput, opts []func(*awsec2.Options)) (*awsec2.CreateTagsOutput, error) {
+						return &awsec2.CreateTagsOutput{}, nil
+					},
+				},
+				cr: instance(
+					withSpec(manualv1alpha1.InstanceParameters{InstanceType: "m5.large", UpdateStrategy: aws.String(manualv1alpha1.InstanceUpdateStrategyStopStart)}),
+					withStatus(manualv1alpha1.InstanceObservation{InstanceType: "m5.xlarge", State: "stopping"}),
+					withConditions(manualv1alpha1.Stopping()),
+				),
+			},
+			want: want{
+				cr: instance(
+					withSpec(manualv1alpha1.InstanceParameters{InstanceType: "m5.large", UpdateStrategy: aws.String(manualv1alpha1.InstanceUpdateStrategyStopStart)}),
+					withStatus(manualv1alpha1.InstanceObservation{InstanceType: "m5.xlarge", State: "stopping"}),
+					withConditions(manualv1alpha1.Stopping()),
+				),
+			},
+		},
 	}
 
 	for name, tc := range cases {
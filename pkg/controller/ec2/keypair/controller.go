@@ -0,0 +1,268 @@
+/*
+Copyright 2023 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package keypair
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	awsec2 "github.com/aws/aws-sdk-go-v2/service/ec2"
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/types"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/connection"
+	"github.com/crossplane/crossplane-runtime/pkg/controller"
+	"github.com/crossplane/crossplane-runtime/pkg/event"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+
+	"github.com/crossplane-contrib/provider-aws/apis/ec2/manualv1alpha1"
+	"github.com/crossplane-contrib/provider-aws/apis/v1alpha1"
+	awsclient "github.com/crossplane-contrib/provider-aws/pkg/clients"
+	"github.com/crossplane-contrib/provider-aws/pkg/clients/ec2"
+	"github.com/crossplane-contrib/provider-aws/pkg/features"
+)
+
+const (
+	errUnexpectedObject = "The managed resource is not a KeyPair resource"
+	errKubeUpdateFailed = "cannot update KeyPair custom resource"
+
+	errDescribe      = "failed to describe KeyPair"
+	errMultipleItems = "retrieved multiple KeyPairs for the given key name"
+	errCreate        = "failed to create the KeyPair resource"
+	errImport        = "failed to import the KeyPair resource"
+	errDelete        = "failed to delete the KeyPair resource"
+	errGetPublicKey  = "cannot get the public key from the given secret"
+	errNoPublicKey   = "the given secret does not contain a public key"
+	errCreateTags    = "failed to create tags for the KeyPair resource"
+	errDeleteTags    = "failed to delete tags for the KeyPair resource"
+)
+
+const (
+	// connectionKeyNameKey is the key of the name of the key pair in the
+	// connection secret.
+	connectionKeyNameKey = "keyName"
+
+	// connectionPrivateKeyKey is the key of the private key of a created key
+	// pair in the connection secret.
+	connectionPrivateKeyKey = "privateKey"
+)
+
+// SetupKeyPair adds a controller that reconciles KeyPairs.
+func SetupKeyPair(mgr ctrl.Manager, o controller.Options) error {
+	name := managed.ControllerName(manualv1alpha1.KeyPairGroupKind)
+
+	cps := []managed.ConnectionPublisher{managed.NewAPISecretPublisher(mgr.GetClient(), mgr.GetScheme())}
+	if o.Features.Enabled(features.EnableAlphaExternalSecretStores) {
+		cps = append(cps, connection.NewDetailsManager(mgr.GetClient(), v1alpha1.StoreConfigGroupVersionKind))
+	}
+
+	return ctrl.NewControllerManagedBy(mgr).
+		Named(name).
+		WithOptions(o.ForControllerRuntime()).
+		WithEventFilter(resource.DesiredStateChanged()).
+		For(&manualv1alpha1.KeyPair{}).
+		Complete(managed.NewReconciler(mgr,
+			resource.ManagedKind(manualv1alpha1.KeyPairGroupVersionKind),
+			managed.WithExternalConnecter(&connector{kube: mgr.GetClient(), newClientFn: ec2.NewKeyPairClient}),
+			managed.WithReferenceResolver(managed.NewAPISimpleReferenceResolver(mgr.GetClient())),
+			managed.WithInitializers(managed.NewNameAsExternalName(mgr.GetClient())),
+			managed.WithPollInterval(o.PollInterval),
+			managed.WithLogger(o.Logger.WithValues("controller", name)),
+			managed.WithRecorder(event.NewAPIRecorder(mgr.GetEventRecorderFor(name))),
+			managed.WithConnectionPublishers(cps...)))
+}
+
+type connector struct {
+	kube        client.Client
+	newClientFn func(config aws.Config) ec2.KeyPairClient
+}
+
+func (c *connector) Connect(ctx context.Context, mg resource.Managed) (managed.ExternalClient, error) {
+	cr, ok := mg.(*manualv1alpha1.KeyPair)
+	if !ok {
+		return nil, errors.New(errUnexpectedObject)
+	}
+	cfg, err := awsclient.GetConfig(ctx, c.kube, mg, cr.Spec.ForProvider.Region)
+	if err != nil {
+		return nil, err
+	}
+	return &external{client: c.newClientFn(*cfg), kube: c.kube}, nil
+}
+
+type external struct {
+	kube   client.Client
+	client ec2.KeyPairClient
+}
+
+func (e *external) Observe(ctx context.Context, mgd resource.Managed) (managed.ExternalObservation, error) {
+	cr, ok := mgd.(*manualv1alpha1.KeyPair)
+	if !ok {
+		return managed.ExternalObservation{}, errors.New(errUnexpectedObject)
+	}
+	if meta.GetExternalName(cr) == "" {
+		return managed.ExternalObservation{
+			ResourceExists: false,
+		}, nil
+	}
+
+	response, err := e.client.DescribeKeyPairs(ctx, &awsec2.DescribeKeyPairsInput{
+		KeyNames: []string{meta.GetExternalName(cr)},
+	})
+	if err != nil {
+		return managed.ExternalObservation{}, awsclient.Wrap(resource.Ignore(ec2.IsKeyPairNotFoundErr, err), errDescribe)
+	}
+
+	// in a successful response, there should be one and only one object
+	if len(response.KeyPairs) != 1 {
+		return managed.ExternalObservation{}, errors.New(errMultipleItems)
+	}
+
+	observed := response.KeyPairs[0]
+
+	current := cr.Spec.ForProvider.DeepCopy()
+	ec2.LateInitializeKeyPair(&cr.Spec.ForProvider, &observed)
+	if !cmp.Equal(current, &cr.Spec.ForProvider) {
+		if err := e.kube.Update(ctx, cr); err != nil {
+			return managed.ExternalObservation{}, errors.Wrap(err, errKubeUpdateFailed)
+		}
+	}
+
+	cr.Status.AtProvider = ec2.GenerateKeyPairObservation(observed)
+	cr.SetConditions(xpv1.Available())
+
+	return managed.ExternalObservation{
+		ResourceExists:   true,
+		ResourceUpToDate: ec2.IsKeyPairUpToDate(cr.Spec.ForProvider, observed),
+		ConnectionDetails: managed.ConnectionDetails{
+			connectionKeyNameKey: []byte(meta.GetExternalName(cr)),
+		},
+	}, nil
+}
+
+func (e *external) Create(ctx context.Context, mgd resource.Managed) (managed.ExternalCreation, error) {
+	cr, ok := mgd.(*manualv1alpha1.KeyPair)
+	if !ok {
+		return managed.ExternalCreation{}, errors.New(errUnexpectedObject)
+	}
+	name := meta.GetExternalName(cr)
+
+	if ref := cr.Spec.ForProvider.PublicKeySecretRef; ref != nil {
+		publicKey, err := e.getPublicKey(ctx, *ref)
+		if err != nil {
+			return managed.ExternalCreation{}, err
+		}
+		if _, err := e.client.ImportKeyPair(ctx, &awsec2.ImportKeyPairInput{
+			KeyName:           aws.String(name),
+			PublicKeyMaterial: publicKey,
+			TagSpecifications: ec2.GenerateKeyPairTagSpecifications(cr.Spec.ForProvider.Tags),
+		}); err != nil {
+			return managed.ExternalCreation{}, awsclient.Wrap(err, errImport)
+		}
+		return managed.ExternalCreation{
+			ConnectionDetails: managed.ConnectionDetails{
+				connectionKeyNameKey: []byte(name),
+			},
+		}, nil
+	}
+
+	response, err := e.client.CreateKeyPair(ctx, ec2.GenerateCreateKeyPairInput(name, cr.Spec.ForProvider))
+	if err != nil {
+		return managed.ExternalCreation{}, awsclient.Wrap(err, errCreate)
+	}
+
+	// The private key is only returned on creation, so it has to be
+	// published right away.
+	return managed.ExternalCreation{
+		ConnectionDetails: managed.ConnectionDetails{
+			connectionKeyNameKey:    []byte(name),
+			connectionPrivateKeyKey: []byte(aws.ToString(response.KeyMaterial)),
+		},
+	}, nil
+}
+
+func (e *external) Update(ctx context.Context, mgd resource.Managed) (managed.ExternalUpdate, error) {
+	cr, ok := mgd.(*manualv1alpha1.KeyPair)
+	if !ok {
+		return managed.ExternalUpdate{}, errors.New(errUnexpectedObject)
+	}
+
+	response, err := e.client.DescribeKeyPairs(ctx, &awsec2.DescribeKeyPairsInput{
+		KeyNames: []string{meta.GetExternalName(cr)},
+	})
+	if err != nil {
+		return managed.ExternalUpdate{}, awsclient.Wrap(err, errDescribe)
+	}
+	if len(response.KeyPairs) != 1 {
+		return managed.ExternalUpdate{}, errors.New(errMultipleItems)
+	}
+	observed := response.KeyPairs[0]
+
+	// Key pairs are tagged by their ID rather than by their name.
+	addTags, removeTags := awsclient.DiffEC2Tags(manualv1alpha1.GenerateEC2Tags(cr.Spec.ForProvider.Tags), observed.Tags)
+	if len(addTags) > 0 {
+		if _, err := e.client.CreateTags(ctx, &awsec2.CreateTagsInput{
+			Resources: []string{aws.ToString(observed.KeyPairId)},
+			Tags:      addTags,
+		}); err != nil {
+			return managed.ExternalUpdate{}, awsclient.Wrap(err, errCreateTags)
+		}
+	}
+	if len(removeTags) > 0 {
+		if _, err := e.client.DeleteTags(ctx, &awsec2.DeleteTagsInput{
+			Resources: []string{aws.ToString(observed.KeyPairId)},
+			Tags:      removeTags,
+		}); err != nil {
+			return managed.ExternalUpdate{}, awsclient.Wrap(err, errDeleteTags)
+		}
+	}
+
+	return managed.ExternalUpdate{}, nil
+}
+
+func (e *external) Delete(ctx context.Context, mgd resource.Managed) error {
+	cr, ok := mgd.(*manualv1alpha1.KeyPair)
+	if !ok {
+		return errors.New(errUnexpectedObject)
+	}
+
+	cr.Status.SetConditions(xpv1.Deleting())
+
+	_, err := e.client.DeleteKeyPair(ctx, &awsec2.DeleteKeyPairInput{
+		KeyName: aws.String(meta.GetExternalName(cr)),
+	})
+
+	return awsclient.Wrap(resource.Ignore(ec2.IsKeyPairNotFoundErr, err), errDelete)
+}
+
+func (e *external) getPublicKey(ctx context.Context, ref xpv1.SecretKeySelector) ([]byte, error) {
+	s := &corev1.Secret{}
+	if err := e.kube.Get(ctx, types.NamespacedName{Name: ref.Name, Namespace: ref.Namespace}, s); err != nil {
+		return nil, errors.Wrap(err, errGetPublicKey)
+	}
+	publicKey := s.Data[ref.Key]
+	if len(publicKey) == 0 {
+		return nil, errors.New(errNoPublicKey)
+	}
+	return publicKey, nil
+}
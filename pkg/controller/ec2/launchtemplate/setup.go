@@ -112,8 +112,8 @@ func (t *tagger) Initialize(ctx context.Context, mgd resource.Managed) error {
 	for _, t := range launchTemplateTags.Tags {
 		tagMap[aws.StringValue(t.Key)] = aws.StringValue(t.Value)
 	}
-	for k, v := range resource.GetExternalTags(mgd) {
-		tagMap[k] = v
+	if err := aws.AddDefaultTags(ctx, t.kube, mgd, tagMap); err != nil {
+		return err
 	}
 	launchTemplateTags.Tags = make([]*svcapitypes.Tag, len(tagMap))
 	launchTemplateTags.ResourceType = aws.String("launch-template")
@@ -0,0 +1,350 @@
+/*
+Copyright 2023 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package networkacl
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	awsec2 "github.com/aws/aws-sdk-go-v2/service/ec2"
+	awsec2types "github.com/aws/aws-sdk-go-v2/service/ec2/types"
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/connection"
+	"github.com/crossplane/crossplane-runtime/pkg/controller"
+	"github.com/crossplane/crossplane-runtime/pkg/event"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+
+	"github.com/crossplane-contrib/provider-aws/apis/ec2/manualv1alpha1"
+	"github.com/crossplane-contrib/provider-aws/apis/v1alpha1"
+	awsclient "github.com/crossplane-contrib/provider-aws/pkg/clients"
+	"github.com/crossplane-contrib/provider-aws/pkg/clients/ec2"
+	"github.com/crossplane-contrib/provider-aws/pkg/features"
+)
+
+const (
+	errUnexpectedObject = "The managed resource is not a NetworkACL resource"
+
+	errDescribe           = "failed to describe NetworkACL"
+	errMultipleItems      = "retrieved multiple NetworkACLs for the given networkAclId"
+	errCreate             = "failed to create the NetworkACL resource"
+	errDelete             = "failed to delete the NetworkACL resource"
+	errCreateEntry        = "failed to create an entry in the NetworkACL resource"
+	errReplaceEntry       = "failed to replace an entry in the NetworkACL resource"
+	errDeleteEntry        = "failed to delete an entry in the NetworkACL resource"
+	errAssociateSubnet    = "failed to associate subnet to the NetworkACL resource"
+	errDisassociateSubnet = "failed to disassociate subnet from the NetworkACL resource"
+	errDescribeDefault    = "failed to describe the default NetworkACL of the VPC"
+	errNoAssociation      = "cannot find the current network ACL association of the subnet"
+	errCreateTags         = "failed to create tags for the NetworkACL resource"
+	errDeleteTags         = "failed to delete tags for the NetworkACL resource"
+)
+
+// SetupNetworkACL adds a controller that reconciles NetworkACLs.
+func SetupNetworkACL(mgr ctrl.Manager, o controller.Options) error {
+	name := managed.ControllerName(manualv1alpha1.NetworkACLGroupKind)
+
+	cps := []managed.ConnectionPublisher{managed.NewAPISecretPublisher(mgr.GetClient(), mgr.GetScheme())}
+	if o.Features.Enabled(features.EnableAlphaExternalSecretStores) {
+		cps = append(cps, connection.NewDetailsManager(mgr.GetClient(), v1alpha1.StoreConfigGroupVersionKind))
+	}
+
+	return ctrl.NewControllerManagedBy(mgr).
+		Named(name).
+		WithOptions(o.ForControllerRuntime()).
+		WithEventFilter(resource.DesiredStateChanged()).
+		For(&manualv1alpha1.NetworkACL{}).
+		Complete(managed.NewReconciler(mgr,
+			resource.ManagedKind(manualv1alpha1.NetworkACLGroupVersionKind),
+			managed.WithExternalConnecter(&connector{kube: mgr.GetClient(), newClientFn: ec2.NewNetworkACLClient}),
+			managed.WithReferenceResolver(managed.NewAPISimpleReferenceResolver(mgr.GetClient())),
+			managed.WithInitializers(),
+			managed.WithPollInterval(o.PollInterval),
+			managed.WithLogger(o.Logger.WithValues("controller", name)),
+			managed.WithRecorder(event.NewAPIRecorder(mgr.GetEventRecorderFor(name))),
+			managed.WithConnectionPublishers(cps...)))
+}
+
+type connector struct {
+	kube        client.Client
+	newClientFn func(config aws.Config) ec2.NetworkACLClient
+}
+
+func (c *connector) Connect(ctx context.Context, mg resource.Managed) (managed.ExternalClient, error) {
+	cr, ok := mg.(*manualv1alpha1.NetworkACL)
+	if !ok {
+		return nil, errors.New(errUnexpectedObject)
+	}
+	cfg, err := awsclient.GetConfig(ctx, c.kube, mg, cr.Spec.ForProvider.Region)
+	if err != nil {
+		return nil, err
+	}
+	return &external{client: c.newClientFn(*cfg)}, nil
+}
+
+type external struct {
+	client ec2.NetworkACLClient
+}
+
+func (e *external) describe(ctx context.Context, id string) (*awsec2types.NetworkAcl, error) {
+	response, err := e.client.DescribeNetworkAcls(ctx, &awsec2.DescribeNetworkAclsInput{
+		NetworkAclIds: []string{id},
+	})
+	if err != nil {
+		return nil, err
+	}
+	// in a successful response, there should be one and only one object
+	if len(response.NetworkAcls) != 1 {
+		return nil, errors.New(errMultipleItems)
+	}
+	return &response.NetworkAcls[0], nil
+}
+
+func (e *external) Observe(ctx context.Context, mgd resource.Managed) (managed.ExternalObservation, error) {
+	cr, ok := mgd.(*manualv1alpha1.NetworkACL)
+	if !ok {
+		return managed.ExternalObservation{}, errors.New(errUnexpectedObject)
+	}
+	if meta.GetExternalName(cr) == "" {
+		return managed.ExternalObservation{
+			ResourceExists: false,
+		}, nil
+	}
+
+	observed, err := e.describe(ctx, meta.GetExternalName(cr))
+	if err != nil {
+		return managed.ExternalObservation{}, awsclient.Wrap(resource.Ignore(ec2.IsNetworkACLNotFoundErr, err), errDescribe)
+	}
+
+	current := cr.Spec.ForProvider.DeepCopy()
+	ec2.LateInitializeNetworkACL(&cr.Spec.ForProvider, observed)
+
+	cr.Status.AtProvider = ec2.GenerateNetworkACLObservation(*observed)
+	cr.SetConditions(xpv1.Available())
+
+	return managed.ExternalObservation{
+		ResourceExists:          true,
+		ResourceUpToDate:        ec2.IsNetworkACLUpToDate(cr.Spec.ForProvider, *observed),
+		ResourceLateInitialized: !cmp.Equal(current, &cr.Spec.ForProvider),
+	}, nil
+}
+
+func (e *external) Create(ctx context.Context, mgd resource.Managed) (managed.ExternalCreation, error) {
+	cr, ok := mgd.(*manualv1alpha1.NetworkACL)
+	if !ok {
+		return managed.ExternalCreation{}, errors.New(errUnexpectedObject)
+	}
+	input := &awsec2.CreateNetworkAclInput{
+		VpcId: cr.Spec.ForProvider.VPCID,
+	}
+	if len(cr.Spec.ForProvider.Tags) > 0 {
+		input.TagSpecifications = []awsec2types.TagSpecification{{
+			ResourceType: awsec2types.ResourceTypeNetworkAcl,
+			Tags:         manualv1alpha1.GenerateEC2Tags(cr.Spec.ForProvider.Tags),
+		}}
+	}
+	result, err := e.client.CreateNetworkAcl(ctx, input)
+	if err != nil {
+		return managed.ExternalCreation{}, awsclient.Wrap(err, errCreate)
+	}
+	meta.SetExternalName(cr, aws.ToString(result.NetworkAcl.NetworkAclId))
+	return managed.ExternalCreation{}, nil
+}
+
+func (e *external) Update(ctx context.Context, mgd resource.Managed) (managed.ExternalUpdate, error) {
+	cr, ok := mgd.(*manualv1alpha1.NetworkACL)
+	if !ok {
+		return managed.ExternalUpdate{}, errors.New(errUnexpectedObject)
+	}
+	id := meta.GetExternalName(cr)
+
+	acl, err := e.describe(ctx, id)
+	if err != nil {
+		return managed.ExternalUpdate{}, awsclient.Wrap(err, errDescribe)
+	}
+
+	if err := e.updateTags(ctx, id, cr.Spec.ForProvider.Tags, acl.Tags); err != nil {
+		return managed.ExternalUpdate{}, err
+	}
+
+	if err := e.updateEntries(ctx, id, cr.Spec.ForProvider, *acl); err != nil {
+		return managed.ExternalUpdate{}, err
+	}
+
+	associate, disassociate := ec2.DiffNetworkACLAssociations(cr.Spec.ForProvider.Associations, acl.Associations)
+	if err := e.disassociate(ctx, aws.ToString(acl.VpcId), disassociate, acl.Associations); err != nil {
+		return managed.ExternalUpdate{}, err
+	}
+	for _, subnetID := range associate {
+		if err := e.associate(ctx, id, subnetID); err != nil {
+			return managed.ExternalUpdate{}, awsclient.Wrap(err, errAssociateSubnet)
+		}
+	}
+
+	return managed.ExternalUpdate{}, nil
+}
+
+func (e *external) Delete(ctx context.Context, mgd resource.Managed) error {
+	cr, ok := mgd.(*manualv1alpha1.NetworkACL)
+	if !ok {
+		return errors.New(errUnexpectedObject)
+	}
+
+	cr.Status.SetConditions(xpv1.Deleting())
+
+	// the subnets have to be associated with another network ACL before
+	// deleting the network ACL.
+	if len(cr.Status.AtProvider.Associations) > 0 {
+		acl, err := e.describe(ctx, meta.GetExternalName(cr))
+		if err != nil {
+			return awsclient.Wrap(resource.Ignore(ec2.IsNetworkACLNotFoundErr, err), errDescribe)
+		}
+		subnets := make([]string, len(acl.Associations))
+		for i, a := range acl.Associations {
+			subnets[i] = aws.ToString(a.SubnetId)
+		}
+		if err := e.disassociate(ctx, aws.ToString(acl.VpcId), subnets, acl.Associations); err != nil {
+			return err
+		}
+	}
+
+	_, err := e.client.DeleteNetworkAcl(ctx, &awsec2.DeleteNetworkAclInput{
+		NetworkAclId: aws.String(meta.GetExternalName(cr)),
+	})
+
+	return awsclient.Wrap(resource.Ignore(ec2.IsNetworkACLNotFoundErr, err), errDelete)
+}
+
+func (e *external) updateTags(ctx context.Context, id string, desired []manualv1alpha1.Tag, observed []awsec2types.Tag) error {
+	addTags, removeTags := awsclient.DiffEC2Tags(manualv1alpha1.GenerateEC2Tags(desired), observed)
+	if len(addTags) > 0 {
+		if _, err := e.client.CreateTags(ctx, &awsec2.CreateTagsInput{
+			Resources: []string{id},
+			Tags:      addTags,
+		}); err != nil {
+			return awsclient.Wrap(err, errCreateTags)
+		}
+	}
+	if len(removeTags) > 0 {
+		if _, err := e.client.DeleteTags(ctx, &awsec2.DeleteTagsInput{
+			Resources: []string{id},
+			Tags:      removeTags,
+		}); err != nil {
+			return awsclient.Wrap(err, errDeleteTags)
+		}
+	}
+	return nil
+}
+
+func (e *external) updateEntries(ctx context.Context, id string, p manualv1alpha1.NetworkACLParameters, acl awsec2types.NetworkAcl) error {
+	create, replace, remove := ec2.DiffNetworkACL(p, acl)
+
+	for _, entry := range remove {
+		_, err := e.client.DeleteNetworkAclEntry(ctx, &awsec2.DeleteNetworkAclEntryInput{
+			NetworkAclId: aws.String(id),
+			Egress:       entry.Egress,
+			RuleNumber:   entry.RuleNumber,
+		})
+		if resource.Ignore(ec2.IsNetworkACLEntryNotFoundErr, err) != nil {
+			return awsclient.Wrap(err, errDeleteEntry)
+		}
+	}
+	for _, entry := range replace {
+		if _, err := e.client.ReplaceNetworkAclEntry(ctx, ec2.GenerateReplaceNetworkACLEntryInput(id, entry)); err != nil {
+			return awsclient.Wrap(err, errReplaceEntry)
+		}
+	}
+	for _, entry := range create {
+		if _, err := e.client.CreateNetworkAclEntry(ctx, ec2.GenerateCreateNetworkACLEntryInput(id, entry)); err != nil {
+			return awsclient.Wrap(err, errCreateEntry)
+		}
+	}
+	return nil
+}
+
+// associate moves the association of the subnet from its current network ACL
+// to the given one. A subnet is always associated with exactly one network
+// ACL.
+func (e *external) associate(ctx context.Context, id, subnetID string) error {
+	response, err := e.client.DescribeNetworkAcls(ctx, &awsec2.DescribeNetworkAclsInput{
+		Filters: []awsec2types.Filter{{
+			Name:   aws.String("association.subnet-id"),
+			Values: []string{subnetID},
+		}},
+	})
+	if err != nil {
+		return err
+	}
+	associationID := ""
+	for _, acl := range response.NetworkAcls {
+		for _, a := range acl.Associations {
+			if aws.ToString(a.SubnetId) == subnetID {
+				associationID = aws.ToString(a.NetworkAclAssociationId)
+			}
+		}
+	}
+	if associationID == "" {
+		return errors.New(errNoAssociation)
+	}
+	_, err = e.client.ReplaceNetworkAclAssociation(ctx, &awsec2.ReplaceNetworkAclAssociationInput{
+		AssociationId: aws.String(associationID),
+		NetworkAclId:  aws.String(id),
+	})
+	return err
+}
+
+// disassociate moves the associations of the subnets back to the default
+// network ACL of the VPC.
+func (e *external) disassociate(ctx context.Context, vpcID string, subnets []string, observed []awsec2types.NetworkAclAssociation) error {
+	if len(subnets) == 0 {
+		return nil
+	}
+	response, err := e.client.DescribeNetworkAcls(ctx, &awsec2.DescribeNetworkAclsInput{
+		Filters: []awsec2types.Filter{
+			{Name: aws.String("vpc-id"), Values: []string{vpcID}},
+			{Name: aws.String("default"), Values: []string{"true"}},
+		},
+	})
+	if err != nil {
+		return awsclient.Wrap(err, errDescribeDefault)
+	}
+	if len(response.NetworkAcls) != 1 {
+		return errors.New(errDescribeDefault)
+	}
+	defaultID := response.NetworkAcls[0].NetworkAclId
+
+	for _, subnetID := range subnets {
+		for _, a := range observed {
+			if aws.ToString(a.SubnetId) != subnetID {
+				continue
+			}
+			if _, err := e.client.ReplaceNetworkAclAssociation(ctx, &awsec2.ReplaceNetworkAclAssociationInput{
+				AssociationId: a.NetworkAclAssociationId,
+				NetworkAclId:  defaultID,
+			}); err != nil {
+				return awsclient.Wrap(err, errDisassociateSubnet)
+			}
+		}
+	}
+	return nil
+}
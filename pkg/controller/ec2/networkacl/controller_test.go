@@ -0,0 +1,452 @@
+/*
+Copyright 2023 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package networkacl
+
+import (
+	"context"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	awsec2 "github.com/aws/aws-sdk-go-v2/service/ec2"
+	awsec2types "github.com/aws/aws-sdk-go-v2/service/ec2/types"
+	"github.com/aws/smithy-go"
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+
+	"github.com/crossplane-contrib/provider-aws/apis/ec2/manualv1alpha1"
+	awsclient "github.com/crossplane-contrib/provider-aws/pkg/clients"
+	"github.com/crossplane-contrib/provider-aws/pkg/clients/ec2"
+	"github.com/crossplane-contrib/provider-aws/pkg/clients/ec2/fake"
+)
+
+var (
+	aclID         = "some acl"
+	defaultACLID  = "default acl"
+	vpcID         = "some vpc"
+	subnetID      = "some subnet"
+	associationID = "some association"
+	cidr          = "10.0.0.0/16"
+	errBoom       = errors.New("boom")
+)
+
+type args struct {
+	acl ec2.NetworkACLClient
+	cr  *manualv1alpha1.NetworkACL
+}
+
+type aclModifier func(*manualv1alpha1.NetworkACL)
+
+func withExternalName(name string) aclModifier {
+	return func(r *manualv1alpha1.NetworkACL) { meta.SetExternalName(r, name) }
+}
+
+func withSpec(p manualv1alpha1.NetworkACLParameters) aclModifier {
+	return func(r *manualv1alpha1.NetworkACL) { r.Spec.ForProvider = p }
+}
+
+func withStatus(s manualv1alpha1.NetworkACLObservation) aclModifier {
+	return func(r *manualv1alpha1.NetworkACL) { r.Status.AtProvider = s }
+}
+
+func withConditions(c ...xpv1.Condition) aclModifier {
+	return func(r *manualv1alpha1.NetworkACL) { r.Status.ConditionedStatus.Conditions = c }
+}
+
+func acl(m ...aclModifier) *manualv1alpha1.NetworkACL {
+	cr := &manualv1alpha1.NetworkACL{}
+	for _, f := range m {
+		f(cr)
+	}
+	return cr
+}
+
+var _ managed.ExternalClient = &external{}
+var _ managed.ExternalConnecter = &connector{}
+
+func TestObserve(t *testing.T) {
+	type want struct {
+		cr     *manualv1alpha1.NetworkACL
+		result managed.ExternalObservation
+		err    error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"SuccessfulAvailable": {
+			args: args{
+				acl: &fake.MockNetworkACLClient{
+					MockDescribe: func(ctx context.Context, input *awsec2.DescribeNetworkAclsInput, opts []func(*awsec2.Options)) (*awsec2.DescribeNetworkAclsOutput, error) {
+						return &awsec2.DescribeNetworkAclsOutput{
+							NetworkAcls: []awsec2types.NetworkAcl{{
+								NetworkAclId: aws.String(aclID),
+								VpcId:        aws.String(vpcID),
+								Entries: []awsec2types.NetworkAclEntry{{
+									Egress:     aws.Bool(false),
+									RuleNumber: aws.Int32(32767),
+									Protocol:   aws.String("-1"),
+									RuleAction: awsec2types.RuleActionDeny,
+									CidrBlock:  aws.String("0.0.0.0/0"),
+								}},
+							}},
+						}, nil
+					},
+				},
+				cr: acl(withSpec(manualv1alpha1.NetworkACLParameters{
+					VPCID: aws.String(vpcID),
+				}), withExternalName(aclID)),
+			},
+			want: want{
+				cr: acl(withSpec(manualv1alpha1.NetworkACLParameters{
+					VPCID: aws.String(vpcID),
+				}), withExternalName(aclID), withStatus(manualv1alpha1.NetworkACLObservation{
+					NetworkACLID: aclID,
+					Entries: []manualv1alpha1.NetworkACLEntryState{{
+						RuleNumber: 32767,
+						Protocol:   "-1",
+						RuleAction: "deny",
+						CIDRBlock:  "0.0.0.0/0",
+					}},
+				}), withConditions(xpv1.Available())),
+				result: managed.ExternalObservation{
+					ResourceExists:   true,
+					ResourceUpToDate: true,
+				},
+			},
+		},
+		"AssociationMissing": {
+			args: args{
+				acl: &fake.MockNetworkACLClient{
+					MockDescribe: func(ctx context.Context, input *awsec2.DescribeNetworkAclsInput, opts []func(*awsec2.Options)) (*awsec2.DescribeNetworkAclsOutput, error) {
+						return &awsec2.DescribeNetworkAclsOutput{
+							NetworkAcls: []awsec2types.NetworkAcl{{
+								NetworkAclId: aws.String(aclID),
+								VpcId:        aws.String(vpcID),
+							}},
+						}, nil
+					},
+				},
+				cr: acl(withSpec(manualv1alpha1.NetworkACLParameters{
+					VPCID:        aws.String(vpcID),
+					Associations: []manualv1alpha1.NetworkACLAssociation{{SubnetID: aws.String(subnetID)}},
+				}), withExternalName(aclID)),
+			},
+			want: want{
+				cr: acl(withSpec(manualv1alpha1.NetworkACLParameters{
+					VPCID:        aws.String(vpcID),
+					Associations: []manualv1alpha1.NetworkACLAssociation{{SubnetID: aws.String(subnetID)}},
+				}), withExternalName(aclID), withStatus(manualv1alpha1.NetworkACLObservation{
+					NetworkACLID: aclID,
+				}), withConditions(xpv1.Available())),
+				result: managed.ExternalObservation{
+					ResourceExists:   true,
+					ResourceUpToDate: false,
+				},
+			},
+		},
+		"NotFound": {
+			args: args{
+				acl: &fake.MockNetworkACLClient{
+					MockDescribe: func(ctx context.Context, input *awsec2.DescribeNetworkAclsInput, opts []func(*awsec2.Options)) (*awsec2.DescribeNetworkAclsOutput, error) {
+						return nil, &smithy.GenericAPIError{Code: ec2.NetworkACLIDNotFound}
+					},
+				},
+				cr: acl(withExternalName(aclID)),
+			},
+			want: want{
+				cr: acl(withExternalName(aclID)),
+			},
+		},
+		"DescribeFail": {
+			args: args{
+				acl: &fake.MockNetworkACLClient{
+					MockDescribe: func(ctx context.Context, input *awsec2.DescribeNetworkAclsInput, opts []func(*awsec2.Options)) (*awsec2.DescribeNetworkAclsOutput, error) {
+						return nil, errBoom
+					},
+				},
+				cr: acl(withExternalName(aclID)),
+			},
+			want: want{
+				cr:  acl(withExternalName(aclID)),
+				err: awsclient.Wrap(errBoom, errDescribe),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{client: tc.acl}
+			o, err := e.Observe(context.Background(), tc.args.cr)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.result, o); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestCreate(t *testing.T) {
+	type want struct {
+		cr     *manualv1alpha1.NetworkACL
+		result managed.ExternalCreation
+		err    error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"Successful": {
+			args: args{
+				acl: &fake.MockNetworkACLClient{
+					MockCreate: func(ctx context.Context, input *awsec2.CreateNetworkAclInput, opts []func(*awsec2.Options)) (*awsec2.CreateNetworkAclOutput, error) {
+						return &awsec2.CreateNetworkAclOutput{
+							NetworkAcl: &awsec2types.NetworkAcl{NetworkAclId: aws.String(aclID)},
+						}, nil
+					},
+				},
+				cr: acl(withSpec(manualv1alpha1.NetworkACLParameters{VPCID: aws.String(vpcID)})),
+			},
+			want: want{
+				cr: acl(withSpec(manualv1alpha1.NetworkACLParameters{VPCID: aws.String(vpcID)}), withExternalName(aclID)),
+			},
+		},
+		"CreateFail": {
+			args: args{
+				acl: &fake.MockNetworkACLClient{
+					MockCreate: func(ctx context.Context, input *awsec2.CreateNetworkAclInput, opts []func(*awsec2.Options)) (*awsec2.CreateNetworkAclOutput, error) {
+						return nil, errBoom
+					},
+				},
+				cr: acl(),
+			},
+			want: want{
+				cr:  acl(),
+				err: awsclient.Wrap(errBoom, errCreate),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{client: tc.acl}
+			o, err := e.Create(context.Background(), tc.args.cr)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.result, o); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestUpdate(t *testing.T) {
+	type want struct {
+		err error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"EntriesAndAssociations": {
+			args: args{
+				acl: &fake.MockNetworkACLClient{
+					MockDescribe: func(ctx context.Context, input *awsec2.DescribeNetworkAclsInput, opts []func(*awsec2.Options)) (*awsec2.DescribeNetworkAclsOutput, error) {
+						if len(input.Filters) > 0 && aws.ToString(input.Filters[0].Name) == "association.subnet-id" {
+							return &awsec2.DescribeNetworkAclsOutput{
+								NetworkAcls: []awsec2types.NetworkAcl{{
+									NetworkAclId: aws.String(defaultACLID),
+									Associations: []awsec2types.NetworkAclAssociation{{
+										NetworkAclAssociationId: aws.String(associationID),
+										SubnetId:                aws.String(subnetID),
+									}},
+								}},
+							}, nil
+						}
+						return &awsec2.DescribeNetworkAclsOutput{
+							NetworkAcls: []awsec2types.NetworkAcl{{
+								NetworkAclId: aws.String(aclID),
+								VpcId:        aws.String(vpcID),
+								Entries: []awsec2types.NetworkAclEntry{{
+									Egress:     aws.Bool(false),
+									RuleNumber: aws.Int32(200),
+									Protocol:   aws.String("-1"),
+									RuleAction: awsec2types.RuleActionAllow,
+									CidrBlock:  aws.String(cidr),
+								}},
+							}},
+						}, nil
+					},
+					MockCreateEntry: func(ctx context.Context, input *awsec2.CreateNetworkAclEntryInput, opts []func(*awsec2.Options)) (*awsec2.CreateNetworkAclEntryOutput, error) {
+						if diff := cmp.Diff(int32(100), aws.ToInt32(input.RuleNumber)); diff != "" {
+							t.Errorf("r: -want, +got:\n%s", diff)
+						}
+						if diff := cmp.Diff("6", aws.ToString(input.Protocol)); diff != "" {
+							t.Errorf("r: -want, +got:\n%s", diff)
+						}
+						return &awsec2.CreateNetworkAclEntryOutput{}, nil
+					},
+					MockDeleteEntry: func(ctx context.Context, input *awsec2.DeleteNetworkAclEntryInput, opts []func(*awsec2.Options)) (*awsec2.DeleteNetworkAclEntryOutput, error) {
+						if diff := cmp.Diff(int32(200), aws.ToInt32(input.RuleNumber)); diff != "" {
+							t.Errorf("r: -want, +got:\n%s", diff)
+						}
+						return &awsec2.DeleteNetworkAclEntryOutput{}, nil
+					},
+					MockReplaceAssociation: func(ctx context.Context, input *awsec2.ReplaceNetworkAclAssociationInput, opts []func(*awsec2.Options)) (*awsec2.ReplaceNetworkAclAssociationOutput, error) {
+						if diff := cmp.Diff(associationID, aws.ToString(input.AssociationId)); diff != "" {
+							t.Errorf("r: -want, +got:\n%s", diff)
+						}
+						if diff := cmp.Diff(aclID, aws.ToString(input.NetworkAclId)); diff != "" {
+							t.Errorf("r: -want, +got:\n%s", diff)
+						}
+						return &awsec2.ReplaceNetworkAclAssociationOutput{}, nil
+					},
+				},
+				cr: acl(withSpec(manualv1alpha1.NetworkACLParameters{
+					VPCID: aws.String(vpcID),
+					Ingress: []manualv1alpha1.NetworkACLRule{{
+						RuleNumber: 100,
+						Protocol:   "tcp",
+						RuleAction: "allow",
+						CIDRBlock:  aws.String(cidr),
+						FromPort:   aws.Int32(443),
+						ToPort:     aws.Int32(443),
+					}},
+					Associations: []manualv1alpha1.NetworkACLAssociation{{SubnetID: aws.String(subnetID)}},
+				}), withExternalName(aclID)),
+			},
+		},
+		"Disassociate": {
+			args: args{
+				acl: &fake.MockNetworkACLClient{
+					MockDescribe: func(ctx context.Context, input *awsec2.DescribeNetworkAclsInput, opts []func(*awsec2.Options)) (*awsec2.DescribeNetworkAclsOutput, error) {
+						if len(input.Filters) > 0 {
+							return &awsec2.DescribeNetworkAclsOutput{
+								NetworkAcls: []awsec2types.NetworkAcl{{NetworkAclId: aws.String(defaultACLID)}},
+							}, nil
+						}
+						return &awsec2.DescribeNetworkAclsOutput{
+							NetworkAcls: []awsec2types.NetworkAcl{{
+								NetworkAclId: aws.String(aclID),
+								VpcId:        aws.String(vpcID),
+								Associations: []awsec2types.NetworkAclAssociation{{
+									NetworkAclAssociationId: aws.String(associationID),
+									SubnetId:                aws.String(subnetID),
+								}},
+							}},
+						}, nil
+					},
+					MockReplaceAssociation: func(ctx context.Context, input *awsec2.ReplaceNetworkAclAssociationInput, opts []func(*awsec2.Options)) (*awsec2.ReplaceNetworkAclAssociationOutput, error) {
+						if diff := cmp.Diff(defaultACLID, aws.ToString(input.NetworkAclId)); diff != "" {
+							t.Errorf("r: -want, +got:\n%s", diff)
+						}
+						return nil, errBoom
+					},
+				},
+				cr: acl(withSpec(manualv1alpha1.NetworkACLParameters{
+					VPCID: aws.String(vpcID),
+				}), withExternalName(aclID)),
+			},
+			want: want{
+				err: awsclient.Wrap(errBoom, errDisassociateSubnet),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{client: tc.acl}
+			_, err := e.Update(context.Background(), tc.args.cr)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestDelete(t *testing.T) {
+	type want struct {
+		cr  *manualv1alpha1.NetworkACL
+		err error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"Successful": {
+			args: args{
+				acl: &fake.MockNetworkACLClient{
+					MockDelete: func(ctx context.Context, input *awsec2.DeleteNetworkAclInput, opts []func(*awsec2.Options)) (*awsec2.DeleteNetworkAclOutput, error) {
+						return &awsec2.DeleteNetworkAclOutput{}, nil
+					},
+				},
+				cr: acl(withExternalName(aclID)),
+			},
+			want: want{
+				cr: acl(withExternalName(aclID), withConditions(xpv1.Deleting())),
+			},
+		},
+		"DeleteFail": {
+			args: args{
+				acl: &fake.MockNetworkACLClient{
+					MockDelete: func(ctx context.Context, input *awsec2.DeleteNetworkAclInput, opts []func(*awsec2.Options)) (*awsec2.DeleteNetworkAclOutput, error) {
+						return nil, errBoom
+					},
+				},
+				cr: acl(withExternalName(aclID)),
+			},
+			want: want{
+				cr:  acl(withExternalName(aclID), withConditions(xpv1.Deleting())),
+				err: awsclient.Wrap(errBoom, errDelete),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{client: tc.acl}
+			err := e.Delete(context.Background(), tc.args.cr)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,389 @@
+/*
+Copyright 2023 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package networkaclentry
+
+import (
+	"context"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	awsec2 "github.com/aws/aws-sdk-go-v2/service/ec2"
+	awsec2types "github.com/aws/aws-sdk-go-v2/service/ec2/types"
+	"github.com/aws/smithy-go"
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+
+	"github.com/crossplane-contrib/provider-aws/apis/ec2/manualv1alpha1"
+	awsclient "github.com/crossplane-contrib/provider-aws/pkg/clients"
+	"github.com/crossplane-contrib/provider-aws/pkg/clients/ec2"
+	"github.com/crossplane-contrib/provider-aws/pkg/clients/ec2/fake"
+)
+
+var (
+	aclID   = "acl-123"
+	cidr    = "10.0.0.0/16"
+	errBoom = errors.New("boom")
+
+	params = manualv1alpha1.NetworkACLEntryParameters{
+		NetworkACLID: aws.String(aclID),
+		RuleNumber:   100,
+		Protocol:     "tcp",
+		RuleAction:   "allow",
+		CIDRBlock:    aws.String(cidr),
+		FromPort:     aws.Int32(443),
+		ToPort:       aws.Int32(443),
+	}
+)
+
+type args struct {
+	entry ec2.NetworkACLEntryClient
+	cr    *manualv1alpha1.NetworkACLEntry
+}
+
+type entryModifier func(*manualv1alpha1.NetworkACLEntry)
+
+func withExternalName(name string) entryModifier {
+	return func(r *manualv1alpha1.NetworkACLEntry) { meta.SetExternalName(r, name) }
+}
+
+func withSpec(p manualv1alpha1.NetworkACLEntryParameters) entryModifier {
+	return func(r *manualv1alpha1.NetworkACLEntry) { r.Spec.ForProvider = p }
+}
+
+func withStatus(s manualv1alpha1.NetworkACLEntryState) entryModifier {
+	return func(r *manualv1alpha1.NetworkACLEntry) { r.Status.AtProvider = s }
+}
+
+func withConditions(c ...xpv1.Condition) entryModifier {
+	return func(r *manualv1alpha1.NetworkACLEntry) { r.Status.ConditionedStatus.Conditions = c }
+}
+
+func entry(m ...entryModifier) *manualv1alpha1.NetworkACLEntry {
+	cr := &manualv1alpha1.NetworkACLEntry{}
+	for _, f := range m {
+		f(cr)
+	}
+	return cr
+}
+
+func describe(entries ...awsec2types.NetworkAclEntry) func(context.Context, *awsec2.DescribeNetworkAclsInput, []func(*awsec2.Options)) (*awsec2.DescribeNetworkAclsOutput, error) {
+	return func(ctx context.Context, input *awsec2.DescribeNetworkAclsInput, opts []func(*awsec2.Options)) (*awsec2.DescribeNetworkAclsOutput, error) {
+		return &awsec2.DescribeNetworkAclsOutput{
+			NetworkAcls: []awsec2types.NetworkAcl{{
+				NetworkAclId: aws.String(aclID),
+				Entries:      entries,
+			}},
+		}, nil
+	}
+}
+
+var _ managed.ExternalClient = &external{}
+var _ managed.ExternalConnecter = &connector{}
+
+func TestObserve(t *testing.T) {
+	type want struct {
+		cr     *manualv1alpha1.NetworkACLEntry
+		result managed.ExternalObservation
+		err    error
+	}
+
+	observed := awsec2types.NetworkAclEntry{
+		Egress:     aws.Bool(false),
+		RuleNumber: aws.Int32(100),
+		Protocol:   aws.String("6"),
+		RuleAction: awsec2types.RuleActionAllow,
+		CidrBlock:  aws.String(cidr),
+		PortRange:  &awsec2types.PortRange{From: aws.Int32(443), To: aws.Int32(443)},
+	}
+	state := manualv1alpha1.NetworkACLEntryState{
+		RuleNumber: 100,
+		Protocol:   "6",
+		RuleAction: "allow",
+		CIDRBlock:  cidr,
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"SuccessfulAvailable": {
+			args: args{
+				entry: &fake.MockNetworkACLEntryClient{
+					MockDescribe: describe(observed),
+				},
+				cr: entry(withSpec(params), withExternalName(aclID)),
+			},
+			want: want{
+				cr: entry(withSpec(params), withExternalName(aclID), withStatus(state), withConditions(xpv1.Available())),
+				result: managed.ExternalObservation{
+					ResourceExists:   true,
+					ResourceUpToDate: true,
+				},
+			},
+		},
+		"NeedsUpdate": {
+			args: args{
+				entry: &fake.MockNetworkACLEntryClient{
+					MockDescribe: describe(observed),
+				},
+				cr: entry(withSpec(manualv1alpha1.NetworkACLEntryParameters{
+					NetworkACLID: aws.String(aclID),
+					RuleNumber:   100,
+					Protocol:     "tcp",
+					RuleAction:   "deny",
+					CIDRBlock:    aws.String(cidr),
+					FromPort:     aws.Int32(443),
+					ToPort:       aws.Int32(443),
+				}), withExternalName(aclID)),
+			},
+			want: want{
+				cr: entry(withSpec(manualv1alpha1.NetworkACLEntryParameters{
+					NetworkACLID: aws.String(aclID),
+					RuleNumber:   100,
+					Protocol:     "tcp",
+					RuleAction:   "deny",
+					CIDRBlock:    aws.String(cidr),
+					FromPort:     aws.Int32(443),
+					ToPort:       aws.Int32(443),
+				}), withExternalName(aclID), withStatus(state), withConditions(xpv1.Available())),
+				result: managed.ExternalObservation{
+					ResourceExists:   true,
+					ResourceUpToDate: false,
+				},
+			},
+		},
+		"EntryNotFound": {
+			args: args{
+				entry: &fake.MockNetworkACLEntryClient{
+					MockDescribe: describe(),
+				},
+				cr: entry(withSpec(params), withExternalName(aclID)),
+			},
+			want: want{
+				cr: entry(withSpec(params), withExternalName(aclID)),
+			},
+		},
+		"NetworkACLNotFound": {
+			args: args{
+				entry: &fake.MockNetworkACLEntryClient{
+					MockDescribe: func(ctx context.Context, input *awsec2.DescribeNetworkAclsInput, opts []func(*awsec2.Options)) (*awsec2.DescribeNetworkAclsOutput, error) {
+						return nil, &smithy.GenericAPIError{Code: ec2.NetworkACLIDNotFound}
+					},
+				},
+				cr: entry(withSpec(params), withExternalName(aclID)),
+			},
+			want: want{
+				cr: entry(withSpec(params), withExternalName(aclID)),
+			},
+		},
+		"DescribeFail": {
+			args: args{
+				entry: &fake.MockNetworkACLEntryClient{
+					MockDescribe: func(ctx context.Context, input *awsec2.DescribeNetworkAclsInput, opts []func(*awsec2.Options)) (*awsec2.DescribeNetworkAclsOutput, error) {
+						return nil, errBoom
+					},
+				},
+				cr: entry(withSpec(params), withExternalName(aclID)),
+			},
+			want: want{
+				cr:  entry(withSpec(params), withExternalName(aclID)),
+				err: awsclient.Wrap(errBoom, errDescribe),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{client: tc.entry}
+			o, err := e.Observe(context.Background(), tc.args.cr)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.result, o); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestCreate(t *testing.T) {
+	type want struct {
+		cr  *manualv1alpha1.NetworkACLEntry
+		err error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"Successful": {
+			args: args{
+				entry: &fake.MockNetworkACLEntryClient{
+					MockCreate: func(ctx context.Context, input *awsec2.CreateNetworkAclEntryInput, opts []func(*awsec2.Options)) (*awsec2.CreateNetworkAclEntryOutput, error) {
+						if diff := cmp.Diff("6", aws.ToString(input.Protocol)); diff != "" {
+							t.Errorf("r: -want, +got:\n%s", diff)
+						}
+						return &awsec2.CreateNetworkAclEntryOutput{}, nil
+					},
+				},
+				cr: entry(withSpec(params)),
+			},
+			want: want{
+				cr: entry(withSpec(params), withExternalName(aclID+":ingress:100"), withConditions(xpv1.Creating())),
+			},
+		},
+		"CreateFail": {
+			args: args{
+				entry: &fake.MockNetworkACLEntryClient{
+					MockCreate: func(ctx context.Context, input *awsec2.CreateNetworkAclEntryInput, opts []func(*awsec2.Options)) (*awsec2.CreateNetworkAclEntryOutput, error) {
+						return nil, errBoom
+					},
+				},
+				cr: entry(withSpec(params)),
+			},
+			want: want{
+				cr:  entry(withSpec(params), withConditions(xpv1.Creating())),
+				err: awsclient.Wrap(errBoom, errCreate),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{client: tc.entry}
+			_, err := e.Create(context.Background(), tc.args.cr)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestUpdate(t *testing.T) {
+	type want struct {
+		err error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"Successful": {
+			args: args{
+				entry: &fake.MockNetworkACLEntryClient{
+					MockReplace: func(ctx context.Context, input *awsec2.ReplaceNetworkAclEntryInput, opts []func(*awsec2.Options)) (*awsec2.ReplaceNetworkAclEntryOutput, error) {
+						return &awsec2.ReplaceNetworkAclEntryOutput{}, nil
+					},
+				},
+				cr: entry(withSpec(params), withExternalName(aclID)),
+			},
+		},
+		"ReplaceFail": {
+			args: args{
+				entry: &fake.MockNetworkACLEntryClient{
+					MockReplace: func(ctx context.Context, input *awsec2.ReplaceNetworkAclEntryInput, opts []func(*awsec2.Options)) (*awsec2.ReplaceNetworkAclEntryOutput, error) {
+						return nil, errBoom
+					},
+				},
+				cr: entry(withSpec(params), withExternalName(aclID)),
+			},
+			want: want{
+				err: awsclient.Wrap(errBoom, errUpdate),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{client: tc.entry}
+			_, err := e.Update(context.Background(), tc.args.cr)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestDelete(t *testing.T) {
+	type want struct {
+		err error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"Successful": {
+			args: args{
+				entry: &fake.MockNetworkACLEntryClient{
+					MockDelete: func(ctx context.Context, input *awsec2.DeleteNetworkAclEntryInput, opts []func(*awsec2.Options)) (*awsec2.DeleteNetworkAclEntryOutput, error) {
+						return &awsec2.DeleteNetworkAclEntryOutput{}, nil
+					},
+				},
+				cr: entry(withSpec(params), withExternalName(aclID)),
+			},
+		},
+		"AlreadyDeleted": {
+			args: args{
+				entry: &fake.MockNetworkACLEntryClient{
+					MockDelete: func(ctx context.Context, input *awsec2.DeleteNetworkAclEntryInput, opts []func(*awsec2.Options)) (*awsec2.DeleteNetworkAclEntryOutput, error) {
+						return nil, &smithy.GenericAPIError{Code: ec2.NetworkACLEntryNotFound}
+					},
+				},
+				cr: entry(withSpec(params), withExternalName(aclID)),
+			},
+		},
+		"DeleteFail": {
+			args: args{
+				entry: &fake.MockNetworkACLEntryClient{
+					MockDelete: func(ctx context.Context, input *awsec2.DeleteNetworkAclEntryInput, opts []func(*awsec2.Options)) (*awsec2.DeleteNetworkAclEntryOutput, error) {
+						return nil, errBoom
+					},
+				},
+				cr: entry(withSpec(params), withExternalName(aclID)),
+			},
+			want: want{
+				err: awsclient.Wrap(errBoom, errDelete),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{client: tc.entry}
+			err := e.Delete(context.Background(), tc.args.cr)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
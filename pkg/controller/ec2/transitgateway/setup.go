@@ -149,8 +149,8 @@ func (t *tagger) Initialize(ctx context.Context, mgd resource.Managed) error {
 	for _, t := range cr.Spec.ForProvider.Tags {
 		tagMap[aws.StringValue(t.Key)] = aws.StringValue(t.Value)
 	}
-	for k, v := range resource.GetExternalTags(mgd) {
-		tagMap[k] = v
+	if err := awsclients.AddDefaultTags(ctx, t.kube, mgd, tagMap); err != nil {
+		return err
 	}
 	transitGatewayTags.Tags = make([]*svcapitypes.Tag, len(tagMap))
 	transitGatewayTags.ResourceType = aws.String("transit-gateway")
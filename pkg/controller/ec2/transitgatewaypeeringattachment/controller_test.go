@@ -0,0 +1,415 @@
+/*
+Copyright 2023 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package transitgatewaypeeringattachment
+
+import (
+	"context"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	awsec2 "github.com/aws/aws-sdk-go-v2/service/ec2"
+	awsec2types "github.com/aws/aws-sdk-go-v2/service/ec2/types"
+	"github.com/aws/smithy-go"
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+
+	svcapitypes "github.com/crossplane-contrib/provider-aws/apis/ec2/v1alpha1"
+	awsclient "github.com/crossplane-contrib/provider-aws/pkg/clients"
+	"github.com/crossplane-contrib/provider-aws/pkg/clients/ec2"
+	"github.com/crossplane-contrib/provider-aws/pkg/clients/ec2/fake"
+)
+
+var (
+	attachmentID = "tgw-attach-123"
+	tgwID        = "tgw-123"
+	peerTGWID    = "tgw-456"
+	tagKey       = "k"
+	tagValue     = "v"
+	errBoom      = errors.New("boom")
+
+	params = svcapitypes.TransitGatewayPeeringAttachmentParameters{
+		TransitGatewayID:     aws.String(tgwID),
+		PeerTransitGatewayID: aws.String(peerTGWID),
+		PeerAccountID:        "123456789012",
+		PeerRegion:           "eu-west-1",
+		Tags:                 []svcapitypes.Tag{{Key: aws.String(tagKey), Value: aws.String(tagValue)}},
+	}
+)
+
+type args struct {
+	attachment ec2.TransitGatewayPeeringAttachmentClient
+	cr         *svcapitypes.TransitGatewayPeeringAttachment
+}
+
+type attachmentModifier func(*svcapitypes.TransitGatewayPeeringAttachment)
+
+func withExternalName(name string) attachmentModifier {
+	return func(r *svcapitypes.TransitGatewayPeeringAttachment) { meta.SetExternalName(r, name) }
+}
+
+func withSpec(p svcapitypes.TransitGatewayPeeringAttachmentParameters) attachmentModifier {
+	return func(r *svcapitypes.TransitGatewayPeeringAttachment) { r.Spec.ForProvider = p }
+}
+
+func withStatus(s svcapitypes.TransitGatewayPeeringAttachmentObservation) attachmentModifier {
+	return func(r *svcapitypes.TransitGatewayPeeringAttachment) { r.Status.AtProvider = s }
+}
+
+func withConditions(c ...xpv1.Condition) attachmentModifier {
+	return func(r *svcapitypes.TransitGatewayPeeringAttachment) { r.Status.ConditionedStatus.Conditions = c }
+}
+
+func attachment(m ...attachmentModifier) *svcapitypes.TransitGatewayPeeringAttachment {
+	cr := &svcapitypes.TransitGatewayPeeringAttachment{}
+	for _, f := range m {
+		f(cr)
+	}
+	return cr
+}
+
+func describe(attachments ...awsec2types.TransitGatewayPeeringAttachment) func(context.Context, *awsec2.DescribeTransitGatewayPeeringAttachmentsInput, []func(*awsec2.Options)) (*awsec2.DescribeTransitGatewayPeeringAttachmentsOutput, error) {
+	return func(ctx context.Context, input *awsec2.DescribeTransitGatewayPeeringAttachmentsInput, opts []func(*awsec2.Options)) (*awsec2.DescribeTransitGatewayPeeringAttachmentsOutput, error) {
+		return &awsec2.DescribeTransitGatewayPeeringAttachmentsOutput{
+			TransitGatewayPeeringAttachments: attachments,
+		}, nil
+	}
+}
+
+func observed(state awsec2types.TransitGatewayAttachmentState, tags ...awsec2types.Tag) awsec2types.TransitGatewayPeeringAttachment {
+	return awsec2types.TransitGatewayPeeringAttachment{
+		TransitGatewayAttachmentId: aws.String(attachmentID),
+		State:                      state,
+		Tags:                       tags,
+	}
+}
+
+var _ managed.ExternalClient = &external{}
+var _ managed.ExternalConnecter = &connector{}
+
+func TestObserve(t *testing.T) {
+	type want struct {
+		cr     *svcapitypes.TransitGatewayPeeringAttachment
+		result managed.ExternalObservation
+		err    error
+	}
+
+	tag := awsec2types.Tag{Key: aws.String(tagKey), Value: aws.String(tagValue)}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"SuccessfulAvailable": {
+			args: args{
+				attachment: &fake.MockTransitGatewayPeeringAttachmentClient{
+					MockDescribe: describe(observed(awsec2types.TransitGatewayAttachmentStateAvailable, tag)),
+				},
+				cr: attachment(withSpec(params), withExternalName(attachmentID)),
+			},
+			want: want{
+				cr: attachment(withSpec(params), withExternalName(attachmentID),
+					withStatus(svcapitypes.TransitGatewayPeeringAttachmentObservation{
+						TransitGatewayAttachmentID: attachmentID,
+						State:                      "available",
+					}),
+					withConditions(xpv1.Available())),
+				result: managed.ExternalObservation{
+					ResourceExists:   true,
+					ResourceUpToDate: true,
+				},
+			},
+		},
+		"PendingAcceptance": {
+			args: args{
+				attachment: &fake.MockTransitGatewayPeeringAttachmentClient{
+					MockDescribe: describe(observed(awsec2types.TransitGatewayAttachmentStatePendingAcceptance, tag)),
+				},
+				cr: attachment(withSpec(params), withExternalName(attachmentID)),
+			},
+			want: want{
+				cr: attachment(withSpec(params), withExternalName(attachmentID),
+					withStatus(svcapitypes.TransitGatewayPeeringAttachmentObservation{
+						TransitGatewayAttachmentID: attachmentID,
+						State:                      "pendingAcceptance",
+					}),
+					withConditions(xpv1.Creating())),
+				result: managed.ExternalObservation{
+					ResourceExists:   true,
+					ResourceUpToDate: true,
+				},
+			},
+		},
+		"TagsChanged": {
+			args: args{
+				attachment: &fake.MockTransitGatewayPeeringAttachmentClient{
+					MockDescribe: describe(observed(awsec2types.TransitGatewayAttachmentStateAvailable)),
+				},
+				cr: attachment(withSpec(params), withExternalName(attachmentID)),
+			},
+			want: want{
+				cr: attachment(withSpec(params), withExternalName(attachmentID),
+					withStatus(svcapitypes.TransitGatewayPeeringAttachmentObservation{
+						TransitGatewayAttachmentID: attachmentID,
+						State:                      "available",
+					}),
+					withConditions(xpv1.Available())),
+				result: managed.ExternalObservation{
+					ResourceExists:   true,
+					ResourceUpToDate: false,
+				},
+			},
+		},
+		"Deleted": {
+			args: args{
+				attachment: &fake.MockTransitGatewayPeeringAttachmentClient{
+					MockDescribe: describe(observed(awsec2types.TransitGatewayAttachmentStateDeleted)),
+				},
+				cr: attachment(withSpec(params), withExternalName(attachmentID)),
+			},
+			want: want{
+				cr: attachment(withSpec(params), withExternalName(attachmentID)),
+			},
+		},
+		"NotFound": {
+			args: args{
+				attachment: &fake.MockTransitGatewayPeeringAttachmentClient{
+					MockDescribe: func(ctx context.Context, input *awsec2.DescribeTransitGatewayPeeringAttachmentsInput, opts []func(*awsec2.Options)) (*awsec2.DescribeTransitGatewayPeeringAttachmentsOutput, error) {
+						return nil, &smithy.GenericAPIError{Code: ec2.TransitGatewayAttachmentNotFound}
+					},
+				},
+				cr: attachment(withSpec(params), withExternalName(attachmentID)),
+			},
+			want: want{
+				cr: attachment(withSpec(params), withExternalName(attachmentID)),
+			},
+		},
+		"DescribeFail": {
+			args: args{
+				attachment: &fake.MockTransitGatewayPeeringAttachmentClient{
+					MockDescribe: func(ctx context.Context, input *awsec2.DescribeTransitGatewayPeeringAttachmentsInput, opts []func(*awsec2.Options)) (*awsec2.DescribeTransitGatewayPeeringAttachmentsOutput, error) {
+						return nil, errBoom
+					},
+				},
+				cr: attachment(withSpec(params), withExternalName(attachmentID)),
+			},
+			want: want{
+				cr:  attachment(withSpec(params), withExternalName(attachmentID)),
+				err: awsclient.Wrap(errBoom, errDescribe),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{client: tc.attachment}
+			o, err := e.Observe(context.Background(), tc.args.cr)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.result, o); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestCreate(t *testing.T) {
+	type want struct {
+		cr  *svcapitypes.TransitGatewayPeeringAttachment
+		err error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"Successful": {
+			args: args{
+				attachment: &fake.MockTransitGatewayPeeringAttachmentClient{
+					MockCreate: func(ctx context.Context, input *awsec2.CreateTransitGatewayPeeringAttachmentInput, opts []func(*awsec2.Options)) (*awsec2.CreateTransitGatewayPeeringAttachmentOutput, error) {
+						if diff := cmp.Diff(peerTGWID, aws.ToString(input.PeerTransitGatewayId)); diff != "" {
+							t.Errorf("r: -want, +got:\n%s", diff)
+						}
+						return &awsec2.CreateTransitGatewayPeeringAttachmentOutput{
+							TransitGatewayPeeringAttachment: &awsec2types.TransitGatewayPeeringAttachment{
+								TransitGatewayAttachmentId: aws.String(attachmentID),
+							},
+						}, nil
+					},
+				},
+				cr: attachment(withSpec(params)),
+			},
+			want: want{
+				cr: attachment(withSpec(params), withExternalName(attachmentID), withConditions(xpv1.Creating())),
+			},
+		},
+		"CreateFail": {
+			args: args{
+				attachment: &fake.MockTransitGatewayPeeringAttachmentClient{
+					MockCreate: func(ctx context.Context, input *awsec2.CreateTransitGatewayPeeringAttachmentInput, opts []func(*awsec2.Options)) (*awsec2.CreateTransitGatewayPeeringAttachmentOutput, error) {
+						return nil, errBoom
+					},
+				},
+				cr: attachment(withSpec(params)),
+			},
+			want: want{
+				cr:  attachment(withSpec(params), withConditions(xpv1.Creating())),
+				err: awsclient.Wrap(errBoom, errCreate),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{client: tc.attachment}
+			_, err := e.Create(context.Background(), tc.args.cr)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestUpdate(t *testing.T) {
+	type want struct {
+		err error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"Successful": {
+			args: args{
+				attachment: &fake.MockTransitGatewayPeeringAttachmentClient{
+					MockDescribe: describe(observed(awsec2types.TransitGatewayAttachmentStateAvailable, awsec2types.Tag{Key: aws.String("old"), Value: aws.String("tag")})),
+					MockCreateTags: func(ctx context.Context, input *awsec2.CreateTagsInput, opts []func(*awsec2.Options)) (*awsec2.CreateTagsOutput, error) {
+						if diff := cmp.Diff([]string{attachmentID}, input.Resources); diff != "" {
+							t.Errorf("r: -want, +got:\n%s", diff)
+						}
+						return &awsec2.CreateTagsOutput{}, nil
+					},
+					MockDeleteTags: func(ctx context.Context, input *awsec2.DeleteTagsInput, opts []func(*awsec2.Options)) (*awsec2.DeleteTagsOutput, error) {
+						return &awsec2.DeleteTagsOutput{}, nil
+					},
+				},
+				cr: attachment(withSpec(params), withExternalName(attachmentID)),
+			},
+		},
+		"CreateTagsFail": {
+			args: args{
+				attachment: &fake.MockTransitGatewayPeeringAttachmentClient{
+					MockDescribe: describe(observed(awsec2types.TransitGatewayAttachmentStateAvailable)),
+					MockCreateTags: func(ctx context.Context, input *awsec2.CreateTagsInput, opts []func(*awsec2.Options)) (*awsec2.CreateTagsOutput, error) {
+						return nil, errBoom
+					},
+				},
+				cr: attachment(withSpec(params), withExternalName(attachmentID)),
+			},
+			want: want{
+				err: awsclient.Wrap(errBoom, errCreateTags),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{client: tc.attachment}
+			_, err := e.Update(context.Background(), tc.args.cr)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestDelete(t *testing.T) {
+	type want struct {
+		err error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"Successful": {
+			args: args{
+				attachment: &fake.MockTransitGatewayPeeringAttachmentClient{
+					MockDelete: func(ctx context.Context, input *awsec2.DeleteTransitGatewayPeeringAttachmentInput, opts []func(*awsec2.Options)) (*awsec2.DeleteTransitGatewayPeeringAttachmentOutput, error) {
+						return &awsec2.DeleteTransitGatewayPeeringAttachmentOutput{}, nil
+					},
+				},
+				cr: attachment(withSpec(params), withExternalName(attachmentID)),
+			},
+		},
+		"AlreadyDeleting": {
+			args: args{
+				attachment: &fake.MockTransitGatewayPeeringAttachmentClient{},
+				cr: attachment(withSpec(params), withExternalName(attachmentID),
+					withStatus(svcapitypes.TransitGatewayPeeringAttachmentObservation{State: "deleting"})),
+			},
+		},
+		"AlreadyDeleted": {
+			args: args{
+				attachment: &fake.MockTransitGatewayPeeringAttachmentClient{
+					MockDelete: func(ctx context.Context, input *awsec2.DeleteTransitGatewayPeeringAttachmentInput, opts []func(*awsec2.Options)) (*awsec2.DeleteTransitGatewayPeeringAttachmentOutput, error) {
+						return nil, &smithy.GenericAPIError{Code: ec2.TransitGatewayAttachmentNotFound}
+					},
+				},
+				cr: attachment(withSpec(params), withExternalName(attachmentID)),
+			},
+		},
+		"DeleteFail": {
+			args: args{
+				attachment: &fake.MockTransitGatewayPeeringAttachmentClient{
+					MockDelete: func(ctx context.Context, input *awsec2.DeleteTransitGatewayPeeringAttachmentInput, opts []func(*awsec2.Options)) (*awsec2.DeleteTransitGatewayPeeringAttachmentOutput, error) {
+						return nil, errBoom
+					},
+				},
+				cr: attachment(withSpec(params), withExternalName(attachmentID)),
+			},
+			want: want{
+				err: awsclient.Wrap(errBoom, errDelete),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{client: tc.attachment}
+			err := e.Delete(context.Background(), tc.args.cr)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,217 @@
+/*
+Copyright 2023 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package transitgatewaypeeringattachmentaccepter
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	awsec2 "github.com/aws/aws-sdk-go-v2/service/ec2"
+	ec2types "github.com/aws/aws-sdk-go-v2/service/ec2/types"
+	"github.com/pkg/errors"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/connection"
+	"github.com/crossplane/crossplane-runtime/pkg/controller"
+	"github.com/crossplane/crossplane-runtime/pkg/event"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+
+	svcapitypes "github.com/crossplane-contrib/provider-aws/apis/ec2/v1alpha1"
+	"github.com/crossplane-contrib/provider-aws/apis/v1alpha1"
+	awsclient "github.com/crossplane-contrib/provider-aws/pkg/clients"
+	"github.com/crossplane-contrib/provider-aws/pkg/clients/ec2"
+	"github.com/crossplane-contrib/provider-aws/pkg/features"
+)
+
+const (
+	errUnexpectedObject = "The managed resource is not a TransitGatewayPeeringAttachmentAccepter resource"
+
+	errDescribe      = "failed to describe TransitGatewayPeeringAttachment"
+	errMultipleItems = "retrieved multiple TransitGatewayPeeringAttachments for the given ID"
+	errNotFound      = "the TransitGatewayPeeringAttachment does not exist"
+	errAccept        = "failed to accept the TransitGatewayPeeringAttachment"
+	errCreateTags    = "failed to create tags for the TransitGatewayPeeringAttachment"
+	errDeleteTags    = "failed to delete tags for the TransitGatewayPeeringAttachment"
+	errDelete        = "failed to delete the TransitGatewayPeeringAttachment"
+)
+
+// SetupTransitGatewayPeeringAttachmentAccepter adds a controller that
+// reconciles TransitGatewayPeeringAttachmentAccepters.
+func SetupTransitGatewayPeeringAttachmentAccepter(mgr ctrl.Manager, o controller.Options) error {
+	name := managed.ControllerName(svcapitypes.TransitGatewayPeeringAttachmentAccepterGroupKind)
+
+	cps := []managed.ConnectionPublisher{managed.NewAPISecretPublisher(mgr.GetClient(), mgr.GetScheme())}
+	if o.Features.Enabled(features.EnableAlphaExternalSecretStores) {
+		cps = append(cps, connection.NewDetailsManager(mgr.GetClient(), v1alpha1.StoreConfigGroupVersionKind))
+	}
+
+	return ctrl.NewControllerManagedBy(mgr).
+		Named(name).
+		WithOptions(o.ForControllerRuntime()).
+		WithEventFilter(resource.DesiredStateChanged()).
+		For(&svcapitypes.TransitGatewayPeeringAttachmentAccepter{}).
+		Complete(managed.NewReconciler(mgr,
+			resource.ManagedKind(svcapitypes.TransitGatewayPeeringAttachmentAccepterGroupVersionKind),
+			managed.WithExternalConnecter(&connector{kube: mgr.GetClient(), newClientFn: ec2.NewTransitGatewayPeeringAttachmentClient}),
+			managed.WithReferenceResolver(managed.NewAPISimpleReferenceResolver(mgr.GetClient())),
+			managed.WithInitializers(),
+			managed.WithPollInterval(o.PollInterval),
+			managed.WithLogger(o.Logger.WithValues("controller", name)),
+			managed.WithRecorder(event.NewAPIRecorder(mgr.GetEventRecorderFor(name))),
+			managed.WithConnectionPublishers(cps...)))
+}
+
+type connector struct {
+	kube        client.Client
+	newClientFn func(config aws.Config) ec2.TransitGatewayPeeringAttachmentClient
+}
+
+func (c *connector) Connect(ctx context.Context, mg resource.Managed) (managed.ExternalClient, error) {
+	cr, ok := mg.(*svcapitypes.TransitGatewayPeeringAttachmentAccepter)
+	if !ok {
+		return nil, errors.New(errUnexpectedObject)
+	}
+	cfg, err := awsclient.GetConfig(ctx, c.kube, mg, cr.Spec.ForProvider.Region)
+	if err != nil {
+		return nil, err
+	}
+	return &external{client: c.newClientFn(*cfg)}, nil
+}
+
+type external struct {
+	client ec2.TransitGatewayPeeringAttachmentClient
+}
+
+// The attachment is looked up by the ID in the spec rather than by the
+// external name, so that an accepter only exists once the attachment has left
+// the pendingAcceptance state.
+func (e *external) describe(ctx context.Context, cr *svcapitypes.TransitGatewayPeeringAttachmentAccepter) (*ec2types.TransitGatewayPeeringAttachment, error) {
+	response, err := e.client.DescribeTransitGatewayPeeringAttachments(ctx, &awsec2.DescribeTransitGatewayPeeringAttachmentsInput{
+		TransitGatewayAttachmentIds: []string{aws.ToString(cr.Spec.ForProvider.TransitGatewayAttachmentID)},
+	})
+	if err != nil {
+		return nil, err
+	}
+	if len(response.TransitGatewayPeeringAttachments) == 0 {
+		return nil, nil
+	}
+	if len(response.TransitGatewayPeeringAttachments) != 1 {
+		return nil, errors.New(errMultipleItems)
+	}
+	return &response.TransitGatewayPeeringAttachments[0], nil
+}
+
+func (e *external) Observe(ctx context.Context, mgd resource.Managed) (managed.ExternalObservation, error) {
+	cr, ok := mgd.(*svcapitypes.TransitGatewayPeeringAttachmentAccepter)
+	if !ok {
+		return managed.ExternalObservation{}, errors.New(errUnexpectedObject)
+	}
+
+	observed, err := e.describe(ctx, cr)
+	if err != nil {
+		return managed.ExternalObservation{}, awsclient.Wrap(resource.Ignore(ec2.IsTransitGatewayAttachmentNotFoundErr, err), errDescribe)
+	}
+	if observed == nil ||
+		observed.State == ec2types.TransitGatewayAttachmentStatePendingAcceptance ||
+		observed.State == ec2types.TransitGatewayAttachmentStateDeleted {
+		return managed.ExternalObservation{
+			ResourceExists: false,
+		}, nil
+	}
+
+	cr.Status.AtProvider = ec2.GenerateTransitGatewayPeeringAttachmentObservation(*observed)
+	cr.SetConditions(ec2.TransitGatewayPeeringAttachmentCondition(observed.State))
+
+	return managed.ExternalObservation{
+		ResourceExists:   true,
+		ResourceUpToDate: ec2.IsTransitGatewayPeeringAttachmentUpToDate(cr.Spec.ForProvider.Tags, *observed),
+	}, nil
+}
+
+func (e *external) Create(ctx context.Context, mgd resource.Managed) (managed.ExternalCreation, error) {
+	cr, ok := mgd.(*svcapitypes.TransitGatewayPeeringAttachmentAccepter)
+	if !ok {
+		return managed.ExternalCreation{}, errors.New(errUnexpectedObject)
+	}
+	cr.SetConditions(xpv1.Creating())
+
+	if _, err := e.client.AcceptTransitGatewayPeeringAttachment(ctx, &awsec2.AcceptTransitGatewayPeeringAttachmentInput{
+		TransitGatewayAttachmentId: cr.Spec.ForProvider.TransitGatewayAttachmentID,
+	}); err != nil {
+		return managed.ExternalCreation{}, awsclient.Wrap(err, errAccept)
+	}
+	meta.SetExternalName(cr, aws.ToString(cr.Spec.ForProvider.TransitGatewayAttachmentID))
+	return managed.ExternalCreation{}, nil
+}
+
+func (e *external) Update(ctx context.Context, mgd resource.Managed) (managed.ExternalUpdate, error) {
+	cr, ok := mgd.(*svcapitypes.TransitGatewayPeeringAttachmentAccepter)
+	if !ok {
+		return managed.ExternalUpdate{}, errors.New(errUnexpectedObject)
+	}
+
+	observed, err := e.describe(ctx, cr)
+	if err != nil {
+		return managed.ExternalUpdate{}, awsclient.Wrap(err, errDescribe)
+	}
+	if observed == nil {
+		return managed.ExternalUpdate{}, errors.New(errNotFound)
+	}
+
+	addTags, removeTags := awsclient.DiffEC2Tags(ec2.GenerateTransitGatewayTags(cr.Spec.ForProvider.Tags), observed.Tags)
+	if len(addTags) > 0 {
+		if _, err := e.client.CreateTags(ctx, &awsec2.CreateTagsInput{
+			Resources: []string{aws.ToString(observed.TransitGatewayAttachmentId)},
+			Tags:      addTags,
+		}); err != nil {
+			return managed.ExternalUpdate{}, awsclient.Wrap(err, errCreateTags)
+		}
+	}
+	if len(removeTags) > 0 {
+		if _, err := e.client.DeleteTags(ctx, &awsec2.DeleteTagsInput{
+			Resources: []string{aws.ToString(observed.TransitGatewayAttachmentId)},
+			Tags:      removeTags,
+		}); err != nil {
+			return managed.ExternalUpdate{}, awsclient.Wrap(err, errDeleteTags)
+		}
+	}
+
+	return managed.ExternalUpdate{}, nil
+}
+
+// Delete deletes the peering attachment, which can be done by either side of
+// the peering.
+func (e *external) Delete(ctx context.Context, mgd resource.Managed) error {
+	cr, ok := mgd.(*svcapitypes.TransitGatewayPeeringAttachmentAccepter)
+	if !ok {
+		return errors.New(errUnexpectedObject)
+	}
+	cr.SetConditions(xpv1.Deleting())
+
+	if cr.Status.AtProvider.State == string(ec2types.TransitGatewayAttachmentStateDeleting) {
+		return nil
+	}
+
+	_, err := e.client.DeleteTransitGatewayPeeringAttachment(ctx, &awsec2.DeleteTransitGatewayPeeringAttachmentInput{
+		TransitGatewayAttachmentId: cr.Spec.ForProvider.TransitGatewayAttachmentID,
+	})
+	return awsclient.Wrap(resource.Ignore(ec2.IsTransitGatewayAttachmentNotFoundErr, err), errDelete)
+}
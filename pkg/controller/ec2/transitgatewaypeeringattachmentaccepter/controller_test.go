@@ -0,0 +1,297 @@
+/*
+Copyright 2023 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package transitgatewaypeeringattachmentaccepter
+
+import (
+	"context"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	awsec2 "github.com/aws/aws-sdk-go-v2/service/ec2"
+	awsec2types "github.com/aws/aws-sdk-go-v2/service/ec2/types"
+	"github.com/aws/smithy-go"
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+
+	svcapitypes "github.com/crossplane-contrib/provider-aws/apis/ec2/v1alpha1"
+	awsclient "github.com/crossplane-contrib/provider-aws/pkg/clients"
+	"github.com/crossplane-contrib/provider-aws/pkg/clients/ec2"
+	"github.com/crossplane-contrib/provider-aws/pkg/clients/ec2/fake"
+)
+
+var (
+	attachmentID = "tgw-attach-123"
+	errBoom      = errors.New("boom")
+
+	params = svcapitypes.TransitGatewayPeeringAttachmentAccepterParameters{
+		TransitGatewayAttachmentID: aws.String(attachmentID),
+	}
+)
+
+type args struct {
+	attachment ec2.TransitGatewayPeeringAttachmentClient
+	cr         *svcapitypes.TransitGatewayPeeringAttachmentAccepter
+}
+
+type accepterModifier func(*svcapitypes.TransitGatewayPeeringAttachmentAccepter)
+
+func withExternalName(name string) accepterModifier {
+	return func(r *svcapitypes.TransitGatewayPeeringAttachmentAccepter) { meta.SetExternalName(r, name) }
+}
+
+func withSpec(p svcapitypes.TransitGatewayPeeringAttachmentAccepterParameters) accepterModifier {
+	return func(r *svcapitypes.TransitGatewayPeeringAttachmentAccepter) { r.Spec.ForProvider = p }
+}
+
+func withStatus(s svcapitypes.TransitGatewayPeeringAttachmentObservation) accepterModifier {
+	return func(r *svcapitypes.TransitGatewayPeeringAttachmentAccepter) { r.Status.AtProvider = s }
+}
+
+func withConditions(c ...xpv1.Condition) accepterModifier {
+	return func(r *svcapitypes.TransitGatewayPeeringAttachmentAccepter) {
+		r.Status.ConditionedStatus.Conditions = c
+	}
+}
+
+func accepter(m ...accepterModifier) *svcapitypes.TransitGatewayPeeringAttachmentAccepter {
+	cr := &svcapitypes.TransitGatewayPeeringAttachmentAccepter{}
+	for _, f := range m {
+		f(cr)
+	}
+	return cr
+}
+
+func describe(state awsec2types.TransitGatewayAttachmentState) func(context.Context, *awsec2.DescribeTransitGatewayPeeringAttachmentsInput, []func(*awsec2.Options)) (*awsec2.DescribeTransitGatewayPeeringAttachmentsOutput, error) {
+	return func(ctx context.Context, input *awsec2.DescribeTransitGatewayPeeringAttachmentsInput, opts []func(*awsec2.Options)) (*awsec2.DescribeTransitGatewayPeeringAttachmentsOutput, error) {
+		return &awsec2.DescribeTransitGatewayPeeringAttachmentsOutput{
+			TransitGatewayPeeringAttachments: []awsec2types.TransitGatewayPeeringAttachment{{
+				TransitGatewayAttachmentId: aws.String(attachmentID),
+				State:                      state,
+			}},
+		}, nil
+	}
+}
+
+var _ managed.ExternalClient = &external{}
+var _ managed.ExternalConnecter = &connector{}
+
+func TestObserve(t *testing.T) {
+	type want struct {
+		cr     *svcapitypes.TransitGatewayPeeringAttachmentAccepter
+		result managed.ExternalObservation
+		err    error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"SuccessfulAvailable": {
+			args: args{
+				attachment: &fake.MockTransitGatewayPeeringAttachmentClient{
+					MockDescribe: describe(awsec2types.TransitGatewayAttachmentStateAvailable),
+				},
+				cr: accepter(withSpec(params), withExternalName(attachmentID)),
+			},
+			want: want{
+				cr: accepter(withSpec(params), withExternalName(attachmentID),
+					withStatus(svcapitypes.TransitGatewayPeeringAttachmentObservation{
+						TransitGatewayAttachmentID: attachmentID,
+						State:                      "available",
+					}),
+					withConditions(xpv1.Available())),
+				result: managed.ExternalObservation{
+					ResourceExists:   true,
+					ResourceUpToDate: true,
+				},
+			},
+		},
+		"PendingAcceptance": {
+			args: args{
+				attachment: &fake.MockTransitGatewayPeeringAttachmentClient{
+					MockDescribe: describe(awsec2types.TransitGatewayAttachmentStatePendingAcceptance),
+				},
+				cr: accepter(withSpec(params)),
+			},
+			want: want{
+				cr: accepter(withSpec(params)),
+			},
+		},
+		"NotFound": {
+			args: args{
+				attachment: &fake.MockTransitGatewayPeeringAttachmentClient{
+					MockDescribe: func(ctx context.Context, input *awsec2.DescribeTransitGatewayPeeringAttachmentsInput, opts []func(*awsec2.Options)) (*awsec2.DescribeTransitGatewayPeeringAttachmentsOutput, error) {
+						return nil, &smithy.GenericAPIError{Code: ec2.TransitGatewayAttachmentNotFound}
+					},
+				},
+				cr: accepter(withSpec(params)),
+			},
+			want: want{
+				cr: accepter(withSpec(params)),
+			},
+		},
+		"DescribeFail": {
+			args: args{
+				attachment: &fake.MockTransitGatewayPeeringAttachmentClient{
+					MockDescribe: func(ctx context.Context, input *awsec2.DescribeTransitGatewayPeeringAttachmentsInput, opts []func(*awsec2.Options)) (*awsec2.DescribeTransitGatewayPeeringAttachmentsOutput, error) {
+						return nil, errBoom
+					},
+				},
+				cr: accepter(withSpec(params)),
+			},
+			want: want{
+				cr:  accepter(withSpec(params)),
+				err: awsclient.Wrap(errBoom, errDescribe),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{client: tc.attachment}
+			o, err := e.Observe(context.Background(), tc.args.cr)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.result, o); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestCreate(t *testing.T) {
+	type want struct {
+		cr  *svcapitypes.TransitGatewayPeeringAttachmentAccepter
+		err error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"Successful": {
+			args: args{
+				attachment: &fake.MockTransitGatewayPeeringAttachmentClient{
+					MockAccept: func(ctx context.Context, input *awsec2.AcceptTransitGatewayPeeringAttachmentInput, opts []func(*awsec2.Options)) (*awsec2.AcceptTransitGatewayPeeringAttachmentOutput, error) {
+						if diff := cmp.Diff(attachmentID, aws.ToString(input.TransitGatewayAttachmentId)); diff != "" {
+							t.Errorf("r: -want, +got:\n%s", diff)
+						}
+						return &awsec2.AcceptTransitGatewayPeeringAttachmentOutput{}, nil
+					},
+				},
+				cr: accepter(withSpec(params)),
+			},
+			want: want{
+				cr: accepter(withSpec(params), withExternalName(attachmentID), withConditions(xpv1.Creating())),
+			},
+		},
+		"AcceptFail": {
+			args: args{
+				attachment: &fake.MockTransitGatewayPeeringAttachmentClient{
+					MockAccept: func(ctx context.Context, input *awsec2.AcceptTransitGatewayPeeringAttachmentInput, opts []func(*awsec2.Options)) (*awsec2.AcceptTransitGatewayPeeringAttachmentOutput, error) {
+						return nil, errBoom
+					},
+				},
+				cr: accepter(withSpec(params)),
+			},
+			want: want{
+				cr:  accepter(withSpec(params), withConditions(xpv1.Creating())),
+				err: awsclient.Wrap(errBoom, errAccept),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{client: tc.attachment}
+			_, err := e.Create(context.Background(), tc.args.cr)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestDelete(t *testing.T) {
+	type want struct {
+		err error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"Successful": {
+			args: args{
+				attachment: &fake.MockTransitGatewayPeeringAttachmentClient{
+					MockDelete: func(ctx context.Context, input *awsec2.DeleteTransitGatewayPeeringAttachmentInput, opts []func(*awsec2.Options)) (*awsec2.DeleteTransitGatewayPeeringAttachmentOutput, error) {
+						return &awsec2.DeleteTransitGatewayPeeringAttachmentOutput{}, nil
+					},
+				},
+				cr: accepter(withSpec(params), withExternalName(attachmentID)),
+			},
+		},
+		"AlreadyDeleted": {
+			args: args{
+				attachment: &fake.MockTransitGatewayPeeringAttachmentClient{
+					MockDelete: func(ctx context.Context, input *awsec2.DeleteTransitGatewayPeeringAttachmentInput, opts []func(*awsec2.Options)) (*awsec2.DeleteTransitGatewayPeeringAttachmentOutput, error) {
+						return nil, &smithy.GenericAPIError{Code: ec2.TransitGatewayAttachmentNotFound}
+					},
+				},
+				cr: accepter(withSpec(params), withExternalName(attachmentID)),
+			},
+		},
+		"DeleteFail": {
+			args: args{
+				attachment: &fake.MockTransitGatewayPeeringAttachmentClient{
+					MockDelete: func(ctx context.Context, input *awsec2.DeleteTransitGatewayPeeringAttachmentInput, opts []func(*awsec2.Options)) (*awsec2.DeleteTransitGatewayPeeringAttachmentOutput, error) {
+						return nil, errBoom
+					},
+				},
+				cr: accepter(withSpec(params), withExternalName(attachmentID)),
+			},
+			want: want{
+				err: awsclient.Wrap(errBoom, errDelete),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{client: tc.attachment}
+			err := e.Delete(context.Background(), tc.args.cr)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
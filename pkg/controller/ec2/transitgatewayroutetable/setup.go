@@ -160,8 +160,8 @@ func (t *tagger) Initialize(ctx context.Context, mgd cpresource.Managed) error {
 	for _, t := range cr.Spec.ForProvider.Tags {
 		tagMap[aws.StringValue(t.Key)] = aws.StringValue(t.Value)
 	}
-	for k, v := range cpresource.GetExternalTags(mgd) {
-		tagMap[k] = v
+	if err := aws.AddDefaultTags(ctx, t.kube, mgd, tagMap); err != nil {
+		return err
 	}
 	transitGatewayRouteTableTags.Tags = make([]*svcapitypes.Tag, len(tagMap))
 	transitGatewayRouteTableTags.ResourceType = aws.String("transit-gateway-route-table")
@@ -0,0 +1,161 @@
+/*
+Copyright 2023 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package transitgatewayroutetablepropagation
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/pkg/errors"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/connection"
+	"github.com/crossplane/crossplane-runtime/pkg/controller"
+	"github.com/crossplane/crossplane-runtime/pkg/event"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+
+	svcapitypes "github.com/crossplane-contrib/provider-aws/apis/ec2/v1alpha1"
+	"github.com/crossplane-contrib/provider-aws/apis/v1alpha1"
+	awsclient "github.com/crossplane-contrib/provider-aws/pkg/clients"
+	"github.com/crossplane-contrib/provider-aws/pkg/clients/ec2"
+	"github.com/crossplane-contrib/provider-aws/pkg/features"
+)
+
+const (
+	errUnexpectedObject = "The managed resource is not a TransitGatewayRouteTablePropagation resource"
+
+	errDescribe = "failed to describe the propagations of the TransitGatewayRouteTable"
+	errCreate   = "failed to create the TransitGatewayRouteTablePropagation resource"
+	errDelete   = "failed to delete the TransitGatewayRouteTablePropagation resource"
+)
+
+// SetupTransitGatewayRouteTablePropagation adds a controller that reconciles
+// TransitGatewayRouteTablePropagations.
+func SetupTransitGatewayRouteTablePropagation(mgr ctrl.Manager, o controller.Options) error {
+	name := managed.ControllerName(svcapitypes.TransitGatewayRouteTablePropagationGroupKind)
+
+	cps := []managed.ConnectionPublisher{managed.NewAPISecretPublisher(mgr.GetClient(), mgr.GetScheme())}
+	if o.Features.Enabled(features.EnableAlphaExternalSecretStores) {
+		cps = append(cps, connection.NewDetailsManager(mgr.GetClient(), v1alpha1.StoreConfigGroupVersionKind))
+	}
+
+	return ctrl.NewControllerManagedBy(mgr).
+		Named(name).
+		WithOptions(o.ForControllerRuntime()).
+		WithEventFilter(resource.DesiredStateChanged()).
+		For(&svcapitypes.TransitGatewayRouteTablePropagation{}).
+		Complete(managed.NewReconciler(mgr,
+			resource.ManagedKind(svcapitypes.TransitGatewayRouteTablePropagationGroupVersionKind),
+			managed.WithExternalConnecter(&connector{kube: mgr.GetClient(), newClientFn: ec2.NewTransitGatewayRouteTablePropagationClient}),
+			managed.WithReferenceResolver(managed.NewAPISimpleReferenceResolver(mgr.GetClient())),
+			managed.WithInitializers(),
+			managed.WithPollInterval(o.PollInterval),
+			managed.WithLogger(o.Logger.WithValues("controller", name)),
+			managed.WithRecorder(event.NewAPIRecorder(mgr.GetEventRecorderFor(name))),
+			managed.WithConnectionPublishers(cps...)))
+}
+
+type connector struct {
+	kube        client.Client
+	newClientFn func(config aws.Config) ec2.TransitGatewayRouteTablePropagationClient
+}
+
+func (c *connector) Connect(ctx context.Context, mg resource.Managed) (managed.ExternalClient, error) {
+	cr, ok := mg.(*svcapitypes.TransitGatewayRouteTablePropagation)
+	if !ok {
+		return nil, errors.New(errUnexpectedObject)
+	}
+	cfg, err := awsclient.GetConfig(ctx, c.kube, mg, cr.Spec.ForProvider.Region)
+	if err != nil {
+		return nil, err
+	}
+	return &external{client: ec2.NewTransitGatewayRouteTablePropagationLinkClient(c.newClientFn(*cfg))}, nil
+}
+
+type external struct {
+	client ec2.TransitGatewayRouteTableLinkClient
+}
+
+// externalName returns the identifier of the propagation, which is made of
+// the IDs of its route table and of its attachment.
+func externalName(p svcapitypes.TransitGatewayRouteTablePropagationParameters) string {
+	return fmt.Sprintf("%s:%s", aws.ToString(p.TransitGatewayRouteTableID), aws.ToString(p.TransitGatewayAttachmentID))
+}
+
+func (e *external) Observe(ctx context.Context, mgd resource.Managed) (managed.ExternalObservation, error) {
+	cr, ok := mgd.(*svcapitypes.TransitGatewayRouteTablePropagation)
+	if !ok {
+		return managed.ExternalObservation{}, errors.New(errUnexpectedObject)
+	}
+	if meta.GetExternalName(cr) == "" {
+		return managed.ExternalObservation{
+			ResourceExists: false,
+		}, nil
+	}
+
+	link, err := ec2.ObserveTransitGatewayRouteTableLink(ctx, e.client, cr, cr.Spec.ForProvider.TransitGatewayRouteTableID, cr.Spec.ForProvider.TransitGatewayAttachmentID)
+	if err != nil {
+		return managed.ExternalObservation{}, awsclient.Wrap(err, errDescribe)
+	}
+	if link == nil {
+		return managed.ExternalObservation{
+			ResourceExists: false,
+		}, nil
+	}
+
+	cr.Status.AtProvider = ec2.GenerateTransitGatewayRouteTablePropagationObservation(*link)
+
+	// All the fields of a propagation are immutable.
+	return managed.ExternalObservation{
+		ResourceExists:   true,
+		ResourceUpToDate: true,
+	}, nil
+}
+
+func (e *external) Create(ctx context.Context, mgd resource.Managed) (managed.ExternalCreation, error) {
+	cr, ok := mgd.(*svcapitypes.TransitGatewayRouteTablePropagation)
+	if !ok {
+		return managed.ExternalCreation{}, errors.New(errUnexpectedObject)
+	}
+	cr.SetConditions(xpv1.Creating())
+
+	if err := e.client.CreateLink(ctx, cr.Spec.ForProvider.TransitGatewayRouteTableID, cr.Spec.ForProvider.TransitGatewayAttachmentID); err != nil {
+		return managed.ExternalCreation{}, awsclient.Wrap(err, errCreate)
+	}
+	meta.SetExternalName(cr, externalName(cr.Spec.ForProvider))
+	return managed.ExternalCreation{}, nil
+}
+
+func (e *external) Update(_ context.Context, _ resource.Managed) (managed.ExternalUpdate, error) {
+	return managed.ExternalUpdate{}, nil
+}
+
+func (e *external) Delete(ctx context.Context, mgd resource.Managed) error {
+	cr, ok := mgd.(*svcapitypes.TransitGatewayRouteTablePropagation)
+	if !ok {
+		return errors.New(errUnexpectedObject)
+	}
+	cr.SetConditions(xpv1.Deleting())
+
+	err := ec2.DeleteTransitGatewayRouteTableLink(ctx, e.client, cr.Spec.ForProvider.TransitGatewayRouteTableID, cr.Spec.ForProvider.TransitGatewayAttachmentID, cr.Status.AtProvider.State)
+	return awsclient.Wrap(err, errDelete)
+}
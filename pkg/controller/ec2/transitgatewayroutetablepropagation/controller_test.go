@@ -0,0 +1,377 @@
+/*
+Copyright 2023 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package transitgatewayroutetablepropagation
+
+import (
+	"context"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/smithy-go"
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+
+	svcapitypes "github.com/crossplane-contrib/provider-aws/apis/ec2/v1alpha1"
+	awsclient "github.com/crossplane-contrib/provider-aws/pkg/clients"
+	"github.com/crossplane-contrib/provider-aws/pkg/clients/ec2"
+	"github.com/crossplane-contrib/provider-aws/pkg/clients/ec2/fake"
+)
+
+var (
+	routeTableID = "tgw-rtb-123"
+	attachmentID = "tgw-attach-123"
+	vpcID        = "vpc-123"
+	errBoom      = errors.New("boom")
+
+	params = svcapitypes.TransitGatewayRouteTablePropagationParameters{
+		TransitGatewayRouteTableID: aws.String(routeTableID),
+		TransitGatewayAttachmentID: aws.String(attachmentID),
+	}
+
+	states = ec2.TransitGatewayRouteTableLinkStates{
+		Available: "enabled",
+		Pending:   "enabling",
+		Deleting:  "disabling",
+		Deleted:   "disabled",
+	}
+)
+
+type args struct {
+	link *fake.MockTransitGatewayRouteTableLinkClient
+	cr   *svcapitypes.TransitGatewayRouteTablePropagation
+}
+
+type propagationModifier func(*svcapitypes.TransitGatewayRouteTablePropagation)
+
+func withExternalName(name string) propagationModifier {
+	return func(r *svcapitypes.TransitGatewayRouteTablePropagation) { meta.SetExternalName(r, name) }
+}
+
+func withSpec(p svcapitypes.TransitGatewayRouteTablePropagationParameters) propagationModifier {
+	return func(r *svcapitypes.TransitGatewayRouteTablePropagation) { r.Spec.ForProvider = p }
+}
+
+func withStatus(s svcapitypes.TransitGatewayRouteTablePropagationObservation) propagationModifier {
+	return func(r *svcapitypes.TransitGatewayRouteTablePropagation) { r.Status.AtProvider = s }
+}
+
+func withConditions(c ...xpv1.Condition) propagationModifier {
+	return func(r *svcapitypes.TransitGatewayRouteTablePropagation) { r.Status.ConditionedStatus.Conditions = c }
+}
+
+func propagation(m ...propagationModifier) *svcapitypes.TransitGatewayRouteTablePropagation {
+	cr := &svcapitypes.TransitGatewayRouteTablePropagation{}
+	for _, f := range m {
+		f(cr)
+	}
+	return cr
+}
+
+func getStates() ec2.TransitGatewayRouteTableLinkStates {
+	return states
+}
+
+func getLink(state string) func(context.Context, *string, *string) (*ec2.TransitGatewayRouteTableLink, error) {
+	return func(ctx context.Context, rtb, attachment *string) (*ec2.TransitGatewayRouteTableLink, error) {
+		if aws.ToString(rtb) != routeTableID || aws.ToString(attachment) != attachmentID {
+			return nil, errors.Errorf("unexpected link %s:%s", aws.ToString(rtb), aws.ToString(attachment))
+		}
+		if state == "" {
+			return nil, nil
+		}
+		return &ec2.TransitGatewayRouteTableLink{
+			ResourceID:   vpcID,
+			ResourceType: "vpc",
+			State:        state,
+		}, nil
+	}
+}
+
+var _ managed.ExternalClient = &external{}
+var _ managed.ExternalConnecter = &connector{}
+
+func TestObserve(t *testing.T) {
+	type want struct {
+		cr     *svcapitypes.TransitGatewayRouteTablePropagation
+		result managed.ExternalObservation
+		err    error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"SuccessfulAvailable": {
+			args: args{
+				link: &fake.MockTransitGatewayRouteTableLinkClient{
+					MockGetLink: getLink(states.Available),
+					MockStates:  getStates,
+				},
+				cr: propagation(withSpec(params), withExternalName(routeTableID+":"+attachmentID)),
+			},
+			want: want{
+				cr: propagation(withSpec(params), withExternalName(routeTableID+":"+attachmentID),
+					withStatus(svcapitypes.TransitGatewayRouteTablePropagationObservation{
+						ResourceID:   vpcID,
+						ResourceType: "vpc",
+						State:        states.Available,
+					}),
+					withConditions(xpv1.Available())),
+				result: managed.ExternalObservation{
+					ResourceExists:   true,
+					ResourceUpToDate: true,
+				},
+			},
+		},
+		"Enabling": {
+			args: args{
+				link: &fake.MockTransitGatewayRouteTableLinkClient{
+					MockGetLink: getLink(states.Pending),
+					MockStates:  getStates,
+				},
+				cr: propagation(withSpec(params), withExternalName(routeTableID+":"+attachmentID)),
+			},
+			want: want{
+				cr: propagation(withSpec(params), withExternalName(routeTableID+":"+attachmentID),
+					withStatus(svcapitypes.TransitGatewayRouteTablePropagationObservation{
+						ResourceID:   vpcID,
+						ResourceType: "vpc",
+						State:        states.Pending,
+					}),
+					withConditions(xpv1.Creating())),
+				result: managed.ExternalObservation{
+					ResourceExists:   true,
+					ResourceUpToDate: true,
+				},
+			},
+		},
+		"Disabled": {
+			args: args{
+				link: &fake.MockTransitGatewayRouteTableLinkClient{
+					MockGetLink: getLink(states.Deleted),
+					MockStates:  getStates,
+				},
+				cr: propagation(withSpec(params), withExternalName(routeTableID+":"+attachmentID)),
+			},
+			want: want{
+				cr: propagation(withSpec(params), withExternalName(routeTableID+":"+attachmentID)),
+			},
+		},
+		"NotFound": {
+			args: args{
+				link: &fake.MockTransitGatewayRouteTableLinkClient{
+					MockGetLink: getLink(""),
+					MockStates:  getStates,
+				},
+				cr: propagation(withSpec(params), withExternalName(routeTableID+":"+attachmentID)),
+			},
+			want: want{
+				cr: propagation(withSpec(params), withExternalName(routeTableID+":"+attachmentID)),
+			},
+		},
+		"RouteTableNotFound": {
+			args: args{
+				link: &fake.MockTransitGatewayRouteTableLinkClient{
+					MockGetLink: func(ctx context.Context, rtb, attachment *string) (*ec2.TransitGatewayRouteTableLink, error) {
+						return nil, &smithy.GenericAPIError{Code: ec2.TransitGatewayRouteTableNotFound}
+					},
+				},
+				cr: propagation(withSpec(params), withExternalName(routeTableID+":"+attachmentID)),
+			},
+			want: want{
+				cr: propagation(withSpec(params), withExternalName(routeTableID+":"+attachmentID)),
+			},
+		},
+		"NoExternalName": {
+			args: args{
+				link: &fake.MockTransitGatewayRouteTableLinkClient{},
+				cr:   propagation(withSpec(params)),
+			},
+			want: want{
+				cr: propagation(withSpec(params)),
+			},
+		},
+		"GetFail": {
+			args: args{
+				link: &fake.MockTransitGatewayRouteTableLinkClient{
+					MockGetLink: func(ctx context.Context, rtb, attachment *string) (*ec2.TransitGatewayRouteTableLink, error) {
+						return nil, errBoom
+					},
+				},
+				cr: propagation(withSpec(params), withExternalName(routeTableID+":"+attachmentID)),
+			},
+			want: want{
+				cr:  propagation(withSpec(params), withExternalName(routeTableID+":"+attachmentID)),
+				err: awsclient.Wrap(errBoom, errDescribe),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{client: tc.link}
+			o, err := e.Observe(context.Background(), tc.args.cr)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.result, o); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestCreate(t *testing.T) {
+	type want struct {
+		cr  *svcapitypes.TransitGatewayRouteTablePropagation
+		err error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"Successful": {
+			args: args{
+				link: &fake.MockTransitGatewayRouteTableLinkClient{
+					MockCreateLink: func(ctx context.Context, rtb, attachment *string) error {
+						if diff := cmp.Diff(routeTableID+":"+attachmentID, aws.ToString(rtb)+":"+aws.ToString(attachment)); diff != "" {
+							t.Errorf("r: -want, +got:\n%s", diff)
+						}
+						return nil
+					},
+				},
+				cr: propagation(withSpec(params)),
+			},
+			want: want{
+				cr: propagation(withSpec(params), withExternalName(routeTableID+":"+attachmentID), withConditions(xpv1.Creating())),
+			},
+		},
+		"CreateFail": {
+			args: args{
+				link: &fake.MockTransitGatewayRouteTableLinkClient{
+					MockCreateLink: func(ctx context.Context, rtb, attachment *string) error {
+						return errBoom
+					},
+				},
+				cr: propagation(withSpec(params)),
+			},
+			want: want{
+				cr:  propagation(withSpec(params), withConditions(xpv1.Creating())),
+				err: awsclient.Wrap(errBoom, errCreate),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{client: tc.link}
+			_, err := e.Create(context.Background(), tc.args.cr)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestDelete(t *testing.T) {
+	type want struct {
+		err error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"Successful": {
+			args: args{
+				link: &fake.MockTransitGatewayRouteTableLinkClient{
+					MockDeleteLink: func(ctx context.Context, rtb, attachment *string) error {
+						if diff := cmp.Diff(routeTableID+":"+attachmentID, aws.ToString(rtb)+":"+aws.ToString(attachment)); diff != "" {
+							t.Errorf("r: -want, +got:\n%s", diff)
+						}
+						return nil
+					},
+					MockStates: getStates,
+				},
+				cr: propagation(withSpec(params), withExternalName(routeTableID+":"+attachmentID)),
+			},
+		},
+		"AlreadyDisabling": {
+			args: args{
+				link: &fake.MockTransitGatewayRouteTableLinkClient{
+					MockDeleteLink: func(ctx context.Context, rtb, attachment *string) error {
+						t.Errorf("a propagation that is being deleted must not be deleted again")
+						return nil
+					},
+					MockStates: getStates,
+				},
+				cr: propagation(withSpec(params), withExternalName(routeTableID+":"+attachmentID),
+					withStatus(svcapitypes.TransitGatewayRouteTablePropagationObservation{State: states.Deleting})),
+			},
+		},
+		"AttachmentNotFound": {
+			args: args{
+				link: &fake.MockTransitGatewayRouteTableLinkClient{
+					MockDeleteLink: func(ctx context.Context, rtb, attachment *string) error {
+						return &smithy.GenericAPIError{Code: ec2.TransitGatewayAttachmentNotFound}
+					},
+					MockStates: getStates,
+				},
+				cr: propagation(withSpec(params), withExternalName(routeTableID+":"+attachmentID)),
+			},
+		},
+		"DeleteFail": {
+			args: args{
+				link: &fake.MockTransitGatewayRouteTableLinkClient{
+					MockDeleteLink: func(ctx context.Context, rtb, attachment *string) error {
+						return errBoom
+					},
+					MockStates: getStates,
+				},
+				cr: propagation(withSpec(params), withExternalName(routeTableID+":"+attachmentID)),
+			},
+			want: want{
+				err: awsclient.Wrap(errBoom, errDelete),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{client: tc.link}
+			err := e.Delete(context.Background(), tc.args.cr)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
@@ -152,8 +152,8 @@ func (t *tagger) Initialize(ctx context.Context, mgd resource.Managed) error {
 	for _, t := range transitGatewayAttachmentTags.Tags {
 		tagMap[aws.StringValue(t.Key)] = aws.StringValue(t.Value)
 	}
-	for k, v := range resource.GetExternalTags(mgd) {
-		tagMap[k] = v
+	if err := awsclients.AddDefaultTags(ctx, t.kube, mgd, tagMap); err != nil {
+		return err
 	}
 	transitGatewayAttachmentTags.Tags = make([]*svcapitypes.Tag, len(tagMap))
 	transitGatewayAttachmentTags.ResourceType = aws.String("transit-gateway-attachment")
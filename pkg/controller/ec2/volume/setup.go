@@ -141,8 +141,8 @@ func (t *tagger) Initialize(ctx context.Context, mgd resource.Managed) error {
 
 	tagMap := cr.Spec.ForProvider.Tags
 	tagMap["Name"] = cr.Name
-	for k, v := range resource.GetExternalTags(mgd) {
-		tagMap[k] = v
+	if err := awsclients.AddDefaultTags(ctx, t.kube, mgd, tagMap); err != nil {
+		return err
 	}
 	volumeTags.Tags = make([]*svcapitypes.Tag, len(tagMap))
 	volumeTags.ResourceType = awsclients.String("volume")
@@ -369,8 +369,8 @@ func (t *tagger) Initialize(ctx context.Context, mgd cpresource.Managed) error {
 
 	tagMap := cr.Spec.ForProvider.Tags
 	tagMap["Name"] = cr.Name
-	for k, v := range cpresource.GetExternalTags(mgd) {
-		tagMap[k] = v
+	if err := awsclients.AddDefaultTags(ctx, t.kube, mgd, tagMap); err != nil {
+		return err
 	}
 	vpcEndpointTags.Tags = make([]*svcapitypes.Tag, len(tagMap))
 	vpcEndpointTags.ResourceType = aws.String("vpc-endpoint")
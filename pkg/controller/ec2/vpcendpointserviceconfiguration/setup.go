@@ -225,8 +225,8 @@ func (t *tagger) Initialize(ctx context.Context, mgd cpresource.Managed) error {
 	for _, t := range cr.Spec.ForProvider.Tags {
 		tagMap[aws.StringValue(t.Key)] = aws.StringValue(t.Value)
 	}
-	for k, v := range cpresource.GetExternalTags(mgd) {
-		tagMap[k] = v
+	if err := awsclients.AddDefaultTags(ctx, t.kube, mgd, tagMap); err != nil {
+		return err
 	}
 	vpcEndpointTags.Tags = make([]*svcapitypes.Tag, len(tagMap))
 	vpcEndpointTags.ResourceType = aws.String("vpc-endpoint-service")
@@ -247,8 +247,8 @@ func (t *tagger) Initialize(ctx context.Context, mgd resource.Managed) error {
 	for _, t := range cr.Spec.ForProvider.Tags {
 		tagMap[aws.StringValue(t.Key)] = aws.StringValue(t.Value)
 	}
-	for k, v := range resource.GetExternalTags(mgd) {
-		tagMap[k] = v
+	if err := awsclients.AddDefaultTags(ctx, t.kube, mgd, tagMap); err != nil {
+		return err
 	}
 	vpcPeeringConnectionTags.Tags = make([]*svcapitypes.Tag, len(tagMap))
 	vpcPeeringConnectionTags.ResourceType = aws.String("vpc-peering-connection")
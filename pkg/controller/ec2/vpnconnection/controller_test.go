@@ -0,0 +1,457 @@
+/*
+Copyright 2023 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package vpnconnection
+
+import (
+	"context"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	awsec2 "github.com/aws/aws-sdk-go-v2/service/ec2"
+	awsec2types "github.com/aws/aws-sdk-go-v2/service/ec2/types"
+	"github.com/aws/smithy-go"
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+
+	"github.com/crossplane-contrib/provider-aws/apis/ec2/manualv1alpha1"
+	awsclient "github.com/crossplane-contrib/provider-aws/pkg/clients"
+	"github.com/crossplane-contrib/provider-aws/pkg/clients/ec2"
+	"github.com/crossplane-contrib/provider-aws/pkg/clients/ec2/fake"
+)
+
+var (
+	vpnID   = "some vpn"
+	cgwID   = "some cgw"
+	vgwID   = "some vgw"
+	cidr    = "10.0.0.0/16"
+	oldCidr = "10.1.0.0/16"
+	errBoom = errors.New("boom")
+
+	tunnelOptions = &awsec2types.VpnConnectionOptions{
+		StaticRoutesOnly: aws.Bool(true),
+		TunnelOptions: []awsec2types.TunnelOption{
+			{OutsideIpAddress: aws.String("1.1.1.1"), PreSharedKey: aws.String("key1")},
+			{OutsideIpAddress: aws.String("2.2.2.2"), PreSharedKey: aws.String("key2")},
+		},
+	}
+	tunnelDetails = managed.ConnectionDetails{
+		"tunnel1Address":      []byte("1.1.1.1"),
+		"tunnel1PreSharedKey": []byte("key1"),
+		"tunnel2Address":      []byte("2.2.2.2"),
+		"tunnel2PreSharedKey": []byte("key2"),
+	}
+)
+
+type args struct {
+	vpn ec2.VPNConnectionClient
+	cr  *manualv1alpha1.VPNConnection
+}
+
+type vpnModifier func(*manualv1alpha1.VPNConnection)
+
+func withExternalName(name string) vpnModifier {
+	return func(r *manualv1alpha1.VPNConnection) { meta.SetExternalName(r, name) }
+}
+
+func withSpec(p manualv1alpha1.VPNConnectionParameters) vpnModifier {
+	return func(r *manualv1alpha1.VPNConnection) { r.Spec.ForProvider = p }
+}
+
+func withStatus(s manualv1alpha1.VPNConnectionObservation) vpnModifier {
+	return func(r *manualv1alpha1.VPNConnection) { r.Status.AtProvider = s }
+}
+
+func withConditions(c ...xpv1.Condition) vpnModifier {
+	return func(r *manualv1alpha1.VPNConnection) { r.Status.ConditionedStatus.Conditions = c }
+}
+
+func vpn(m ...vpnModifier) *manualv1alpha1.VPNConnection {
+	cr := &manualv1alpha1.VPNConnection{}
+	for _, f := range m {
+		f(cr)
+	}
+	return cr
+}
+
+func describeVPNConnection(state awsec2types.VpnState, routes ...awsec2types.VpnStaticRoute) func(ctx context.Context, input *awsec2.DescribeVpnConnectionsInput, opts []func(*awsec2.Options)) (*awsec2.DescribeVpnConnectionsOutput, error) {
+	return func(ctx context.Context, input *awsec2.DescribeVpnConnectionsInput, opts []func(*awsec2.Options)) (*awsec2.DescribeVpnConnectionsOutput, error) {
+		return &awsec2.DescribeVpnConnectionsOutput{
+			VpnConnections: []awsec2types.VpnConnection{{
+				VpnConnectionId:   aws.String(vpnID),
+				CustomerGatewayId: aws.String(cgwID),
+				VpnGatewayId:      aws.String(vgwID),
+				State:             state,
+				Options:           tunnelOptions,
+				Routes:            routes,
+			}},
+		}, nil
+	}
+}
+
+var _ managed.ExternalClient = &external{}
+var _ managed.ExternalConnecter = &connector{}
+
+func TestObserve(t *testing.T) {
+	type want struct {
+		cr     *manualv1alpha1.VPNConnection
+		result managed.ExternalObservation
+		err    error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"SuccessfulAvailable": {
+			args: args{
+				vpn: &fake.MockVPNConnectionClient{
+					MockDescribe: describeVPNConnection(awsec2types.VpnStateAvailable, awsec2types.VpnStaticRoute{
+						DestinationCidrBlock: aws.String(cidr),
+						State:                awsec2types.VpnStateAvailable,
+					}),
+				},
+				cr: vpn(withSpec(manualv1alpha1.VPNConnectionParameters{
+					StaticRoutesOnly: aws.Bool(true),
+					StaticRoutes:     []string{cidr},
+				}), withExternalName(vpnID)),
+			},
+			want: want{
+				cr: vpn(withSpec(manualv1alpha1.VPNConnectionParameters{
+					StaticRoutesOnly: aws.Bool(true),
+					StaticRoutes:     []string{cidr},
+				}), withExternalName(vpnID), withStatus(manualv1alpha1.VPNConnectionObservation{
+					VPNConnectionID: vpnID,
+					State:           "available",
+					Routes:          []manualv1alpha1.VPNStaticRoute{{DestinationCIDRBlock: cidr, State: "available"}},
+				}), withConditions(xpv1.Available())),
+				result: managed.ExternalObservation{
+					ResourceExists:    true,
+					ResourceUpToDate:  true,
+					ConnectionDetails: tunnelDetails,
+				},
+			},
+		},
+		"RoutesChanged": {
+			args: args{
+				vpn: &fake.MockVPNConnectionClient{
+					MockDescribe: describeVPNConnection(awsec2types.VpnStatePending),
+				},
+				cr: vpn(withSpec(manualv1alpha1.VPNConnectionParameters{
+					StaticRoutes: []string{cidr},
+				}), withExternalName(vpnID)),
+			},
+			want: want{
+				cr: vpn(withSpec(manualv1alpha1.VPNConnectionParameters{
+					StaticRoutesOnly: aws.Bool(true),
+					StaticRoutes:     []string{cidr},
+				}), withExternalName(vpnID), withStatus(manualv1alpha1.VPNConnectionObservation{
+					VPNConnectionID: vpnID,
+					State:           "pending",
+				}), withConditions(xpv1.Creating())),
+				result: managed.ExternalObservation{
+					ResourceExists:          true,
+					ResourceUpToDate:        false,
+					ResourceLateInitialized: true,
+					ConnectionDetails:       tunnelDetails,
+				},
+			},
+		},
+		"Deleted": {
+			args: args{
+				vpn: &fake.MockVPNConnectionClient{
+					MockDescribe: describeVPNConnection(awsec2types.VpnStateDeleted),
+				},
+				cr: vpn(withExternalName(vpnID)),
+			},
+			want: want{
+				cr: vpn(withExternalName(vpnID)),
+			},
+		},
+		"NotFound": {
+			args: args{
+				vpn: &fake.MockVPNConnectionClient{
+					MockDescribe: func(ctx context.Context, input *awsec2.DescribeVpnConnectionsInput, opts []func(*awsec2.Options)) (*awsec2.DescribeVpnConnectionsOutput, error) {
+						return nil, &smithy.GenericAPIError{Code: ec2.VPNConnectionNotFound}
+					},
+				},
+				cr: vpn(withExternalName(vpnID)),
+			},
+			want: want{
+				cr: vpn(withExternalName(vpnID)),
+			},
+		},
+		"DescribeFail": {
+			args: args{
+				vpn: &fake.MockVPNConnectionClient{
+					MockDescribe: func(ctx context.Context, input *awsec2.DescribeVpnConnectionsInput, opts []func(*awsec2.Options)) (*awsec2.DescribeVpnConnectionsOutput, error) {
+						return nil, errBoom
+					},
+				},
+				cr: vpn(withExternalName(vpnID)),
+			},
+			want: want{
+				cr:  vpn(withExternalName(vpnID)),
+				err: awsclient.Wrap(errBoom, errDescribe),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{client: tc.vpn}
+			o, err := e.Observe(context.Background(), tc.args.cr)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.result, o); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestCreate(t *testing.T) {
+	type want struct {
+		cr     *manualv1alpha1.VPNConnection
+		result managed.ExternalCreation
+		err    error
+	}
+
+	params := manualv1alpha1.VPNConnectionParameters{
+		Type:              "ipsec.1",
+		CustomerGatewayID: aws.String(cgwID),
+		VPNGatewayID:      aws.String(vgwID),
+		StaticRoutesOnly:  aws.Bool(true),
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"Successful": {
+			args: args{
+				vpn: &fake.MockVPNConnectionClient{
+					MockCreate: func(ctx context.Context, input *awsec2.CreateVpnConnectionInput, opts []func(*awsec2.Options)) (*awsec2.CreateVpnConnectionOutput, error) {
+						if diff := cmp.Diff(true, aws.ToBool(input.Options.StaticRoutesOnly)); diff != "" {
+							t.Errorf("r: -want, +got:\n%s", diff)
+						}
+						return &awsec2.CreateVpnConnectionOutput{
+							VpnConnection: &awsec2types.VpnConnection{
+								VpnConnectionId: aws.String(vpnID),
+								Options:         tunnelOptions,
+							},
+						}, nil
+					},
+				},
+				cr: vpn(withSpec(params)),
+			},
+			want: want{
+				cr: vpn(withSpec(params), withExternalName(vpnID)),
+				result: managed.ExternalCreation{
+					ConnectionDetails: tunnelDetails,
+				},
+			},
+		},
+		"CreateFail": {
+			args: args{
+				vpn: &fake.MockVPNConnectionClient{
+					MockCreate: func(ctx context.Context, input *awsec2.CreateVpnConnectionInput, opts []func(*awsec2.Options)) (*awsec2.CreateVpnConnectionOutput, error) {
+						return nil, errBoom
+					},
+				},
+				cr: vpn(),
+			},
+			want: want{
+				cr:  vpn(),
+				err: awsclient.Wrap(errBoom, errCreate),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{client: tc.vpn}
+			o, err := e.Create(context.Background(), tc.args.cr)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.result, o); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestUpdate(t *testing.T) {
+	type want struct {
+		err error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"SuccessfulRoutes": {
+			args: args{
+				vpn: &fake.MockVPNConnectionClient{
+					MockDescribe: describeVPNConnection(awsec2types.VpnStateAvailable, awsec2types.VpnStaticRoute{
+						DestinationCidrBlock: aws.String(oldCidr),
+						State:                awsec2types.VpnStateAvailable,
+					}),
+					MockCreateRoute: func(ctx context.Context, input *awsec2.CreateVpnConnectionRouteInput, opts []func(*awsec2.Options)) (*awsec2.CreateVpnConnectionRouteOutput, error) {
+						if diff := cmp.Diff(cidr, aws.ToString(input.DestinationCidrBlock)); diff != "" {
+							t.Errorf("r: -want, +got:\n%s", diff)
+						}
+						return &awsec2.CreateVpnConnectionRouteOutput{}, nil
+					},
+					MockDeleteRoute: func(ctx context.Context, input *awsec2.DeleteVpnConnectionRouteInput, opts []func(*awsec2.Options)) (*awsec2.DeleteVpnConnectionRouteOutput, error) {
+						if diff := cmp.Diff(oldCidr, aws.ToString(input.DestinationCidrBlock)); diff != "" {
+							t.Errorf("r: -want, +got:\n%s", diff)
+						}
+						return &awsec2.DeleteVpnConnectionRouteOutput{}, nil
+					},
+				},
+				cr: vpn(withSpec(manualv1alpha1.VPNConnectionParameters{
+					StaticRoutes: []string{cidr},
+				}), withExternalName(vpnID)),
+			},
+		},
+		"CreateRouteFail": {
+			args: args{
+				vpn: &fake.MockVPNConnectionClient{
+					MockDescribe: describeVPNConnection(awsec2types.VpnStateAvailable),
+					MockCreateRoute: func(ctx context.Context, input *awsec2.CreateVpnConnectionRouteInput, opts []func(*awsec2.Options)) (*awsec2.CreateVpnConnectionRouteOutput, error) {
+						return nil, errBoom
+					},
+				},
+				cr: vpn(withSpec(manualv1alpha1.VPNConnectionParameters{
+					StaticRoutes: []string{cidr},
+				}), withExternalName(vpnID)),
+			},
+			want: want{
+				err: awsclient.Wrap(errBoom, errCreateRoute),
+			},
+		},
+		"CreateTagsFail": {
+			args: args{
+				vpn: &fake.MockVPNConnectionClient{
+					MockDescribe: describeVPNConnection(awsec2types.VpnStateAvailable),
+					MockCreateTags: func(ctx context.Context, input *awsec2.CreateTagsInput, opts []func(*awsec2.Options)) (*awsec2.CreateTagsOutput, error) {
+						return nil, errBoom
+					},
+				},
+				cr: vpn(withSpec(manualv1alpha1.VPNConnectionParameters{
+					Tags: []manualv1alpha1.Tag{{Key: "k", Value: "v"}},
+				}), withExternalName(vpnID)),
+			},
+			want: want{
+				err: awsclient.Wrap(errBoom, errCreateTags),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{client: tc.vpn}
+			_, err := e.Update(context.Background(), tc.args.cr)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestDelete(t *testing.T) {
+	type want struct {
+		cr  *manualv1alpha1.VPNConnection
+		err error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"Successful": {
+			args: args{
+				vpn: &fake.MockVPNConnectionClient{
+					MockDelete: func(ctx context.Context, input *awsec2.DeleteVpnConnectionInput, opts []func(*awsec2.Options)) (*awsec2.DeleteVpnConnectionOutput, error) {
+						return &awsec2.DeleteVpnConnectionOutput{}, nil
+					},
+				},
+				cr: vpn(withExternalName(vpnID)),
+			},
+			want: want{
+				cr: vpn(withExternalName(vpnID), withConditions(xpv1.Deleting())),
+			},
+		},
+		"NotFound": {
+			args: args{
+				vpn: &fake.MockVPNConnectionClient{
+					MockDelete: func(ctx context.Context, input *awsec2.DeleteVpnConnectionInput, opts []func(*awsec2.Options)) (*awsec2.DeleteVpnConnectionOutput, error) {
+						return nil, &smithy.GenericAPIError{Code: ec2.VPNConnectionNotFound}
+					},
+				},
+				cr: vpn(withExternalName(vpnID)),
+			},
+			want: want{
+				cr: vpn(withExternalName(vpnID), withConditions(xpv1.Deleting())),
+			},
+		},
+		"DeleteFail": {
+			args: args{
+				vpn: &fake.MockVPNConnectionClient{
+					MockDelete: func(ctx context.Context, input *awsec2.DeleteVpnConnectionInput, opts []func(*awsec2.Options)) (*awsec2.DeleteVpnConnectionOutput, error) {
+						return nil, errBoom
+					},
+				},
+				cr: vpn(withExternalName(vpnID)),
+			},
+			want: want{
+				cr:  vpn(withExternalName(vpnID), withConditions(xpv1.Deleting())),
+				err: awsclient.Wrap(errBoom, errDelete),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{client: tc.vpn}
+			err := e.Delete(context.Background(), tc.args.cr)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,319 @@
+/*
+Copyright 2023 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package vpngateway
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	awsec2 "github.com/aws/aws-sdk-go-v2/service/ec2"
+	awsec2types "github.com/aws/aws-sdk-go-v2/service/ec2/types"
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/connection"
+	"github.com/crossplane/crossplane-runtime/pkg/controller"
+	"github.com/crossplane/crossplane-runtime/pkg/event"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+
+	"github.com/crossplane-contrib/provider-aws/apis/ec2/manualv1alpha1"
+	"github.com/crossplane-contrib/provider-aws/apis/v1alpha1"
+	awsclient "github.com/crossplane-contrib/provider-aws/pkg/clients"
+	"github.com/crossplane-contrib/provider-aws/pkg/clients/ec2"
+	"github.com/crossplane-contrib/provider-aws/pkg/features"
+)
+
+const (
+	errUnexpectedObject = "The managed resource is not a VPNGateway resource"
+
+	errDescribe            = "failed to describe VPNGateway"
+	errMultipleItems       = "retrieved multiple VPNGateways for the given vpnGatewayId"
+	errCreate              = "failed to create the VPNGateway resource"
+	errDelete              = "failed to delete the VPNGateway resource"
+	errAttach              = "failed to attach the VPNGateway to the VPC"
+	errDetach              = "failed to detach the VPNGateway from the VPC"
+	errDescribeRouteTables = "failed to describe the route tables of the attached VPC"
+	errEnablePropagation   = "failed to enable route propagation of the VPNGateway"
+	errDisablePropagation  = "failed to disable route propagation of the VPNGateway"
+	errCreateTags          = "failed to create tags for the VPNGateway resource"
+	errDeleteTags          = "failed to delete tags for the VPNGateway resource"
+)
+
+// SetupVPNGateway adds a controller that reconciles VPNGateways.
+func SetupVPNGateway(mgr ctrl.Manager, o controller.Options) error {
+	name := managed.ControllerName(manualv1alpha1.VPNGatewayGroupKind)
+
+	cps := []managed.ConnectionPublisher{managed.NewAPISecretPublisher(mgr.GetClient(), mgr.GetScheme())}
+	if o.Features.Enabled(features.EnableAlphaExternalSecretStores) {
+		cps = append(cps, connection.NewDetailsManager(mgr.GetClient(), v1alpha1.StoreConfigGroupVersionKind))
+	}
+
+	return ctrl.NewControllerManagedBy(mgr).
+		Named(name).
+		WithOptions(o.ForControllerRuntime()).
+		WithEventFilter(resource.DesiredStateChanged()).
+		For(&manualv1alpha1.VPNGateway{}).
+		Complete(managed.NewReconciler(mgr,
+			resource.ManagedKind(manualv1alpha1.VPNGatewayGroupVersionKind),
+			managed.WithExternalConnecter(&connector{kube: mgr.GetClient(), newClientFn: ec2.NewVPNGatewayClient}),
+			managed.WithReferenceResolver(managed.NewAPISimpleReferenceResolver(mgr.GetClient())),
+			managed.WithInitializers(),
+			managed.WithPollInterval(o.PollInterval),
+			managed.WithLogger(o.Logger.WithValues("controller", name)),
+			managed.WithRecorder(event.NewAPIRecorder(mgr.GetEventRecorderFor(name))),
+			managed.WithConnectionPublishers(cps...)))
+}
+
+type connector struct {
+	kube        client.Client
+	newClientFn func(config aws.Config) ec2.VPNGatewayClient
+}
+
+func (c *connector) Connect(ctx context.Context, mg resource.Managed) (managed.ExternalClient, error) {
+	cr, ok := mg.(*manualv1alpha1.VPNGateway)
+	if !ok {
+		return nil, errors.New(errUnexpectedObject)
+	}
+	cfg, err := awsclient.GetConfig(ctx, c.kube, mg, cr.Spec.ForProvider.Region)
+	if err != nil {
+		return nil, err
+	}
+	return &external{client: c.newClientFn(*cfg)}, nil
+}
+
+type external struct {
+	client ec2.VPNGatewayClient
+}
+
+func (e *external) describe(ctx context.Context, id string) (*awsec2types.VpnGateway, error) {
+	response, err := e.client.DescribeVpnGateways(ctx, &awsec2.DescribeVpnGatewaysInput{
+		VpnGatewayIds: []string{id},
+	})
+	if err != nil {
+		return nil, err
+	}
+	// in a successful response, there should be one and only one object
+	if len(response.VpnGateways) != 1 {
+		return nil, errors.New(errMultipleItems)
+	}
+	return &response.VpnGateways[0], nil
+}
+
+// propagatingRouteTables returns the IDs of the route tables of the VPC the
+// virtual private gateway is attached to that its routes are propagated to.
+func (e *external) propagatingRouteTables(ctx context.Context, gw awsec2types.VpnGateway) ([]string, error) {
+	a := ec2.FindVPNGatewayVPCAttachment(gw)
+	if a == nil || a.State != awsec2types.AttachmentStatusAttached {
+		return nil, nil
+	}
+	response, err := e.client.DescribeRouteTables(ctx, &awsec2.DescribeRouteTablesInput{
+		Filters: []awsec2types.Filter{{
+			Name:   aws.String("vpc-id"),
+			Values: []string{aws.ToString(a.VpcId)},
+		}},
+	})
+	if err != nil {
+		return nil, awsclient.Wrap(err, errDescribeRouteTables)
+	}
+	return ec2.FindPropagatingRouteTables(aws.ToString(gw.VpnGatewayId), response.RouteTables), nil
+}
+
+func (e *external) Observe(ctx context.Context, mgd resource.Managed) (managed.ExternalObservation, error) {
+	cr, ok := mgd.(*manualv1alpha1.VPNGateway)
+	if !ok {
+		return managed.ExternalObservation{}, errors.New(errUnexpectedObject)
+	}
+	if meta.GetExternalName(cr) == "" {
+		return managed.ExternalObservation{
+			ResourceExists: false,
+		}, nil
+	}
+
+	observed, err := e.describe(ctx, meta.GetExternalName(cr))
+	if err != nil {
+		return managed.ExternalObservation{}, awsclient.Wrap(resource.Ignore(ec2.IsVPNGatewayNotFoundErr, err), errDescribe)
+	}
+
+	// deleted virtual private gateways are still described for a while.
+	if observed.State == awsec2types.VpnStateDeleted {
+		return managed.ExternalObservation{
+			ResourceExists: false,
+		}, nil
+	}
+
+	propagating, err := e.propagatingRouteTables(ctx, *observed)
+	if err != nil {
+		return managed.ExternalObservation{}, err
+	}
+
+	current := cr.Spec.ForProvider.DeepCopy()
+	ec2.LateInitializeVPNGateway(&cr.Spec.ForProvider, observed)
+
+	cr.Status.AtProvider = ec2.GenerateVPNGatewayObservation(*observed, propagating)
+	cr.SetConditions(ec2.VPNStateCondition(cr.Status.AtProvider.State))
+
+	return managed.ExternalObservation{
+		ResourceExists:          true,
+		ResourceUpToDate:        ec2.IsVPNGatewayUpToDate(cr.Spec.ForProvider, *observed, propagating),
+		ResourceLateInitialized: !cmp.Equal(current, &cr.Spec.ForProvider),
+	}, nil
+}
+
+func (e *external) Create(ctx context.Context, mgd resource.Managed) (managed.ExternalCreation, error) {
+	cr, ok := mgd.(*manualv1alpha1.VPNGateway)
+	if !ok {
+		return managed.ExternalCreation{}, errors.New(errUnexpectedObject)
+	}
+	result, err := e.client.CreateVpnGateway(ctx, ec2.GenerateCreateVPNGatewayInput(cr.Spec.ForProvider))
+	if err != nil {
+		return managed.ExternalCreation{}, awsclient.Wrap(err, errCreate)
+	}
+	// the VPC is attached on the following update.
+	meta.SetExternalName(cr, aws.ToString(result.VpnGateway.VpnGatewayId))
+	return managed.ExternalCreation{}, nil
+}
+
+func (e *external) Update(ctx context.Context, mgd resource.Managed) (managed.ExternalUpdate, error) {
+	cr, ok := mgd.(*manualv1alpha1.VPNGateway)
+	if !ok {
+		return managed.ExternalUpdate{}, errors.New(errUnexpectedObject)
+	}
+	id := meta.GetExternalName(cr)
+
+	gw, err := e.describe(ctx, id)
+	if err != nil {
+		return managed.ExternalUpdate{}, awsclient.Wrap(err, errDescribe)
+	}
+
+	if err := e.updateTags(ctx, id, cr.Spec.ForProvider.Tags, gw.Tags); err != nil {
+		return managed.ExternalUpdate{}, err
+	}
+
+	// a virtual private gateway can only be attached to a single VPC, so
+	// the current attachment has to be detached before attaching a new one.
+	vpcID := cr.Spec.ForProvider.VPCID
+	a := ec2.FindVPNGatewayVPCAttachment(*gw)
+	switch {
+	case a != nil && aws.ToString(a.VpcId) != aws.ToString(vpcID):
+		if a.State != awsec2types.AttachmentStatusDetaching {
+			if _, err := e.client.DetachVpnGateway(ctx, &awsec2.DetachVpnGatewayInput{
+				VpnGatewayId: aws.String(id),
+				VpcId:        a.VpcId,
+			}); err != nil {
+				return managed.ExternalUpdate{}, awsclient.Wrap(err, errDetach)
+			}
+		}
+		return managed.ExternalUpdate{}, nil
+	case a == nil && vpcID != nil:
+		_, err := e.client.AttachVpnGateway(ctx, &awsec2.AttachVpnGatewayInput{
+			VpnGatewayId: aws.String(id),
+			VpcId:        vpcID,
+		})
+		return managed.ExternalUpdate{}, awsclient.Wrap(err, errAttach)
+	case a == nil || a.State != awsec2types.AttachmentStatusAttached:
+		return managed.ExternalUpdate{}, nil
+	}
+
+	propagating, err := e.propagatingRouteTables(ctx, *gw)
+	if err != nil {
+		return managed.ExternalUpdate{}, err
+	}
+	enable, disable := ec2.DiffVPNGatewayRoutePropagation(cr.Spec.ForProvider.RouteTableIDs, propagating)
+	for _, rt := range enable {
+		if _, err := e.client.EnableVgwRoutePropagation(ctx, &awsec2.EnableVgwRoutePropagationInput{
+			GatewayId:    aws.String(id),
+			RouteTableId: aws.String(rt),
+		}); err != nil {
+			return managed.ExternalUpdate{}, awsclient.Wrap(err, errEnablePropagation)
+		}
+	}
+	for _, rt := range disable {
+		if _, err := e.client.DisableVgwRoutePropagation(ctx, &awsec2.DisableVgwRoutePropagationInput{
+			GatewayId:    aws.String(id),
+			RouteTableId: aws.String(rt),
+		}); err != nil {
+			return managed.ExternalUpdate{}, awsclient.Wrap(err, errDisablePropagation)
+		}
+	}
+
+	return managed.ExternalUpdate{}, nil
+}
+
+func (e *external) Delete(ctx context.Context, mgd resource.Managed) error {
+	cr, ok := mgd.(*manualv1alpha1.VPNGateway)
+	if !ok {
+		return errors.New(errUnexpectedObject)
+	}
+
+	cr.Status.SetConditions(xpv1.Deleting())
+	if cr.Status.AtProvider.State == string(awsec2types.VpnStateDeleting) {
+		return nil
+	}
+
+	// the virtual private gateway has to be detached from its VPC before
+	// it can be deleted.
+	attached := false
+	for _, a := range cr.Status.AtProvider.VPCAttachments {
+		switch awsec2types.AttachmentStatus(a.State) {
+		case awsec2types.AttachmentStatusDetached:
+			continue
+		case awsec2types.AttachmentStatusAttaching, awsec2types.AttachmentStatusAttached:
+			if _, err := e.client.DetachVpnGateway(ctx, &awsec2.DetachVpnGatewayInput{
+				VpnGatewayId: aws.String(meta.GetExternalName(cr)),
+				VpcId:        aws.String(a.VPCID),
+			}); err != nil {
+				return awsclient.Wrap(err, errDetach)
+			}
+		}
+		attached = true
+	}
+	if attached {
+		return nil
+	}
+
+	_, err := e.client.DeleteVpnGateway(ctx, &awsec2.DeleteVpnGatewayInput{
+		VpnGatewayId: aws.String(meta.GetExternalName(cr)),
+	})
+
+	return awsclient.Wrap(resource.Ignore(ec2.IsVPNGatewayNotFoundErr, err), errDelete)
+}
+
+func (e *external) updateTags(ctx context.Context, id string, desired []manualv1alpha1.Tag, observed []awsec2types.Tag) error {
+	addTags, removeTags := awsclient.DiffEC2Tags(manualv1alpha1.GenerateEC2Tags(desired), observed)
+	if len(addTags) > 0 {
+		if _, err := e.client.CreateTags(ctx, &awsec2.CreateTagsInput{
+			Resources: []string{id},
+			Tags:      addTags,
+		}); err != nil {
+			return awsclient.Wrap(err, errCreateTags)
+		}
+	}
+	if len(removeTags) > 0 {
+		if _, err := e.client.DeleteTags(ctx, &awsec2.DeleteTagsInput{
+			Resources: []string{id},
+			Tags:      removeTags,
+		}); err != nil {
+			return awsclient.Wrap(err, errDeleteTags)
+		}
+	}
+	return nil
+}
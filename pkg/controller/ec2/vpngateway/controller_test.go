@@ -0,0 +1,533 @@
+/*
+Copyright 2023 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package vpngateway
+
+import (
+	"context"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	awsec2 "github.com/aws/aws-sdk-go-v2/service/ec2"
+	awsec2types "github.com/aws/aws-sdk-go-v2/service/ec2/types"
+	"github.com/aws/smithy-go"
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+
+	"github.com/crossplane-contrib/provider-aws/apis/ec2/manualv1alpha1"
+	awsclient "github.com/crossplane-contrib/provider-aws/pkg/clients"
+	"github.com/crossplane-contrib/provider-aws/pkg/clients/ec2"
+	"github.com/crossplane-contrib/provider-aws/pkg/clients/ec2/fake"
+)
+
+var (
+	vgwID   = "some vgw"
+	vpcID   = "some vpc"
+	otherID = "other vpc"
+	rtbID   = "some route table"
+	oldRtb  = "old route table"
+	asn     = int64(64512)
+	errBoom = errors.New("boom")
+)
+
+type args struct {
+	vgw ec2.VPNGatewayClient
+	cr  *manualv1alpha1.VPNGateway
+}
+
+type vgwModifier func(*manualv1alpha1.VPNGateway)
+
+func withExternalName(name string) vgwModifier {
+	return func(r *manualv1alpha1.VPNGateway) { meta.SetExternalName(r, name) }
+}
+
+func withSpec(p manualv1alpha1.VPNGatewayParameters) vgwModifier {
+	return func(r *manualv1alpha1.VPNGateway) { r.Spec.ForProvider = p }
+}
+
+func withStatus(s manualv1alpha1.VPNGatewayObservation) vgwModifier {
+	return func(r *manualv1alpha1.VPNGateway) { r.Status.AtProvider = s }
+}
+
+func withConditions(c ...xpv1.Condition) vgwModifier {
+	return func(r *manualv1alpha1.VPNGateway) { r.Status.ConditionedStatus.Conditions = c }
+}
+
+func vgw(m ...vgwModifier) *manualv1alpha1.VPNGateway {
+	cr := &manualv1alpha1.VPNGateway{}
+	for _, f := range m {
+		f(cr)
+	}
+	return cr
+}
+
+func describeVPNGateway(state awsec2types.VpnState, attachments ...awsec2types.VpcAttachment) func(ctx context.Context, input *awsec2.DescribeVpnGatewaysInput, opts []func(*awsec2.Options)) (*awsec2.DescribeVpnGatewaysOutput, error) {
+	return func(ctx context.Context, input *awsec2.DescribeVpnGatewaysInput, opts []func(*awsec2.Options)) (*awsec2.DescribeVpnGatewaysOutput, error) {
+		return &awsec2.DescribeVpnGatewaysOutput{
+			VpnGateways: []awsec2types.VpnGateway{{
+				VpnGatewayId:   aws.String(vgwID),
+				AmazonSideAsn:  aws.Int64(asn),
+				State:          state,
+				VpcAttachments: attachments,
+			}},
+		}, nil
+	}
+}
+
+func describeRouteTables(ctx context.Context, input *awsec2.DescribeRouteTablesInput, opts []func(*awsec2.Options)) (*awsec2.DescribeRouteTablesOutput, error) {
+	return &awsec2.DescribeRouteTablesOutput{
+		RouteTables: []awsec2types.RouteTable{
+			{RouteTableId: aws.String(rtbID)},
+			{RouteTableId: aws.String(oldRtb), PropagatingVgws: []awsec2types.PropagatingVgw{{GatewayId: aws.String(vgwID)}}},
+		},
+	}, nil
+}
+
+var _ managed.ExternalClient = &external{}
+var _ managed.ExternalConnecter = &connector{}
+
+func TestObserve(t *testing.T) {
+	type want struct {
+		cr     *manualv1alpha1.VPNGateway
+		result managed.ExternalObservation
+		err    error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"SuccessfulAttached": {
+			args: args{
+				vgw: &fake.MockVPNGatewayClient{
+					MockDescribe: describeVPNGateway(awsec2types.VpnStateAvailable, awsec2types.VpcAttachment{
+						VpcId: aws.String(vpcID),
+						State: awsec2types.AttachmentStatusAttached,
+					}),
+					MockDescribeRouteTables: describeRouteTables,
+				},
+				cr: vgw(withSpec(manualv1alpha1.VPNGatewayParameters{
+					AmazonSideASN: aws.Int64(asn),
+					VPCID:         aws.String(vpcID),
+					RouteTableIDs: []string{oldRtb},
+				}), withExternalName(vgwID)),
+			},
+			want: want{
+				cr: vgw(withSpec(manualv1alpha1.VPNGatewayParameters{
+					AmazonSideASN: aws.Int64(asn),
+					VPCID:         aws.String(vpcID),
+					RouteTableIDs: []string{oldRtb},
+				}), withExternalName(vgwID), withStatus(manualv1alpha1.VPNGatewayObservation{
+					VPNGatewayID:             vgwID,
+					AmazonSideASN:            aws.Int64(asn),
+					State:                    "available",
+					VPCAttachments:           []manualv1alpha1.VPNGatewayVPCAttachment{{VPCID: vpcID, State: "attached"}},
+					PropagatingRouteTableIDs: []string{oldRtb},
+				}), withConditions(xpv1.Available())),
+				result: managed.ExternalObservation{
+					ResourceExists:   true,
+					ResourceUpToDate: true,
+				},
+			},
+		},
+		"PropagationChanged": {
+			args: args{
+				vgw: &fake.MockVPNGatewayClient{
+					MockDescribe: describeVPNGateway(awsec2types.VpnStateAvailable, awsec2types.VpcAttachment{
+						VpcId: aws.String(vpcID),
+						State: awsec2types.AttachmentStatusAttached,
+					}),
+					MockDescribeRouteTables: describeRouteTables,
+				},
+				cr: vgw(withSpec(manualv1alpha1.VPNGatewayParameters{
+					AmazonSideASN: aws.Int64(asn),
+					VPCID:         aws.String(vpcID),
+					RouteTableIDs: []string{rtbID},
+				}), withExternalName(vgwID)),
+			},
+			want: want{
+				cr: vgw(withSpec(manualv1alpha1.VPNGatewayParameters{
+					AmazonSideASN: aws.Int64(asn),
+					VPCID:         aws.String(vpcID),
+					RouteTableIDs: []string{rtbID},
+				}), withExternalName(vgwID), withStatus(manualv1alpha1.VPNGatewayObservation{
+					VPNGatewayID:             vgwID,
+					AmazonSideASN:            aws.Int64(asn),
+					State:                    "available",
+					VPCAttachments:           []manualv1alpha1.VPNGatewayVPCAttachment{{VPCID: vpcID, State: "attached"}},
+					PropagatingRouteTableIDs: []string{oldRtb},
+				}), withConditions(xpv1.Available())),
+				result: managed.ExternalObservation{
+					ResourceExists:   true,
+					ResourceUpToDate: false,
+				},
+			},
+		},
+		"NotAttached": {
+			args: args{
+				vgw: &fake.MockVPNGatewayClient{
+					MockDescribe: describeVPNGateway(awsec2types.VpnStateAvailable),
+				},
+				cr: vgw(withSpec(manualv1alpha1.VPNGatewayParameters{
+					VPCID: aws.String(vpcID),
+				}), withExternalName(vgwID)),
+			},
+			want: want{
+				cr: vgw(withSpec(manualv1alpha1.VPNGatewayParameters{
+					AmazonSideASN: aws.Int64(asn),
+					VPCID:         aws.String(vpcID),
+				}), withExternalName(vgwID), withStatus(manualv1alpha1.VPNGatewayObservation{
+					VPNGatewayID:  vgwID,
+					AmazonSideASN: aws.Int64(asn),
+					State:         "available",
+				}), withConditions(xpv1.Available())),
+				result: managed.ExternalObservation{
+					ResourceExists:          true,
+					ResourceUpToDate:        false,
+					ResourceLateInitialized: true,
+				},
+			},
+		},
+		"Deleted": {
+			args: args{
+				vgw: &fake.MockVPNGatewayClient{
+					MockDescribe: describeVPNGateway(awsec2types.VpnStateDeleted),
+				},
+				cr: vgw(withExternalName(vgwID)),
+			},
+			want: want{
+				cr: vgw(withExternalName(vgwID)),
+			},
+		},
+		"NotFound": {
+			args: args{
+				vgw: &fake.MockVPNGatewayClient{
+					MockDescribe: func(ctx context.Context, input *awsec2.DescribeVpnGatewaysInput, opts []func(*awsec2.Options)) (*awsec2.DescribeVpnGatewaysOutput, error) {
+						return nil, &smithy.GenericAPIError{Code: ec2.VPNGatewayNotFound}
+					},
+				},
+				cr: vgw(withExternalName(vgwID)),
+			},
+			want: want{
+				cr: vgw(withExternalName(vgwID)),
+			},
+		},
+		"DescribeRouteTablesFail": {
+			args: args{
+				vgw: &fake.MockVPNGatewayClient{
+					MockDescribe: describeVPNGateway(awsec2types.VpnStateAvailable, awsec2types.VpcAttachment{
+						VpcId: aws.String(vpcID),
+						State: awsec2types.AttachmentStatusAttached,
+					}),
+					MockDescribeRouteTables: func(ctx context.Context, input *awsec2.DescribeRouteTablesInput, opts []func(*awsec2.Options)) (*awsec2.DescribeRouteTablesOutput, error) {
+						return nil, errBoom
+					},
+				},
+				cr: vgw(withExternalName(vgwID)),
+			},
+			want: want{
+				cr:  vgw(withExternalName(vgwID)),
+				err: awsclient.Wrap(errBoom, errDescribeRouteTables),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{client: tc.vgw}
+			o, err := e.Observe(context.Background(), tc.args.cr)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.result, o); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestCreate(t *testing.T) {
+	type want struct {
+		cr     *manualv1alpha1.VPNGateway
+		result managed.ExternalCreation
+		err    error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"Successful": {
+			args: args{
+				vgw: &fake.MockVPNGatewayClient{
+					MockCreate: func(ctx context.Context, input *awsec2.CreateVpnGatewayInput, opts []func(*awsec2.Options)) (*awsec2.CreateVpnGatewayOutput, error) {
+						return &awsec2.CreateVpnGatewayOutput{
+							VpnGateway: &awsec2types.VpnGateway{VpnGatewayId: aws.String(vgwID)},
+						}, nil
+					},
+				},
+				cr: vgw(withSpec(manualv1alpha1.VPNGatewayParameters{VPCID: aws.String(vpcID)})),
+			},
+			want: want{
+				cr: vgw(withSpec(manualv1alpha1.VPNGatewayParameters{VPCID: aws.String(vpcID)}), withExternalName(vgwID)),
+			},
+		},
+		"CreateFail": {
+			args: args{
+				vgw: &fake.MockVPNGatewayClient{
+					MockCreate: func(ctx context.Context, input *awsec2.CreateVpnGatewayInput, opts []func(*awsec2.Options)) (*awsec2.CreateVpnGatewayOutput, error) {
+						return nil, errBoom
+					},
+				},
+				cr: vgw(),
+			},
+			want: want{
+				cr:  vgw(),
+				err: awsclient.Wrap(errBoom, errCreate),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{client: tc.vgw}
+			o, err := e.Create(context.Background(), tc.args.cr)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.result, o); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestUpdate(t *testing.T) {
+	type want struct {
+		err error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"Attach": {
+			args: args{
+				vgw: &fake.MockVPNGatewayClient{
+					MockDescribe: describeVPNGateway(awsec2types.VpnStateAvailable),
+					MockAttach: func(ctx context.Context, input *awsec2.AttachVpnGatewayInput, opts []func(*awsec2.Options)) (*awsec2.AttachVpnGatewayOutput, error) {
+						if diff := cmp.Diff(vpcID, aws.ToString(input.VpcId)); diff != "" {
+							t.Errorf("r: -want, +got:\n%s", diff)
+						}
+						return &awsec2.AttachVpnGatewayOutput{}, nil
+					},
+				},
+				cr: vgw(withSpec(manualv1alpha1.VPNGatewayParameters{VPCID: aws.String(vpcID)}), withExternalName(vgwID)),
+			},
+		},
+		"DetachOtherVPC": {
+			args: args{
+				vgw: &fake.MockVPNGatewayClient{
+					MockDescribe: describeVPNGateway(awsec2types.VpnStateAvailable, awsec2types.VpcAttachment{
+						VpcId: aws.String(otherID),
+						State: awsec2types.AttachmentStatusAttached,
+					}),
+					MockDetach: func(ctx context.Context, input *awsec2.DetachVpnGatewayInput, opts []func(*awsec2.Options)) (*awsec2.DetachVpnGatewayOutput, error) {
+						if diff := cmp.Diff(otherID, aws.ToString(input.VpcId)); diff != "" {
+							t.Errorf("r: -want, +got:\n%s", diff)
+						}
+						return &awsec2.DetachVpnGatewayOutput{}, nil
+					},
+				},
+				cr: vgw(withSpec(manualv1alpha1.VPNGatewayParameters{VPCID: aws.String(vpcID)}), withExternalName(vgwID)),
+			},
+		},
+		"RoutePropagation": {
+			args: args{
+				vgw: &fake.MockVPNGatewayClient{
+					MockDescribe: describeVPNGateway(awsec2types.VpnStateAvailable, awsec2types.VpcAttachment{
+						VpcId: aws.String(vpcID),
+						State: awsec2types.AttachmentStatusAttached,
+					}),
+					MockDescribeRouteTables: describeRouteTables,
+					MockEnableRoutePropagation: func(ctx context.Context, input *awsec2.EnableVgwRoutePropagationInput, opts []func(*awsec2.Options)) (*awsec2.EnableVgwRoutePropagationOutput, error) {
+						if diff := cmp.Diff(rtbID, aws.ToString(input.RouteTableId)); diff != "" {
+							t.Errorf("r: -want, +got:\n%s", diff)
+						}
+						return &awsec2.EnableVgwRoutePropagationOutput{}, nil
+					},
+					MockDisableRoutePropagation: func(ctx context.Context, input *awsec2.DisableVgwRoutePropagationInput, opts []func(*awsec2.Options)) (*awsec2.DisableVgwRoutePropagationOutput, error) {
+						if diff := cmp.Diff(oldRtb, aws.ToString(input.RouteTableId)); diff != "" {
+							t.Errorf("r: -want, +got:\n%s", diff)
+						}
+						return &awsec2.DisableVgwRoutePropagationOutput{}, nil
+					},
+				},
+				cr: vgw(withSpec(manualv1alpha1.VPNGatewayParameters{
+					VPCID:         aws.String(vpcID),
+					RouteTableIDs: []string{rtbID},
+				}), withExternalName(vgwID)),
+			},
+		},
+		"EnablePropagationFail": {
+			args: args{
+				vgw: &fake.MockVPNGatewayClient{
+					MockDescribe: describeVPNGateway(awsec2types.VpnStateAvailable, awsec2types.VpcAttachment{
+						VpcId: aws.String(vpcID),
+						State: awsec2types.AttachmentStatusAttached,
+					}),
+					MockDescribeRouteTables: describeRouteTables,
+					MockEnableRoutePropagation: func(ctx context.Context, input *awsec2.EnableVgwRoutePropagationInput, opts []func(*awsec2.Options)) (*awsec2.EnableVgwRoutePropagationOutput, error) {
+						return nil, errBoom
+					},
+				},
+				cr: vgw(withSpec(manualv1alpha1.VPNGatewayParameters{
+					VPCID:         aws.String(vpcID),
+					RouteTableIDs: []string{rtbID, oldRtb},
+				}), withExternalName(vgwID)),
+			},
+			want: want{
+				err: awsclient.Wrap(errBoom, errEnablePropagation),
+			},
+		},
+		"AttachFail": {
+			args: args{
+				vgw: &fake.MockVPNGatewayClient{
+					MockDescribe: describeVPNGateway(awsec2types.VpnStateAvailable),
+					MockAttach: func(ctx context.Context, input *awsec2.AttachVpnGatewayInput, opts []func(*awsec2.Options)) (*awsec2.AttachVpnGatewayOutput, error) {
+						return nil, errBoom
+					},
+				},
+				cr: vgw(withSpec(manualv1alpha1.VPNGatewayParameters{VPCID: aws.String(vpcID)}), withExternalName(vgwID)),
+			},
+			want: want{
+				err: awsclient.Wrap(errBoom, errAttach),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{client: tc.vgw}
+			_, err := e.Update(context.Background(), tc.args.cr)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestDelete(t *testing.T) {
+	type want struct {
+		cr  *manualv1alpha1.VPNGateway
+		err error
+	}
+
+	attached := manualv1alpha1.VPNGatewayObservation{
+		VPCAttachments: []manualv1alpha1.VPNGatewayVPCAttachment{{VPCID: vpcID, State: "attached"}},
+	}
+	detached := manualv1alpha1.VPNGatewayObservation{
+		VPCAttachments: []manualv1alpha1.VPNGatewayVPCAttachment{{VPCID: vpcID, State: "detached"}},
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"Successful": {
+			args: args{
+				vgw: &fake.MockVPNGatewayClient{
+					MockDelete: func(ctx context.Context, input *awsec2.DeleteVpnGatewayInput, opts []func(*awsec2.Options)) (*awsec2.DeleteVpnGatewayOutput, error) {
+						return &awsec2.DeleteVpnGatewayOutput{}, nil
+					},
+				},
+				cr: vgw(withExternalName(vgwID), withStatus(detached)),
+			},
+			want: want{
+				cr: vgw(withExternalName(vgwID), withStatus(detached), withConditions(xpv1.Deleting())),
+			},
+		},
+		"DetachFirst": {
+			args: args{
+				vgw: &fake.MockVPNGatewayClient{
+					MockDetach: func(ctx context.Context, input *awsec2.DetachVpnGatewayInput, opts []func(*awsec2.Options)) (*awsec2.DetachVpnGatewayOutput, error) {
+						return &awsec2.DetachVpnGatewayOutput{}, nil
+					},
+				},
+				cr: vgw(withExternalName(vgwID), withStatus(attached)),
+			},
+			want: want{
+				cr: vgw(withExternalName(vgwID), withStatus(attached), withConditions(xpv1.Deleting())),
+			},
+		},
+		"DetachFail": {
+			args: args{
+				vgw: &fake.MockVPNGatewayClient{
+					MockDetach: func(ctx context.Context, input *awsec2.DetachVpnGatewayInput, opts []func(*awsec2.Options)) (*awsec2.DetachVpnGatewayOutput, error) {
+						return nil, errBoom
+					},
+				},
+				cr: vgw(withExternalName(vgwID), withStatus(attached)),
+			},
+			want: want{
+				cr:  vgw(withExternalName(vgwID), withStatus(attached), withConditions(xpv1.Deleting())),
+				err: awsclient.Wrap(errBoom, errDetach),
+			},
+		},
+		"DeleteFail": {
+			args: args{
+				vgw: &fake.MockVPNGatewayClient{
+					MockDelete: func(ctx context.Context, input *awsec2.DeleteVpnGatewayInput, opts []func(*awsec2.Options)) (*awsec2.DeleteVpnGatewayOutput, error) {
+						return nil, errBoom
+					},
+				},
+				cr: vgw(withExternalName(vgwID)),
+			},
+			want: want{
+				cr:  vgw(withExternalName(vgwID), withConditions(xpv1.Deleting())),
+				err: awsclient.Wrap(errBoom, errDelete),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{client: tc.vgw}
+			err := e.Delete(context.Background(), tc.args.cr)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
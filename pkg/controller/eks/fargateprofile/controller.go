@@ -197,7 +197,14 @@ func (t *tagger) Initialize(ctx context.Context, mg resource.Managed) error {
 	if cr.Spec.ForProvider.Tags == nil {
 		cr.Spec.ForProvider.Tags = map[string]string{}
 	}
-	for k, v := range resource.GetExternalTags(mg) {
+	tags := make(map[string]string, len(cr.Spec.ForProvider.Tags))
+	for k, v := range cr.Spec.ForProvider.Tags {
+		tags[k] = v
+	}
+	if err := awsclient.AddDefaultTags(ctx, t.kube, mg, tags); err != nil {
+		return err
+	}
+	for k, v := range tags {
 		if cr.Spec.ForProvider.Tags[k] != v {
 			cr.Spec.ForProvider.Tags[k] = v
 			changed = true
@@ -216,8 +216,8 @@ func (t *tagger) Initialize(ctx context.Context, mg resource.Managed) error {
 	if cr.Spec.ForProvider.Tags == nil {
 		cr.Spec.ForProvider.Tags = map[string]string{}
 	}
-	for k, v := range resource.GetExternalTags(mg) {
-		cr.Spec.ForProvider.Tags[k] = v
+	if err := awsclient.AddDefaultTags(ctx, t.kube, mg, cr.Spec.ForProvider.Tags); err != nil {
+		return err
 	}
 	return errors.Wrap(t.kube.Update(ctx, cr), errKubeUpdateFailed)
 }
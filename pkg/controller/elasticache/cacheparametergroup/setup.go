@@ -18,11 +18,13 @@ package cacheparametergroup
 
 import (
 	"context"
+	"sort"
 
 	svcsdk "github.com/aws/aws-sdk-go/service/elasticache"
 	"github.com/aws/aws-sdk-go/service/elasticache/elasticacheiface"
 	"github.com/google/go-cmp/cmp"
 	"github.com/google/go-cmp/cmp/cmpopts"
+	"github.com/pkg/errors"
 	ctrl "sigs.k8s.io/controller-runtime"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 
@@ -40,6 +42,10 @@ import (
 	"github.com/crossplane-contrib/provider-aws/pkg/features"
 )
 
+const (
+	errKubeUpdateFailed = "cannot update CacheParameterGroup custom resource"
+)
+
 // SetupCacheParameterGroup adds a controller that reconciles a CacheParameterGroup.
 func SetupCacheParameterGroup(mgr ctrl.Manager, o controller.Options) error {
 	name := managed.ControllerName(svcapitypes.CacheParameterGroupKind)
@@ -58,6 +64,7 @@ func SetupCacheParameterGroup(mgr ctrl.Manager, o controller.Options) error {
 		Complete(managed.NewReconciler(mgr,
 			resource.ManagedKind(svcapitypes.CacheParameterGroupGroupVersionKind),
 			managed.WithExternalConnecter(&connector{kube: mgr.GetClient(), opts: opts}),
+			managed.WithInitializers(managed.NewNameAsExternalName(mgr.GetClient()), &tagger{kube: mgr.GetClient()}),
 			managed.WithPollInterval(o.PollInterval),
 			managed.WithLogger(o.Logger.WithValues("controller", name)),
 			managed.WithRecorder(event.NewAPIRecorder(mgr.GetEventRecorderFor(name))),
@@ -159,3 +166,33 @@ func preDelete(_ context.Context, cr *svcapitypes.CacheParameterGroup, obj *svcs
 	obj.CacheParameterGroupName = awsclient.String(meta.GetExternalName(cr))
 	return false, nil
 }
+
+type tagger struct {
+	kube client.Client
+}
+
+func (t *tagger) Initialize(ctx context.Context, mg resource.Managed) error {
+	cr, ok := mg.(*svcapitypes.CacheParameterGroup)
+	if !ok {
+		return errors.New(errUnexpectedObject)
+	}
+	tagMap := map[string]string{}
+	for _, t := range cr.Spec.ForProvider.Tags {
+		tagMap[awsclient.StringValue(t.Key)] = awsclient.StringValue(t.Value)
+	}
+	changed, err := awsclient.MergeDefaultTags(ctx, t.kube, mg, tagMap)
+	if err != nil {
+		return err
+	}
+	if !changed {
+		return nil
+	}
+	cr.Spec.ForProvider.Tags = make([]*svcapitypes.Tag, 0, len(tagMap))
+	for k, v := range tagMap {
+		cr.Spec.ForProvider.Tags = append(cr.Spec.ForProvider.Tags, &svcapitypes.Tag{Key: awsclient.String(k), Value: awsclient.String(v, awsclient.FieldRequired)})
+	}
+	sort.Slice(cr.Spec.ForProvider.Tags, func(i, j int) bool {
+		return awsclient.StringValue(cr.Spec.ForProvider.Tags[i].Key) < awsclient.StringValue(cr.Spec.ForProvider.Tags[j].Key)
+	})
+	return errors.Wrap(t.kube.Update(ctx, cr), errKubeUpdateFailed)
+}
@@ -17,6 +17,8 @@ limitations under the License.
 package cacheparametergroup
 
 import (
+	"context"
+	"sort"
 	"testing"
 
 	"github.com/aws/aws-sdk-go/aws"
@@ -26,8 +28,12 @@ import (
 
 	"github.com/google/go-cmp/cmp"
 	"github.com/google/go-cmp/cmp/cmpopts"
+	"github.com/pkg/errors"
+	"sigs.k8s.io/controller-runtime/pkg/client"
 
 	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+	"github.com/crossplane/crossplane-runtime/pkg/test"
 
 	svcapitypes "github.com/crossplane-contrib/provider-aws/apis/elasticache/v1alpha1"
 	awsclient "github.com/crossplane-contrib/provider-aws/pkg/clients"
@@ -188,3 +194,78 @@ func TestIsUpToDate(t *testing.T) {
 		})
 	}
 }
+
+var errBoom = errors.New("boom")
+
+func withTags(tagMaps ...map[string]string) cacheParameterGroupModifier {
+	return func(cr *svcapitypes.CacheParameterGroup) {
+		tagMap := map[string]string{}
+		for _, m := range tagMaps {
+			for k, v := range m {
+				tagMap[k] = v
+			}
+		}
+		cr.Spec.ForProvider.Tags = nil
+		for _, k := range sortedKeys(tagMap) {
+			cr.Spec.ForProvider.Tags = append(cr.Spec.ForProvider.Tags, &svcapitypes.Tag{Key: awsclient.String(k), Value: awsclient.String(tagMap[k], awsclient.FieldRequired)})
+		}
+	}
+}
+
+func sortedKeys(m map[string]string) []string {
+	keys := make([]string, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	return keys
+}
+
+func TestInitialize(t *testing.T) {
+	type args struct {
+		cr   *svcapitypes.CacheParameterGroup
+		kube client.Client
+	}
+	type want struct {
+		cr  *svcapitypes.CacheParameterGroup
+		err error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"Successful": {
+			args: args{
+				cr:   cacheParameterGroup(withTags(map[string]string{"foo": "bar"})),
+				kube: &test.MockClient{MockUpdate: test.NewMockUpdateFn(nil)},
+			},
+			want: want{
+				cr: cacheParameterGroup(withTags(resource.GetExternalTags(cacheParameterGroup()), map[string]string{"foo": "bar"})),
+			},
+		},
+		"AlreadyTagged": {
+			args: args{
+				cr:   cacheParameterGroup(withTags(resource.GetExternalTags(cacheParameterGroup()), map[string]string{"foo": "bar"})),
+				kube: &test.MockClient{MockUpdate: test.NewMockUpdateFn(errBoom)},
+			},
+			want: want{
+				cr: cacheParameterGroup(withTags(resource.GetExternalTags(cacheParameterGroup()), map[string]string{"foo": "bar"})),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &tagger{kube: tc.kube}
+			err := e.Initialize(context.Background(), tc.args.cr)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr); err == nil && diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
@@ -2,10 +2,13 @@ package loadbalancer
 
 import (
 	"context"
+	"sort"
 
 	"github.com/aws/aws-sdk-go/aws"
 	svcsdk "github.com/aws/aws-sdk-go/service/elbv2"
+	"github.com/pkg/errors"
 	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
 
 	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
 	"github.com/crossplane/crossplane-runtime/pkg/connection"
@@ -17,9 +20,14 @@ import (
 
 	svcapitypes "github.com/crossplane-contrib/provider-aws/apis/elbv2/v1alpha1"
 	"github.com/crossplane-contrib/provider-aws/apis/v1alpha1"
+	awsclients "github.com/crossplane-contrib/provider-aws/pkg/clients"
 	"github.com/crossplane-contrib/provider-aws/pkg/features"
 )
 
+const (
+	errKubeUpdateFailed = "cannot update LoadBalancer custom resource"
+)
+
 // SetupLoadBalancer adds a controller that reconciles LoadBalancer.
 func SetupLoadBalancer(mgr ctrl.Manager, o controller.Options) error {
 	name := managed.ControllerName(svcapitypes.LoadBalancerGroupKind)
@@ -45,7 +53,7 @@ func SetupLoadBalancer(mgr ctrl.Manager, o controller.Options) error {
 		Complete(managed.NewReconciler(mgr,
 			resource.ManagedKind(svcapitypes.LoadBalancerGroupVersionKind),
 			managed.WithExternalConnecter(&connector{kube: mgr.GetClient(), opts: opts}),
-			managed.WithInitializers(),
+			managed.WithInitializers(&tagger{kube: mgr.GetClient()}),
 			managed.WithPollInterval(o.PollInterval),
 			managed.WithLogger(o.Logger.WithValues("controller", name)),
 			managed.WithRecorder(event.NewAPIRecorder(mgr.GetEventRecorderFor(name))),
@@ -82,3 +90,33 @@ func preCreate(_ context.Context, cr *svcapitypes.LoadBalancer, obj *svcsdk.Crea
 	obj.Type = cr.Spec.ForProvider.Type
 	return nil
 }
+
+type tagger struct {
+	kube client.Client
+}
+
+func (t *tagger) Initialize(ctx context.Context, mg resource.Managed) error {
+	cr, ok := mg.(*svcapitypes.LoadBalancer)
+	if !ok {
+		return errors.New(errUnexpectedObject)
+	}
+	tagMap := map[string]string{}
+	for _, t := range cr.Spec.ForProvider.Tags {
+		tagMap[awsclients.StringValue(t.Key)] = awsclients.StringValue(t.Value)
+	}
+	changed, err := awsclients.MergeDefaultTags(ctx, t.kube, mg, tagMap)
+	if err != nil {
+		return err
+	}
+	if !changed {
+		return nil
+	}
+	cr.Spec.ForProvider.Tags = make([]*svcapitypes.Tag, 0, len(tagMap))
+	for k, v := range tagMap {
+		cr.Spec.ForProvider.Tags = append(cr.Spec.ForProvider.Tags, &svcapitypes.Tag{Key: awsclients.String(k), Value: awsclients.String(v, awsclients.FieldRequired)})
+	}
+	sort.Slice(cr.Spec.ForProvider.Tags, func(i, j int) bool {
+		return awsclients.StringValue(cr.Spec.ForProvider.Tags[i].Key) < awsclients.StringValue(cr.Spec.ForProvider.Tags[j].Key)
+	})
+	return errors.Wrap(t.kube.Update(ctx, cr), errKubeUpdateFailed)
+}
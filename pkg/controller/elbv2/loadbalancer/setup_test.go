@@ -0,0 +1,103 @@
+package loadbalancer
+
+import (
+	"context"
+	"sort"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+
+	svcapitypes "github.com/crossplane-contrib/provider-aws/apis/elbv2/v1alpha1"
+	awsclients "github.com/crossplane-contrib/provider-aws/pkg/clients"
+)
+
+var errBoom = errors.New("boom")
+
+type loadBalancerModifier func(*svcapitypes.LoadBalancer)
+
+func loadBalancer(m ...loadBalancerModifier) *svcapitypes.LoadBalancer {
+	cr := &svcapitypes.LoadBalancer{}
+	cr.SetName("test-lb")
+	for _, f := range m {
+		f(cr)
+	}
+	return cr
+}
+
+func withTags(tagMaps ...map[string]string) loadBalancerModifier {
+	return func(cr *svcapitypes.LoadBalancer) {
+		tagMap := map[string]string{}
+		for _, m := range tagMaps {
+			for k, v := range m {
+				tagMap[k] = v
+			}
+		}
+		cr.Spec.ForProvider.Tags = nil
+		for _, k := range sortedKeys(tagMap) {
+			cr.Spec.ForProvider.Tags = append(cr.Spec.ForProvider.Tags, &svcapitypes.Tag{Key: awsclients.String(k), Value: awsclients.String(tagMap[k], awsclients.FieldRequired)})
+		}
+	}
+}
+
+func sortedKeys(m map[string]string) []string {
+	keys := make([]string, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	return keys
+}
+
+func TestInitialize(t *testing.T) {
+	type args struct {
+		cr   *svcapitypes.LoadBalancer
+		kube client.Client
+	}
+	type want struct {
+		cr  *svcapitypes.LoadBalancer
+		err error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"Successful": {
+			args: args{
+				cr:   loadBalancer(withTags(map[string]string{"foo": "bar"})),
+				kube: &test.MockClient{MockUpdate: test.NewMockUpdateFn(nil)},
+			},
+			want: want{
+				cr: loadBalancer(withTags(resource.GetExternalTags(loadBalancer()), map[string]string{"foo": "bar"})),
+			},
+		},
+		"AlreadyTagged": {
+			args: args{
+				cr:   loadBalancer(withTags(resource.GetExternalTags(loadBalancer()), map[string]string{"foo": "bar"})),
+				kube: &test.MockClient{MockUpdate: test.NewMockUpdateFn(errBoom)},
+			},
+			want: want{
+				cr: loadBalancer(withTags(resource.GetExternalTags(loadBalancer()), map[string]string{"foo": "bar"})),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &tagger{kube: tc.kube}
+			err := e.Initialize(context.Background(), tc.args.cr)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr); err == nil && diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
@@ -2,9 +2,12 @@ package targetgroup
 
 import (
 	"context"
+	"sort"
 
 	svcsdk "github.com/aws/aws-sdk-go/service/elbv2"
+	"github.com/pkg/errors"
 	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
 
 	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
 	"github.com/crossplane/crossplane-runtime/pkg/connection"
@@ -20,6 +23,10 @@ import (
 	"github.com/crossplane-contrib/provider-aws/pkg/features"
 )
 
+const (
+	errKubeUpdateFailed = "cannot update TargetGroup custom resource"
+)
+
 // SetupTargetGroup adds a controller that reconciles TargetGroup.
 func SetupTargetGroup(mgr ctrl.Manager, o controller.Options) error {
 	name := managed.ControllerName(svcapitypes.TargetGroupGroupKind)
@@ -44,7 +51,7 @@ func SetupTargetGroup(mgr ctrl.Manager, o controller.Options) error {
 		Complete(managed.NewReconciler(mgr,
 			resource.ManagedKind(svcapitypes.TargetGroupGroupVersionKind),
 			managed.WithExternalConnecter(&connector{kube: mgr.GetClient(), opts: opts}),
-			managed.WithInitializers(),
+			managed.WithInitializers(&tagger{kube: mgr.GetClient()}),
 			managed.WithPollInterval(o.PollInterval),
 			managed.WithLogger(o.Logger.WithValues("controller", name)),
 			managed.WithRecorder(event.NewAPIRecorder(mgr.GetEventRecorderFor(name))),
@@ -71,3 +78,33 @@ func preDelete(_ context.Context, cr *svcapitypes.TargetGroup, obj *svcsdk.Delet
 	obj.TargetGroupArn = aws.String(meta.GetExternalName(cr))
 	return false, nil
 }
+
+type tagger struct {
+	kube client.Client
+}
+
+func (t *tagger) Initialize(ctx context.Context, mg resource.Managed) error {
+	cr, ok := mg.(*svcapitypes.TargetGroup)
+	if !ok {
+		return errors.New(errUnexpectedObject)
+	}
+	tagMap := map[string]string{}
+	for _, t := range cr.Spec.ForProvider.Tags {
+		tagMap[aws.StringValue(t.Key)] = aws.StringValue(t.Value)
+	}
+	changed, err := aws.MergeDefaultTags(ctx, t.kube, mg, tagMap)
+	if err != nil {
+		return err
+	}
+	if !changed {
+		return nil
+	}
+	cr.Spec.ForProvider.Tags = make([]*svcapitypes.Tag, 0, len(tagMap))
+	for k, v := range tagMap {
+		cr.Spec.ForProvider.Tags = append(cr.Spec.ForProvider.Tags, &svcapitypes.Tag{Key: aws.String(k), Value: aws.String(v, aws.FieldRequired)})
+	}
+	sort.Slice(cr.Spec.ForProvider.Tags, func(i, j int) bool {
+		return aws.StringValue(cr.Spec.ForProvider.Tags[i].Key) < aws.StringValue(cr.Spec.ForProvider.Tags[j].Key)
+	})
+	return errors.Wrap(t.kube.Update(ctx, cr), errKubeUpdateFailed)
+}
@@ -0,0 +1,103 @@
+package targetgroup
+
+import (
+	"context"
+	"sort"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+
+	svcapitypes "github.com/crossplane-contrib/provider-aws/apis/elbv2/v1alpha1"
+	aws "github.com/crossplane-contrib/provider-aws/pkg/clients"
+)
+
+var errBoom = errors.New("boom")
+
+type targetGroupModifier func(*svcapitypes.TargetGroup)
+
+func targetGroup(m ...targetGroupModifier) *svcapitypes.TargetGroup {
+	cr := &svcapitypes.TargetGroup{}
+	cr.SetName("test-tg")
+	for _, f := range m {
+		f(cr)
+	}
+	return cr
+}
+
+func withTags(tagMaps ...map[string]string) targetGroupModifier {
+	return func(cr *svcapitypes.TargetGroup) {
+		tagMap := map[string]string{}
+		for _, m := range tagMaps {
+			for k, v := range m {
+				tagMap[k] = v
+			}
+		}
+		cr.Spec.ForProvider.Tags = nil
+		for _, k := range sortedKeys(tagMap) {
+			cr.Spec.ForProvider.Tags = append(cr.Spec.ForProvider.Tags, &svcapitypes.Tag{Key: aws.String(k), Value: aws.String(tagMap[k], aws.FieldRequired)})
+		}
+	}
+}
+
+func sortedKeys(m map[string]string) []string {
+	keys := make([]string, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	return keys
+}
+
+func TestInitialize(t *testing.T) {
+	type args struct {
+		cr   *svcapitypes.TargetGroup
+		kube client.Client
+	}
+	type want struct {
+		cr  *svcapitypes.TargetGroup
+		err error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"Successful": {
+			args: args{
+				cr:   targetGroup(withTags(map[string]string{"foo": "bar"})),
+				kube: &test.MockClient{MockUpdate: test.NewMockUpdateFn(nil)},
+			},
+			want: want{
+				cr: targetGroup(withTags(resource.GetExternalTags(targetGroup()), map[string]string{"foo": "bar"})),
+			},
+		},
+		"AlreadyTagged": {
+			args: args{
+				cr:   targetGroup(withTags(resource.GetExternalTags(targetGroup()), map[string]string{"foo": "bar"})),
+				kube: &test.MockClient{MockUpdate: test.NewMockUpdateFn(errBoom)},
+			},
+			want: want{
+				cr: targetGroup(withTags(resource.GetExternalTags(targetGroup()), map[string]string{"foo": "bar"})),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &tagger{kube: tc.kube}
+			err := e.Initialize(context.Background(), tc.args.cr)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr); err == nil && diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
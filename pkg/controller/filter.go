@@ -0,0 +1,94 @@
+/*
+Copyright 2023 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"strings"
+
+	"github.com/pkg/errors"
+)
+
+// groupSuffix is the suffix that is shared by all API groups of this
+// provider.
+const groupSuffix = ".aws.crossplane.io"
+
+// A Filter selects the managed resources whose controllers are set up. The
+// zero value selects all managed resources.
+type Filter struct {
+	enabled  map[string]bool
+	disabled map[string]bool
+}
+
+// NewFilter returns a Filter that selects the managed resources of the
+// supplied enabled API groups and kinds, or all managed resources if none are
+// enabled, except for the ones of the supplied disabled API groups and kinds.
+//
+// API groups are given by their full name, e.g. s3.aws.crossplane.io, or
+// their short name, e.g. s3. Kinds are given as <kind>.<group>, e.g.
+// Bucket.s3.aws.crossplane.io or Bucket.s3. Names are case-insensitive and
+// each entry may be a comma separated list of names. An error is returned
+// for any name that is not an API group or kind of this provider.
+func NewFilter(enable, disable []string) (*Filter, error) {
+	known := map[string]bool{}
+	for _, c := range controllers {
+		known[c.group] = true
+		known[kindKey(c.group, c.kind)] = true
+	}
+	enabled, err := parseNames(enable, known)
+	if err != nil {
+		return nil, errors.Wrap(err, "cannot parse enabled API groups and kinds")
+	}
+	disabled, err := parseNames(disable, known)
+	if err != nil {
+		return nil, errors.Wrap(err, "cannot parse disabled API groups and kinds")
+	}
+	return &Filter{enabled: enabled, disabled: disabled}, nil
+}
+
+// Selects returns true if the controller of the managed resources of the
+// supplied API group and kind should be set up. The API group is given by its
+// short name.
+func (f *Filter) Selects(group, kind string) bool {
+	group = strings.ToLower(group)
+	k := kindKey(group, kind)
+	if f.disabled[group] || f.disabled[k] {
+		return false
+	}
+	return len(f.enabled) == 0 || f.enabled[group] || f.enabled[k]
+}
+
+func parseNames(names []string, known map[string]bool) (map[string]bool, error) {
+	parsed := map[string]bool{}
+	for _, entry := range names {
+		for _, n := range strings.Split(entry, ",") {
+			n = strings.TrimSpace(n)
+			if n == "" {
+				continue
+			}
+			key := strings.TrimSuffix(strings.ToLower(n), groupSuffix)
+			if !known[key] {
+				return nil, errors.Errorf("unknown API group or kind %q", n)
+			}
+			parsed[key] = true
+		}
+	}
+	return parsed, nil
+}
+
+func kindKey(group, kind string) string {
+	return strings.ToLower(kind + "." + group)
+}
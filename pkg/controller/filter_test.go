@@ -0,0 +1,103 @@
+/*
+Copyright 2023 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+)
+
+func TestFilter(t *testing.T) {
+	type args struct {
+		enable  []string
+		disable []string
+	}
+	type want struct {
+		selected map[string]bool
+		err      error
+	}
+	cases := map[string]struct {
+		args args
+		want want
+	}{
+		"NoFilter": {
+			want: want{selected: map[string]bool{
+				"s3/Bucket":    true,
+				"ec2/Instance": true,
+				"sfn/Activity": true,
+			}},
+		},
+		"EnableGroups": {
+			args: args{enable: []string{"s3,iam.aws.crossplane.io", "EC2"}},
+			want: want{selected: map[string]bool{
+				"s3/Bucket":    true,
+				"iam/Role":     true,
+				"ec2/Instance": true,
+				"sfn/Activity": false,
+			}},
+		},
+		"EnableKinds": {
+			args: args{enable: []string{"Bucket.s3", "instance.ec2.aws.crossplane.io"}},
+			want: want{selected: map[string]bool{
+				"s3/Bucket":       true,
+				"s3/BucketPolicy": false,
+				"ec2/Instance":    true,
+				"ec2/VPC":         false,
+			}},
+		},
+		"DisableTakesPrecedence": {
+			args: args{enable: []string{"ec2"}, disable: []string{"Instance.ec2", "sfn"}},
+			want: want{selected: map[string]bool{
+				"ec2/VPC":      true,
+				"ec2/Instance": false,
+				"sfn/Activity": false,
+			}},
+		},
+		"UnknownGroup": {
+			args: args{enable: []string{"s3", "s4"}},
+			want: want{err: errors.Wrap(errors.New(`unknown API group or kind "s4"`), "cannot parse enabled API groups and kinds")},
+		},
+		"UnknownKind": {
+			args: args{disable: []string{"Bucket.ec2"}},
+			want: want{err: errors.Wrap(errors.New(`unknown API group or kind "Bucket.ec2"`), "cannot parse disabled API groups and kinds")},
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			f, err := NewFilter(tc.args.enable, tc.args.disable)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("NewFilter(...): -want, +got:\n%s", diff)
+			}
+			if err != nil {
+				return
+			}
+			got := map[string]bool{}
+			for gk := range tc.want.selected {
+				group, kind, _ := strings.Cut(gk, "/")
+				got[gk] = f.Selects(group, kind)
+			}
+			if diff := cmp.Diff(tc.want.selected, got); diff != "" {
+				t.Errorf("Selects(...): -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
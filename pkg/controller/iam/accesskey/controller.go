@@ -18,11 +18,13 @@ package accesskey
 
 import (
 	"context"
+	"time"
 
 	"github.com/aws/aws-sdk-go-v2/aws"
 	awsiam "github.com/aws/aws-sdk-go-v2/service/iam"
 	awsiamtypes "github.com/aws/aws-sdk-go-v2/service/iam/types"
 	"github.com/pkg/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	ctrl "sigs.k8s.io/controller-runtime"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 
@@ -47,6 +49,15 @@ const (
 	errCreate           = "failed to create the AccessKey resource"
 	errDelete           = "failed to delete the AccessKey resource"
 	errUpdate           = "failed to update the AccessKey resource"
+	errGetLastUsed      = "failed to get the last use of the AccessKey"
+	errRotate           = "failed to create the rotated AccessKey"
+	errRetire           = "failed to delete the previous AccessKey"
+	errKubeUpdateFailed = "cannot update the external name of the rotated AccessKey"
+
+	// annotationPreviousAccessKey records the ID of the access key that was
+	// replaced by the last rotation together with the external name of the
+	// new one, so that it is not lost with the status of the resource.
+	annotationPreviousAccessKey = "crossplane.io/previous-aws-iam-access-key-id"
 )
 
 // SetupAccessKey adds a controller that reconciles AccessKeys.
@@ -116,17 +127,32 @@ func (e *external) Observe(ctx context.Context, mgd resource.Managed) (managed.E
 	if !found {
 		return managed.ExternalObservation{ResourceExists: false}, nil
 	}
+	cr.Status.AtProvider.PreviousAccessKeyID = iam.PreviousAccessKeyID(keys.AccessKeyMetadata, meta.GetExternalName(cr), recordedPreviousAccessKey(cr))
 	switch accessKey.Status {
 	case awsiamtypes.StatusTypeActive:
 		cr.SetConditions(xpv1.Available())
 	case awsiamtypes.StatusTypeInactive:
 		cr.SetConditions(xpv1.Unavailable())
 	}
+	lastUsed, err := e.client.GetAccessKeyLastUsed(ctx, &awsiam.GetAccessKeyLastUsedInput{AccessKeyId: accessKey.AccessKeyId})
+	if err != nil {
+		return managed.ExternalObservation{}, awsclient.Wrap(err, errGetLastUsed)
+	}
+	cr.Status.AtProvider.CreateDate = awsclient.TimeToMetaTime(accessKey.CreateDate)
+	if lastUsed.AccessKeyLastUsed != nil {
+		cr.Status.AtProvider.LastUsedDate = awsclient.TimeToMetaTime(lastUsed.AccessKeyLastUsed.LastUsedDate)
+		cr.Status.AtProvider.LastUsedServiceName = aws.ToString(lastUsed.AccessKeyLastUsed.ServiceName)
+		cr.Status.AtProvider.LastUsedRegion = aws.ToString(lastUsed.AccessKeyLastUsed.Region)
+	}
+
 	current := cr.Spec.ForProvider.Status
 	cr.Spec.ForProvider.Status = awsclient.LateInitializeString(cr.Spec.ForProvider.Status, aws.String(string(accessKey.Status)))
+	now := time.Now()
 	return managed.ExternalObservation{
-		ResourceExists:          true,
-		ResourceUpToDate:        string(accessKey.Status) == cr.Spec.ForProvider.Status,
+		ResourceExists: true,
+		ResourceUpToDate: string(accessKey.Status) == cr.Spec.ForProvider.Status &&
+			!iam.IsAccessKeyRotationDue(cr.Spec.ForProvider.Rotation, cr.Status.AtProvider, now) &&
+			!iam.IsPreviousAccessKeyExpired(cr.Spec.ForProvider.Rotation, cr.Status.AtProvider, now),
 		ResourceLateInitialized: current != cr.Spec.ForProvider.Status,
 	}, nil
 }
@@ -164,8 +190,93 @@ func (e *external) Update(ctx context.Context, mgd resource.Managed) (managed.Ex
 		Status:      awsiamtypes.StatusType(cr.Spec.ForProvider.Status),
 		UserName:    aws.String(cr.Spec.ForProvider.Username),
 	})
+	if err != nil {
+		return managed.ExternalUpdate{}, awsclient.Wrap(err, errUpdate)
+	}
 
-	return managed.ExternalUpdate{}, awsclient.Wrap(err, errUpdate)
+	now := time.Now()
+	if iam.IsPreviousAccessKeyExpired(cr.Spec.ForProvider.Rotation, cr.Status.AtProvider, now) {
+		if err := e.retire(ctx, cr, cr.Status.AtProvider.PreviousAccessKeyID); err != nil {
+			return managed.ExternalUpdate{}, err
+		}
+	}
+	if iam.IsAccessKeyRotationDue(cr.Spec.ForProvider.Rotation, cr.Status.AtProvider, now) {
+		conn, err := e.rotate(ctx, cr)
+		return managed.ExternalUpdate{ConnectionDetails: conn}, err
+	}
+	return managed.ExternalUpdate{}, nil
+}
+
+// rotate creates a new access key, makes it the current one and returns its
+// connection details. The replaced access key becomes the previous one.
+func (e *external) rotate(ctx context.Context, cr *v1beta1.AccessKey) (managed.ConnectionDetails, error) {
+	response, err := e.client.CreateAccessKey(ctx, &awsiam.CreateAccessKeyInput{UserName: aws.String(cr.Spec.ForProvider.Username)})
+	if err != nil {
+		return nil, awsclient.Wrap(err, errRotate)
+	}
+
+	previous := meta.GetExternalName(cr)
+	meta.SetExternalName(cr, aws.ToString(response.AccessKey.AccessKeyId))
+	meta.AddAnnotations(cr, map[string]string{annotationPreviousAccessKey: previous})
+	if err := e.kube.Update(ctx, cr); err != nil {
+		// NOTE: The new access key is deleted again because its ID could
+		// not be stored, it is created anew on the next reconciliation.
+		_, _ = e.client.DeleteAccessKey(ctx, &awsiam.DeleteAccessKeyInput{
+			UserName:    aws.String(cr.Spec.ForProvider.Username),
+			AccessKeyId: response.AccessKey.AccessKeyId,
+		})
+		return nil, errors.Wrap(err, errKubeUpdateFailed)
+	}
+
+	// NOTE: The update of the resource overwrites its status with the one
+	// stored in the API server.
+	now := metav1.Now()
+	cr.Status.AtProvider = v1beta1.AccessKeyObservation{
+		CreateDate:          awsclient.TimeToMetaTime(response.AccessKey.CreateDate),
+		PreviousAccessKeyID: previous,
+		LastRotationTime:    &now,
+	}
+	if cr.Status.AtProvider.CreateDate == nil {
+		cr.Status.AtProvider.CreateDate = &now
+	}
+	return managed.ConnectionDetails{
+		xpv1.ResourceCredentialsSecretUserKey:     []byte(aws.ToString(response.AccessKey.AccessKeyId)),
+		xpv1.ResourceCredentialsSecretPasswordKey: []byte(aws.ToString(response.AccessKey.SecretAccessKey)),
+	}, nil
+}
+
+// recordedPreviousAccessKey returns the ID of the access key that was
+// replaced by the last rotation. The annotation stands in for the status if
+// the status got lost.
+func recordedPreviousAccessKey(cr *v1beta1.AccessKey) string {
+	if id := cr.Status.AtProvider.PreviousAccessKeyID; id != "" {
+		return id
+	}
+	return cr.GetAnnotations()[annotationPreviousAccessKey]
+}
+
+// retire deactivates and deletes the previous access key.
+func (e *external) retire(ctx context.Context, cr *v1beta1.AccessKey, previous string) error {
+	id := aws.String(previous)
+	_, err := e.client.UpdateAccessKey(ctx, &awsiam.UpdateAccessKeyInput{
+		AccessKeyId: id,
+		Status:      awsiamtypes.StatusTypeInactive,
+		UserName:    aws.String(cr.Spec.ForProvider.Username),
+	})
+	if resource.Ignore(iam.IsErrorNotFound, err) != nil {
+		return awsclient.Wrap(err, errRetire)
+	}
+	_, err = e.client.DeleteAccessKey(ctx, &awsiam.DeleteAccessKeyInput{
+		AccessKeyId: id,
+		UserName:    aws.String(cr.Spec.ForProvider.Username),
+	})
+	if resource.Ignore(iam.IsErrorNotFound, err) != nil {
+		return awsclient.Wrap(err, errRetire)
+	}
+	// NOTE: The annotation is left in place. It refers to an access key that
+	// does not exist anymore and is overwritten by the next rotation.
+	cr.Status.AtProvider.PreviousAccessKeyID = ""
+	return nil
 }
 
 func (e *external) Delete(ctx context.Context, mgd resource.Managed) error {
@@ -176,6 +287,12 @@ func (e *external) Delete(ctx context.Context, mgd resource.Managed) error {
 
 	cr.Status.SetConditions(xpv1.Deleting())
 
+	if previous := recordedPreviousAccessKey(cr); previous != "" && previous != meta.GetExternalName(cr) {
+		if err := e.retire(ctx, cr, previous); err != nil {
+			return err
+		}
+	}
+
 	_, err := e.client.DeleteAccessKey(ctx, &awsiam.DeleteAccessKeyInput{
 		UserName:    aws.String(cr.Spec.ForProvider.Username),
 		AccessKeyId: aws.String(meta.GetExternalName(cr)),
@@ -19,12 +19,15 @@ package accesskey
 import (
 	"context"
 	"testing"
+	"time"
 
 	"github.com/aws/aws-sdk-go-v2/aws"
 	awsiam "github.com/aws/aws-sdk-go-v2/service/iam"
 	awsiamtypes "github.com/aws/aws-sdk-go-v2/service/iam/types"
 	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
 	"github.com/pkg/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 
 	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
@@ -47,6 +50,11 @@ var (
 	inactiveStatus = awsiamtypes.StatusTypeInactive
 	accessKeyID    = "accessKeyID"
 	secretKeyID    = "secretKeyID"
+	newAccessKeyID = "newAccessKeyID"
+	lastUsedDate   = time.Date(2023, 1, 2, 3, 4, 5, 0, time.UTC)
+	createDate     = time.Date(2023, 1, 1, 0, 0, 0, 0, time.UTC)
+	serviceName    = "s3"
+	region         = "us-east-1"
 
 	errBoom = errors.New("boom")
 )
@@ -81,6 +89,62 @@ func withAccessKey(keyid string) accessModifier {
 	}
 }
 
+func withRotation(period, overlap time.Duration) accessModifier {
+	return func(r *v1beta1.AccessKey) {
+		r.Spec.ForProvider.Rotation = &v1beta1.AccessKeyRotation{
+			RotationPeriod: metav1.Duration{Duration: period},
+			OverlapPeriod:  &metav1.Duration{Duration: overlap},
+		}
+	}
+}
+
+func withPreviousAccessKeyAnnotation(keyid string) accessModifier {
+	return func(r *v1beta1.AccessKey) {
+		meta.AddAnnotations(r, map[string]string{annotationPreviousAccessKey: keyid})
+	}
+}
+
+func withObservation(o v1beta1.AccessKeyObservation) accessModifier {
+	return func(r *v1beta1.AccessKey) {
+		r.Status.AtProvider = o
+	}
+}
+
+func lastUsed() v1beta1.AccessKeyObservation {
+	return v1beta1.AccessKeyObservation{
+		CreateDate:          &metav1.Time{Time: createDate},
+		LastUsedDate:        &metav1.Time{Time: lastUsedDate},
+		LastUsedServiceName: serviceName,
+		LastUsedRegion:      region,
+	}
+}
+
+func getAccessKeyLastUsed(ctx context.Context, input *awsiam.GetAccessKeyLastUsedInput, opts []func(*awsiam.Options)) (*awsiam.GetAccessKeyLastUsedOutput, error) {
+	return &awsiam.GetAccessKeyLastUsedOutput{
+		AccessKeyLastUsed: &awsiamtypes.AccessKeyLastUsed{
+			LastUsedDate: &lastUsedDate,
+			ServiceName:  aws.String(serviceName),
+			Region:       aws.String(region),
+		},
+		UserName: aws.String(userName),
+	}, nil
+}
+
+func listAccessKeys(ids ...string) func(ctx context.Context, input *awsiam.ListAccessKeysInput, opts []func(*awsiam.Options)) (*awsiam.ListAccessKeysOutput, error) {
+	return func(ctx context.Context, input *awsiam.ListAccessKeysInput, opts []func(*awsiam.Options)) (*awsiam.ListAccessKeysOutput, error) {
+		out := &awsiam.ListAccessKeysOutput{}
+		for _, id := range ids {
+			out.AccessKeyMetadata = append(out.AccessKeyMetadata, awsiamtypes.AccessKeyMetadata{
+				AccessKeyId: aws.String(id),
+				CreateDate:  &createDate,
+				Status:      activeStatus,
+				UserName:    aws.String(userName),
+			})
+		}
+		return out, nil
+	}
+}
+
 func accesskey(m ...accessModifier) *v1beta1.AccessKey {
 	cr := &v1beta1.AccessKey{}
 	for _, f := range m {
@@ -107,11 +171,13 @@ func TestObserve(t *testing.T) {
 						return &awsiam.ListAccessKeysOutput{
 							AccessKeyMetadata: []awsiamtypes.AccessKeyMetadata{{
 								AccessKeyId: aws.String(accessKeyID),
+								CreateDate:  &createDate,
 								Status:      activeStatus,
 								UserName:    aws.String(userName),
 							}},
 						}, nil
 					},
+					MockGetAccessKeyLastUsed: getAccessKeyLastUsed,
 				},
 				cr: accesskey(withUsername(userName), withAccessKey(accessKeyID), withStatus(string(activeStatus))),
 			},
@@ -119,6 +185,7 @@ func TestObserve(t *testing.T) {
 				cr: accesskey(withUsername(userName),
 					withAccessKey(accessKeyID),
 					withStatus(string(activeStatus)),
+					withObservation(lastUsed()),
 					withConditions(xpv1.Available())),
 				result: managed.ExternalObservation{
 					ResourceExists:   true,
@@ -133,11 +200,13 @@ func TestObserve(t *testing.T) {
 						return &awsiam.ListAccessKeysOutput{
 							AccessKeyMetadata: []awsiamtypes.AccessKeyMetadata{{
 								AccessKeyId: aws.String(accessKeyID),
+								CreateDate:  &createDate,
 								Status:      inactiveStatus,
 								UserName:    aws.String(userName),
 							}},
 						}, nil
 					},
+					MockGetAccessKeyLastUsed: getAccessKeyLastUsed,
 				},
 				cr: accesskey(withUsername(userName), withAccessKey(accessKeyID), withStatus(string(activeStatus))),
 			},
@@ -145,6 +214,7 @@ func TestObserve(t *testing.T) {
 				cr: accesskey(withUsername(userName),
 					withAccessKey(accessKeyID),
 					withStatus(string(activeStatus)),
+					withObservation(lastUsed()),
 					withConditions(xpv1.Unavailable())),
 				result: managed.ExternalObservation{
 					ResourceExists:   true,
@@ -152,6 +222,140 @@ func TestObserve(t *testing.T) {
 				},
 			},
 		},
+		"RotationDue": {
+			args: args{
+				iam: &fake.MockAccessClient{
+					MockListAccessKeys: func(ctx context.Context, input *awsiam.ListAccessKeysInput, opts []func(*awsiam.Options)) (*awsiam.ListAccessKeysOutput, error) {
+						return &awsiam.ListAccessKeysOutput{
+							AccessKeyMetadata: []awsiamtypes.AccessKeyMetadata{{
+								AccessKeyId: aws.String(accessKeyID),
+								CreateDate:  &createDate,
+								Status:      activeStatus,
+								UserName:    aws.String(userName),
+							}},
+						}, nil
+					},
+					MockGetAccessKeyLastUsed: getAccessKeyLastUsed,
+				},
+				cr: accesskey(withUsername(userName), withAccessKey(accessKeyID), withStatus(string(activeStatus)), withRotation(90*24*time.Hour, time.Hour)),
+			},
+			want: want{
+				cr: accesskey(withUsername(userName),
+					withAccessKey(accessKeyID),
+					withStatus(string(activeStatus)),
+					withRotation(90*24*time.Hour, time.Hour),
+					withObservation(lastUsed()),
+					withConditions(xpv1.Available())),
+				result: managed.ExternalObservation{
+					ResourceExists:   true,
+					ResourceUpToDate: false,
+				},
+			},
+		},
+		"OverlapPeriodPassed": {
+			args: args{
+				iam: &fake.MockAccessClient{
+					MockListAccessKeys:       listAccessKeys(accessKeyID, "previous"),
+					MockGetAccessKeyLastUsed: getAccessKeyLastUsed,
+				},
+				cr: accesskey(withUsername(userName), withAccessKey(accessKeyID), withStatus(string(activeStatus)),
+					withRotation(90*24*time.Hour, time.Hour),
+					withObservation(v1beta1.AccessKeyObservation{PreviousAccessKeyID: "previous", LastRotationTime: &metav1.Time{Time: createDate}})),
+			},
+			want: want{
+				cr: accesskey(withUsername(userName),
+					withAccessKey(accessKeyID),
+					withStatus(string(activeStatus)),
+					withRotation(90*24*time.Hour, time.Hour),
+					withObservation(func() v1beta1.AccessKeyObservation {
+						o := lastUsed()
+						o.PreviousAccessKeyID = "previous"
+						o.LastRotationTime = &metav1.Time{Time: createDate}
+						return o
+					}()),
+					withConditions(xpv1.Available())),
+				result: managed.ExternalObservation{
+					ResourceExists:   true,
+					ResourceUpToDate: false,
+				},
+			},
+		},
+		"PreviousAccessKeyFromAnnotation": {
+			args: args{
+				iam: &fake.MockAccessClient{
+					MockListAccessKeys:       listAccessKeys(accessKeyID, "previous"),
+					MockGetAccessKeyLastUsed: getAccessKeyLastUsed,
+				},
+				cr: accesskey(withUsername(userName), withAccessKey(accessKeyID), withStatus(string(activeStatus)),
+					withRotation(100*365*24*time.Hour, time.Hour), withPreviousAccessKeyAnnotation("previous")),
+			},
+			want: want{
+				cr: accesskey(withUsername(userName),
+					withAccessKey(accessKeyID),
+					withStatus(string(activeStatus)),
+					withRotation(100*365*24*time.Hour, time.Hour),
+					withPreviousAccessKeyAnnotation("previous"),
+					withObservation(func() v1beta1.AccessKeyObservation {
+						o := lastUsed()
+						o.PreviousAccessKeyID = "previous"
+						return o
+					}()),
+					withConditions(xpv1.Available())),
+				result: managed.ExternalObservation{
+					ResourceExists:   true,
+					ResourceUpToDate: false,
+				},
+			},
+		},
+		"OtherAccessKeyIsNotPrevious": {
+			args: args{
+				iam: &fake.MockAccessClient{
+					MockListAccessKeys:       listAccessKeys(accessKeyID, "other"),
+					MockGetAccessKeyLastUsed: getAccessKeyLastUsed,
+				},
+				cr: accesskey(withUsername(userName), withAccessKey(accessKeyID), withStatus(string(activeStatus)),
+					withRotation(100*365*24*time.Hour, time.Hour), withPreviousAccessKeyAnnotation("retired")),
+			},
+			want: want{
+				cr: accesskey(withUsername(userName),
+					withAccessKey(accessKeyID),
+					withStatus(string(activeStatus)),
+					withRotation(100*365*24*time.Hour, time.Hour),
+					withPreviousAccessKeyAnnotation("retired"),
+					withObservation(lastUsed()),
+					withConditions(xpv1.Available())),
+				result: managed.ExternalObservation{
+					ResourceExists:   true,
+					ResourceUpToDate: true,
+				},
+			},
+		},
+		"GetLastUsedError": {
+			args: args{
+				iam: &fake.MockAccessClient{
+					MockListAccessKeys: func(ctx context.Context, input *awsiam.ListAccessKeysInput, opts []func(*awsiam.Options)) (*awsiam.ListAccessKeysOutput, error) {
+						return &awsiam.ListAccessKeysOutput{
+							AccessKeyMetadata: []awsiamtypes.AccessKeyMetadata{{
+								AccessKeyId: aws.String(accessKeyID),
+								Status:      activeStatus,
+								UserName:    aws.String(userName),
+							}},
+						}, nil
+					},
+					MockGetAccessKeyLastUsed: func(ctx context.Context, input *awsiam.GetAccessKeyLastUsedInput, opts []func(*awsiam.Options)) (*awsiam.GetAccessKeyLastUsedOutput, error) {
+						return nil, errBoom
+					},
+				},
+				cr: accesskey(withUsername(userName), withAccessKey(accessKeyID), withStatus(string(activeStatus))),
+			},
+			want: want{
+				cr: accesskey(withUsername(userName),
+					withAccessKey(accessKeyID),
+					withStatus(string(activeStatus)),
+					withConditions(xpv1.Available())),
+				err: awsclient.Wrap(errBoom, errGetLastUsed),
+			},
+		},
 		"ValidInputNotExists": {
 			args: args{
 				iam: &fake.MockAccessClient{
@@ -363,6 +567,45 @@ func TestDelete(t *testing.T) {
 				cr: accesskey(withConditions(xpv1.Deleting())),
 			},
 		},
+		"PreviousAccessKey": {
+			args: args{
+				iam: &fake.MockAccessClient{
+					MockUpdateAccessKey: func(ctx context.Context, input *awsiam.UpdateAccessKeyInput, opts []func(*awsiam.Options)) (*awsiam.UpdateAccessKeyOutput, error) {
+						if diff := cmp.Diff("previous", aws.ToString(input.AccessKeyId)); diff != "" {
+							t.Errorf("r: -want, +got:\n%s", diff)
+						}
+						return &awsiam.UpdateAccessKeyOutput{}, nil
+					},
+					MockDeleteAccessKey: func(ctx context.Context, input *awsiam.DeleteAccessKeyInput, opts []func(*awsiam.Options)) (*awsiam.DeleteAccessKeyOutput, error) {
+						return &awsiam.DeleteAccessKeyOutput{}, nil
+					},
+				},
+				cr: accesskey(withAccessKey(accessKeyID), withUsername(userName), withRotation(24*time.Hour, time.Hour),
+					withPreviousAccessKeyAnnotation("previous")),
+			},
+			want: want{
+				cr: accesskey(withAccessKey(accessKeyID), withUsername(userName), withRotation(24*time.Hour, time.Hour),
+					withPreviousAccessKeyAnnotation("previous"),
+					withConditions(xpv1.Deleting())),
+			},
+		},
+		"NoPreviousAccessKey": {
+			args: args{
+				iam: &fake.MockAccessClient{
+					MockDeleteAccessKey: func(ctx context.Context, input *awsiam.DeleteAccessKeyInput, opts []func(*awsiam.Options)) (*awsiam.DeleteAccessKeyOutput, error) {
+						if diff := cmp.Diff(accessKeyID, aws.ToString(input.AccessKeyId)); diff != "" {
+							t.Errorf("r: -want, +got:\n%s", diff)
+						}
+						return &awsiam.DeleteAccessKeyOutput{}, nil
+					},
+				},
+				cr: accesskey(withAccessKey(accessKeyID), withUsername(userName), withRotation(24*time.Hour, time.Hour)),
+			},
+			want: want{
+				cr: accesskey(withAccessKey(accessKeyID), withUsername(userName), withRotation(24*time.Hour, time.Hour),
+					withConditions(xpv1.Deleting())),
+			},
+		},
 	}
 
 	for name, tc := range cases {
@@ -404,6 +647,108 @@ func TestUpdate(t *testing.T) {
 				cr: accesskey(withAccessKey(accessKeyID), withUsername(userName), withStatus(string(activeStatus))),
 			},
 		},
+		"Rotate": {
+			args: args{
+				iam: &fake.MockAccessClient{
+					MockUpdateAccessKey: func(ctx context.Context, input *awsiam.UpdateAccessKeyInput, opts []func(*awsiam.Options)) (*awsiam.UpdateAccessKeyOutput, error) {
+						return &awsiam.UpdateAccessKeyOutput{}, nil
+					},
+					MockCreateAccessKey: func(ctx context.Context, input *awsiam.CreateAccessKeyInput, opts []func(*awsiam.Options)) (*awsiam.CreateAccessKeyOutput, error) {
+						return &awsiam.CreateAccessKeyOutput{
+							AccessKey: &awsiamtypes.AccessKey{
+								AccessKeyId:     aws.String(newAccessKeyID),
+								CreateDate:      &lastUsedDate,
+								SecretAccessKey: aws.String(secretKeyID),
+								Status:          activeStatus,
+								UserName:        aws.String(userName),
+							},
+						}, nil
+					},
+				},
+				kube: &test.MockClient{
+					MockUpdate: test.NewMockUpdateFn(nil),
+				},
+				cr: accesskey(withAccessKey(accessKeyID), withUsername(userName), withStatus(string(activeStatus)),
+					withRotation(24*time.Hour, time.Hour), withObservation(lastUsed())),
+			},
+			want: want{
+				cr: accesskey(withAccessKey(newAccessKeyID), withUsername(userName), withStatus(string(activeStatus)),
+					withRotation(24*time.Hour, time.Hour), withPreviousAccessKeyAnnotation(accessKeyID),
+					withObservation(v1beta1.AccessKeyObservation{
+						CreateDate:          &metav1.Time{Time: lastUsedDate},
+						PreviousAccessKeyID: accessKeyID,
+					})),
+				update: managed.ExternalUpdate{
+					ConnectionDetails: managed.ConnectionDetails{
+						xpv1.ResourceCredentialsSecretUserKey:     []byte(newAccessKeyID),
+						xpv1.ResourceCredentialsSecretPasswordKey: []byte(secretKeyID),
+					},
+				},
+			},
+		},
+		"RotateKubeUpdateError": {
+			args: args{
+				iam: &fake.MockAccessClient{
+					MockUpdateAccessKey: func(ctx context.Context, input *awsiam.UpdateAccessKeyInput, opts []func(*awsiam.Options)) (*awsiam.UpdateAccessKeyOutput, error) {
+						return &awsiam.UpdateAccessKeyOutput{}, nil
+					},
+					MockCreateAccessKey: func(ctx context.Context, input *awsiam.CreateAccessKeyInput, opts []func(*awsiam.Options)) (*awsiam.CreateAccessKeyOutput, error) {
+						return &awsiam.CreateAccessKeyOutput{
+							AccessKey: &awsiamtypes.AccessKey{
+								AccessKeyId:     aws.String(newAccessKeyID),
+								SecretAccessKey: aws.String(secretKeyID),
+							},
+						}, nil
+					},
+					MockDeleteAccessKey: func(ctx context.Context, input *awsiam.DeleteAccessKeyInput, opts []func(*awsiam.Options)) (*awsiam.DeleteAccessKeyOutput, error) {
+						if diff := cmp.Diff(newAccessKeyID, aws.ToString(input.AccessKeyId)); diff != "" {
+							t.Errorf("r: -want, +got:\n%s", diff)
+						}
+						return &awsiam.DeleteAccessKeyOutput{}, nil
+					},
+				},
+				kube: &test.MockClient{
+					MockUpdate: test.NewMockUpdateFn(errBoom),
+				},
+				cr: accesskey(withAccessKey(accessKeyID), withUsername(userName), withStatus(string(activeStatus)),
+					withRotation(24*time.Hour, time.Hour), withObservation(lastUsed())),
+			},
+			want: want{
+				cr: accesskey(withAccessKey(newAccessKeyID), withUsername(userName), withStatus(string(activeStatus)),
+					withRotation(24*time.Hour, time.Hour), withPreviousAccessKeyAnnotation(accessKeyID), withObservation(lastUsed())),
+				err: errors.Wrap(errBoom, errKubeUpdateFailed),
+			},
+		},
+		"RetirePreviousAccessKey": {
+			args: args{
+				iam: &fake.MockAccessClient{
+					MockUpdateAccessKey: func(ctx context.Context, input *awsiam.UpdateAccessKeyInput, opts []func(*awsiam.Options)) (*awsiam.UpdateAccessKeyOutput, error) {
+						return &awsiam.UpdateAccessKeyOutput{}, nil
+					},
+					MockDeleteAccessKey: func(ctx context.Context, input *awsiam.DeleteAccessKeyInput, opts []func(*awsiam.Options)) (*awsiam.DeleteAccessKeyOutput, error) {
+						if diff := cmp.Diff("previous", aws.ToString(input.AccessKeyId)); diff != "" {
+							t.Errorf("r: -want, +got:\n%s", diff)
+						}
+						return &awsiam.DeleteAccessKeyOutput{}, nil
+					},
+				},
+				cr: accesskey(withAccessKey(accessKeyID), withUsername(userName), withStatus(string(activeStatus)),
+					withRotation(100*365*24*time.Hour, time.Hour),
+					withObservation(v1beta1.AccessKeyObservation{
+						CreateDate:          &metav1.Time{Time: createDate},
+						PreviousAccessKeyID: "previous",
+						LastRotationTime:    &metav1.Time{Time: createDate},
+					})),
+			},
+			want: want{
+				cr: accesskey(withAccessKey(accessKeyID), withUsername(userName), withStatus(string(activeStatus)),
+					withRotation(100*365*24*time.Hour, time.Hour),
+					withObservation(v1beta1.AccessKeyObservation{
+						CreateDate:       &metav1.Time{Time: createDate},
+						LastRotationTime: &metav1.Time{Time: createDate},
+					})),
+			},
+		},
 		"InValidInput": {
 			args: args{
 				cr: unexpectedItem,
@@ -431,7 +776,7 @@ func TestUpdate(t *testing.T) {
 
 	for name, tc := range cases {
 		t.Run(name, func(t *testing.T) {
-			e := &external{client: tc.iam}
+			e := &external{client: tc.iam, kube: tc.kube}
 			update, err := e.Update(context.Background(), tc.args.cr)
 
 			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
@@ -440,7 +785,8 @@ func TestUpdate(t *testing.T) {
 			if diff := cmp.Diff(tc.want.update, update, test.EquateConditions()); diff != "" {
 				t.Errorf("r: -want, +got:\n%s", diff)
 			}
-			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+			// NOTE: The time of a rotation is the current time.
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions(), cmpopts.IgnoreFields(v1beta1.AccessKeyObservation{}, "LastRotationTime")); diff != "" {
 				t.Errorf("r: -want, +got:\n%s", diff)
 			}
 		})
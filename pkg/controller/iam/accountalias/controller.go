@@ -0,0 +1,213 @@
+/*
+Copyright 2023 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package accountalias
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	awsiam "github.com/aws/aws-sdk-go-v2/service/iam"
+	"github.com/pkg/errors"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/connection"
+	"github.com/crossplane/crossplane-runtime/pkg/controller"
+	"github.com/crossplane/crossplane-runtime/pkg/event"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+
+	"github.com/crossplane-contrib/provider-aws/apis/iam/v1beta1"
+	"github.com/crossplane-contrib/provider-aws/apis/v1alpha1"
+	awsclient "github.com/crossplane-contrib/provider-aws/pkg/clients"
+	"github.com/crossplane-contrib/provider-aws/pkg/clients/iam"
+	"github.com/crossplane-contrib/provider-aws/pkg/features"
+)
+
+const (
+	errUnexpectedObject = "The managed resource is not an AccountAlias resource"
+	errList             = "failed to list the account aliases"
+	errCreate           = "failed to create the account alias"
+	errDelete           = "failed to delete the account alias"
+	errForeignAlias     = "the account already has the alias %q that is not managed by this resource"
+)
+
+// SetupAccountAlias adds a controller that reconciles AccountAliases.
+func SetupAccountAlias(mgr ctrl.Manager, o controller.Options) error {
+	name := managed.ControllerName(v1beta1.AccountAliasGroupKind)
+
+	cps := []managed.ConnectionPublisher{managed.NewAPISecretPublisher(mgr.GetClient(), mgr.GetScheme())}
+	if o.Features.Enabled(features.EnableAlphaExternalSecretStores) {
+		cps = append(cps, connection.NewDetailsManager(mgr.GetClient(), v1alpha1.StoreConfigGroupVersionKind))
+	}
+
+	return ctrl.NewControllerManagedBy(mgr).
+		Named(name).
+		WithOptions(o.ForControllerRuntime()).
+		WithEventFilter(resource.DesiredStateChanged()).
+		For(&v1beta1.AccountAlias{}).
+		Complete(managed.NewReconciler(mgr,
+			resource.ManagedKind(v1beta1.AccountAliasGroupVersionKind),
+			managed.WithExternalConnecter(&connector{kube: mgr.GetClient(), newClientFn: iam.NewAccountAliasClient}),
+			managed.WithInitializers(),
+			managed.WithPollInterval(o.PollInterval),
+			managed.WithLogger(o.Logger.WithValues("controller", name)),
+			managed.WithRecorder(event.NewAPIRecorder(mgr.GetEventRecorderFor(name))),
+			managed.WithConnectionPublishers(cps...)))
+}
+
+type connector struct {
+	kube        client.Client
+	newClientFn func(config aws.Config) iam.AccountAliasClient
+}
+
+func (c *connector) Connect(ctx context.Context, mg resource.Managed) (managed.ExternalClient, error) {
+	cfg, err := awsclient.GetConfig(ctx, c.kube, mg, awsclient.GlobalRegion)
+	if err != nil {
+		return nil, err
+	}
+	return &external{client: c.newClientFn(*cfg)}, nil
+}
+
+type external struct {
+	client iam.AccountAliasClient
+}
+
+func (e *external) Observe(ctx context.Context, mgd resource.Managed) (managed.ExternalObservation, error) {
+	cr, ok := mgd.(*v1beta1.AccountAlias)
+	if !ok {
+		return managed.ExternalObservation{}, errors.New(errUnexpectedObject)
+	}
+
+	current, err := e.currentAlias(ctx)
+	if err != nil {
+		return managed.ExternalObservation{}, awsclient.Wrap(err, errList)
+	}
+	if current == "" {
+		return managed.ExternalObservation{ResourceExists: false}, nil
+	}
+	// NOTE: An account has at most one alias. An alias that was neither
+	// requested nor created by this resource belongs to someone else and must
+	// not be replaced or deleted.
+	if !isManagedAlias(cr, current) {
+		return managed.ExternalObservation{}, errors.Errorf(errForeignAlias, current)
+	}
+
+	cr.SetConditions(xpv1.Available())
+
+	lateInitialized := false
+	if current == cr.Spec.ForProvider.AccountAlias && meta.GetExternalName(cr) != current {
+		meta.SetExternalName(cr, current)
+		lateInitialized = true
+	}
+
+	return managed.ExternalObservation{
+		ResourceExists:          true,
+		ResourceUpToDate:        current == cr.Spec.ForProvider.AccountAlias,
+		ResourceLateInitialized: lateInitialized,
+	}, nil
+}
+
+func (e *external) Create(ctx context.Context, mgd resource.Managed) (managed.ExternalCreation, error) {
+	cr, ok := mgd.(*v1beta1.AccountAlias)
+	if !ok {
+		return managed.ExternalCreation{}, errors.New(errUnexpectedObject)
+	}
+
+	cr.SetConditions(xpv1.Creating())
+
+	_, err := e.client.CreateAccountAlias(ctx, &awsiam.CreateAccountAliasInput{
+		AccountAlias: aws.String(cr.Spec.ForProvider.AccountAlias),
+	})
+	if err != nil {
+		return managed.ExternalCreation{}, awsclient.Wrap(err, errCreate)
+	}
+	meta.SetExternalName(cr, cr.Spec.ForProvider.AccountAlias)
+	return managed.ExternalCreation{}, nil
+}
+
+func (e *external) Update(ctx context.Context, mgd resource.Managed) (managed.ExternalUpdate, error) {
+	cr, ok := mgd.(*v1beta1.AccountAlias)
+	if !ok {
+		return managed.ExternalUpdate{}, errors.New(errUnexpectedObject)
+	}
+
+	// An account has at most one alias, so a different alias has to be
+	// removed before the desired one can be created.
+	current, err := e.currentAlias(ctx)
+	if err != nil {
+		return managed.ExternalUpdate{}, awsclient.Wrap(err, errList)
+	}
+	if current != "" {
+		if !isManagedAlias(cr, current) {
+			return managed.ExternalUpdate{}, errors.Errorf(errForeignAlias, current)
+		}
+		_, err := e.client.DeleteAccountAlias(ctx, &awsiam.DeleteAccountAliasInput{
+			AccountAlias: aws.String(current),
+		})
+		if resource.Ignore(iam.IsErrorNotFound, err) != nil {
+			return managed.ExternalUpdate{}, awsclient.Wrap(err, errDelete)
+		}
+	}
+
+	_, err = e.client.CreateAccountAlias(ctx, &awsiam.CreateAccountAliasInput{
+		AccountAlias: aws.String(cr.Spec.ForProvider.AccountAlias),
+	})
+	return managed.ExternalUpdate{}, awsclient.Wrap(err, errCreate)
+}
+
+func (e *external) Delete(ctx context.Context, mgd resource.Managed) error {
+	cr, ok := mgd.(*v1beta1.AccountAlias)
+	if !ok {
+		return errors.New(errUnexpectedObject)
+	}
+
+	cr.SetConditions(xpv1.Deleting())
+
+	// NOTE: The current alias is deleted rather than the desired one because
+	// the alias of the account may still be the one that the desired alias
+	// replaces.
+	current, err := e.currentAlias(ctx)
+	if err != nil {
+		return awsclient.Wrap(err, errList)
+	}
+	if current == "" || !isManagedAlias(cr, current) {
+		return nil
+	}
+	_, err = e.client.DeleteAccountAlias(ctx, &awsiam.DeleteAccountAliasInput{
+		AccountAlias: aws.String(current),
+	})
+	return awsclient.Wrap(resource.Ignore(iam.IsErrorNotFound, err), errDelete)
+}
+
+// isManagedAlias returns true if the alias is either the desired one or the
+// one that was created by the resource.
+func isManagedAlias(cr *v1beta1.AccountAlias, alias string) bool {
+	return alias == cr.Spec.ForProvider.AccountAlias || alias == meta.GetExternalName(cr)
+}
+
+// currentAlias returns the alias of the account, or an empty string if the
+// account has no alias.
+func (e *external) currentAlias(ctx context.Context) (string, error) {
+	res, err := e.client.ListAccountAliases(ctx, &awsiam.ListAccountAliasesInput{})
+	if err != nil || len(res.AccountAliases) == 0 {
+		return "", err
+	}
+	return res.AccountAliases[0], nil
+}
@@ -0,0 +1,492 @@
+/*
+Copyright 2023 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package accountalias
+
+import (
+	"context"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	awsiam "github.com/aws/aws-sdk-go-v2/service/iam"
+	awsiamtypes "github.com/aws/aws-sdk-go-v2/service/iam/types"
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+
+	"github.com/crossplane-contrib/provider-aws/apis/iam/v1beta1"
+	awsclient "github.com/crossplane-contrib/provider-aws/pkg/clients"
+	"github.com/crossplane-contrib/provider-aws/pkg/clients/iam"
+	"github.com/crossplane-contrib/provider-aws/pkg/clients/iam/fake"
+)
+
+var (
+	// an arbitrary managed resource
+	unexpectedItem resource.Managed
+	alias          = "some-alias"
+	otherAlias     = "other-alias"
+
+	errBoom = errors.New("boom")
+)
+
+type args struct {
+	iam iam.AccountAliasClient
+	cr  resource.Managed
+}
+
+type accountAliasModifier func(*v1beta1.AccountAlias)
+
+func withConditions(c ...xpv1.Condition) accountAliasModifier {
+	return func(r *v1beta1.AccountAlias) { r.Status.ConditionedStatus.Conditions = c }
+}
+
+func withExternalName(n string) accountAliasModifier {
+	return func(r *v1beta1.AccountAlias) { meta.SetExternalName(r, n) }
+}
+
+func accountAlias(m ...accountAliasModifier) *v1beta1.AccountAlias {
+	cr := &v1beta1.AccountAlias{
+		Spec: v1beta1.AccountAliasSpec{
+			ForProvider: v1beta1.AccountAliasParameters{
+				AccountAlias: alias,
+			},
+		},
+	}
+	for _, f := range m {
+		f(cr)
+	}
+	return cr
+}
+
+func listAccountAliases(aliases ...string) func(ctx context.Context, input *awsiam.ListAccountAliasesInput, opts []func(*awsiam.Options)) (*awsiam.ListAccountAliasesOutput, error) {
+	return func(ctx context.Context, input *awsiam.ListAccountAliasesInput, opts []func(*awsiam.Options)) (*awsiam.ListAccountAliasesOutput, error) {
+		return &awsiam.ListAccountAliasesOutput{AccountAliases: aliases}, nil
+	}
+}
+
+func TestObserve(t *testing.T) {
+
+	type want struct {
+		cr     resource.Managed
+		result managed.ExternalObservation
+		err    error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"UpToDate": {
+			args: args{
+				iam: &fake.MockAccountAliasClient{
+					MockListAccountAliases: listAccountAliases(alias),
+				},
+				cr: accountAlias(withExternalName(alias)),
+			},
+			want: want{
+				cr: accountAlias(withExternalName(alias), withConditions(xpv1.Available())),
+				result: managed.ExternalObservation{
+					ResourceExists:   true,
+					ResourceUpToDate: true,
+				},
+			},
+		},
+		"AdoptDesiredAlias": {
+			args: args{
+				iam: &fake.MockAccountAliasClient{
+					MockListAccountAliases: listAccountAliases(alias),
+				},
+				cr: accountAlias(),
+			},
+			want: want{
+				cr: accountAlias(withExternalName(alias), withConditions(xpv1.Available())),
+				result: managed.ExternalObservation{
+					ResourceExists:          true,
+					ResourceUpToDate:        true,
+					ResourceLateInitialized: true,
+				},
+			},
+		},
+		"ReplacedAlias": {
+			args: args{
+				iam: &fake.MockAccountAliasClient{
+					MockListAccountAliases: listAccountAliases(otherAlias),
+				},
+				cr: accountAlias(withExternalName(otherAlias)),
+			},
+			want: want{
+				cr: accountAlias(withExternalName(otherAlias), withConditions(xpv1.Available())),
+				result: managed.ExternalObservation{
+					ResourceExists:   true,
+					ResourceUpToDate: false,
+				},
+			},
+		},
+		"ForeignAlias": {
+			args: args{
+				iam: &fake.MockAccountAliasClient{
+					MockListAccountAliases: listAccountAliases(otherAlias),
+				},
+				cr: accountAlias(),
+			},
+			want: want{
+				cr:  accountAlias(),
+				err: errors.Errorf(errForeignAlias, otherAlias),
+			},
+		},
+		"NoAlias": {
+			args: args{
+				iam: &fake.MockAccountAliasClient{
+					MockListAccountAliases: listAccountAliases(),
+				},
+				cr: accountAlias(),
+			},
+			want: want{
+				cr: accountAlias(),
+			},
+		},
+		"InValidInput": {
+			args: args{
+				cr: unexpectedItem,
+			},
+			want: want{
+				cr:  unexpectedItem,
+				err: errors.New(errUnexpectedObject),
+			},
+		},
+		"ClientError": {
+			args: args{
+				iam: &fake.MockAccountAliasClient{
+					MockListAccountAliases: func(ctx context.Context, input *awsiam.ListAccountAliasesInput, opts []func(*awsiam.Options)) (*awsiam.ListAccountAliasesOutput, error) {
+						return nil, errBoom
+					},
+				},
+				cr: accountAlias(),
+			},
+			want: want{
+				cr:  accountAlias(),
+				err: awsclient.Wrap(errBoom, errList),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{client: tc.iam}
+			o, err := e.Observe(context.Background(), tc.args.cr)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.result, o); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestCreate(t *testing.T) {
+
+	type want struct {
+		cr     resource.Managed
+		result managed.ExternalCreation
+		err    error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"VaildInput": {
+			args: args{
+				iam: &fake.MockAccountAliasClient{
+					MockCreateAccountAlias: func(ctx context.Context, input *awsiam.CreateAccountAliasInput, opts []func(*awsiam.Options)) (*awsiam.CreateAccountAliasOutput, error) {
+						if diff := cmp.Diff(alias, aws.ToString(input.AccountAlias)); diff != "" {
+							t.Errorf("r: -want, +got:\n%s", diff)
+						}
+						return &awsiam.CreateAccountAliasOutput{}, nil
+					},
+				},
+				cr: accountAlias(),
+			},
+			want: want{
+				cr: accountAlias(withExternalName(alias), withConditions(xpv1.Creating())),
+			},
+		},
+		"InValidInput": {
+			args: args{
+				cr: unexpectedItem,
+			},
+			want: want{
+				cr:  unexpectedItem,
+				err: errors.New(errUnexpectedObject),
+			},
+		},
+		"ClientError": {
+			args: args{
+				iam: &fake.MockAccountAliasClient{
+					MockCreateAccountAlias: func(ctx context.Context, input *awsiam.CreateAccountAliasInput, opts []func(*awsiam.Options)) (*awsiam.CreateAccountAliasOutput, error) {
+						return nil, errBoom
+					},
+				},
+				cr: accountAlias(),
+			},
+			want: want{
+				cr:  accountAlias(withConditions(xpv1.Creating())),
+				err: awsclient.Wrap(errBoom, errCreate),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{client: tc.iam}
+			o, err := e.Create(context.Background(), tc.args.cr)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.result, o); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestUpdate(t *testing.T) {
+
+	type want struct {
+		cr     resource.Managed
+		result managed.ExternalUpdate
+		err    error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"ReplacesAlias": {
+			args: args{
+				iam: &fake.MockAccountAliasClient{
+					MockListAccountAliases: listAccountAliases(otherAlias),
+					MockDeleteAccountAlias: func(ctx context.Context, input *awsiam.DeleteAccountAliasInput, opts []func(*awsiam.Options)) (*awsiam.DeleteAccountAliasOutput, error) {
+						if diff := cmp.Diff(otherAlias, aws.ToString(input.AccountAlias)); diff != "" {
+							t.Errorf("r: -want, +got:\n%s", diff)
+						}
+						return &awsiam.DeleteAccountAliasOutput{}, nil
+					},
+					MockCreateAccountAlias: func(ctx context.Context, input *awsiam.CreateAccountAliasInput, opts []func(*awsiam.Options)) (*awsiam.CreateAccountAliasOutput, error) {
+						if diff := cmp.Diff(alias, aws.ToString(input.AccountAlias)); diff != "" {
+							t.Errorf("r: -want, +got:\n%s", diff)
+						}
+						return &awsiam.CreateAccountAliasOutput{}, nil
+					},
+				},
+				cr: accountAlias(withExternalName(otherAlias)),
+			},
+			want: want{
+				cr: accountAlias(withExternalName(otherAlias)),
+			},
+		},
+		"InValidInput": {
+			args: args{
+				cr: unexpectedItem,
+			},
+			want: want{
+				cr:  unexpectedItem,
+				err: errors.New(errUnexpectedObject),
+			},
+		},
+		"DeleteError": {
+			args: args{
+				iam: &fake.MockAccountAliasClient{
+					MockListAccountAliases: listAccountAliases(otherAlias),
+					MockDeleteAccountAlias: func(ctx context.Context, input *awsiam.DeleteAccountAliasInput, opts []func(*awsiam.Options)) (*awsiam.DeleteAccountAliasOutput, error) {
+						return nil, errBoom
+					},
+				},
+				cr: accountAlias(withExternalName(otherAlias)),
+			},
+			want: want{
+				cr:  accountAlias(withExternalName(otherAlias)),
+				err: awsclient.Wrap(errBoom, errDelete),
+			},
+		},
+		"ForeignAlias": {
+			args: args{
+				iam: &fake.MockAccountAliasClient{
+					MockListAccountAliases: listAccountAliases(otherAlias),
+				},
+				cr: accountAlias(),
+			},
+			want: want{
+				cr:  accountAlias(),
+				err: errors.Errorf(errForeignAlias, otherAlias),
+			},
+		},
+		"CreateError": {
+			args: args{
+				iam: &fake.MockAccountAliasClient{
+					MockListAccountAliases: listAccountAliases(),
+					MockCreateAccountAlias: func(ctx context.Context, input *awsiam.CreateAccountAliasInput, opts []func(*awsiam.Options)) (*awsiam.CreateAccountAliasOutput, error) {
+						return nil, errBoom
+					},
+				},
+				cr: accountAlias(),
+			},
+			want: want{
+				cr:  accountAlias(),
+				err: awsclient.Wrap(errBoom, errCreate),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{client: tc.iam}
+			o, err := e.Update(context.Background(), tc.args.cr)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.result, o); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestDelete(t *testing.T) {
+
+	type want struct {
+		cr  resource.Managed
+		err error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"VaildInput": {
+			args: args{
+				iam: &fake.MockAccountAliasClient{
+					MockListAccountAliases: listAccountAliases(alias),
+					MockDeleteAccountAlias: func(ctx context.Context, input *awsiam.DeleteAccountAliasInput, opts []func(*awsiam.Options)) (*awsiam.DeleteAccountAliasOutput, error) {
+						return &awsiam.DeleteAccountAliasOutput{}, nil
+					},
+				},
+				cr: accountAlias(),
+			},
+			want: want{
+				cr: accountAlias(withConditions(xpv1.Deleting())),
+			},
+		},
+		"InValidInput": {
+			args: args{
+				cr: unexpectedItem,
+			},
+			want: want{
+				cr:  unexpectedItem,
+				err: errors.New(errUnexpectedObject),
+			},
+		},
+		"ResourceDoesNotExist": {
+			args: args{
+				iam: &fake.MockAccountAliasClient{
+					MockListAccountAliases: listAccountAliases(alias),
+					MockDeleteAccountAlias: func(ctx context.Context, input *awsiam.DeleteAccountAliasInput, opts []func(*awsiam.Options)) (*awsiam.DeleteAccountAliasOutput, error) {
+						return nil, &awsiamtypes.NoSuchEntityException{}
+					},
+				},
+				cr: accountAlias(),
+			},
+			want: want{
+				cr: accountAlias(withConditions(xpv1.Deleting())),
+			},
+		},
+		"ReplacedAlias": {
+			args: args{
+				iam: &fake.MockAccountAliasClient{
+					MockListAccountAliases: listAccountAliases(otherAlias),
+					MockDeleteAccountAlias: func(ctx context.Context, input *awsiam.DeleteAccountAliasInput, opts []func(*awsiam.Options)) (*awsiam.DeleteAccountAliasOutput, error) {
+						if diff := cmp.Diff(otherAlias, aws.ToString(input.AccountAlias)); diff != "" {
+							t.Errorf("r: -want, +got:\n%s", diff)
+						}
+						return &awsiam.DeleteAccountAliasOutput{}, nil
+					},
+				},
+				cr: accountAlias(withExternalName(otherAlias)),
+			},
+			want: want{
+				cr: accountAlias(withExternalName(otherAlias), withConditions(xpv1.Deleting())),
+			},
+		},
+		"ForeignAlias": {
+			args: args{
+				iam: &fake.MockAccountAliasClient{
+					MockListAccountAliases: listAccountAliases(otherAlias),
+				},
+				cr: accountAlias(),
+			},
+			want: want{
+				cr: accountAlias(withConditions(xpv1.Deleting())),
+			},
+		},
+		"ClientError": {
+			args: args{
+				iam: &fake.MockAccountAliasClient{
+					MockListAccountAliases: listAccountAliases(alias),
+					MockDeleteAccountAlias: func(ctx context.Context, input *awsiam.DeleteAccountAliasInput, opts []func(*awsiam.Options)) (*awsiam.DeleteAccountAliasOutput, error) {
+						return nil, errBoom
+					},
+				},
+				cr: accountAlias(),
+			},
+			want: want{
+				cr:  accountAlias(withConditions(xpv1.Deleting())),
+				err: awsclient.Wrap(errBoom, errDelete),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{client: tc.iam}
+			err := e.Delete(context.Background(), tc.args.cr)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,442 @@
+/*
+Copyright 2023 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package accountpasswordpolicy
+
+import (
+	"context"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	awsiam "github.com/aws/aws-sdk-go-v2/service/iam"
+	awsiamtypes "github.com/aws/aws-sdk-go-v2/service/iam/types"
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+	"github.com/pkg/errors"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+
+	"github.com/crossplane-contrib/provider-aws/apis/iam/v1beta1"
+	awsclient "github.com/crossplane-contrib/provider-aws/pkg/clients"
+	"github.com/crossplane-contrib/provider-aws/pkg/clients/iam"
+	"github.com/crossplane-contrib/provider-aws/pkg/clients/iam/fake"
+)
+
+var (
+	// an arbitrary managed resource
+	unexpectedItem resource.Managed
+
+	errBoom = errors.New("boom")
+)
+
+type args struct {
+	iam iam.AccountPasswordPolicyClient
+	cr  resource.Managed
+}
+
+type passwordPolicyModifier func(*v1beta1.AccountPasswordPolicy)
+
+func withConditions(c ...xpv1.Condition) passwordPolicyModifier {
+	return func(r *v1beta1.AccountPasswordPolicy) { r.Status.ConditionedStatus.Conditions = c }
+}
+
+func withSpec(p v1beta1.AccountPasswordPolicyParameters) passwordPolicyModifier {
+	return func(r *v1beta1.AccountPasswordPolicy) { r.Spec.ForProvider = p }
+}
+
+func withMinimumPasswordLength(l int32) passwordPolicyModifier {
+	return func(r *v1beta1.AccountPasswordPolicy) { r.Spec.ForProvider.MinimumPasswordLength = aws.Int32(l) }
+}
+
+func withExpirePasswords(b bool) passwordPolicyModifier {
+	return func(r *v1beta1.AccountPasswordPolicy) { r.Status.AtProvider.ExpirePasswords = b }
+}
+
+func passwordPolicy(m ...passwordPolicyModifier) *v1beta1.AccountPasswordPolicy {
+	cr := &v1beta1.AccountPasswordPolicy{}
+	for _, f := range m {
+		f(cr)
+	}
+	return cr
+}
+
+// observedParameters are the parameters that match observedPolicy.
+var observedParameters = v1beta1.AccountPasswordPolicyParameters{
+	AllowUsersToChangePassword: aws.Bool(true),
+	MaxPasswordAge:             aws.Int32(90),
+	MinimumPasswordLength:      aws.Int32(14),
+	RequireLowercaseCharacters: aws.Bool(true),
+	RequireNumbers:             aws.Bool(true),
+	RequireSymbols:             aws.Bool(false),
+	RequireUppercaseCharacters: aws.Bool(true),
+}
+
+var observedPolicy = awsiamtypes.PasswordPolicy{
+	AllowUsersToChangePassword: true,
+	ExpirePasswords:            true,
+	MaxPasswordAge:             aws.Int32(90),
+	MinimumPasswordLength:      aws.Int32(14),
+	RequireLowercaseCharacters: true,
+	RequireNumbers:             true,
+	RequireUppercaseCharacters: true,
+}
+
+func getAccountPasswordPolicy(ctx context.Context, input *awsiam.GetAccountPasswordPolicyInput, opts []func(*awsiam.Options)) (*awsiam.GetAccountPasswordPolicyOutput, error) {
+	p := observedPolicy
+	return &awsiam.GetAccountPasswordPolicyOutput{PasswordPolicy: &p}, nil
+}
+
+func TestObserve(t *testing.T) {
+
+	type want struct {
+		cr     resource.Managed
+		result managed.ExternalObservation
+		err    error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"UpToDate": {
+			args: args{
+				iam: &fake.MockAccountPasswordPolicyClient{
+					MockGetAccountPasswordPolicy: getAccountPasswordPolicy,
+				},
+				cr: passwordPolicy(withSpec(observedParameters)),
+			},
+			want: want{
+				cr: passwordPolicy(withSpec(observedParameters), withExpirePasswords(true), withConditions(xpv1.Available())),
+				result: managed.ExternalObservation{
+					ResourceExists:   true,
+					ResourceUpToDate: true,
+				},
+			},
+		},
+		"LateInitialized": {
+			args: args{
+				iam: &fake.MockAccountPasswordPolicyClient{
+					MockGetAccountPasswordPolicy: getAccountPasswordPolicy,
+				},
+				cr: passwordPolicy(),
+			},
+			want: want{
+				cr: passwordPolicy(withSpec(observedParameters), withExpirePasswords(true), withConditions(xpv1.Available())),
+				result: managed.ExternalObservation{
+					ResourceExists:          true,
+					ResourceUpToDate:        true,
+					ResourceLateInitialized: true,
+				},
+			},
+		},
+		"NotUpToDate": {
+			args: args{
+				iam: &fake.MockAccountPasswordPolicyClient{
+					MockGetAccountPasswordPolicy: getAccountPasswordPolicy,
+				},
+				cr: passwordPolicy(withMinimumPasswordLength(20)),
+			},
+			want: want{
+				cr: passwordPolicy(withSpec(observedParameters), withMinimumPasswordLength(20),
+					withExpirePasswords(true), withConditions(xpv1.Available())),
+				result: managed.ExternalObservation{
+					ResourceExists:          true,
+					ResourceUpToDate:        false,
+					ResourceLateInitialized: true,
+				},
+			},
+		},
+		"InValidInput": {
+			args: args{
+				cr: unexpectedItem,
+			},
+			want: want{
+				cr:  unexpectedItem,
+				err: errors.New(errUnexpectedObject),
+			},
+		},
+		"ClientError": {
+			args: args{
+				iam: &fake.MockAccountPasswordPolicyClient{
+					MockGetAccountPasswordPolicy: func(ctx context.Context, input *awsiam.GetAccountPasswordPolicyInput, opts []func(*awsiam.Options)) (*awsiam.GetAccountPasswordPolicyOutput, error) {
+						return nil, errBoom
+					},
+				},
+				cr: passwordPolicy(),
+			},
+			want: want{
+				cr:  passwordPolicy(),
+				err: awsclient.Wrap(errBoom, errGet),
+			},
+		},
+		"ResourceDoesNotExist": {
+			args: args{
+				iam: &fake.MockAccountPasswordPolicyClient{
+					MockGetAccountPasswordPolicy: func(ctx context.Context, input *awsiam.GetAccountPasswordPolicyInput, opts []func(*awsiam.Options)) (*awsiam.GetAccountPasswordPolicyOutput, error) {
+						return nil, &awsiamtypes.NoSuchEntityException{}
+					},
+				},
+				cr: passwordPolicy(),
+			},
+			want: want{
+				cr: passwordPolicy(),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{client: tc.iam}
+			o, err := e.Observe(context.Background(), tc.args.cr)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.result, o, cmpopts.IgnoreFields(managed.ExternalObservation{}, "Diff")); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestCreate(t *testing.T) {
+
+	type want struct {
+		cr     resource.Managed
+		result managed.ExternalCreation
+		err    error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"VaildInput": {
+			args: args{
+				iam: &fake.MockAccountPasswordPolicyClient{
+					MockUpdateAccountPasswordPolicy: func(ctx context.Context, input *awsiam.UpdateAccountPasswordPolicyInput, opts []func(*awsiam.Options)) (*awsiam.UpdateAccountPasswordPolicyOutput, error) {
+						if diff := cmp.Diff(&awsiam.UpdateAccountPasswordPolicyInput{
+							AllowUsersToChangePassword: true,
+							MaxPasswordAge:             aws.Int32(90),
+							MinimumPasswordLength:      aws.Int32(14),
+							RequireLowercaseCharacters: true,
+							RequireNumbers:             true,
+							RequireUppercaseCharacters: true,
+						}, input, cmpopts.IgnoreUnexported(awsiam.UpdateAccountPasswordPolicyInput{})); diff != "" {
+							t.Errorf("r: -want, +got:\n%s", diff)
+						}
+						return &awsiam.UpdateAccountPasswordPolicyOutput{}, nil
+					},
+				},
+				cr: passwordPolicy(withSpec(observedParameters)),
+			},
+			want: want{
+				cr: passwordPolicy(withSpec(observedParameters), withConditions(xpv1.Creating())),
+			},
+		},
+		"InValidInput": {
+			args: args{
+				cr: unexpectedItem,
+			},
+			want: want{
+				cr:  unexpectedItem,
+				err: errors.New(errUnexpectedObject),
+			},
+		},
+		"ClientError": {
+			args: args{
+				iam: &fake.MockAccountPasswordPolicyClient{
+					MockUpdateAccountPasswordPolicy: func(ctx context.Context, input *awsiam.UpdateAccountPasswordPolicyInput, opts []func(*awsiam.Options)) (*awsiam.UpdateAccountPasswordPolicyOutput, error) {
+						return nil, errBoom
+					},
+				},
+				cr: passwordPolicy(),
+			},
+			want: want{
+				cr:  passwordPolicy(withConditions(xpv1.Creating())),
+				err: awsclient.Wrap(errBoom, errUpdate),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{client: tc.iam}
+			o, err := e.Create(context.Background(), tc.args.cr)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.result, o); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestUpdate(t *testing.T) {
+
+	type want struct {
+		cr     resource.Managed
+		result managed.ExternalUpdate
+		err    error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"VaildInput": {
+			args: args{
+				iam: &fake.MockAccountPasswordPolicyClient{
+					MockUpdateAccountPasswordPolicy: func(ctx context.Context, input *awsiam.UpdateAccountPasswordPolicyInput, opts []func(*awsiam.Options)) (*awsiam.UpdateAccountPasswordPolicyOutput, error) {
+						return &awsiam.UpdateAccountPasswordPolicyOutput{}, nil
+					},
+				},
+				cr: passwordPolicy(withSpec(observedParameters)),
+			},
+			want: want{
+				cr: passwordPolicy(withSpec(observedParameters)),
+			},
+		},
+		"InValidInput": {
+			args: args{
+				cr: unexpectedItem,
+			},
+			want: want{
+				cr:  unexpectedItem,
+				err: errors.New(errUnexpectedObject),
+			},
+		},
+		"ClientError": {
+			args: args{
+				iam: &fake.MockAccountPasswordPolicyClient{
+					MockUpdateAccountPasswordPolicy: func(ctx context.Context, input *awsiam.UpdateAccountPasswordPolicyInput, opts []func(*awsiam.Options)) (*awsiam.UpdateAccountPasswordPolicyOutput, error) {
+						return nil, errBoom
+					},
+				},
+				cr: passwordPolicy(),
+			},
+			want: want{
+				cr:  passwordPolicy(),
+				err: awsclient.Wrap(errBoom, errUpdate),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{client: tc.iam}
+			o, err := e.Update(context.Background(), tc.args.cr)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.result, o); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestDelete(t *testing.T) {
+
+	type want struct {
+		cr  resource.Managed
+		err error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"VaildInput": {
+			args: args{
+				iam: &fake.MockAccountPasswordPolicyClient{
+					MockDeleteAccountPasswordPolicy: func(ctx context.Context, input *awsiam.DeleteAccountPasswordPolicyInput, opts []func(*awsiam.Options)) (*awsiam.DeleteAccountPasswordPolicyOutput, error) {
+						return &awsiam.DeleteAccountPasswordPolicyOutput{}, nil
+					},
+				},
+				cr: passwordPolicy(),
+			},
+			want: want{
+				cr: passwordPolicy(withConditions(xpv1.Deleting())),
+			},
+		},
+		"InValidInput": {
+			args: args{
+				cr: unexpectedItem,
+			},
+			want: want{
+				cr:  unexpectedItem,
+				err: errors.New(errUnexpectedObject),
+			},
+		},
+		"ResourceDoesNotExist": {
+			args: args{
+				iam: &fake.MockAccountPasswordPolicyClient{
+					MockDeleteAccountPasswordPolicy: func(ctx context.Context, input *awsiam.DeleteAccountPasswordPolicyInput, opts []func(*awsiam.Options)) (*awsiam.DeleteAccountPasswordPolicyOutput, error) {
+						return nil, &awsiamtypes.NoSuchEntityException{}
+					},
+				},
+				cr: passwordPolicy(),
+			},
+			want: want{
+				cr: passwordPolicy(withConditions(xpv1.Deleting())),
+			},
+		},
+		"ClientError": {
+			args: args{
+				iam: &fake.MockAccountPasswordPolicyClient{
+					MockDeleteAccountPasswordPolicy: func(ctx context.Context, input *awsiam.DeleteAccountPasswordPolicyInput, opts []func(*awsiam.Options)) (*awsiam.DeleteAccountPasswordPolicyOutput, error) {
+						return nil, errBoom
+					},
+				},
+				cr: passwordPolicy(),
+			},
+			want: want{
+				cr:  passwordPolicy(withConditions(xpv1.Deleting())),
+				err: awsclient.Wrap(errBoom, errDelete),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{client: tc.iam}
+			err := e.Delete(context.Background(), tc.args.cr)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
@@ -85,12 +85,17 @@ func (c *connector) Connect(ctx context.Context, mg resource.Managed) (managed.E
 	if err != nil {
 		return nil, err
 	}
-	return &external{client: c.newClientFn(*cfg), kube: c.kube}, nil
+	return &external{
+		client:   c.newClientFn(*cfg),
+		policies: iam.NewGroupInlinePolicyClient(iam.NewGroupPolicyClient(*cfg)),
+		kube:     c.kube,
+	}, nil
 }
 
 type external struct {
-	kube   client.Client
-	client iam.GroupClient
+	kube     client.Client
+	client   iam.GroupClient
+	policies iam.InlinePolicyClient
 }
 
 func (e *external) Observe(ctx context.Context, mgd resource.Managed) (managed.ExternalObservation, error) {
@@ -129,10 +134,19 @@ func (e *external) Observe(ctx context.Context, mgd resource.Managed) (managed.E
 		GroupID: aws.ToString(group.GroupId),
 	}
 
+	var unmanaged []string
+	if aws.ToBool(cr.Spec.ForProvider.RemoveUnmanagedInlinePolicies) {
+		unmanaged, err = iam.FindUnmanagedInlinePolicies(ctx, e.kube, e.policies, cr, &v1beta1.GroupPolicyList{}, policyGroupName)
+		if err != nil {
+			return managed.ExternalObservation{}, err
+		}
+	}
+
 	return managed.ExternalObservation{
 		ResourceExists: true,
 		ResourceUpToDate: aws.ToString(cr.Spec.ForProvider.Path) == aws.ToString(group.Path) &&
-			meta.GetExternalName(cr) == aws.ToString(group.GroupName),
+			meta.GetExternalName(cr) == aws.ToString(group.GroupName) &&
+			len(unmanaged) == 0,
 	}, nil
 }
 
@@ -160,8 +174,14 @@ func (e *external) Update(ctx context.Context, mgd resource.Managed) (managed.Ex
 		NewPath:      cr.Spec.ForProvider.Path,
 		NewGroupName: aws.String(meta.GetExternalName(cr)),
 	})
+	if err != nil {
+		return managed.ExternalUpdate{}, awsclient.Wrap(err, errUpdate)
+	}
 
-	return managed.ExternalUpdate{}, awsclient.Wrap(err, errUpdate)
+	if !aws.ToBool(cr.Spec.ForProvider.RemoveUnmanagedInlinePolicies) {
+		return managed.ExternalUpdate{}, nil
+	}
+	return managed.ExternalUpdate{}, iam.RemoveUnmanagedInlinePolicies(ctx, e.kube, e.policies, cr, &v1beta1.GroupPolicyList{}, policyGroupName)
 }
 
 func (e *external) Delete(ctx context.Context, mgd resource.Managed) error {
@@ -178,3 +198,8 @@ func (e *external) Delete(ctx context.Context, mgd resource.Managed) error {
 
 	return awsclient.Wrap(resource.Ignore(iam.IsErrorNotFound, err), errDelete)
 }
+
+// policyGroupName returns the name of the group a GroupPolicy refers to.
+func policyGroupName(mg resource.Managed) string {
+	return mg.(*v1beta1.GroupPolicy).Spec.ForProvider.GroupName
+}
@@ -0,0 +1,247 @@
+/*
+Copyright 2023 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package loginprofile
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/aws/arn"
+	awsiam "github.com/aws/aws-sdk-go-v2/service/iam"
+	"github.com/aws/aws-sdk-go-v2/service/sts"
+	"github.com/pkg/errors"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/connection"
+	"github.com/crossplane/crossplane-runtime/pkg/controller"
+	"github.com/crossplane/crossplane-runtime/pkg/event"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/password"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+
+	"github.com/crossplane-contrib/provider-aws/apis/iam/v1beta1"
+	"github.com/crossplane-contrib/provider-aws/apis/v1alpha1"
+	awsclient "github.com/crossplane-contrib/provider-aws/pkg/clients"
+	rds "github.com/crossplane-contrib/provider-aws/pkg/clients/database"
+	"github.com/crossplane-contrib/provider-aws/pkg/clients/iam"
+	"github.com/crossplane-contrib/provider-aws/pkg/features"
+)
+
+const (
+	errUnexpectedObject  = "The managed resource is not a LoginProfile resource"
+	errGet               = "failed to get the login profile of user"
+	errCreate            = "failed to create the login profile of user"
+	errUpdate            = "failed to update the login profile of user"
+	errDelete            = "failed to delete the login profile of user"
+	errGetPassword       = "cannot get password from the given secret"
+	errGeneratePassword  = "cannot generate password"
+	errGetCallerIdentity = "cannot get the caller identity"
+	errNoUserName        = "the name of the user of the login profile is not set"
+)
+
+// connectionSignInURLKey is the key of the console sign-in URL in the
+// connection secret.
+const connectionSignInURLKey = "signInURL"
+
+// SetupLoginProfile adds a controller that reconciles LoginProfiles.
+func SetupLoginProfile(mgr ctrl.Manager, o controller.Options) error {
+	name := managed.ControllerName(v1beta1.LoginProfileGroupKind)
+
+	cps := []managed.ConnectionPublisher{managed.NewAPISecretPublisher(mgr.GetClient(), mgr.GetScheme())}
+	if o.Features.Enabled(features.EnableAlphaExternalSecretStores) {
+		cps = append(cps, connection.NewDetailsManager(mgr.GetClient(), v1alpha1.StoreConfigGroupVersionKind))
+	}
+
+	return ctrl.NewControllerManagedBy(mgr).
+		Named(name).
+		WithOptions(o.ForControllerRuntime()).
+		WithEventFilter(resource.DesiredStateChanged()).
+		For(&v1beta1.LoginProfile{}).
+		Complete(managed.NewReconciler(mgr,
+			resource.ManagedKind(v1beta1.LoginProfileGroupVersionKind),
+			managed.WithExternalConnecter(&connector{kube: mgr.GetClient(), newClientFn: iam.NewLoginProfileClient, newSTSClientFn: iam.NewSTSClient}),
+			managed.WithReferenceResolver(managed.NewAPISimpleReferenceResolver(mgr.GetClient())),
+			managed.WithInitializers(),
+			managed.WithPollInterval(o.PollInterval),
+			managed.WithLogger(o.Logger.WithValues("controller", name)),
+			managed.WithRecorder(event.NewAPIRecorder(mgr.GetEventRecorderFor(name))),
+			managed.WithConnectionPublishers(cps...)))
+}
+
+type connector struct {
+	kube           client.Client
+	newClientFn    func(config aws.Config) iam.LoginProfileClient
+	newSTSClientFn func(config aws.Config) iam.STSClient
+}
+
+func (c *connector) Connect(ctx context.Context, mg resource.Managed) (managed.ExternalClient, error) {
+	cfg, err := awsclient.GetConfig(ctx, c.kube, mg, awsclient.GlobalRegion)
+	if err != nil {
+		return nil, err
+	}
+	return &external{client: c.newClientFn(*cfg), sts: c.newSTSClientFn(*cfg), kube: c.kube}, nil
+}
+
+type external struct {
+	client iam.LoginProfileClient
+	sts    iam.STSClient
+	kube   client.Client
+}
+
+func (e *external) Observe(ctx context.Context, mgd resource.Managed) (managed.ExternalObservation, error) {
+	cr, ok := mgd.(*v1beta1.LoginProfile)
+	if !ok {
+		return managed.ExternalObservation{}, errors.New(errUnexpectedObject)
+	}
+
+	// The login profile has no identity of its own, it is observed by the
+	// name of the user it belongs to. Without it, IAM would return the login
+	// profile of the calling user.
+	if cr.Spec.ForProvider.UserName == "" {
+		return managed.ExternalObservation{}, errors.New(errNoUserName)
+	}
+
+	observed, err := e.client.GetLoginProfile(ctx, &awsiam.GetLoginProfileInput{
+		UserName: aws.String(cr.Spec.ForProvider.UserName),
+	})
+	if err != nil {
+		return managed.ExternalObservation{}, awsclient.Wrap(resource.Ignore(iam.IsErrorNotFound, err), errGet)
+	}
+
+	// The external name may be missing if it could not be persisted after the
+	// login profile was created.
+	lateInitialized := false
+	if meta.GetExternalName(cr) != cr.Spec.ForProvider.UserName {
+		meta.SetExternalName(cr, cr.Spec.ForProvider.UserName)
+		lateInitialized = true
+	}
+
+	cr.Status.AtProvider = iam.GenerateLoginProfileObservation(*observed.LoginProfile)
+	cr.SetConditions(xpv1.Available())
+
+	_, changed, err := rds.GetPassword(ctx, e.kube, cr.Spec.ForProvider.PasswordSecretRef, cr.Spec.WriteConnectionSecretToReference)
+	if err != nil {
+		return managed.ExternalObservation{}, errors.Wrap(err, errGetPassword)
+	}
+
+	return managed.ExternalObservation{
+		ResourceExists:          true,
+		ResourceUpToDate:        !changed && iam.IsLoginProfileUpToDate(cr.Spec.ForProvider, *observed.LoginProfile),
+		ResourceLateInitialized: lateInitialized,
+	}, nil
+}
+
+func (e *external) Create(ctx context.Context, mgd resource.Managed) (managed.ExternalCreation, error) {
+	cr, ok := mgd.(*v1beta1.LoginProfile)
+	if !ok {
+		return managed.ExternalCreation{}, errors.New(errUnexpectedObject)
+	}
+
+	cr.SetConditions(xpv1.Creating())
+
+	pw, _, err := rds.GetPassword(ctx, e.kube, cr.Spec.ForProvider.PasswordSecretRef, cr.Spec.WriteConnectionSecretToReference)
+	if err != nil {
+		return managed.ExternalCreation{}, errors.Wrap(err, errGetPassword)
+	}
+	if pw == "" {
+		pw, err = password.Generate()
+		if err != nil {
+			return managed.ExternalCreation{}, errors.Wrap(err, errGeneratePassword)
+		}
+	}
+
+	// The sign-in URL is determined before the login profile is created, so
+	// that a failure does not leave behind a login profile whose password is
+	// not published.
+	caller, err := e.getCallerIdentityArn(ctx)
+	if err != nil {
+		return managed.ExternalCreation{}, awsclient.Wrap(err, errGetCallerIdentity)
+	}
+
+	_, err = e.client.CreateLoginProfile(ctx, &awsiam.CreateLoginProfileInput{
+		UserName:              aws.String(cr.Spec.ForProvider.UserName),
+		Password:              aws.String(pw),
+		PasswordResetRequired: aws.ToBool(cr.Spec.ForProvider.PasswordResetRequired),
+	})
+	if err != nil {
+		return managed.ExternalCreation{}, awsclient.Wrap(err, errCreate)
+	}
+
+	meta.SetExternalName(cr, cr.Spec.ForProvider.UserName)
+
+	return managed.ExternalCreation{
+		ConnectionDetails: managed.ConnectionDetails{
+			xpv1.ResourceCredentialsSecretUserKey:     []byte(cr.Spec.ForProvider.UserName),
+			xpv1.ResourceCredentialsSecretPasswordKey: []byte(pw),
+			connectionSignInURLKey:                    []byte(iam.SignInURL(caller)),
+		},
+	}, nil
+}
+
+func (e *external) Update(ctx context.Context, mgd resource.Managed) (managed.ExternalUpdate, error) {
+	cr, ok := mgd.(*v1beta1.LoginProfile)
+	if !ok {
+		return managed.ExternalUpdate{}, errors.New(errUnexpectedObject)
+	}
+
+	pw, changed, err := rds.GetPassword(ctx, e.kube, cr.Spec.ForProvider.PasswordSecretRef, cr.Spec.WriteConnectionSecretToReference)
+	if err != nil {
+		return managed.ExternalUpdate{}, errors.Wrap(err, errGetPassword)
+	}
+
+	input := &awsiam.UpdateLoginProfileInput{
+		UserName:              aws.String(cr.Spec.ForProvider.UserName),
+		PasswordResetRequired: cr.Spec.ForProvider.PasswordResetRequired,
+	}
+	var conn managed.ConnectionDetails
+	if changed {
+		input.Password = aws.String(pw)
+		conn = managed.ConnectionDetails{
+			xpv1.ResourceCredentialsSecretPasswordKey: []byte(pw),
+		}
+	}
+
+	if _, err := e.client.UpdateLoginProfile(ctx, input); err != nil {
+		return managed.ExternalUpdate{}, awsclient.Wrap(err, errUpdate)
+	}
+	return managed.ExternalUpdate{ConnectionDetails: conn}, nil
+}
+
+func (e *external) Delete(ctx context.Context, mgd resource.Managed) error {
+	cr, ok := mgd.(*v1beta1.LoginProfile)
+	if !ok {
+		return errors.New(errUnexpectedObject)
+	}
+
+	cr.SetConditions(xpv1.Deleting())
+
+	_, err := e.client.DeleteLoginProfile(ctx, &awsiam.DeleteLoginProfileInput{
+		UserName: aws.String(cr.Spec.ForProvider.UserName),
+	})
+	return awsclient.Wrap(resource.Ignore(iam.IsErrorNotFound, err), errDelete)
+}
+
+func (e *external) getCallerIdentityArn(ctx context.Context) (arn.ARN, error) {
+	resp, err := e.sts.GetCallerIdentity(ctx, &sts.GetCallerIdentityInput{})
+	if err != nil {
+		return arn.ARN{}, err
+	}
+	return arn.Parse(aws.ToString(resp.Arn))
+}
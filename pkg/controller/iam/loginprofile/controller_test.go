@@ -0,0 +1,599 @@
+/*
+Copyright 2023 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package loginprofile
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	awsiam "github.com/aws/aws-sdk-go-v2/service/iam"
+	awsiamtypes "github.com/aws/aws-sdk-go-v2/service/iam/types"
+	"github.com/aws/aws-sdk-go-v2/service/sts"
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+	"github.com/pkg/errors"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+
+	"github.com/crossplane-contrib/provider-aws/apis/iam/v1beta1"
+	awsclient "github.com/crossplane-contrib/provider-aws/pkg/clients"
+	"github.com/crossplane-contrib/provider-aws/pkg/clients/iam"
+	"github.com/crossplane-contrib/provider-aws/pkg/clients/iam/fake"
+)
+
+var (
+	// an arbitrary managed resource
+	unexpectedItem resource.Managed
+	userName       = "some-user"
+	pw             = "some-password"
+	otherPw        = "other-password"
+	callerArn      = "arn:aws:iam::123456789012:user/crossplane"
+	signInURL      = "https://123456789012.signin.aws.amazon.com/console"
+	createDate     = time.Date(2023, 4, 1, 0, 0, 0, 0, time.UTC)
+
+	errBoom = errors.New("boom")
+)
+
+type args struct {
+	iam  iam.LoginProfileClient
+	sts  iam.STSClient
+	kube client.Client
+	cr   resource.Managed
+}
+
+type loginProfileModifier func(*v1beta1.LoginProfile)
+
+func withConditions(c ...xpv1.Condition) loginProfileModifier {
+	return func(r *v1beta1.LoginProfile) { r.Status.ConditionedStatus.Conditions = c }
+}
+
+func withExternalName(n string) loginProfileModifier {
+	return func(r *v1beta1.LoginProfile) { meta.SetExternalName(r, n) }
+}
+
+func withUserName(n string) loginProfileModifier {
+	return func(r *v1beta1.LoginProfile) { r.Spec.ForProvider.UserName = n }
+}
+
+func withPasswordSecretRef() loginProfileModifier {
+	return func(r *v1beta1.LoginProfile) {
+		r.Spec.ForProvider.PasswordSecretRef = &xpv1.SecretKeySelector{
+			SecretReference: xpv1.SecretReference{Name: "password", Namespace: "default"},
+			Key:             "password",
+		}
+		r.Spec.WriteConnectionSecretToReference = &xpv1.SecretReference{Name: "connection", Namespace: "default"}
+	}
+}
+
+func withPasswordResetRequired(b bool) loginProfileModifier {
+	return func(r *v1beta1.LoginProfile) { r.Spec.ForProvider.PasswordResetRequired = aws.Bool(b) }
+}
+
+func withObservation(resetRequired bool) loginProfileModifier {
+	return func(r *v1beta1.LoginProfile) {
+		r.Status.AtProvider = v1beta1.LoginProfileObservation{
+			CreateDate:            &metav1.Time{Time: createDate},
+			PasswordResetRequired: resetRequired,
+		}
+	}
+}
+
+func loginProfile(m ...loginProfileModifier) *v1beta1.LoginProfile {
+	cr := &v1beta1.LoginProfile{
+		Spec: v1beta1.LoginProfileSpec{
+			ForProvider: v1beta1.LoginProfileParameters{
+				UserName: userName,
+			},
+		},
+	}
+	for _, f := range m {
+		f(cr)
+	}
+	return cr
+}
+
+// secrets returns a kube client that serves the password secret with the
+// supplied password and the connection secret with the published one.
+func secrets(password, published string) client.Client {
+	return &test.MockClient{
+		MockGet: func(_ context.Context, key client.ObjectKey, obj client.Object) error {
+			s := obj.(*corev1.Secret)
+			switch key.Name {
+			case "password":
+				s.Data = map[string][]byte{"password": []byte(password)}
+			case "connection":
+				s.Data = map[string][]byte{xpv1.ResourceCredentialsSecretPasswordKey: []byte(published)}
+			}
+			return nil
+		},
+	}
+}
+
+func getLoginProfile(resetRequired bool) func(ctx context.Context, input *awsiam.GetLoginProfileInput, opts []func(*awsiam.Options)) (*awsiam.GetLoginProfileOutput, error) {
+	return func(ctx context.Context, input *awsiam.GetLoginProfileInput, opts []func(*awsiam.Options)) (*awsiam.GetLoginProfileOutput, error) {
+		return &awsiam.GetLoginProfileOutput{
+			LoginProfile: &awsiamtypes.LoginProfile{
+				UserName:              input.UserName,
+				CreateDate:            &createDate,
+				PasswordResetRequired: resetRequired,
+			},
+		}, nil
+	}
+}
+
+func getCallerIdentity(ctx context.Context, input *sts.GetCallerIdentityInput, opts []func(*sts.Options)) (*sts.GetCallerIdentityOutput, error) {
+	return &sts.GetCallerIdentityOutput{Arn: aws.String(callerArn)}, nil
+}
+
+func TestObserve(t *testing.T) {
+
+	type want struct {
+		cr     resource.Managed
+		result managed.ExternalObservation
+		err    error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"UpToDate": {
+			args: args{
+				iam: &fake.MockLoginProfileClient{
+					MockGetLoginProfile: getLoginProfile(true),
+				},
+				cr: loginProfile(withExternalName(userName), withPasswordResetRequired(true)),
+			},
+			want: want{
+				cr: loginProfile(withExternalName(userName), withPasswordResetRequired(true),
+					withObservation(true), withConditions(xpv1.Available())),
+				result: managed.ExternalObservation{
+					ResourceExists:   true,
+					ResourceUpToDate: true,
+				},
+			},
+		},
+		"PasswordResetRequiredChanged": {
+			args: args{
+				iam: &fake.MockLoginProfileClient{
+					MockGetLoginProfile: getLoginProfile(true),
+				},
+				cr: loginProfile(withExternalName(userName), withPasswordResetRequired(false)),
+			},
+			want: want{
+				cr: loginProfile(withExternalName(userName), withPasswordResetRequired(false),
+					withObservation(true), withConditions(xpv1.Available())),
+				result: managed.ExternalObservation{
+					ResourceExists:   true,
+					ResourceUpToDate: false,
+				},
+			},
+		},
+		"PasswordChanged": {
+			args: args{
+				iam: &fake.MockLoginProfileClient{
+					MockGetLoginProfile: getLoginProfile(false),
+				},
+				kube: secrets(otherPw, pw),
+				cr:   loginProfile(withExternalName(userName), withPasswordSecretRef()),
+			},
+			want: want{
+				cr: loginProfile(withExternalName(userName), withPasswordSecretRef(),
+					withObservation(false), withConditions(xpv1.Available())),
+				result: managed.ExternalObservation{
+					ResourceExists:   true,
+					ResourceUpToDate: false,
+				},
+			},
+		},
+		"NoExternalName": {
+			args: args{
+				iam: &fake.MockLoginProfileClient{
+					MockGetLoginProfile: getLoginProfile(false),
+				},
+				cr: loginProfile(),
+			},
+			want: want{
+				cr: loginProfile(withExternalName(userName), withObservation(false), withConditions(xpv1.Available())),
+				result: managed.ExternalObservation{
+					ResourceExists:          true,
+					ResourceUpToDate:        true,
+					ResourceLateInitialized: true,
+				},
+			},
+		},
+		"NoUserName": {
+			args: args{
+				cr: loginProfile(withUserName("")),
+			},
+			want: want{
+				cr:  loginProfile(withUserName("")),
+				err: errors.New(errNoUserName),
+			},
+		},
+		"InValidInput": {
+			args: args{
+				cr: unexpectedItem,
+			},
+			want: want{
+				cr:  unexpectedItem,
+				err: errors.New(errUnexpectedObject),
+			},
+		},
+		"ClientError": {
+			args: args{
+				iam: &fake.MockLoginProfileClient{
+					MockGetLoginProfile: func(ctx context.Context, input *awsiam.GetLoginProfileInput, opts []func(*awsiam.Options)) (*awsiam.GetLoginProfileOutput, error) {
+						return nil, errBoom
+					},
+				},
+				cr: loginProfile(withExternalName(userName)),
+			},
+			want: want{
+				cr:  loginProfile(withExternalName(userName)),
+				err: awsclient.Wrap(errBoom, errGet),
+			},
+		},
+		"ResourceDoesNotExist": {
+			args: args{
+				iam: &fake.MockLoginProfileClient{
+					MockGetLoginProfile: func(ctx context.Context, input *awsiam.GetLoginProfileInput, opts []func(*awsiam.Options)) (*awsiam.GetLoginProfileOutput, error) {
+						return nil, &awsiamtypes.NoSuchEntityException{}
+					},
+				},
+				cr: loginProfile(withExternalName(userName)),
+			},
+			want: want{
+				cr: loginProfile(withExternalName(userName)),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{client: tc.iam, kube: tc.kube}
+			o, err := e.Observe(context.Background(), tc.args.cr)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.result, o); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestCreate(t *testing.T) {
+
+	type want struct {
+		cr     resource.Managed
+		result managed.ExternalCreation
+		err    error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"PasswordFromSecret": {
+			args: args{
+				iam: &fake.MockLoginProfileClient{
+					MockCreateLoginProfile: func(ctx context.Context, input *awsiam.CreateLoginProfileInput, opts []func(*awsiam.Options)) (*awsiam.CreateLoginProfileOutput, error) {
+						if diff := cmp.Diff(&awsiam.CreateLoginProfileInput{
+							UserName:              aws.String(userName),
+							Password:              aws.String(pw),
+							PasswordResetRequired: true,
+						}, input, cmpopts.IgnoreUnexported(awsiam.CreateLoginProfileInput{})); diff != "" {
+							t.Errorf("r: -want, +got:\n%s", diff)
+						}
+						return &awsiam.CreateLoginProfileOutput{}, nil
+					},
+				},
+				sts:  &fake.MockSTSClient{MockGetCallerIdentity: getCallerIdentity},
+				kube: secrets(pw, ""),
+				cr:   loginProfile(withPasswordSecretRef(), withPasswordResetRequired(true)),
+			},
+			want: want{
+				cr: loginProfile(withPasswordSecretRef(), withPasswordResetRequired(true),
+					withExternalName(userName), withConditions(xpv1.Creating())),
+				result: managed.ExternalCreation{
+					ConnectionDetails: managed.ConnectionDetails{
+						xpv1.ResourceCredentialsSecretUserKey:     []byte(userName),
+						xpv1.ResourceCredentialsSecretPasswordKey: []byte(pw),
+						connectionSignInURLKey:                    []byte(signInURL),
+					},
+				},
+			},
+		},
+		"GeneratedPassword": {
+			args: args{
+				iam: &fake.MockLoginProfileClient{
+					MockCreateLoginProfile: func(ctx context.Context, input *awsiam.CreateLoginProfileInput, opts []func(*awsiam.Options)) (*awsiam.CreateLoginProfileOutput, error) {
+						if aws.ToString(input.Password) == "" {
+							t.Errorf("CreateLoginProfile(...): expected a generated password")
+						}
+						return &awsiam.CreateLoginProfileOutput{}, nil
+					},
+				},
+				sts: &fake.MockSTSClient{MockGetCallerIdentity: getCallerIdentity},
+				cr:  loginProfile(),
+			},
+			want: want{
+				cr: loginProfile(withExternalName(userName), withConditions(xpv1.Creating())),
+				result: managed.ExternalCreation{
+					ConnectionDetails: managed.ConnectionDetails{
+						xpv1.ResourceCredentialsSecretUserKey: []byte(userName),
+						connectionSignInURLKey:                []byte(signInURL),
+					},
+				},
+			},
+		},
+		"InValidInput": {
+			args: args{
+				cr: unexpectedItem,
+			},
+			want: want{
+				cr:  unexpectedItem,
+				err: errors.New(errUnexpectedObject),
+			},
+		},
+		"CallerIdentityError": {
+			args: args{
+				sts: &fake.MockSTSClient{
+					MockGetCallerIdentity: func(ctx context.Context, input *sts.GetCallerIdentityInput, opts []func(*sts.Options)) (*sts.GetCallerIdentityOutput, error) {
+						return nil, errBoom
+					},
+				},
+				cr: loginProfile(),
+			},
+			want: want{
+				cr:  loginProfile(withConditions(xpv1.Creating())),
+				err: awsclient.Wrap(errBoom, errGetCallerIdentity),
+			},
+		},
+		"ClientError": {
+			args: args{
+				iam: &fake.MockLoginProfileClient{
+					MockCreateLoginProfile: func(ctx context.Context, input *awsiam.CreateLoginProfileInput, opts []func(*awsiam.Options)) (*awsiam.CreateLoginProfileOutput, error) {
+						return nil, errBoom
+					},
+				},
+				sts: &fake.MockSTSClient{MockGetCallerIdentity: getCallerIdentity},
+				cr:  loginProfile(),
+			},
+			want: want{
+				cr:  loginProfile(withConditions(xpv1.Creating())),
+				err: awsclient.Wrap(errBoom, errCreate),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{client: tc.iam, sts: tc.sts, kube: tc.kube}
+			o, err := e.Create(context.Background(), tc.args.cr)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			// NOTE: Generated passwords are random, so only the presence
+			// of the password key is checked if the password is not known.
+			ignorePassword := cmpopts.IgnoreMapEntries(func(k string, _ []byte) bool {
+				_, known := tc.want.result.ConnectionDetails[xpv1.ResourceCredentialsSecretPasswordKey]
+				return !known && k == xpv1.ResourceCredentialsSecretPasswordKey
+			})
+			if diff := cmp.Diff(tc.want.result, o, ignorePassword); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if err == nil && len(o.ConnectionDetails[xpv1.ResourceCredentialsSecretPasswordKey]) == 0 {
+				t.Errorf("Create(...): expected a password in the connection details")
+			}
+		})
+	}
+}
+
+func TestUpdate(t *testing.T) {
+
+	type want struct {
+		cr     resource.Managed
+		result managed.ExternalUpdate
+		err    error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"PasswordChanged": {
+			args: args{
+				iam: &fake.MockLoginProfileClient{
+					MockUpdateLoginProfile: func(ctx context.Context, input *awsiam.UpdateLoginProfileInput, opts []func(*awsiam.Options)) (*awsiam.UpdateLoginProfileOutput, error) {
+						if diff := cmp.Diff(&awsiam.UpdateLoginProfileInput{
+							UserName: aws.String(userName),
+							Password: aws.String(otherPw),
+						}, input, cmpopts.IgnoreUnexported(awsiam.UpdateLoginProfileInput{})); diff != "" {
+							t.Errorf("r: -want, +got:\n%s", diff)
+						}
+						return &awsiam.UpdateLoginProfileOutput{}, nil
+					},
+				},
+				kube: secrets(otherPw, pw),
+				cr:   loginProfile(withExternalName(userName), withPasswordSecretRef()),
+			},
+			want: want{
+				cr: loginProfile(withExternalName(userName), withPasswordSecretRef()),
+				result: managed.ExternalUpdate{
+					ConnectionDetails: managed.ConnectionDetails{
+						xpv1.ResourceCredentialsSecretPasswordKey: []byte(otherPw),
+					},
+				},
+			},
+		},
+		"PasswordResetRequiredChanged": {
+			args: args{
+				iam: &fake.MockLoginProfileClient{
+					MockUpdateLoginProfile: func(ctx context.Context, input *awsiam.UpdateLoginProfileInput, opts []func(*awsiam.Options)) (*awsiam.UpdateLoginProfileOutput, error) {
+						if diff := cmp.Diff(&awsiam.UpdateLoginProfileInput{
+							UserName:              aws.String(userName),
+							PasswordResetRequired: aws.Bool(true),
+						}, input, cmpopts.IgnoreUnexported(awsiam.UpdateLoginProfileInput{})); diff != "" {
+							t.Errorf("r: -want, +got:\n%s", diff)
+						}
+						return &awsiam.UpdateLoginProfileOutput{}, nil
+					},
+				},
+				cr: loginProfile(withExternalName(userName), withPasswordResetRequired(true)),
+			},
+			want: want{
+				cr: loginProfile(withExternalName(userName), withPasswordResetRequired(true)),
+			},
+		},
+		"InValidInput": {
+			args: args{
+				cr: unexpectedItem,
+			},
+			want: want{
+				cr:  unexpectedItem,
+				err: errors.New(errUnexpectedObject),
+			},
+		},
+		"ClientError": {
+			args: args{
+				iam: &fake.MockLoginProfileClient{
+					MockUpdateLoginProfile: func(ctx context.Context, input *awsiam.UpdateLoginProfileInput, opts []func(*awsiam.Options)) (*awsiam.UpdateLoginProfileOutput, error) {
+						return nil, errBoom
+					},
+				},
+				cr: loginProfile(withExternalName(userName)),
+			},
+			want: want{
+				cr:  loginProfile(withExternalName(userName)),
+				err: awsclient.Wrap(errBoom, errUpdate),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{client: tc.iam, kube: tc.kube}
+			o, err := e.Update(context.Background(), tc.args.cr)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.result, o); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestDelete(t *testing.T) {
+
+	type want struct {
+		cr  resource.Managed
+		err error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"VaildInput": {
+			args: args{
+				iam: &fake.MockLoginProfileClient{
+					MockDeleteLoginProfile: func(ctx context.Context, input *awsiam.DeleteLoginProfileInput, opts []func(*awsiam.Options)) (*awsiam.DeleteLoginProfileOutput, error) {
+						return &awsiam.DeleteLoginProfileOutput{}, nil
+					},
+				},
+				cr: loginProfile(withExternalName(userName)),
+			},
+			want: want{
+				cr: loginProfile(withExternalName(userName), withConditions(xpv1.Deleting())),
+			},
+		},
+		"InValidInput": {
+			args: args{
+				cr: unexpectedItem,
+			},
+			want: want{
+				cr:  unexpectedItem,
+				err: errors.New(errUnexpectedObject),
+			},
+		},
+		"ResourceDoesNotExist": {
+			args: args{
+				iam: &fake.MockLoginProfileClient{
+					MockDeleteLoginProfile: func(ctx context.Context, input *awsiam.DeleteLoginProfileInput, opts []func(*awsiam.Options)) (*awsiam.DeleteLoginProfileOutput, error) {
+						return nil, &awsiamtypes.NoSuchEntityException{}
+					},
+				},
+				cr: loginProfile(withExternalName(userName)),
+			},
+			want: want{
+				cr: loginProfile(withExternalName(userName), withConditions(xpv1.Deleting())),
+			},
+		},
+		"ClientError": {
+			args: args{
+				iam: &fake.MockLoginProfileClient{
+					MockDeleteLoginProfile: func(ctx context.Context, input *awsiam.DeleteLoginProfileInput, opts []func(*awsiam.Options)) (*awsiam.DeleteLoginProfileOutput, error) {
+						return nil, errBoom
+					},
+				},
+				cr: loginProfile(withExternalName(userName)),
+			},
+			want: want{
+				cr:  loginProfile(withExternalName(userName), withConditions(xpv1.Deleting())),
+				err: awsclient.Wrap(errBoom, errDelete),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{client: tc.iam}
+			err := e.Delete(context.Background(), tc.args.cr)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
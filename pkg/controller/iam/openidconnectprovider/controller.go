@@ -297,7 +297,14 @@ func (t *tagger) Initialize(ctx context.Context, mgd resource.Managed) error {
 	for _, t := range cr.Spec.ForProvider.Tags {
 		tagMap[t.Key] = t.Value
 	}
-	for k, v := range resource.GetExternalTags(mgd) {
+	tags := make(map[string]string, len(tagMap))
+	for k, v := range tagMap {
+		tags[k] = v
+	}
+	if err := awsclient.AddDefaultTags(ctx, t.kube, mgd, tags); err != nil {
+		return err
+	}
+	for k, v := range tags {
 		if p, ok := tagMap[k]; !ok || v != p {
 			cr.Spec.ForProvider.Tags = append(cr.Spec.ForProvider.Tags, v1beta1.Tag{Key: k, Value: v})
 			added = true
@@ -57,6 +57,9 @@ const (
 	errKubeUpdateFailed = "cannot late initialize IAM Policy"
 	errTag              = "cannot tag policy"
 	errUntag            = "cannot untag policy"
+	errListVersions     = "cannot list IAM Policy versions"
+	errSetDefault       = "cannot set the default version of the IAM Policy"
+	errVersionLimit     = "cannot create a new version of the IAM Policy, %d versions exist already and the retention strategy is Never"
 )
 
 // SetupPolicy adds a controller that reconciles IAM Policy.
@@ -145,19 +148,32 @@ func (e *external) Observe(ctx context.Context, mgd resource.Managed) (managed.E
 		PolicyID:                      aws.ToString(policy.PolicyId),
 	}
 
-	versionRsp, err := e.client.GetPolicyVersion(ctx, &awsiam.GetPolicyVersionInput{
-		PolicyArn: aws.String(meta.GetExternalName(cr)),
-		VersionId: aws.String(cr.Status.AtProvider.DefaultVersionID),
-	})
-
-	if err != nil || versionRsp.PolicyVersion == nil {
-		return managed.ExternalObservation{}, awsclient.Wrap(err, errPolicyVersion)
+	versions, err := e.listPolicyVersions(ctx, meta.GetExternalName(cr))
+	if err != nil {
+		return managed.ExternalObservation{}, awsclient.Wrap(err, errListVersions)
 	}
+	cr.Status.AtProvider.Versions = iam.GeneratePolicyVersions(versions)
 
-	update, diff, err := iam.IsPolicyUpToDate(cr.Spec.ForProvider, *versionRsp.PolicyVersion)
+	update, diff := true, ""
+	if pinned := cr.Spec.ForProvider.DefaultVersionID; pinned != nil {
+		// The document of a pinned version is not compared, the version
+		// itself is the desired state.
+		update = aws.ToString(pinned) == cr.Status.AtProvider.DefaultVersionID
+	} else {
+		versionRsp, err := e.client.GetPolicyVersion(ctx, &awsiam.GetPolicyVersionInput{
+			PolicyArn: aws.String(meta.GetExternalName(cr)),
+			VersionId: aws.String(cr.Status.AtProvider.DefaultVersionID),
+		})
 
-	if err != nil {
-		return managed.ExternalObservation{}, awsclient.Wrap(err, errUpToDate)
+		if err != nil || versionRsp.PolicyVersion == nil {
+			return managed.ExternalObservation{}, awsclient.Wrap(err, errPolicyVersion)
+		}
+
+		update, diff, err = iam.IsPolicyUpToDate(cr.Spec.ForProvider, *versionRsp.PolicyVersion)
+
+		if err != nil {
+			return managed.ExternalObservation{}, awsclient.Wrap(err, errUpToDate)
+		}
 	}
 
 	crTagMap := make(map[string]string, len(cr.Spec.ForProvider.Tags))
@@ -211,22 +227,18 @@ func (e *external) Update(ctx context.Context, mgd resource.Managed) (managed.Ex
 		return managed.ExternalUpdate{}, errors.New(errUnexpectedObject)
 	}
 
-	// An update to AWS Policy is a new version of that policy.
-	// A maximum of 5 versions are allowed. Below, the oldest version is deleted
-	// for an update request when 5 versions already exist.
-	// The new version is set as default.
-
-	if err := e.deleteOldestVersion(ctx, meta.GetExternalName(cr)); err != nil {
-		return managed.ExternalUpdate{}, awsclient.Wrap(err, errUpdate)
-	}
-
-	_, err := e.client.CreatePolicyVersion(ctx, &awsiam.CreatePolicyVersionInput{
-		PolicyArn:      aws.String(meta.GetExternalName(cr)),
-		PolicyDocument: aws.String(cr.Spec.ForProvider.Document),
-		SetAsDefault:   true,
-	})
-
-	if err != nil {
+	// An update to AWS Policy is a new version of that policy, unless the
+	// default version is pinned to an existing version.
+	if pinned := cr.Spec.ForProvider.DefaultVersionID; pinned != nil {
+		if aws.ToString(pinned) != cr.Status.AtProvider.DefaultVersionID {
+			if _, err := e.client.SetDefaultPolicyVersion(ctx, &awsiam.SetDefaultPolicyVersionInput{
+				PolicyArn: aws.String(meta.GetExternalName(cr)),
+				VersionId: pinned,
+			}); err != nil {
+				return managed.ExternalUpdate{}, awsclient.Wrap(err, errSetDefault)
+			}
+		}
+	} else if err := e.createVersion(ctx, cr); err != nil {
 		return managed.ExternalUpdate{}, awsclient.Wrap(err, errUpdate)
 	}
 
@@ -299,35 +311,61 @@ func (e *external) listPolicyVersions(ctx context.Context, policyArn string) ([]
 	return resp.Versions, nil
 }
 
-func (e *external) deleteOldestVersion(ctx context.Context, arn string) error {
-	allVersions, err := e.listPolicyVersions(ctx, arn)
+// createVersion creates a new default version of the policy with the desired
+// document. The versions that are no longer needed are deleted according to
+// the retention strategy of the policy.
+func (e *external) createVersion(ctx context.Context, cr *v1beta1.Policy) error {
+	policyArn := meta.GetExternalName(cr)
+	allVersions, err := e.listPolicyVersions(ctx, policyArn)
 	if err != nil {
 		return err
 	}
 
-	if len(allVersions) < 5 {
-		return nil
+	strategy, maxVersions := iam.PolicyVersionRetention(cr.Spec.ForProvider.VersionRetention)
+	var pruned []awsiamtypes.PolicyVersion
+	if strategy == v1beta1.PolicyVersionRetentionNever {
+		if len(allVersions) >= maxVersions {
+			return errors.Errorf(errVersionLimit, len(allVersions))
+		}
+	} else {
+		// Make room for the new version.
+		pruned = iam.PolicyVersionsToPrune(allVersions, maxVersions-1)
+		if err := e.deleteVersions(ctx, policyArn, pruned); err != nil {
+			return err
+		}
 	}
 
-	var oldestVersion awsiamtypes.PolicyVersion
+	if _, err := e.client.CreatePolicyVersion(ctx, &awsiam.CreatePolicyVersionInput{
+		PolicyArn:      aws.String(policyArn),
+		PolicyDocument: aws.String(cr.Spec.ForProvider.Document),
+		SetAsDefault:   true,
+	}); err != nil {
+		return err
+	}
 
-	// loop through all version to find the oldest version.
+	// The previous default version could not be deleted before the new
+	// version was created, i.e. if only a single version is retained.
+	if strategy == v1beta1.PolicyVersionRetentionNever || len(allVersions)-len(pruned) < maxVersions {
+		return nil
+	}
 	for _, version := range allVersions {
 		if version.IsDefaultVersion {
-			continue
-		}
-		if oldestVersion.CreateDate == nil ||
-			version.CreateDate.Before(*oldestVersion.CreateDate) {
-			oldestVersion = version
+			return e.deleteVersions(ctx, policyArn, []awsiamtypes.PolicyVersion{version})
 		}
 	}
+	return nil
+}
 
-	_, err = e.client.DeletePolicyVersion(ctx, &awsiam.DeletePolicyVersionInput{
-		PolicyArn: aws.String(arn),
-		VersionId: oldestVersion.VersionId,
-	})
-
-	return err
+func (e *external) deleteVersions(ctx context.Context, policyArn string, versions []awsiamtypes.PolicyVersion) error {
+	for _, version := range versions {
+		if _, err := e.client.DeletePolicyVersion(ctx, &awsiam.DeletePolicyVersionInput{
+			PolicyArn: aws.String(policyArn),
+			VersionId: version.VersionId,
+		}); err != nil {
+			return err
+		}
+	}
+	return nil
 }
 
 func (e *external) deleteNonDefaultVersions(ctx context.Context, policyArn string) error {
@@ -391,7 +429,13 @@ func (t *tagger) Initialize(ctx context.Context, mgd resource.Managed) error {
 		return errors.New(errUnexpectedObject)
 	}
 	added := false
-	defaultTags := resource.GetExternalTags(mgd)
+	defaultTags := make(map[string]string, len(cr.Spec.ForProvider.Tags))
+	for _, tag := range cr.Spec.ForProvider.Tags {
+		defaultTags[tag.Key] = tag.Value
+	}
+	if err := awsclient.AddDefaultTags(ctx, t.kube, mgd, defaultTags); err != nil {
+		return err
+	}
 
 	for i, t := range cr.Spec.ForProvider.Tags {
 		v, ok := defaultTags[t.Key]
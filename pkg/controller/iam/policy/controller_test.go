@@ -19,6 +19,7 @@ package policy
 import (
 	"context"
 	"net/url"
+	"time"
 
 	"testing"
 
@@ -33,6 +34,7 @@ import (
 	"github.com/aws/aws-sdk-go/aws"
 
 	"github.com/pkg/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 
 	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
@@ -67,6 +69,14 @@ var (
 
 	errBoom = errors.New("boom")
 
+	versionTime = time.Date(2023, time.January, 1, 0, 0, 0, 0, time.UTC)
+
+	policyVersions = []awsiamtypes.PolicyVersion{
+		{VersionId: aws.String("v3"), CreateDate: aws.Time(versionTime.Add(2 * time.Hour)), IsDefaultVersion: true},
+		{VersionId: aws.String("v2"), CreateDate: aws.Time(versionTime.Add(time.Hour))},
+		{VersionId: aws.String("v1"), CreateDate: aws.Time(versionTime)},
+	}
+
 	getCallerIdentityOutput = &sts.GetCallerIdentityOutput{
 		Account:        awsclient.String("123456789012"),
 		Arn:            awsclient.String("arn:aws:iam::123456789012:user/DevAdmin"),
@@ -139,6 +149,25 @@ func withPath(path string) policyModifier {
 	}
 }
 
+func withDefaultVersionID(id string) policyModifier {
+	return func(r *v1beta1.Policy) {
+		r.Spec.ForProvider.DefaultVersionID = awsclient.String(id)
+	}
+}
+
+func withVersionRetention(strategy string, max int32) policyModifier {
+	return func(r *v1beta1.Policy) {
+		r.Spec.ForProvider.VersionRetention = &v1beta1.PolicyVersionRetention{
+			Strategy:    strategy,
+			MaxVersions: &max,
+		}
+	}
+}
+
+func withObservation(o v1beta1.PolicyObservation) policyModifier {
+	return func(r *v1beta1.Policy) { r.Status.AtProvider = o }
+}
+
 func withTags(tagMaps ...map[string]string) policyModifier {
 	var tagList []v1beta1.Tag
 	for _, tagMap := range tagMaps {
@@ -427,10 +456,98 @@ func TestObserve(t *testing.T) {
 				},
 			},
 		},
+		"ListVersionsError": {
+			args: args{
+				iam: &fake.MockPolicyClient{
+					MockGetPolicy: func(ctx context.Context, input *awsiam.GetPolicyInput, opts []func(*awsiam.Options)) (*awsiam.GetPolicyOutput, error) {
+						return &awsiam.GetPolicyOutput{
+							Policy: &awsiamtypes.Policy{},
+						}, nil
+					},
+					MockListPolicyVersions: func(ctx context.Context, input *awsiam.ListPolicyVersionsInput, opts []func(*awsiam.Options)) (*awsiam.ListPolicyVersionsOutput, error) {
+						return nil, errBoom
+					},
+				},
+				cr: policy(withExternalName(policyArn)),
+			},
+			want: want{
+				cr: policy(withExternalName(policyArn),
+					withConditions(xpv1.Available())),
+				err: awsclient.Wrap(errBoom, errListVersions),
+			},
+		},
+		"PinnedDefaultVersion": {
+			args: args{
+				iam: &fake.MockPolicyClient{
+					MockGetPolicy: func(ctx context.Context, input *awsiam.GetPolicyInput, opts []func(*awsiam.Options)) (*awsiam.GetPolicyOutput, error) {
+						return &awsiam.GetPolicyOutput{
+							Policy: &awsiamtypes.Policy{DefaultVersionId: aws.String("v3")},
+						}, nil
+					},
+					MockListPolicyVersions: func(ctx context.Context, input *awsiam.ListPolicyVersionsInput, opts []func(*awsiam.Options)) (*awsiam.ListPolicyVersionsOutput, error) {
+						return &awsiam.ListPolicyVersionsOutput{Versions: policyVersions}, nil
+					},
+				},
+				cr: policy(withExternalName(policyArn), withDefaultVersionID("v3")),
+			},
+			want: want{
+				cr: policy(withExternalName(policyArn), withDefaultVersionID("v3"),
+					withConditions(xpv1.Available()),
+					withObservation(v1beta1.PolicyObservation{
+						DefaultVersionID: "v3",
+						Versions: []v1beta1.PolicyVersion{
+							{VersionID: "v3", CreateDate: &metav1.Time{Time: versionTime.Add(2 * time.Hour)}, IsDefaultVersion: true},
+							{VersionID: "v2", CreateDate: &metav1.Time{Time: versionTime.Add(time.Hour)}},
+							{VersionID: "v1", CreateDate: &metav1.Time{Time: versionTime}},
+						},
+					})),
+				result: managed.ExternalObservation{
+					ResourceExists:   true,
+					ResourceUpToDate: true,
+				},
+			},
+		},
+		"PinnedDefaultVersionDiffers": {
+			args: args{
+				iam: &fake.MockPolicyClient{
+					MockGetPolicy: func(ctx context.Context, input *awsiam.GetPolicyInput, opts []func(*awsiam.Options)) (*awsiam.GetPolicyOutput, error) {
+						return &awsiam.GetPolicyOutput{
+							Policy: &awsiamtypes.Policy{DefaultVersionId: aws.String("v3")},
+						}, nil
+					},
+					MockListPolicyVersions: func(ctx context.Context, input *awsiam.ListPolicyVersionsInput, opts []func(*awsiam.Options)) (*awsiam.ListPolicyVersionsOutput, error) {
+						return &awsiam.ListPolicyVersionsOutput{Versions: policyVersions}, nil
+					},
+				},
+				cr: policy(withExternalName(policyArn), withDefaultVersionID("v2")),
+			},
+			want: want{
+				cr: policy(withExternalName(policyArn), withDefaultVersionID("v2"),
+					withConditions(xpv1.Available()),
+					withObservation(v1beta1.PolicyObservation{
+						DefaultVersionID: "v3",
+						Versions: []v1beta1.PolicyVersion{
+							{VersionID: "v3", CreateDate: &metav1.Time{Time: versionTime.Add(2 * time.Hour)}, IsDefaultVersion: true},
+							{VersionID: "v2", CreateDate: &metav1.Time{Time: versionTime.Add(time.Hour)}},
+							{VersionID: "v1", CreateDate: &metav1.Time{Time: versionTime}},
+						},
+					})),
+				result: managed.ExternalObservation{
+					ResourceExists:   true,
+					ResourceUpToDate: false,
+				},
+			},
+		},
 	}
 
 	for name, tc := range cases {
 		t.Run(name, func(t *testing.T) {
+			if tc.iam != nil && tc.iam.MockListPolicyVersions == nil {
+				tc.iam.MockListPolicyVersions = func(ctx context.Context, input *awsiam.ListPolicyVersionsInput, opts []func(*awsiam.Options)) (*awsiam.ListPolicyVersionsOutput, error) {
+					return &awsiam.ListPolicyVersionsOutput{}, nil
+				}
+			}
+
 			e := &external{client: tc.iam, sts: tc.sts, kube: tc.kube}
 			o, err := e.Observe(context.Background(), tc.args.cr)
 
@@ -639,6 +756,34 @@ func TestUpdate(t *testing.T) {
 				err: awsclient.Wrap(errBoom, errUpdate),
 			},
 		},
+		"RetentionNeverLimitReached": {
+			args: args{
+				iam: &fake.MockPolicyClient{
+					MockListPolicyVersions: func(ctx context.Context, input *awsiam.ListPolicyVersionsInput, opts []func(*awsiam.Options)) (*awsiam.ListPolicyVersionsOutput, error) {
+						return &awsiam.ListPolicyVersionsOutput{Versions: policyVersions}, nil
+					},
+				},
+				cr: policy(withExternalName(policyArn), withVersionRetention(v1beta1.PolicyVersionRetentionNever, 3)),
+			},
+			want: want{
+				cr:  policy(withExternalName(policyArn), withVersionRetention(v1beta1.PolicyVersionRetentionNever, 3)),
+				err: awsclient.Wrap(errors.Errorf(errVersionLimit, 3), errUpdate),
+			},
+		},
+		"SetDefaultVersionError": {
+			args: args{
+				iam: &fake.MockPolicyClient{
+					MockSetDefaultPolicyVersion: func(ctx context.Context, input *awsiam.SetDefaultPolicyVersionInput, opts []func(*awsiam.Options)) (*awsiam.SetDefaultPolicyVersionOutput, error) {
+						return nil, errBoom
+					},
+				},
+				cr: policy(withExternalName(policyArn), withDefaultVersionID("v2")),
+			},
+			want: want{
+				cr:  policy(withExternalName(policyArn), withDefaultVersionID("v2")),
+				err: awsclient.Wrap(errBoom, errSetDefault),
+			},
+		},
 	}
 
 	for name, tc := range cases {
@@ -883,6 +1028,118 @@ func TestUpdate_Tags(t *testing.T) {
 		})
 	}
 }
+
+func TestUpdate_Versions(t *testing.T) {
+
+	type want struct {
+		err        error
+		deleted    []string
+		created    bool
+		setDefault *string
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"KeepLatestWithRoom": {
+			args: args{
+				cr: policy(withExternalName(policyArn)),
+			},
+			want: want{
+				created: true,
+			},
+		},
+		"KeepLatestDeletesOldest": {
+			args: args{
+				cr: policy(withExternalName(policyArn), withVersionRetention(v1beta1.PolicyVersionRetentionKeepLatest, 3)),
+			},
+			want: want{
+				deleted: []string{"v1"},
+				created: true,
+			},
+		},
+		"KeepLatestSingleVersion": {
+			args: args{
+				cr: policy(withExternalName(policyArn), withVersionRetention(v1beta1.PolicyVersionRetentionKeepLatest, 1)),
+			},
+			want: want{
+				deleted: []string{"v1", "v2", "v3"},
+				created: true,
+			},
+		},
+		"NeverWithRoom": {
+			args: args{
+				cr: policy(withExternalName(policyArn), withVersionRetention(v1beta1.PolicyVersionRetentionNever, 4)),
+			},
+			want: want{
+				created: true,
+			},
+		},
+		"PinnedDefaultVersion": {
+			args: args{
+				cr: policy(withExternalName(policyArn), withDefaultVersionID("v2"),
+					withObservation(v1beta1.PolicyObservation{DefaultVersionID: "v3"})),
+			},
+			want: want{
+				setDefault: aws.String("v2"),
+			},
+		},
+		"PinnedDefaultVersionIsDefault": {
+			args: args{
+				cr: policy(withExternalName(policyArn), withDefaultVersionID("v3"),
+					withObservation(v1beta1.PolicyObservation{DefaultVersionID: "v3"})),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			var deleted []string
+			var created bool
+			var setDefault *string
+			tc.iam = &fake.MockPolicyClient{
+				MockListPolicyVersions: func(ctx context.Context, input *awsiam.ListPolicyVersionsInput, opts []func(*awsiam.Options)) (*awsiam.ListPolicyVersionsOutput, error) {
+					return &awsiam.ListPolicyVersionsOutput{Versions: policyVersions}, nil
+				},
+				MockDeletePolicyVersion: func(ctx context.Context, input *awsiam.DeletePolicyVersionInput, opts []func(*awsiam.Options)) (*awsiam.DeletePolicyVersionOutput, error) {
+					deleted = append(deleted, aws.StringValue(input.VersionId))
+					return &awsiam.DeletePolicyVersionOutput{}, nil
+				},
+				MockCreatePolicyVersion: func(ctx context.Context, input *awsiam.CreatePolicyVersionInput, opts []func(*awsiam.Options)) (*awsiam.CreatePolicyVersionOutput, error) {
+					created = true
+					return &awsiam.CreatePolicyVersionOutput{}, nil
+				},
+				MockSetDefaultPolicyVersion: func(ctx context.Context, input *awsiam.SetDefaultPolicyVersionInput, opts []func(*awsiam.Options)) (*awsiam.SetDefaultPolicyVersionOutput, error) {
+					setDefault = input.VersionId
+					return &awsiam.SetDefaultPolicyVersionOutput{}, nil
+				},
+				MockGetPolicy: func(ctx context.Context, input *awsiam.GetPolicyInput, opts []func(*awsiam.Options)) (*awsiam.GetPolicyOutput, error) {
+					return &awsiam.GetPolicyOutput{
+						Policy: &awsiamtypes.Policy{},
+					}, nil
+				},
+			}
+
+			e := &external{client: tc.iam}
+			_, err := e.Update(context.Background(), tc.args.cr)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.deleted, deleted); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.created, created); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.setDefault, setDefault); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
 func TestDelete(t *testing.T) {
 
 	type want struct {
@@ -90,12 +90,17 @@ func (c *connector) Connect(ctx context.Context, mg resource.Managed) (managed.E
 	if err != nil {
 		return nil, err
 	}
-	return &external{client: c.newClientFn(*cfg), kube: c.kube}, nil
+	return &external{
+		client:   c.newClientFn(*cfg),
+		policies: iam.NewRoleInlinePolicyClient(iam.NewRolePolicyClient(*cfg)),
+		kube:     c.kube,
+	}, nil
 }
 
 type external struct {
-	client iam.RoleClient
-	kube   client.Client
+	client   iam.RoleClient
+	kube     client.Client
+	policies iam.InlinePolicyClient
 }
 
 func (e *external) Observe(ctx context.Context, mgd resource.Managed) (managed.ExternalObservation, error) {
@@ -134,9 +139,17 @@ func (e *external) Observe(ctx context.Context, mgd resource.Managed) (managed.E
 		return managed.ExternalObservation{}, errors.Wrap(err, errUpToDateFailed)
 	}
 
+	var unmanaged []string
+	if aws.ToBool(cr.Spec.ForProvider.RemoveUnmanagedInlinePolicies) {
+		unmanaged, err = iam.FindUnmanagedInlinePolicies(ctx, e.kube, e.policies, cr, &v1beta1.RolePolicyList{}, policyRoleName)
+		if err != nil {
+			return managed.ExternalObservation{}, err
+		}
+	}
+
 	return managed.ExternalObservation{
 		ResourceExists:   true,
-		ResourceUpToDate: upToDate,
+		ResourceUpToDate: upToDate && len(unmanaged) == 0,
 		ConnectionDetails: managed.ConnectionDetails{
 			"arn": []byte(cr.Status.AtProvider.ARN),
 		},
@@ -243,7 +256,11 @@ func (e *external) Update(ctx context.Context, mgd resource.Managed) (managed.Ex
 			return managed.ExternalUpdate{}, awsclient.Wrap(err, errUpdate)
 		}
 	}
-	return managed.ExternalUpdate{}, nil
+
+	if !aws.ToBool(cr.Spec.ForProvider.RemoveUnmanagedInlinePolicies) {
+		return managed.ExternalUpdate{}, nil
+	}
+	return managed.ExternalUpdate{}, iam.RemoveUnmanagedInlinePolicies(ctx, e.kube, e.policies, cr, &v1beta1.RolePolicyList{}, policyRoleName)
 }
 
 func (e *external) Delete(ctx context.Context, mgd resource.Managed) error {
@@ -261,6 +278,11 @@ func (e *external) Delete(ctx context.Context, mgd resource.Managed) error {
 	return awsclient.Wrap(resource.Ignore(iam.IsErrorNotFound, err), errDelete)
 }
 
+// policyRoleName returns the name of the role a RolePolicy refers to.
+func policyRoleName(mg resource.Managed) string {
+	return mg.(*v1beta1.RolePolicy).Spec.ForProvider.RoleName
+}
+
 type tagger struct {
 	kube client.Client
 }
@@ -272,7 +294,13 @@ func (t *tagger) Initialize(ctx context.Context, mgd resource.Managed) error {
 	}
 
 	added := false
-	defaultTags := resource.GetExternalTags(mgd)
+	defaultTags := make(map[string]string, len(cr.Spec.ForProvider.Tags))
+	for _, tag := range cr.Spec.ForProvider.Tags {
+		defaultTags[tag.Key] = tag.Value
+	}
+	if err := awsclient.AddDefaultTags(ctx, t.kube, mgd, defaultTags); err != nil {
+		return err
+	}
 
 	for i, t := range cr.Spec.ForProvider.Tags {
 		if v, ok := defaultTags[t.Key]; ok {
@@ -40,6 +40,11 @@ import (
 	"github.com/crossplane-contrib/provider-aws/pkg/clients/iam/fake"
 )
 
+const (
+	errListInlinePolicies = "failed to list the inline policies"
+	errDeleteInlinePolicy = "failed to delete the inline policy"
+)
+
 var (
 	// an arbitrary managed resource
 	unexpectedItem resource.Managed
@@ -63,8 +68,10 @@ var (
 )
 
 type args struct {
-	iam iam.RoleClient
-	cr  resource.Managed
+	iam      iam.RoleClient
+	policies iam.RolePolicyClient
+	kube     client.Client
+	cr       resource.Managed
 }
 
 type roleModifier func(*v1beta1.Role)
@@ -115,6 +122,35 @@ func withGroupVersionKind() roleModifier {
 	}
 }
 
+func withRemoveUnmanagedInlinePolicies() roleModifier {
+	return func(r *v1beta1.Role) {
+		r.Spec.ForProvider.RemoveUnmanagedInlinePolicies = aws.Bool(true)
+	}
+}
+
+// withRolePolicies lists a RolePolicy named managed for the role, one for
+// another role, one for a role of the same name in another account and one
+// whose role name is not resolved yet.
+func withRolePolicies() *test.MockClient {
+	return &test.MockClient{
+		MockList: func(ctx context.Context, list client.ObjectList, opts ...client.ListOption) error {
+			l := list.(*v1beta1.RolePolicyList)
+			l.Items = []v1beta1.RolePolicy{
+				{Spec: v1beta1.RolePolicySpec{ForProvider: v1beta1.RolePolicyParameters{RoleName: roleName}}},
+				{Spec: v1beta1.RolePolicySpec{ForProvider: v1beta1.RolePolicyParameters{RoleName: "other"}}},
+				{Spec: v1beta1.RolePolicySpec{ForProvider: v1beta1.RolePolicyParameters{RoleName: roleName}}},
+				{Spec: v1beta1.RolePolicySpec{ForProvider: v1beta1.RolePolicyParameters{}}},
+			}
+			meta.SetExternalName(&l.Items[0], "managed")
+			meta.SetExternalName(&l.Items[1], "unmanaged")
+			meta.SetExternalName(&l.Items[2], "foreign")
+			l.Items[2].SetProviderConfigReference(&xpv1.Reference{Name: "other-account"})
+			meta.SetExternalName(&l.Items[3], "unresolved")
+			return nil
+		},
+	}
+}
+
 func role(m ...roleModifier) *v1beta1.Role {
 	cr := &v1beta1.Role{}
 	for _, f := range m {
@@ -163,6 +199,69 @@ func TestObserve(t *testing.T) {
 				},
 			},
 		},
+		"UnmanagedInlinePolicies": {
+			args: args{
+				iam: &fake.MockRoleClient{
+					MockGetRole: func(ctx context.Context, input *awsiam.GetRoleInput, opts []func(*awsiam.Options)) (*awsiam.GetRoleOutput, error) {
+						return &awsiam.GetRoleOutput{
+							Role: &awsiamtypes.Role{
+								Arn: awsclient.String(arn),
+							},
+						}, nil
+					},
+				},
+				policies: &fake.MockRolePolicyClient{
+					MockListRolePolicies: func(ctx context.Context, input *awsiam.ListRolePoliciesInput, opts []func(*awsiam.Options)) (*awsiam.ListRolePoliciesOutput, error) {
+						return &awsiam.ListRolePoliciesOutput{
+							PolicyNames: []string{"managed", "unmanaged"},
+						}, nil
+					},
+				},
+				kube: withRolePolicies(),
+				cr:   role(withRoleName(&roleName), withRemoveUnmanagedInlinePolicies()),
+			},
+			want: want{
+				cr: role(
+					withRoleName(&roleName),
+					withRemoveUnmanagedInlinePolicies(),
+					withArn(arn),
+					withConditions(xpv1.Available())),
+				result: managed.ExternalObservation{
+					ResourceExists:   true,
+					ResourceUpToDate: false,
+					ConnectionDetails: map[string][]byte{
+						"arn": []byte(arn),
+					},
+				},
+			},
+		},
+		"ListInlinePoliciesError": {
+			args: args{
+				iam: &fake.MockRoleClient{
+					MockGetRole: func(ctx context.Context, input *awsiam.GetRoleInput, opts []func(*awsiam.Options)) (*awsiam.GetRoleOutput, error) {
+						return &awsiam.GetRoleOutput{
+							Role: &awsiamtypes.Role{
+								Arn: awsclient.String(arn),
+							},
+						}, nil
+					},
+				},
+				policies: &fake.MockRolePolicyClient{
+					MockListRolePolicies: func(ctx context.Context, input *awsiam.ListRolePoliciesInput, opts []func(*awsiam.Options)) (*awsiam.ListRolePoliciesOutput, error) {
+						return nil, errBoom
+					},
+				},
+				cr: role(withRoleName(&roleName), withRemoveUnmanagedInlinePolicies()),
+			},
+			want: want{
+				cr: role(
+					withRoleName(&roleName),
+					withRemoveUnmanagedInlinePolicies(),
+					withArn(arn),
+					withConditions(xpv1.Available())),
+				err: awsclient.Wrap(errBoom, errListInlinePolicies),
+			},
+		},
 		"InValidInput": {
 			args: args{
 				cr: unexpectedItem,
@@ -203,7 +302,7 @@ func TestObserve(t *testing.T) {
 
 	for name, tc := range cases {
 		t.Run(name, func(t *testing.T) {
-			e := &external{client: tc.iam}
+			e := &external{client: tc.iam, policies: iam.NewRoleInlinePolicyClient(tc.policies), kube: tc.kube}
 			o, err := e.Observe(context.Background(), tc.args.cr)
 
 			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
@@ -328,6 +427,62 @@ func TestUpdate(t *testing.T) {
 				err: errors.New(errUnexpectedObject),
 			},
 		},
+		"RemoveUnmanagedInlinePolicies": {
+			args: args{
+				iam: &fake.MockRoleClient{
+					MockGetRole: func(ctx context.Context, input *awsiam.GetRoleInput, opts []func(*awsiam.Options)) (*awsiam.GetRoleOutput, error) {
+						return &awsiam.GetRoleOutput{
+							Role: &awsiamtypes.Role{},
+						}, nil
+					},
+				},
+				policies: &fake.MockRolePolicyClient{
+					MockListRolePolicies: func(ctx context.Context, input *awsiam.ListRolePoliciesInput, opts []func(*awsiam.Options)) (*awsiam.ListRolePoliciesOutput, error) {
+						return &awsiam.ListRolePoliciesOutput{
+							PolicyNames: []string{"managed", "unmanaged", "foreign", "unresolved"},
+						}, nil
+					},
+					MockDeleteRolePolicy: func(ctx context.Context, input *awsiam.DeleteRolePolicyInput, opts []func(*awsiam.Options)) (*awsiam.DeleteRolePolicyOutput, error) {
+						if aws.ToString(input.PolicyName) == "managed" {
+							t.Errorf("managed inline policy must not be deleted")
+						}
+						return &awsiam.DeleteRolePolicyOutput{}, nil
+					},
+				},
+				kube: withRolePolicies(),
+				cr:   role(withRoleName(&roleName), withRemoveUnmanagedInlinePolicies()),
+			},
+			want: want{
+				cr: role(withRoleName(&roleName), withRemoveUnmanagedInlinePolicies()),
+			},
+		},
+		"DeleteInlinePolicyError": {
+			args: args{
+				iam: &fake.MockRoleClient{
+					MockGetRole: func(ctx context.Context, input *awsiam.GetRoleInput, opts []func(*awsiam.Options)) (*awsiam.GetRoleOutput, error) {
+						return &awsiam.GetRoleOutput{
+							Role: &awsiamtypes.Role{},
+						}, nil
+					},
+				},
+				policies: &fake.MockRolePolicyClient{
+					MockListRolePolicies: func(ctx context.Context, input *awsiam.ListRolePoliciesInput, opts []func(*awsiam.Options)) (*awsiam.ListRolePoliciesOutput, error) {
+						return &awsiam.ListRolePoliciesOutput{
+							PolicyNames: []string{"unmanaged"},
+						}, nil
+					},
+					MockDeleteRolePolicy: func(ctx context.Context, input *awsiam.DeleteRolePolicyInput, opts []func(*awsiam.Options)) (*awsiam.DeleteRolePolicyOutput, error) {
+						return nil, errBoom
+					},
+				},
+				kube: withRolePolicies(),
+				cr:   role(withRoleName(&roleName), withRemoveUnmanagedInlinePolicies()),
+			},
+			want: want{
+				cr:  role(withRoleName(&roleName), withRemoveUnmanagedInlinePolicies()),
+				err: awsclient.Wrap(errBoom, errDeleteInlinePolicy),
+			},
+		},
 		"ClientUpdateRoleError": {
 			args: args{
 				iam: &fake.MockRoleClient{
@@ -373,7 +528,7 @@ func TestUpdate(t *testing.T) {
 
 	for name, tc := range cases {
 		t.Run(name, func(t *testing.T) {
-			e := &external{client: tc.iam}
+			e := &external{client: tc.iam, policies: iam.NewRoleInlinePolicyClient(tc.policies), kube: tc.kube}
 			o, err := e.Update(context.Background(), tc.args.cr)
 
 			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
@@ -0,0 +1,255 @@
+/*
+Copyright 2023 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package samlprovider
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	awsiam "github.com/aws/aws-sdk-go-v2/service/iam"
+	"github.com/pkg/errors"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/connection"
+	"github.com/crossplane/crossplane-runtime/pkg/controller"
+	"github.com/crossplane/crossplane-runtime/pkg/event"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+
+	"github.com/crossplane-contrib/provider-aws/apis/iam/v1beta1"
+	"github.com/crossplane-contrib/provider-aws/apis/v1alpha1"
+	awsclient "github.com/crossplane-contrib/provider-aws/pkg/clients"
+	"github.com/crossplane-contrib/provider-aws/pkg/clients/iam"
+	"github.com/crossplane-contrib/provider-aws/pkg/features"
+)
+
+const (
+	errUnexpectedObject = "managed resource is not a SAMLProvider resource"
+
+	errList             = "cannot list SAMLProviders in AWS"
+	errGet              = "cannot get SAMLProvider in AWS"
+	errCreate           = "cannot create SAMLProvider in AWS"
+	errUpdate           = "cannot update SAMLProvider metadata document in AWS"
+	errDelete           = "failed to delete SAMLProvider"
+	errAddTags          = "cannot add tags to SAMLProvider in AWS"
+	errRemoveTags       = "cannot remove tags from SAMLProvider in AWS"
+	errKubeUpdateFailed = "cannot update SAMLProvider instance custom resource"
+)
+
+// SetupSAMLProvider adds a controller that reconciles SAMLProviders.
+func SetupSAMLProvider(mgr ctrl.Manager, o controller.Options) error {
+	name := managed.ControllerName(v1beta1.SAMLProviderGroupKind)
+
+	cps := []managed.ConnectionPublisher{managed.NewAPISecretPublisher(mgr.GetClient(), mgr.GetScheme())}
+	if o.Features.Enabled(features.EnableAlphaExternalSecretStores) {
+		cps = append(cps, connection.NewDetailsManager(mgr.GetClient(), v1alpha1.StoreConfigGroupVersionKind))
+	}
+
+	return ctrl.NewControllerManagedBy(mgr).
+		Named(name).
+		WithOptions(o.ForControllerRuntime()).
+		WithEventFilter(resource.DesiredStateChanged()).
+		For(&v1beta1.SAMLProvider{}).
+		Complete(managed.NewReconciler(mgr,
+			resource.ManagedKind(v1beta1.SAMLProviderGroupVersionKind),
+			managed.WithExternalConnecter(&connector{kube: mgr.GetClient(), newClientFn: iam.NewSAMLProviderClient}),
+			managed.WithInitializers(&tagger{kube: mgr.GetClient()}),
+			managed.WithPollInterval(o.PollInterval),
+			managed.WithLogger(o.Logger.WithValues("controller", name)),
+			managed.WithRecorder(event.NewAPIRecorder(mgr.GetEventRecorderFor(name))),
+			managed.WithConnectionPublishers(cps...)))
+}
+
+type connector struct {
+	kube        client.Client
+	newClientFn func(config aws.Config) iam.SAMLProviderClient
+}
+
+func (c *connector) Connect(ctx context.Context, mg resource.Managed) (managed.ExternalClient, error) {
+	cfg, err := awsclient.GetConfig(ctx, c.kube, mg, awsclient.GlobalRegion)
+	if err != nil {
+		return nil, err
+	}
+	return &external{
+		kube:   c.kube,
+		client: c.newClientFn(*cfg),
+	}, nil
+}
+
+type external struct {
+	kube   client.Client
+	client iam.SAMLProviderClient
+}
+
+func (e *external) Observe(ctx context.Context, mgd resource.Managed) (managed.ExternalObservation, error) {
+	cr, ok := mgd.(*v1beta1.SAMLProvider)
+	if !ok {
+		return managed.ExternalObservation{}, errors.New(errUnexpectedObject)
+	}
+
+	if meta.GetExternalName(cr) == "" {
+		arn, err := e.getSAMLProviderByName(ctx, cr.Spec.ForProvider.Name)
+		if arn == nil || err != nil {
+			return managed.ExternalObservation{}, err
+		}
+
+		meta.SetExternalName(cr, aws.ToString(arn))
+		_ = e.kube.Update(ctx, cr)
+	}
+
+	observed, err := e.client.GetSAMLProvider(ctx, &awsiam.GetSAMLProviderInput{
+		SAMLProviderArn: aws.String(meta.GetExternalName(cr)),
+	})
+	if err != nil {
+		return managed.ExternalObservation{}, awsclient.Wrap(resource.Ignore(iam.IsErrorNotFound, err), errGet)
+	}
+
+	cr.SetConditions(xpv1.Available())
+	cr.Status.AtProvider = iam.GenerateSAMLProviderObservation(*observed)
+
+	return managed.ExternalObservation{
+		ResourceExists:   true,
+		ResourceUpToDate: iam.IsSAMLProviderUpToDate(cr.Spec.ForProvider, *observed),
+	}, nil
+}
+
+func (e *external) Create(ctx context.Context, mgd resource.Managed) (managed.ExternalCreation, error) {
+	cr, ok := mgd.(*v1beta1.SAMLProvider)
+	if !ok {
+		return managed.ExternalCreation{}, errors.New(errUnexpectedObject)
+	}
+
+	observed, err := e.client.CreateSAMLProvider(ctx, &awsiam.CreateSAMLProviderInput{
+		Name:                 aws.String(cr.Spec.ForProvider.Name),
+		SAMLMetadataDocument: aws.String(cr.Spec.ForProvider.SAMLMetadataDocument),
+		Tags:                 iam.BuildIAMTags(cr.Spec.ForProvider.Tags),
+	})
+	if err != nil {
+		return managed.ExternalCreation{}, awsclient.Wrap(err, errCreate)
+	}
+
+	meta.SetExternalName(cr, aws.ToString(observed.SAMLProviderArn))
+	return managed.ExternalCreation{}, nil
+}
+
+func (e *external) Update(ctx context.Context, mgd resource.Managed) (managed.ExternalUpdate, error) {
+	cr, ok := mgd.(*v1beta1.SAMLProvider)
+	if !ok {
+		return managed.ExternalUpdate{}, errors.New(errUnexpectedObject)
+	}
+	arn := aws.String(meta.GetExternalName(cr))
+	observed, err := e.client.GetSAMLProvider(ctx, &awsiam.GetSAMLProviderInput{
+		SAMLProviderArn: arn,
+	})
+	if err != nil {
+		return managed.ExternalUpdate{}, awsclient.Wrap(err, errGet)
+	}
+
+	if !iam.IsSAMLMetadataDocumentUpToDate(cr.Spec.ForProvider, *observed) {
+		if _, err := e.client.UpdateSAMLProvider(ctx, &awsiam.UpdateSAMLProviderInput{
+			SAMLProviderArn:      arn,
+			SAMLMetadataDocument: aws.String(cr.Spec.ForProvider.SAMLMetadataDocument),
+		}); err != nil {
+			return managed.ExternalUpdate{}, awsclient.Wrap(err, errUpdate)
+		}
+	}
+
+	addTags, removeTags, _ := iam.DiffIAMTagsWithUpdates(cr.Spec.ForProvider.Tags, observed.Tags)
+
+	if len(addTags) > 0 {
+		if _, err := e.client.TagSAMLProvider(ctx, &awsiam.TagSAMLProviderInput{
+			SAMLProviderArn: arn,
+			Tags:            addTags,
+		}); err != nil {
+			return managed.ExternalUpdate{}, awsclient.Wrap(err, errAddTags)
+		}
+	}
+
+	if len(removeTags) > 0 {
+		if _, err := e.client.UntagSAMLProvider(ctx, &awsiam.UntagSAMLProviderInput{
+			SAMLProviderArn: arn,
+			TagKeys:         removeTags,
+		}); err != nil {
+			return managed.ExternalUpdate{}, awsclient.Wrap(err, errRemoveTags)
+		}
+	}
+
+	return managed.ExternalUpdate{}, nil
+}
+
+func (e *external) Delete(ctx context.Context, mgd resource.Managed) error {
+	cr, ok := mgd.(*v1beta1.SAMLProvider)
+	if !ok {
+		return errors.New(errUnexpectedObject)
+	}
+	_, err := e.client.DeleteSAMLProvider(ctx, &awsiam.DeleteSAMLProviderInput{
+		SAMLProviderArn: aws.String(meta.GetExternalName(cr)),
+	})
+
+	return awsclient.Wrap(resource.Ignore(iam.IsErrorNotFound, err), errDelete)
+}
+
+// getSAMLProviderByName returns the ARN of the SAML provider with the
+// supplied name, if any. The name of a SAML provider is unique in an account.
+func (e *external) getSAMLProviderByName(ctx context.Context, name string) (*string, error) {
+	res, err := e.client.ListSAMLProviders(ctx, &awsiam.ListSAMLProvidersInput{})
+	if err != nil {
+		return nil, awsclient.Wrap(err, errList)
+	}
+	for _, p := range res.SAMLProviderList {
+		if iam.SAMLProviderName(aws.ToString(p.Arn)) == name {
+			return p.Arn, nil
+		}
+	}
+	return nil, nil
+}
+
+type tagger struct {
+	kube client.Client
+}
+
+func (t *tagger) Initialize(ctx context.Context, mgd resource.Managed) error {
+	cr, ok := mgd.(*v1beta1.SAMLProvider)
+	if !ok {
+		return errors.New(errUnexpectedObject)
+	}
+	added := false
+	tagMap := map[string]string{}
+	for _, t := range cr.Spec.ForProvider.Tags {
+		tagMap[t.Key] = t.Value
+	}
+	tags := make(map[string]string, len(tagMap))
+	for k, v := range tagMap {
+		tags[k] = v
+	}
+	if err := awsclient.AddDefaultTags(ctx, t.kube, mgd, tags); err != nil {
+		return err
+	}
+	for k, v := range tags {
+		if p, ok := tagMap[k]; !ok || v != p {
+			cr.Spec.ForProvider.Tags = append(cr.Spec.ForProvider.Tags, v1beta1.Tag{Key: k, Value: v})
+			added = true
+		}
+	}
+	if !added {
+		return nil
+	}
+	return errors.Wrap(t.kube.Update(ctx, cr), errKubeUpdateFailed)
+}
@@ -0,0 +1,507 @@
+/*
+Copyright 2023 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package samlprovider
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	awsiam "github.com/aws/aws-sdk-go-v2/service/iam"
+	iamtypes "github.com/aws/aws-sdk-go-v2/service/iam/types"
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+	"github.com/pkg/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+
+	"github.com/crossplane-contrib/provider-aws/apis/iam/v1beta1"
+	awsclient "github.com/crossplane-contrib/provider-aws/pkg/clients"
+	"github.com/crossplane-contrib/provider-aws/pkg/clients/iam/fake"
+)
+
+var (
+	unexpectedItem resource.Managed
+	providerName   = "okta"
+	providerArn    = "arn:aws:iam::123456789012:saml-provider/okta"
+	document       = `<EntityDescriptor entityID="http://www.okta.com/abc"/>`
+	otherDocument  = `<EntityDescriptor entityID="http://www.okta.com/xyz"/>`
+	createDate     = time.Date(2023, 4, 1, 0, 0, 0, 0, time.UTC)
+
+	errBoom = errors.New("boom")
+)
+
+type args struct {
+	iam  *fake.MockSAMLProviderClient
+	kube client.Client
+	cr   resource.Managed
+}
+
+type samlProviderModifier func(*v1beta1.SAMLProvider)
+
+func withConditions(c ...xpv1.Condition) samlProviderModifier {
+	return func(r *v1beta1.SAMLProvider) { r.Status.ConditionedStatus.Conditions = c }
+}
+
+func withExternalName(name string) samlProviderModifier {
+	return func(r *v1beta1.SAMLProvider) { meta.SetExternalName(r, name) }
+}
+
+func withTags(tags ...v1beta1.Tag) samlProviderModifier {
+	return func(r *v1beta1.SAMLProvider) { r.Spec.ForProvider.Tags = tags }
+}
+
+func withAtProvider() samlProviderModifier {
+	return func(r *v1beta1.SAMLProvider) {
+		r.Status.AtProvider = v1beta1.SAMLProviderObservation{CreateDate: &metav1.Time{Time: createDate}}
+	}
+}
+
+func samlProvider(m ...samlProviderModifier) *v1beta1.SAMLProvider {
+	cr := &v1beta1.SAMLProvider{
+		Spec: v1beta1.SAMLProviderSpec{
+			ForProvider: v1beta1.SAMLProviderParameters{
+				Name:                 providerName,
+				SAMLMetadataDocument: document,
+			},
+		},
+	}
+	for _, f := range m {
+		f(cr)
+	}
+	return cr
+}
+
+func getSAMLProvider(doc string, tags ...iamtypes.Tag) func(ctx context.Context, input *awsiam.GetSAMLProviderInput, opts []func(*awsiam.Options)) (*awsiam.GetSAMLProviderOutput, error) {
+	return func(ctx context.Context, input *awsiam.GetSAMLProviderInput, opts []func(*awsiam.Options)) (*awsiam.GetSAMLProviderOutput, error) {
+		return &awsiam.GetSAMLProviderOutput{
+			CreateDate:           &createDate,
+			SAMLMetadataDocument: aws.String(doc),
+			Tags:                 tags,
+		}, nil
+	}
+}
+
+func TestObserve(t *testing.T) {
+
+	type want struct {
+		cr     resource.Managed
+		result managed.ExternalObservation
+		err    error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"UpToDate": {
+			args: args{
+				iam: &fake.MockSAMLProviderClient{
+					MockGetSAMLProvider: getSAMLProvider(document),
+				},
+				cr: samlProvider(withExternalName(providerArn)),
+			},
+			want: want{
+				cr: samlProvider(withExternalName(providerArn), withAtProvider(), withConditions(xpv1.Available())),
+				result: managed.ExternalObservation{
+					ResourceExists:   true,
+					ResourceUpToDate: true,
+				},
+			},
+		},
+		"DocumentChanged": {
+			args: args{
+				iam: &fake.MockSAMLProviderClient{
+					MockGetSAMLProvider: getSAMLProvider(otherDocument),
+				},
+				cr: samlProvider(withExternalName(providerArn)),
+			},
+			want: want{
+				cr: samlProvider(withExternalName(providerArn), withAtProvider(), withConditions(xpv1.Available())),
+				result: managed.ExternalObservation{
+					ResourceExists:   true,
+					ResourceUpToDate: false,
+				},
+			},
+		},
+		"FoundByName": {
+			args: args{
+				iam: &fake.MockSAMLProviderClient{
+					MockListSAMLProviders: func(ctx context.Context, input *awsiam.ListSAMLProvidersInput, opts []func(*awsiam.Options)) (*awsiam.ListSAMLProvidersOutput, error) {
+						return &awsiam.ListSAMLProvidersOutput{SAMLProviderList: []iamtypes.SAMLProviderListEntry{
+							{Arn: aws.String("arn:aws:iam::123456789012:saml-provider/other")},
+							{Arn: aws.String(providerArn)},
+						}}, nil
+					},
+					MockGetSAMLProvider: getSAMLProvider(document),
+				},
+				kube: &test.MockClient{
+					MockUpdate: test.NewMockUpdateFn(nil),
+				},
+				cr: samlProvider(),
+			},
+			want: want{
+				cr: samlProvider(withExternalName(providerArn), withAtProvider(), withConditions(xpv1.Available())),
+				result: managed.ExternalObservation{
+					ResourceExists:   true,
+					ResourceUpToDate: true,
+				},
+			},
+		},
+		"NotFoundByName": {
+			args: args{
+				iam: &fake.MockSAMLProviderClient{
+					MockListSAMLProviders: func(ctx context.Context, input *awsiam.ListSAMLProvidersInput, opts []func(*awsiam.Options)) (*awsiam.ListSAMLProvidersOutput, error) {
+						return &awsiam.ListSAMLProvidersOutput{}, nil
+					},
+				},
+				cr: samlProvider(),
+			},
+			want: want{
+				cr: samlProvider(),
+			},
+		},
+		"InValidInput": {
+			args: args{
+				cr: unexpectedItem,
+			},
+			want: want{
+				cr:  unexpectedItem,
+				err: errors.New(errUnexpectedObject),
+			},
+		},
+		"ListError": {
+			args: args{
+				iam: &fake.MockSAMLProviderClient{
+					MockListSAMLProviders: func(ctx context.Context, input *awsiam.ListSAMLProvidersInput, opts []func(*awsiam.Options)) (*awsiam.ListSAMLProvidersOutput, error) {
+						return nil, errBoom
+					},
+				},
+				cr: samlProvider(),
+			},
+			want: want{
+				cr:  samlProvider(),
+				err: awsclient.Wrap(errBoom, errList),
+			},
+		},
+		"GetError": {
+			args: args{
+				iam: &fake.MockSAMLProviderClient{
+					MockGetSAMLProvider: func(ctx context.Context, input *awsiam.GetSAMLProviderInput, opts []func(*awsiam.Options)) (*awsiam.GetSAMLProviderOutput, error) {
+						return nil, errBoom
+					},
+				},
+				cr: samlProvider(withExternalName(providerArn)),
+			},
+			want: want{
+				cr:  samlProvider(withExternalName(providerArn)),
+				err: awsclient.Wrap(errBoom, errGet),
+			},
+		},
+		"ResourceDoesNotExist": {
+			args: args{
+				iam: &fake.MockSAMLProviderClient{
+					MockGetSAMLProvider: func(ctx context.Context, input *awsiam.GetSAMLProviderInput, opts []func(*awsiam.Options)) (*awsiam.GetSAMLProviderOutput, error) {
+						return nil, &iamtypes.NoSuchEntityException{}
+					},
+				},
+				cr: samlProvider(withExternalName(providerArn)),
+			},
+			want: want{
+				cr: samlProvider(withExternalName(providerArn)),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{client: tc.iam, kube: tc.kube}
+			o, err := e.Observe(context.Background(), tc.args.cr)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.result, o); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestCreate(t *testing.T) {
+
+	type want struct {
+		cr     resource.Managed
+		result managed.ExternalCreation
+		err    error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"VaildInput": {
+			args: args{
+				iam: &fake.MockSAMLProviderClient{
+					MockCreateSAMLProvider: func(ctx context.Context, input *awsiam.CreateSAMLProviderInput, opts []func(*awsiam.Options)) (*awsiam.CreateSAMLProviderOutput, error) {
+						if diff := cmp.Diff(&awsiam.CreateSAMLProviderInput{
+							Name:                 aws.String(providerName),
+							SAMLMetadataDocument: aws.String(document),
+							Tags:                 []iamtypes.Tag{{Key: aws.String("k"), Value: aws.String("v")}},
+						}, input, cmpopts.IgnoreUnexported(awsiam.CreateSAMLProviderInput{}, iamtypes.Tag{})); diff != "" {
+							t.Errorf("r: -want, +got:\n%s", diff)
+						}
+						return &awsiam.CreateSAMLProviderOutput{SAMLProviderArn: aws.String(providerArn)}, nil
+					},
+				},
+				cr: samlProvider(withTags(v1beta1.Tag{Key: "k", Value: "v"})),
+			},
+			want: want{
+				cr: samlProvider(withTags(v1beta1.Tag{Key: "k", Value: "v"}), withExternalName(providerArn)),
+			},
+		},
+		"InValidInput": {
+			args: args{
+				cr: unexpectedItem,
+			},
+			want: want{
+				cr:  unexpectedItem,
+				err: errors.New(errUnexpectedObject),
+			},
+		},
+		"ClientError": {
+			args: args{
+				iam: &fake.MockSAMLProviderClient{
+					MockCreateSAMLProvider: func(ctx context.Context, input *awsiam.CreateSAMLProviderInput, opts []func(*awsiam.Options)) (*awsiam.CreateSAMLProviderOutput, error) {
+						return nil, errBoom
+					},
+				},
+				cr: samlProvider(),
+			},
+			want: want{
+				cr:  samlProvider(),
+				err: awsclient.Wrap(errBoom, errCreate),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{client: tc.iam, kube: tc.kube}
+			o, err := e.Create(context.Background(), tc.args.cr)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.result, o); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestUpdate(t *testing.T) {
+
+	type want struct {
+		cr     resource.Managed
+		result managed.ExternalUpdate
+		err    error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"UpdateDocumentAndTags": {
+			args: args{
+				iam: &fake.MockSAMLProviderClient{
+					MockGetSAMLProvider: getSAMLProvider(otherDocument, iamtypes.Tag{Key: aws.String("old"), Value: aws.String("v")}),
+					MockUpdateSAMLProvider: func(ctx context.Context, input *awsiam.UpdateSAMLProviderInput, opts []func(*awsiam.Options)) (*awsiam.UpdateSAMLProviderOutput, error) {
+						if diff := cmp.Diff(&awsiam.UpdateSAMLProviderInput{
+							SAMLProviderArn:      aws.String(providerArn),
+							SAMLMetadataDocument: aws.String(document),
+						}, input, cmpopts.IgnoreUnexported(awsiam.UpdateSAMLProviderInput{})); diff != "" {
+							t.Errorf("r: -want, +got:\n%s", diff)
+						}
+						return &awsiam.UpdateSAMLProviderOutput{}, nil
+					},
+					MockTagSAMLProvider: func(ctx context.Context, input *awsiam.TagSAMLProviderInput, opts []func(*awsiam.Options)) (*awsiam.TagSAMLProviderOutput, error) {
+						if diff := cmp.Diff([]iamtypes.Tag{{Key: aws.String("new"), Value: aws.String("v")}}, input.Tags, cmpopts.IgnoreUnexported(iamtypes.Tag{})); diff != "" {
+							t.Errorf("r: -want, +got:\n%s", diff)
+						}
+						return &awsiam.TagSAMLProviderOutput{}, nil
+					},
+					MockUntagSAMLProvider: func(ctx context.Context, input *awsiam.UntagSAMLProviderInput, opts []func(*awsiam.Options)) (*awsiam.UntagSAMLProviderOutput, error) {
+						if diff := cmp.Diff([]string{"old"}, input.TagKeys); diff != "" {
+							t.Errorf("r: -want, +got:\n%s", diff)
+						}
+						return &awsiam.UntagSAMLProviderOutput{}, nil
+					},
+				},
+				cr: samlProvider(withExternalName(providerArn), withTags(v1beta1.Tag{Key: "new", Value: "v"})),
+			},
+			want: want{
+				cr: samlProvider(withExternalName(providerArn), withTags(v1beta1.Tag{Key: "new", Value: "v"})),
+			},
+		},
+		"InValidInput": {
+			args: args{
+				cr: unexpectedItem,
+			},
+			want: want{
+				cr:  unexpectedItem,
+				err: errors.New(errUnexpectedObject),
+			},
+		},
+		"GetError": {
+			args: args{
+				iam: &fake.MockSAMLProviderClient{
+					MockGetSAMLProvider: func(ctx context.Context, input *awsiam.GetSAMLProviderInput, opts []func(*awsiam.Options)) (*awsiam.GetSAMLProviderOutput, error) {
+						return nil, errBoom
+					},
+				},
+				cr: samlProvider(withExternalName(providerArn)),
+			},
+			want: want{
+				cr:  samlProvider(withExternalName(providerArn)),
+				err: awsclient.Wrap(errBoom, errGet),
+			},
+		},
+		"UpdateError": {
+			args: args{
+				iam: &fake.MockSAMLProviderClient{
+					MockGetSAMLProvider: getSAMLProvider(otherDocument),
+					MockUpdateSAMLProvider: func(ctx context.Context, input *awsiam.UpdateSAMLProviderInput, opts []func(*awsiam.Options)) (*awsiam.UpdateSAMLProviderOutput, error) {
+						return nil, errBoom
+					},
+				},
+				cr: samlProvider(withExternalName(providerArn)),
+			},
+			want: want{
+				cr:  samlProvider(withExternalName(providerArn)),
+				err: awsclient.Wrap(errBoom, errUpdate),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{client: tc.iam, kube: tc.kube}
+			o, err := e.Update(context.Background(), tc.args.cr)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.result, o); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestDelete(t *testing.T) {
+
+	type want struct {
+		cr  resource.Managed
+		err error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"VaildInput": {
+			args: args{
+				iam: &fake.MockSAMLProviderClient{
+					MockDeleteSAMLProvider: func(ctx context.Context, input *awsiam.DeleteSAMLProviderInput, opts []func(*awsiam.Options)) (*awsiam.DeleteSAMLProviderOutput, error) {
+						return &awsiam.DeleteSAMLProviderOutput{}, nil
+					},
+				},
+				cr: samlProvider(withExternalName(providerArn)),
+			},
+			want: want{
+				cr: samlProvider(withExternalName(providerArn)),
+			},
+		},
+		"InValidInput": {
+			args: args{
+				cr: unexpectedItem,
+			},
+			want: want{
+				cr:  unexpectedItem,
+				err: errors.New(errUnexpectedObject),
+			},
+		},
+		"ResourceDoesNotExist": {
+			args: args{
+				iam: &fake.MockSAMLProviderClient{
+					MockDeleteSAMLProvider: func(ctx context.Context, input *awsiam.DeleteSAMLProviderInput, opts []func(*awsiam.Options)) (*awsiam.DeleteSAMLProviderOutput, error) {
+						return nil, &iamtypes.NoSuchEntityException{}
+					},
+				},
+				cr: samlProvider(withExternalName(providerArn)),
+			},
+			want: want{
+				cr: samlProvider(withExternalName(providerArn)),
+			},
+		},
+		"ClientError": {
+			args: args{
+				iam: &fake.MockSAMLProviderClient{
+					MockDeleteSAMLProvider: func(ctx context.Context, input *awsiam.DeleteSAMLProviderInput, opts []func(*awsiam.Options)) (*awsiam.DeleteSAMLProviderOutput, error) {
+						return nil, errBoom
+					},
+				},
+				cr: samlProvider(withExternalName(providerArn)),
+			},
+			want: want{
+				cr:  samlProvider(withExternalName(providerArn)),
+				err: awsclient.Wrap(errBoom, errDelete),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{client: tc.iam, kube: tc.kube}
+			err := e.Delete(context.Background(), tc.args.cr)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
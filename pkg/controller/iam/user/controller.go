@@ -96,12 +96,17 @@ func (c *connector) Connect(ctx context.Context, mg resource.Managed) (managed.E
 	if err != nil {
 		return nil, err
 	}
-	return &external{client: c.newClientFn(*cfg), kube: c.kube}, nil
+	return &external{
+		client:   c.newClientFn(*cfg),
+		policies: iam.NewUserInlinePolicyClient(iam.NewUserPolicyClient(*cfg)),
+		kube:     c.kube,
+	}, nil
 }
 
 type external struct {
-	kube   client.Client
-	client iam.UserClient
+	kube     client.Client
+	client   iam.UserClient
+	policies iam.InlinePolicyClient
 }
 
 func (e *external) Observe(ctx context.Context, mgd resource.Managed) (managed.ExternalObservation, error) {
@@ -138,9 +143,17 @@ func (e *external) Observe(ctx context.Context, mgd resource.Managed) (managed.E
 		UserID: aws.ToString(user.UserId),
 	}
 
+	var unmanaged []string
+	if aws.ToBool(cr.Spec.ForProvider.RemoveUnmanagedInlinePolicies) {
+		unmanaged, err = iam.FindUnmanagedInlinePolicies(ctx, e.kube, e.policies, cr, &v1beta1.UserPolicyList{}, policyUserName)
+		if err != nil {
+			return managed.ExternalObservation{}, err
+		}
+	}
+
 	return managed.ExternalObservation{
 		ResourceExists:   true,
-		ResourceUpToDate: isUpToDate(cr, &user),
+		ResourceUpToDate: isUpToDate(cr, &user) && len(unmanaged) == 0,
 	}, nil
 }
 
@@ -189,7 +202,15 @@ func (e *external) Update(ctx context.Context, mgd resource.Managed) (managed.Ex
 
 	// take care of changes to Tags
 	err = e.updateTags(ctx, observed, cr)
-	return managed.ExternalUpdate{}, err
+	if err != nil {
+		return managed.ExternalUpdate{}, err
+	}
+
+	// take care of inline policies that are not managed by UserPolicies
+	if !aws.ToBool(cr.Spec.ForProvider.RemoveUnmanagedInlinePolicies) {
+		return managed.ExternalUpdate{}, nil
+	}
+	return managed.ExternalUpdate{}, iam.RemoveUnmanagedInlinePolicies(ctx, e.kube, e.policies, cr, &v1beta1.UserPolicyList{}, policyUserName)
 }
 
 func (e *external) Delete(ctx context.Context, mgd resource.Managed) error {
@@ -207,6 +228,11 @@ func (e *external) Delete(ctx context.Context, mgd resource.Managed) error {
 	return awsclient.Wrap(resource.Ignore(iam.IsErrorNotFound, err), errDelete)
 }
 
+// policyUserName returns the name of the user a UserPolicy refers to.
+func policyUserName(mg resource.Managed) string {
+	return mg.(*v1beta1.UserPolicy).Spec.ForProvider.UserName
+}
+
 type tagger struct {
 	kube client.Client
 }
@@ -218,7 +244,13 @@ func (t *tagger) Initialize(ctx context.Context, mgd resource.Managed) error {
 	}
 
 	added := false
-	defaultTags := resource.GetExternalTags(mgd)
+	defaultTags := make(map[string]string, len(cr.Spec.ForProvider.Tags))
+	for _, tag := range cr.Spec.ForProvider.Tags {
+		defaultTags[tag.Key] = tag.Value
+	}
+	if err := awsclient.AddDefaultTags(ctx, t.kube, mgd, defaultTags); err != nil {
+		return err
+	}
 
 	for i, t := range cr.Spec.ForProvider.Tags {
 		if v, ok := defaultTags[t.Key]; ok {
@@ -38,9 +38,15 @@ import (
 
 	"github.com/crossplane-contrib/provider-aws/apis/iam/v1beta1"
 	awsclient "github.com/crossplane-contrib/provider-aws/pkg/clients"
+	"github.com/crossplane-contrib/provider-aws/pkg/clients/iam"
 	"github.com/crossplane-contrib/provider-aws/pkg/clients/iam/fake"
 )
 
+const (
+	errListInlinePolicies = "failed to list the inline policies"
+	errDeleteInlinePolicy = "failed to delete the inline policy"
+)
+
 var (
 	unexpectedItem resource.Managed
 	userName       = "some user"
@@ -76,8 +82,10 @@ var (
 )
 
 type args struct {
-	iam *fake.MockUserClient
-	cr  resource.Managed
+	iam      *fake.MockUserClient
+	policies *fake.MockUserPolicyClient
+	kube     client.Client
+	cr       resource.Managed
 }
 
 type userModifier func(*v1beta1.User)
@@ -120,6 +128,35 @@ func withBoundary(boundary *string) userModifier {
 	}
 }
 
+func withRemoveUnmanagedInlinePolicies() userModifier {
+	return func(r *v1beta1.User) {
+		r.Spec.ForProvider.RemoveUnmanagedInlinePolicies = aws.Bool(true)
+	}
+}
+
+// withUserPolicies lists a UserPolicy named managed for the user, one for
+// another user, one for a user of the same name in another account and one
+// whose user name is not resolved yet.
+func withUserPolicies() *test.MockClient {
+	return &test.MockClient{
+		MockList: func(ctx context.Context, list client.ObjectList, opts ...client.ListOption) error {
+			l := list.(*v1beta1.UserPolicyList)
+			l.Items = []v1beta1.UserPolicy{
+				{Spec: v1beta1.UserPolicySpec{ForProvider: v1beta1.UserPolicyParameters{UserName: userName}}},
+				{Spec: v1beta1.UserPolicySpec{ForProvider: v1beta1.UserPolicyParameters{UserName: "other"}}},
+				{Spec: v1beta1.UserPolicySpec{ForProvider: v1beta1.UserPolicyParameters{UserName: userName}}},
+				{Spec: v1beta1.UserPolicySpec{ForProvider: v1beta1.UserPolicyParameters{}}},
+			}
+			meta.SetExternalName(&l.Items[0], "managed")
+			meta.SetExternalName(&l.Items[1], "unmanaged")
+			meta.SetExternalName(&l.Items[2], "foreign")
+			l.Items[2].SetProviderConfigReference(&xpv1.Reference{Name: "other-account"})
+			meta.SetExternalName(&l.Items[3], "unresolved")
+			return nil
+		},
+	}
+}
+
 func user(m ...userModifier) *v1beta1.User {
 	cr := &v1beta1.User{}
 	for _, f := range m {
@@ -233,11 +270,92 @@ func TestObserve(t *testing.T) {
 				},
 			},
 		},
+		"UnmanagedInlinePolicies": {
+			args: args{
+				iam: &fake.MockUserClient{
+					MockGetUser: func(ctx context.Context, input *awsiam.GetUserInput, opts []func(*awsiam.Options)) (*awsiam.GetUserOutput, error) {
+						return &awsiam.GetUserOutput{
+							User: &awsiamtypes.User{},
+						}, nil
+					},
+				},
+				policies: &fake.MockUserPolicyClient{
+					MockListUserPolicies: func(ctx context.Context, input *awsiam.ListUserPoliciesInput, opts []func(*awsiam.Options)) (*awsiam.ListUserPoliciesOutput, error) {
+						return &awsiam.ListUserPoliciesOutput{
+							PolicyNames: []string{"managed", "unmanaged"},
+						}, nil
+					},
+				},
+				kube: withUserPolicies(),
+				cr:   user(withExternalName(userName), withRemoveUnmanagedInlinePolicies()),
+			},
+			want: want{
+				cr: user(withExternalName(userName),
+					withRemoveUnmanagedInlinePolicies(),
+					withConditions(xpv1.Available())),
+				result: managed.ExternalObservation{
+					ResourceExists:   true,
+					ResourceUpToDate: false,
+				},
+			},
+		},
+		"ManagedInlinePolicies": {
+			args: args{
+				iam: &fake.MockUserClient{
+					MockGetUser: func(ctx context.Context, input *awsiam.GetUserInput, opts []func(*awsiam.Options)) (*awsiam.GetUserOutput, error) {
+						return &awsiam.GetUserOutput{
+							User: &awsiamtypes.User{},
+						}, nil
+					},
+				},
+				policies: &fake.MockUserPolicyClient{
+					MockListUserPolicies: func(ctx context.Context, input *awsiam.ListUserPoliciesInput, opts []func(*awsiam.Options)) (*awsiam.ListUserPoliciesOutput, error) {
+						return &awsiam.ListUserPoliciesOutput{
+							PolicyNames: []string{"managed"},
+						}, nil
+					},
+				},
+				kube: withUserPolicies(),
+				cr:   user(withExternalName(userName), withRemoveUnmanagedInlinePolicies()),
+			},
+			want: want{
+				cr: user(withExternalName(userName),
+					withRemoveUnmanagedInlinePolicies(),
+					withConditions(xpv1.Available())),
+				result: managed.ExternalObservation{
+					ResourceExists:   true,
+					ResourceUpToDate: true,
+				},
+			},
+		},
+		"ListInlinePoliciesError": {
+			args: args{
+				iam: &fake.MockUserClient{
+					MockGetUser: func(ctx context.Context, input *awsiam.GetUserInput, opts []func(*awsiam.Options)) (*awsiam.GetUserOutput, error) {
+						return &awsiam.GetUserOutput{
+							User: &awsiamtypes.User{},
+						}, nil
+					},
+				},
+				policies: &fake.MockUserPolicyClient{
+					MockListUserPolicies: func(ctx context.Context, input *awsiam.ListUserPoliciesInput, opts []func(*awsiam.Options)) (*awsiam.ListUserPoliciesOutput, error) {
+						return nil, errBoom
+					},
+				},
+				cr: user(withExternalName(userName), withRemoveUnmanagedInlinePolicies()),
+			},
+			want: want{
+				cr: user(withExternalName(userName),
+					withRemoveUnmanagedInlinePolicies(),
+					withConditions(xpv1.Available())),
+				err: awsclient.Wrap(errBoom, errListInlinePolicies),
+			},
+		},
 	}
 
 	for name, tc := range cases {
 		t.Run(name, func(t *testing.T) {
-			e := &external{client: tc.iam}
+			e := &external{client: tc.iam, policies: iam.NewUserInlinePolicyClient(tc.policies), kube: tc.kube}
 			o, err := e.Observe(context.Background(), tc.args.cr)
 
 			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
@@ -398,11 +516,67 @@ func TestUpdate(t *testing.T) {
 				err: awsclient.Wrap(errBoom, errGet),
 			},
 		},
+		"RemoveUnmanagedInlinePolicies": {
+			args: args{
+				iam: &fake.MockUserClient{
+					MockGetUser: func(ctx context.Context, input *awsiam.GetUserInput, opts []func(*awsiam.Options)) (*awsiam.GetUserOutput, error) {
+						return &awsiam.GetUserOutput{
+							User: &awsiamtypes.User{},
+						}, nil
+					},
+				},
+				policies: &fake.MockUserPolicyClient{
+					MockListUserPolicies: func(ctx context.Context, input *awsiam.ListUserPoliciesInput, opts []func(*awsiam.Options)) (*awsiam.ListUserPoliciesOutput, error) {
+						return &awsiam.ListUserPoliciesOutput{
+							PolicyNames: []string{"managed", "unmanaged", "foreign", "unresolved"},
+						}, nil
+					},
+					MockDeleteUserPolicy: func(ctx context.Context, input *awsiam.DeleteUserPolicyInput, opts []func(*awsiam.Options)) (*awsiam.DeleteUserPolicyOutput, error) {
+						if aws.ToString(input.PolicyName) == "managed" {
+							t.Errorf("managed inline policy must not be deleted")
+						}
+						return &awsiam.DeleteUserPolicyOutput{}, nil
+					},
+				},
+				kube: withUserPolicies(),
+				cr:   user(withExternalName(userName), withRemoveUnmanagedInlinePolicies()),
+			},
+			want: want{
+				cr: user(withExternalName(userName), withRemoveUnmanagedInlinePolicies()),
+			},
+		},
+		"DeleteInlinePolicyError": {
+			args: args{
+				iam: &fake.MockUserClient{
+					MockGetUser: func(ctx context.Context, input *awsiam.GetUserInput, opts []func(*awsiam.Options)) (*awsiam.GetUserOutput, error) {
+						return &awsiam.GetUserOutput{
+							User: &awsiamtypes.User{},
+						}, nil
+					},
+				},
+				policies: &fake.MockUserPolicyClient{
+					MockListUserPolicies: func(ctx context.Context, input *awsiam.ListUserPoliciesInput, opts []func(*awsiam.Options)) (*awsiam.ListUserPoliciesOutput, error) {
+						return &awsiam.ListUserPoliciesOutput{
+							PolicyNames: []string{"unmanaged"},
+						}, nil
+					},
+					MockDeleteUserPolicy: func(ctx context.Context, input *awsiam.DeleteUserPolicyInput, opts []func(*awsiam.Options)) (*awsiam.DeleteUserPolicyOutput, error) {
+						return nil, errBoom
+					},
+				},
+				kube: withUserPolicies(),
+				cr:   user(withExternalName(userName), withRemoveUnmanagedInlinePolicies()),
+			},
+			want: want{
+				cr:  user(withExternalName(userName), withRemoveUnmanagedInlinePolicies()),
+				err: awsclient.Wrap(errBoom, errDeleteInlinePolicy),
+			},
+		},
 	}
 
 	for name, tc := range cases {
 		t.Run(name, func(t *testing.T) {
-			e := &external{client: tc.iam}
+			e := &external{client: tc.iam, policies: iam.NewUserInlinePolicyClient(tc.policies), kube: tc.kube}
 			o, err := e.Update(context.Background(), tc.args.cr)
 
 			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
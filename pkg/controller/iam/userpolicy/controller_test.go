@@ -0,0 +1,362 @@
+/*
+Copyright 2023 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package userpolicy
+
+import (
+	"context"
+	"net/url"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	awsiam "github.com/aws/aws-sdk-go-v2/service/iam"
+	awsiamtypes "github.com/aws/aws-sdk-go-v2/service/iam/types"
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+
+	"github.com/crossplane-contrib/provider-aws/apis/iam/v1beta1"
+	awsv1beta1 "github.com/crossplane-contrib/provider-aws/apis/v1beta1"
+	awsclient "github.com/crossplane-contrib/provider-aws/pkg/clients"
+	"github.com/crossplane-contrib/provider-aws/pkg/clients/iam"
+	"github.com/crossplane-contrib/provider-aws/pkg/clients/iam/fake"
+)
+
+const (
+	errGetInlinePolicy    = "failed to get the inline policy"
+	errPutInlinePolicy    = "failed to put the inline policy"
+	errDeleteInlinePolicy = "failed to delete the inline policy"
+)
+
+var (
+	// an arbitrary managed resource
+	unexpectedItem resource.Managed
+	userName       = "some-user"
+	policyName     = "some-policy"
+	document       = `{"Version":"2012-10-17","Statement":[{"Effect":"Allow","Action":"s3:GetObject","Resource":"*"}]}`
+	otherDocument  = `{"Version":"2012-10-17","Statement":[{"Effect":"Deny","Action":"s3:GetObject","Resource":"*"}]}`
+
+	errBoom = errors.New("boom")
+)
+
+type args struct {
+	iam iam.UserPolicyClient
+	cr  resource.Managed
+}
+
+type userPolicyModifier func(*v1beta1.UserPolicy)
+
+func withConditions(c ...xpv1.Condition) userPolicyModifier {
+	return func(r *v1beta1.UserPolicy) { r.Status.ConditionedStatus.Conditions = c }
+}
+
+func userPolicy(m ...userPolicyModifier) *v1beta1.UserPolicy {
+	cr := &v1beta1.UserPolicy{
+		Spec: v1beta1.UserPolicySpec{
+			ForProvider: v1beta1.UserPolicyParameters{
+				Document: document,
+				UserName: userName,
+			},
+		},
+	}
+	meta.SetExternalName(cr, policyName)
+	for _, f := range m {
+		f(cr)
+	}
+	return cr
+}
+
+func getUserPolicy(doc string) func(ctx context.Context, input *awsiam.GetUserPolicyInput, opts []func(*awsiam.Options)) (*awsiam.GetUserPolicyOutput, error) {
+	return func(ctx context.Context, input *awsiam.GetUserPolicyInput, opts []func(*awsiam.Options)) (*awsiam.GetUserPolicyOutput, error) {
+		return &awsiam.GetUserPolicyOutput{
+			PolicyName:     input.PolicyName,
+			UserName:       input.UserName,
+			PolicyDocument: aws.String(url.QueryEscape(doc)),
+		}, nil
+	}
+}
+
+func TestConnect(t *testing.T) {
+	var region string
+	c := &connector{
+		kube: &test.MockClient{
+			MockGet: test.NewMockGetFn(nil, func(obj client.Object) error {
+				if pc, ok := obj.(*awsv1beta1.ProviderConfig); ok {
+					*pc = awsv1beta1.ProviderConfig{
+						ObjectMeta: metav1.ObjectMeta{Name: "default"},
+						Spec: awsv1beta1.ProviderConfigSpec{
+							Credentials: awsv1beta1.ProviderCredentials{Source: xpv1.CredentialsSourceNone},
+						},
+					}
+				}
+				return nil
+			}),
+		},
+		newClientFn: func(cfg aws.Config) iam.UserPolicyClient {
+			region = cfg.Region
+			return &fake.MockUserPolicyClient{}
+		},
+	}
+	cr := userPolicy()
+	cr.SetProviderConfigReference(&xpv1.Reference{Name: "default"})
+
+	if _, err := c.Connect(context.Background(), cr); err != nil {
+		t.Fatalf("Connect(...): %s", err)
+	}
+	if diff := cmp.Diff(awsclient.GlobalRegion, region); diff != "" {
+		t.Errorf("region: -want, +got:\n%s", diff)
+	}
+}
+
+func TestObserve(t *testing.T) {
+
+	type want struct {
+		cr     resource.Managed
+		result managed.ExternalObservation
+		err    error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"UpToDate": {
+			args: args{
+				iam: &fake.MockUserPolicyClient{
+					MockGetUserPolicy: getUserPolicy(document),
+				},
+				cr: userPolicy(),
+			},
+			want: want{
+				cr: userPolicy(withConditions(xpv1.Available())),
+				result: managed.ExternalObservation{
+					ResourceExists:   true,
+					ResourceUpToDate: true,
+				},
+			},
+		},
+		"NotUpToDate": {
+			args: args{
+				iam: &fake.MockUserPolicyClient{
+					MockGetUserPolicy: getUserPolicy(otherDocument),
+				},
+				cr: userPolicy(),
+			},
+			want: want{
+				cr: userPolicy(withConditions(xpv1.Available())),
+				result: managed.ExternalObservation{
+					ResourceExists:   true,
+					ResourceUpToDate: false,
+				},
+			},
+		},
+		"NotFound": {
+			args: args{
+				iam: &fake.MockUserPolicyClient{
+					MockGetUserPolicy: func(ctx context.Context, input *awsiam.GetUserPolicyInput, opts []func(*awsiam.Options)) (*awsiam.GetUserPolicyOutput, error) {
+						return nil, &awsiamtypes.NoSuchEntityException{}
+					},
+				},
+				cr: userPolicy(),
+			},
+			want: want{
+				cr: userPolicy(),
+			},
+		},
+		"InValidInput": {
+			args: args{
+				cr: unexpectedItem,
+			},
+			want: want{
+				cr:  unexpectedItem,
+				err: errors.New(errUnexpectedObject),
+			},
+		},
+		"ClientError": {
+			args: args{
+				iam: &fake.MockUserPolicyClient{
+					MockGetUserPolicy: func(ctx context.Context, input *awsiam.GetUserPolicyInput, opts []func(*awsiam.Options)) (*awsiam.GetUserPolicyOutput, error) {
+						return nil, errBoom
+					},
+				},
+				cr: userPolicy(),
+			},
+			want: want{
+				cr:  userPolicy(),
+				err: awsclient.Wrap(errBoom, errGetInlinePolicy),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{client: iam.NewUserInlinePolicyClient(tc.iam)}
+			o, err := e.Observe(context.Background(), tc.args.cr)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.result.ResourceExists, o.ResourceExists); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.result.ResourceUpToDate, o.ResourceUpToDate); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestCreate(t *testing.T) {
+
+	type want struct {
+		cr  resource.Managed
+		err error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"Successful": {
+			args: args{
+				iam: &fake.MockUserPolicyClient{
+					MockPutUserPolicy: func(ctx context.Context, input *awsiam.PutUserPolicyInput, opts []func(*awsiam.Options)) (*awsiam.PutUserPolicyOutput, error) {
+						return &awsiam.PutUserPolicyOutput{}, nil
+					},
+				},
+				cr: userPolicy(),
+			},
+			want: want{
+				cr: userPolicy(withConditions(xpv1.Creating())),
+			},
+		},
+		"InValidInput": {
+			args: args{
+				cr: unexpectedItem,
+			},
+			want: want{
+				cr:  unexpectedItem,
+				err: errors.New(errUnexpectedObject),
+			},
+		},
+		"ClientError": {
+			args: args{
+				iam: &fake.MockUserPolicyClient{
+					MockPutUserPolicy: func(ctx context.Context, input *awsiam.PutUserPolicyInput, opts []func(*awsiam.Options)) (*awsiam.PutUserPolicyOutput, error) {
+						return nil, errBoom
+					},
+				},
+				cr: userPolicy(),
+			},
+			want: want{
+				cr:  userPolicy(withConditions(xpv1.Creating())),
+				err: awsclient.Wrap(errBoom, errPutInlinePolicy),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{client: iam.NewUserInlinePolicyClient(tc.iam)}
+			_, err := e.Create(context.Background(), tc.args.cr)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestDelete(t *testing.T) {
+
+	type want struct {
+		cr  resource.Managed
+		err error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"Successful": {
+			args: args{
+				iam: &fake.MockUserPolicyClient{
+					MockDeleteUserPolicy: func(ctx context.Context, input *awsiam.DeleteUserPolicyInput, opts []func(*awsiam.Options)) (*awsiam.DeleteUserPolicyOutput, error) {
+						return &awsiam.DeleteUserPolicyOutput{}, nil
+					},
+				},
+				cr: userPolicy(),
+			},
+			want: want{
+				cr: userPolicy(withConditions(xpv1.Deleting())),
+			},
+		},
+		"AlreadyDeleted": {
+			args: args{
+				iam: &fake.MockUserPolicyClient{
+					MockDeleteUserPolicy: func(ctx context.Context, input *awsiam.DeleteUserPolicyInput, opts []func(*awsiam.Options)) (*awsiam.DeleteUserPolicyOutput, error) {
+						return nil, &awsiamtypes.NoSuchEntityException{}
+					},
+				},
+				cr: userPolicy(),
+			},
+			want: want{
+				cr: userPolicy(withConditions(xpv1.Deleting())),
+			},
+		},
+		"ClientError": {
+			args: args{
+				iam: &fake.MockUserPolicyClient{
+					MockDeleteUserPolicy: func(ctx context.Context, input *awsiam.DeleteUserPolicyInput, opts []func(*awsiam.Options)) (*awsiam.DeleteUserPolicyOutput, error) {
+						return nil, errBoom
+					},
+				},
+				cr: userPolicy(),
+			},
+			want: want{
+				cr:  userPolicy(withConditions(xpv1.Deleting())),
+				err: awsclient.Wrap(errBoom, errDeleteInlinePolicy),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{client: iam.NewUserInlinePolicyClient(tc.iam)}
+			err := e.Delete(context.Background(), tc.args.cr)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
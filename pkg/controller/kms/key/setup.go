@@ -2,11 +2,13 @@ package key
 
 import (
 	"context"
+	"sort"
 
 	svcsdk "github.com/aws/aws-sdk-go/service/kms"
 	svcsdkapi "github.com/aws/aws-sdk-go/service/kms/kmsiface"
 	"github.com/pkg/errors"
 	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
 
 	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
 	"github.com/crossplane/crossplane-runtime/pkg/connection"
@@ -22,6 +24,10 @@ import (
 	"github.com/crossplane-contrib/provider-aws/pkg/features"
 )
 
+const (
+	errKubeUpdateFailed = "cannot update Key custom resource"
+)
+
 // SetupKey adds a controller that reconciles Key.
 func SetupKey(mgr ctrl.Manager, o controller.Options) error {
 	name := managed.ControllerName(svcapitypes.KeyGroupKind)
@@ -54,7 +60,7 @@ func SetupKey(mgr ctrl.Manager, o controller.Options) error {
 			resource.ManagedKind(svcapitypes.KeyGroupVersionKind),
 			managed.WithExternalConnecter(&connector{kube: mgr.GetClient(), opts: opts}),
 			managed.WithPollInterval(o.PollInterval),
-			managed.WithInitializers(),
+			managed.WithInitializers(&tagger{kube: mgr.GetClient()}),
 			managed.WithLogger(o.Logger.WithValues("controller", name)),
 			managed.WithRecorder(event.NewAPIRecorder(mgr.GetEventRecorderFor(name))),
 			managed.WithConnectionPublishers(cps...)))
@@ -300,7 +306,7 @@ func (o *observer) isUpToDate(cr *svcapitypes.Key, obj *svcsdk.DescribeKeyOutput
 	if err != nil {
 		return false, awsclients.Wrap(err, "cannot get key policy")
 	}
-	if awsclients.StringValue(cr.Spec.ForProvider.Policy) != awsclients.StringValue(resPolicy.Policy) {
+	if !awsclients.IsPolicyUpToDate(cr.Spec.ForProvider.Policy, resPolicy.Policy) {
 		return false, nil
 	}
 
@@ -348,3 +354,33 @@ func diffTags(spec []*svcapitypes.Tag, current []*svcsdk.Tag) (addTags []*svcsdk
 	}
 	return
 }
+
+type tagger struct {
+	kube client.Client
+}
+
+func (t *tagger) Initialize(ctx context.Context, mg resource.Managed) error {
+	cr, ok := mg.(*svcapitypes.Key)
+	if !ok {
+		return errors.New(errUnexpectedObject)
+	}
+	tagMap := map[string]string{}
+	for _, t := range cr.Spec.ForProvider.Tags {
+		tagMap[awsclients.StringValue(t.TagKey)] = awsclients.StringValue(t.TagValue)
+	}
+	changed, err := awsclients.MergeDefaultTags(ctx, t.kube, mg, tagMap)
+	if err != nil {
+		return err
+	}
+	if !changed {
+		return nil
+	}
+	cr.Spec.ForProvider.Tags = make([]*svcapitypes.Tag, 0, len(tagMap))
+	for k, v := range tagMap {
+		cr.Spec.ForProvider.Tags = append(cr.Spec.ForProvider.Tags, &svcapitypes.Tag{TagKey: awsclients.String(k), TagValue: awsclients.String(v, awsclients.FieldRequired)})
+	}
+	sort.Slice(cr.Spec.ForProvider.Tags, func(i, j int) bool {
+		return awsclients.StringValue(cr.Spec.ForProvider.Tags[i].TagKey) < awsclients.StringValue(cr.Spec.ForProvider.Tags[j].TagKey)
+	})
+	return errors.Wrap(t.kube.Update(ctx, cr), errKubeUpdateFailed)
+}
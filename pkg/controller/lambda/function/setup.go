@@ -10,6 +10,7 @@ import (
 	"github.com/google/go-cmp/cmp/cmpopts"
 	"github.com/pkg/errors"
 	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
 
 	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
 	"github.com/crossplane/crossplane-runtime/pkg/connection"
@@ -35,6 +36,8 @@ const (
 	// used in observation
 	repositoryTypeECR = "ECR"
 	repositoryTypeS3  = "S3"
+
+	errKubeUpdateFailed = "cannot update Function custom resource"
 )
 
 // SetupFunction adds a controller that reconciles Function.
@@ -66,6 +69,7 @@ func SetupFunction(mgr ctrl.Manager, o controller.Options) error {
 		Complete(managed.NewReconciler(mgr,
 			resource.ManagedKind(svcapitypes.FunctionGroupVersionKind),
 			managed.WithExternalConnecter(&connector{kube: mgr.GetClient(), opts: opts}),
+			managed.WithInitializers(managed.NewNameAsExternalName(mgr.GetClient()), &tagger{kube: mgr.GetClient()}),
 			managed.WithPollInterval(o.PollInterval),
 			managed.WithLogger(o.Logger.WithValues("controller", name)),
 			managed.WithRecorder(event.NewAPIRecorder(mgr.GetEventRecorderFor(name))),
@@ -613,3 +617,32 @@ func generateFuntionObservation(resp *svcsdk.GetFunctionOutput) svcapitypes.Func
 	}
 	return o
 }
+
+type tagger struct {
+	kube client.Client
+}
+
+func (t *tagger) Initialize(ctx context.Context, mg resource.Managed) error {
+	cr, ok := mg.(*svcapitypes.Function)
+	if !ok {
+		return errors.New(errUnexpectedObject)
+	}
+	if cr.Spec.ForProvider.Tags == nil {
+		cr.Spec.ForProvider.Tags = map[string]*string{}
+	}
+	tagMap := make(map[string]string, len(cr.Spec.ForProvider.Tags))
+	for k, v := range cr.Spec.ForProvider.Tags {
+		tagMap[k] = aws.StringValue(v)
+	}
+	changed, err := aws.MergeDefaultTags(ctx, t.kube, mg, tagMap)
+	if err != nil {
+		return err
+	}
+	if !changed {
+		return nil
+	}
+	for k, v := range tagMap {
+		cr.Spec.ForProvider.Tags[k] = aws.String(v, aws.FieldRequired)
+	}
+	return errors.Wrap(t.kube.Update(ctx, cr), errKubeUpdateFailed)
+}
@@ -1,6 +1,7 @@
 package function
 
 import (
+	"context"
 	"testing"
 
 	svcapitypesv1beta1 "github.com/crossplane-contrib/provider-aws/apis/lambda/v1beta1"
@@ -8,8 +9,11 @@ import (
 	"github.com/aws/aws-sdk-go-v2/aws"
 	svcsdk "github.com/aws/aws-sdk-go/service/lambda"
 	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
 	"github.com/crossplane/crossplane-runtime/pkg/test"
 	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+	"sigs.k8s.io/controller-runtime/pkg/client"
 
 	"github.com/crossplane-contrib/provider-aws/apis/lambda/v1beta1"
 )
@@ -924,3 +928,63 @@ func TestIsUpToDateCodeImage(t *testing.T) {
 		})
 	}
 }
+
+func TestInitialize(t *testing.T) {
+	errBoom := errors.New("boom")
+	withTags := func(tagMaps ...map[string]string) functionModifier {
+		return func(r *v1beta1.Function) {
+			r.Spec.ForProvider.Tags = map[string]*string{}
+			for _, tagMap := range tagMaps {
+				for k, v := range tagMap {
+					r.Spec.ForProvider.Tags[k] = aws.String(v)
+				}
+			}
+		}
+	}
+	type args struct {
+		cr   *v1beta1.Function
+		kube client.Client
+	}
+	type want struct {
+		cr  *v1beta1.Function
+		err error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"Successful": {
+			args: args{
+				cr:   function(withTags(map[string]string{"foo": "bar"})),
+				kube: &test.MockClient{MockUpdate: test.NewMockUpdateFn(nil)},
+			},
+			want: want{
+				cr: function(withTags(resource.GetExternalTags(function()), map[string]string{"foo": "bar"})),
+			},
+		},
+		"AlreadyTagged": {
+			args: args{
+				cr:   function(withTags(resource.GetExternalTags(function()), map[string]string{"foo": "bar"})),
+				kube: &test.MockClient{MockUpdate: test.NewMockUpdateFn(errBoom)},
+			},
+			want: want{
+				cr: function(withTags(resource.GetExternalTags(function()), map[string]string{"foo": "bar"})),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &tagger{kube: tc.kube}
+			err := e.Initialize(context.Background(), tc.args.cr)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr); err == nil && diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
@@ -129,7 +129,14 @@ func (t *tagger) Initialize(ctx context.Context, mg resource.Managed) error {
 	if cr.Spec.ForProvider.Tags == nil {
 		cr.Spec.ForProvider.Tags = map[string]*string{}
 	}
-	for k, v := range resource.GetExternalTags(mg) {
+	tagMap := make(map[string]string, len(cr.Spec.ForProvider.Tags))
+	for k, v := range cr.Spec.ForProvider.Tags {
+		tagMap[k] = awsclients.StringValue(v)
+	}
+	if err := awsclients.AddDefaultTags(ctx, t.kube, mg, tagMap); err != nil {
+		return err
+	}
+	for k, v := range tagMap {
 		cr.Spec.ForProvider.Tags[k] = awsclients.String(v)
 	}
 	return errors.Wrap(t.kube.Update(ctx, cr), errKubeUpdateFailed)
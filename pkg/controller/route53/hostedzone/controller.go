@@ -53,6 +53,8 @@ const (
 
 	errListTags   = "cannot list tags"
 	errUpdateTags = "cannot update tags"
+
+	errKubeUpdateFailed = "cannot update Hosted Zone custom resource"
 )
 
 // SetupHostedZone adds a controller that reconciles Hosted Zones.
@@ -74,7 +76,7 @@ func SetupHostedZone(mgr ctrl.Manager, o controller.Options) error {
 			managed.WithExternalConnecter(&connector{kube: mgr.GetClient(), newClientFn: hostedzone.NewClient}),
 			managed.WithReferenceResolver(managed.NewAPISimpleReferenceResolver(mgr.GetClient())),
 			managed.WithConnectionPublishers(),
-			managed.WithInitializers(),
+			managed.WithInitializers(&tagger{kube: mgr.GetClient()}),
 			managed.WithPollInterval(o.PollInterval),
 			managed.WithLogger(o.Logger.WithValues("controller", name)),
 			managed.WithRecorder(event.NewAPIRecorder(mgr.GetEventRecorderFor(name))),
@@ -210,3 +212,25 @@ func (e *external) Delete(ctx context.Context, mg resource.Managed) error {
 
 	return awsclient.Wrap(resource.Ignore(hostedzone.IsNotFound, err), errDelete)
 }
+
+type tagger struct {
+	kube client.Client
+}
+
+func (t *tagger) Initialize(ctx context.Context, mg resource.Managed) error {
+	cr, ok := mg.(*route53v1alpha1.HostedZone)
+	if !ok {
+		return errors.New(errUnexpectedObject)
+	}
+	if cr.Spec.ForProvider.Tags == nil {
+		cr.Spec.ForProvider.Tags = map[string]string{}
+	}
+	changed, err := awsclient.MergeDefaultTags(ctx, t.kube, mg, cr.Spec.ForProvider.Tags)
+	if err != nil {
+		return err
+	}
+	if !changed {
+		return nil
+	}
+	return errors.Wrap(t.kube.Update(ctx, cr), errKubeUpdateFailed)
+}
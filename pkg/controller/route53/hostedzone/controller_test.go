@@ -609,3 +609,58 @@ func TestDelete(t *testing.T) {
 		})
 	}
 }
+
+func TestInitialize(t *testing.T) {
+	withTags := func(tagMaps ...map[string]string) zoneModifier {
+		return func(r *v1alpha1.HostedZone) {
+			r.Spec.ForProvider.Tags = map[string]string{}
+			for _, tagMap := range tagMaps {
+				for k, v := range tagMap {
+					r.Spec.ForProvider.Tags[k] = v
+				}
+			}
+		}
+	}
+	type want struct {
+		cr  resource.Managed
+		err error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"Successful": {
+			args: args{
+				cr:   instance(withTags(map[string]string{"foo": "bar"})),
+				kube: &test.MockClient{MockUpdate: test.NewMockUpdateFn(nil)},
+			},
+			want: want{
+				cr: instance(withTags(resource.GetExternalTags(instance()), map[string]string{"foo": "bar"})),
+			},
+		},
+		"AlreadyTagged": {
+			args: args{
+				cr:   instance(withTags(resource.GetExternalTags(instance()), map[string]string{"foo": "bar"})),
+				kube: &test.MockClient{MockUpdate: test.NewMockUpdateFn(errBoom)},
+			},
+			want: want{
+				cr: instance(withTags(resource.GetExternalTags(instance()), map[string]string{"foo": "bar"})),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &tagger{kube: tc.kube}
+			err := e.Initialize(context.Background(), tc.args.cr)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr); err == nil && diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
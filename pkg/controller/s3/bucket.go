@@ -18,6 +18,7 @@ package s3
 
 import (
 	"context"
+	"sort"
 
 	"github.com/aws/aws-sdk-go-v2/aws"
 	awss3 "github.com/aws/aws-sdk-go-v2/service/s3"
@@ -51,6 +52,11 @@ const (
 	errCreateOrUpdate   = "cannot create or update"
 	errDelete           = "cannot delete"
 	errKubeUpdateFailed = "cannot update S3 custom resource"
+	errEmptyBucket      = "cannot empty the Bucket before deletion"
+
+	// maxEmptyBucketBatches is the maximum number of batches of up to 1000
+	// objects that are deleted from a bucket with forceDestroy per reconcile.
+	maxEmptyBucketBatches = 10
 )
 
 // SetupBucket adds a controller that reconciles Buckets.
@@ -71,6 +77,7 @@ func SetupBucket(mgr ctrl.Manager, o controller.Options) error {
 			resource.ManagedKind(v1beta1.BucketGroupVersionKind),
 			managed.WithExternalConnecter(&connector{kube: mgr.GetClient(), newClientFn: s3.NewClient, logger: o.Logger.WithValues("controller", name)}),
 			managed.WithReferenceResolver(managed.NewAPISimpleReferenceResolver(mgr.GetClient())),
+			managed.WithInitializers(managed.NewNameAsExternalName(mgr.GetClient()), &tagger{kube: mgr.GetClient()}),
 			managed.WithPollInterval(o.PollInterval),
 			managed.WithLogger(o.Logger.WithValues("controller", name)),
 			managed.WithRecorder(event.NewAPIRecorder(mgr.GetEventRecorderFor(name))),
@@ -120,7 +127,9 @@ func (e *external) Observe(ctx context.Context, mg resource.Managed) (managed.Ex
 		return managed.ExternalObservation{}, err1
 	}
 
+	forceDestroy := cr.Status.AtProvider.ForceDestroy
 	cr.Status.AtProvider = s3.GenerateBucketObservation(meta.GetExternalName(cr), endpoint.PartitionID)
+	cr.Status.AtProvider.ForceDestroy = forceDestroy
 
 	lateInit := false
 	current := cr.Spec.ForProvider.DeepCopy()
@@ -164,12 +173,6 @@ func (e *external) Observe(ctx context.Context, mg resource.Managed) (managed.Ex
 		}
 	}
 
-	// TODO: smarter updating for the bucket, we don't need to update the ObjectOwnership every time
-	err := s3.UpdateBucketOwnershipControls(ctx, e.s3client, cr)
-	if err != nil {
-		return managed.ExternalObservation{}, err
-	}
-
 	cr.Status.SetConditions(xpv1.Available())
 
 	return managed.ExternalObservation{
@@ -250,6 +253,55 @@ func (e *external) Delete(ctx context.Context, mg resource.Managed) error {
 	}
 
 	cr.Status.SetConditions(xpv1.Deleting())
+	if aws.ToBool(cr.Spec.ForProvider.ForceDestroy) {
+		if cr.Status.AtProvider.ForceDestroy == nil {
+			cr.Status.AtProvider.ForceDestroy = &v1beta1.BucketForceDestroyStatus{}
+		}
+		empty, err := s3.EmptyBucket(ctx, e.s3client, meta.GetExternalName(cr), cr.Status.AtProvider.ForceDestroy, maxEmptyBucketBatches)
+		if err != nil {
+			return errors.Wrap(resource.Ignore(s3.IsErrorBucketNotFound, err), errEmptyBucket)
+		}
+		if !empty {
+			// NOTE: The bucket is deleted in a later reconcile, once all
+			// remaining objects have been deleted.
+			return nil
+		}
+	}
 	_, err := e.s3client.DeleteBucket(ctx, &awss3.DeleteBucketInput{Bucket: aws.String(meta.GetExternalName(cr))})
 	return resource.Ignore(s3.IsNotFound, err)
 }
+
+type tagger struct {
+	kube client.Client
+}
+
+func (t *tagger) Initialize(ctx context.Context, mg resource.Managed) error {
+	cr, ok := mg.(*v1beta1.Bucket)
+	if !ok {
+		return errors.New(errUnexpectedObject)
+	}
+	tagMap := map[string]string{}
+	if cr.Spec.ForProvider.BucketTagging != nil {
+		for _, t := range cr.Spec.ForProvider.BucketTagging.TagSet {
+			tagMap[t.Key] = t.Value
+		}
+	}
+	changed, err := awsclient.MergeDefaultTags(ctx, t.kube, mg, tagMap)
+	if err != nil {
+		return err
+	}
+	if !changed {
+		return nil
+	}
+	if cr.Spec.ForProvider.BucketTagging == nil {
+		cr.Spec.ForProvider.BucketTagging = &v1beta1.Tagging{}
+	}
+	cr.Spec.ForProvider.BucketTagging.TagSet = make([]v1beta1.Tag, 0, len(tagMap))
+	for k, v := range tagMap {
+		cr.Spec.ForProvider.BucketTagging.TagSet = append(cr.Spec.ForProvider.BucketTagging.TagSet, v1beta1.Tag{Key: k, Value: v})
+	}
+	sort.Slice(cr.Spec.ForProvider.BucketTagging.TagSet, func(i, j int) bool {
+		return cr.Spec.ForProvider.BucketTagging.TagSet[i].Key < cr.Spec.ForProvider.BucketTagging.TagSet[j].Key
+	})
+	return errors.Wrap(t.kube.Update(ctx, cr), errKubeUpdateFailed)
+}
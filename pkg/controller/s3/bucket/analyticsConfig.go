@@ -0,0 +1,225 @@
+/*
+Copyright 2023 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package bucket
+
+import (
+	"context"
+
+	awss3 "github.com/aws/aws-sdk-go-v2/service/s3"
+	"github.com/aws/aws-sdk-go-v2/service/s3/types"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+
+	"github.com/crossplane-contrib/provider-aws/apis/s3/v1beta1"
+	awsclient "github.com/crossplane-contrib/provider-aws/pkg/clients"
+	"github.com/crossplane-contrib/provider-aws/pkg/clients/s3"
+)
+
+const (
+	analyticsListFailed   = "cannot list Bucket analytics configurations"
+	analyticsPutFailed    = "cannot put Bucket analytics configuration"
+	analyticsDeleteFailed = "cannot delete Bucket analytics configuration"
+)
+
+// AnalyticsConfigurationClient is the client for API methods and reconciling the AnalyticsConfigurations
+type AnalyticsConfigurationClient struct {
+	client s3.BucketClient
+}
+
+// NewAnalyticsConfigurationClient creates the client for Analytics Configurations
+func NewAnalyticsConfigurationClient(client s3.BucketClient) *AnalyticsConfigurationClient {
+	return &AnalyticsConfigurationClient{client: client}
+}
+
+// Observe checks if the resource exists and if it matches the local configuration
+func (in *AnalyticsConfigurationClient) Observe(ctx context.Context, bucket *v1beta1.Bucket) (ResourceStatus, error) {
+	observed, err := in.observe(ctx, bucket)
+	if err != nil {
+		return NeedsUpdate, err
+	}
+	return configurationsStatus(desiredAnalyticsConfigurations(bucket), observed), nil
+}
+
+// CreateOrUpdate sends a request to have resource created on AWS
+func (in *AnalyticsConfigurationClient) CreateOrUpdate(ctx context.Context, bucket *v1beta1.Bucket) error {
+	observed, err := in.observe(ctx, bucket)
+	if err != nil {
+		return err
+	}
+	desired := desiredAnalyticsConfigurations(bucket)
+	put, remove := configurationsDiff(desired, observed)
+	for _, id := range put {
+		config := desired[id].(types.AnalyticsConfiguration)
+		if _, err := in.client.PutBucketAnalyticsConfiguration(ctx, &awss3.PutBucketAnalyticsConfigurationInput{
+			Bucket:                 awsclient.String(meta.GetExternalName(bucket)),
+			Id:                     awsclient.String(id),
+			AnalyticsConfiguration: &config,
+		}); err != nil {
+			return awsclient.Wrap(err, analyticsPutFailed)
+		}
+	}
+	return in.delete(ctx, bucket, remove)
+}
+
+// Delete creates the request to delete the resource on AWS or set it to the default value.
+func (in *AnalyticsConfigurationClient) Delete(ctx context.Context, bucket *v1beta1.Bucket) error {
+	observed, err := in.observe(ctx, bucket)
+	if err != nil {
+		return err
+	}
+	_, remove := configurationsDiff(nil, observed)
+	return in.delete(ctx, bucket, remove)
+}
+
+// LateInitialize is responsible for initializing the resource based on the external value
+func (in *AnalyticsConfigurationClient) LateInitialize(ctx context.Context, bucket *v1beta1.Bucket) error {
+	if len(bucket.Spec.ForProvider.AnalyticsConfigurations) != 0 {
+		return nil
+	}
+	observed, err := in.observe(ctx, bucket)
+	if err != nil {
+		return err
+	}
+	_, ids := configurationsDiff(nil, observed)
+	for _, id := range ids {
+		bucket.Spec.ForProvider.AnalyticsConfigurations = append(bucket.Spec.ForProvider.AnalyticsConfigurations,
+			GenerateLocalAnalyticsConfiguration(observed[id].(types.AnalyticsConfiguration)))
+	}
+	return nil
+}
+
+// SubresourceExists checks if the subresource this controller manages currently exists
+func (in *AnalyticsConfigurationClient) SubresourceExists(bucket *v1beta1.Bucket) bool {
+	return len(bucket.Spec.ForProvider.AnalyticsConfigurations) != 0
+}
+
+func (in *AnalyticsConfigurationClient) observe(ctx context.Context, bucket *v1beta1.Bucket) (map[string]interface{}, error) {
+	observed := map[string]interface{}{}
+	input := &awss3.ListBucketAnalyticsConfigurationsInput{Bucket: awsclient.String(meta.GetExternalName(bucket))}
+	for {
+		out, err := in.client.ListBucketAnalyticsConfigurations(ctx, input)
+		if err != nil {
+			return nil, awsclient.Wrap(err, analyticsListFailed)
+		}
+		for _, c := range out.AnalyticsConfigurationList {
+			observed[awsclient.StringValue(c.Id)] = normalizeAnalyticsConfiguration(c)
+		}
+		if !out.IsTruncated {
+			return observed, nil
+		}
+		input.ContinuationToken = out.NextContinuationToken
+	}
+}
+
+func (in *AnalyticsConfigurationClient) delete(ctx context.Context, bucket *v1beta1.Bucket, ids []string) error {
+	for _, id := range ids {
+		if _, err := in.client.DeleteBucketAnalyticsConfiguration(ctx, &awss3.DeleteBucketAnalyticsConfigurationInput{
+			Bucket: awsclient.String(meta.GetExternalName(bucket)),
+			Id:     awsclient.String(id),
+		}); err != nil {
+			return awsclient.Wrap(err, analyticsDeleteFailed)
+		}
+	}
+	return nil
+}
+
+func desiredAnalyticsConfigurations(bucket *v1beta1.Bucket) map[string]interface{} {
+	desired := map[string]interface{}{}
+	for _, c := range bucket.Spec.ForProvider.AnalyticsConfigurations {
+		desired[c.ID] = normalizeAnalyticsConfiguration(GenerateAnalyticsConfiguration(c))
+	}
+	return desired
+}
+
+// normalizeAnalyticsConfiguration sorts the tags of the configuration, whose
+// order is irrelevant.
+func normalizeAnalyticsConfiguration(c types.AnalyticsConfiguration) types.AnalyticsConfiguration {
+	if and, ok := c.Filter.(*types.AnalyticsFilterMemberAnd); ok {
+		value := and.Value
+		value.Tags = s3.SortS3TagSet(value.Tags)
+		c.Filter = &types.AnalyticsFilterMemberAnd{Value: value}
+	}
+	return c
+}
+
+// GenerateAnalyticsConfiguration creates the AnalyticsConfiguration for the AWS SDK
+func GenerateAnalyticsConfiguration(local v1beta1.AnalyticsConfiguration) types.AnalyticsConfiguration {
+	c := types.AnalyticsConfiguration{
+		Id:                   awsclient.String(local.ID),
+		StorageClassAnalysis: &types.StorageClassAnalysis{},
+	}
+	if export := local.StorageClassAnalysis.DataExport; export != nil {
+		dest := export.Destination.S3BucketDestination
+		c.StorageClassAnalysis.DataExport = &types.StorageClassAnalysisDataExport{
+			OutputSchemaVersion: types.StorageClassAnalysisSchemaVersion(export.OutputSchemaVersion),
+			Destination: &types.AnalyticsExportDestination{
+				S3BucketDestination: &types.AnalyticsS3BucketDestination{
+					Bucket:          awsclient.String(dest.Bucket),
+					BucketAccountId: dest.BucketAccountID,
+					Format:          types.AnalyticsS3ExportFileFormat(dest.Format),
+					Prefix:          dest.Prefix,
+				},
+			},
+		}
+	}
+	if local.Filter == nil {
+		return c
+	}
+	switch {
+	case local.Filter.And != nil:
+		c.Filter = &types.AnalyticsFilterMemberAnd{Value: types.AnalyticsAndOperator{
+			Prefix: local.Filter.And.Prefix,
+			Tags:   s3.CopyTags(local.Filter.And.Tags),
+		}}
+	case local.Filter.Tag != nil:
+		c.Filter = &types.AnalyticsFilterMemberTag{Value: types.Tag{Key: awsclient.String(local.Filter.Tag.Key), Value: awsclient.String(local.Filter.Tag.Value)}}
+	case local.Filter.Prefix != nil:
+		c.Filter = &types.AnalyticsFilterMemberPrefix{Value: awsclient.StringValue(local.Filter.Prefix)}
+	}
+	return c
+}
+
+// GenerateLocalAnalyticsConfiguration creates the local AnalyticsConfiguration from the external one
+func GenerateLocalAnalyticsConfiguration(external types.AnalyticsConfiguration) v1beta1.AnalyticsConfiguration {
+	c := v1beta1.AnalyticsConfiguration{ID: awsclient.StringValue(external.Id)}
+	if external.StorageClassAnalysis != nil && external.StorageClassAnalysis.DataExport != nil {
+		export := external.StorageClassAnalysis.DataExport
+		c.StorageClassAnalysis.DataExport = &v1beta1.StorageClassAnalysisDataExport{
+			OutputSchemaVersion: string(export.OutputSchemaVersion),
+		}
+		if export.Destination != nil && export.Destination.S3BucketDestination != nil {
+			dest := export.Destination.S3BucketDestination
+			c.StorageClassAnalysis.DataExport.Destination.S3BucketDestination = v1beta1.AnalyticsS3BucketDestination{
+				Bucket:          awsclient.StringValue(dest.Bucket),
+				BucketAccountID: dest.BucketAccountId,
+				Format:          string(dest.Format),
+				Prefix:          dest.Prefix,
+			}
+		}
+	}
+	switch v := external.Filter.(type) {
+	case *types.AnalyticsFilterMemberAnd:
+		c.Filter = &v1beta1.AnalyticsFilter{And: &v1beta1.AnalyticsAndOperator{
+			Prefix: v.Value.Prefix,
+			Tags:   s3.CopyAWSTags(v.Value.Tags),
+		}}
+	case *types.AnalyticsFilterMemberTag:
+		c.Filter = &v1beta1.AnalyticsFilter{Tag: &v1beta1.Tag{Key: awsclient.StringValue(v.Value.Key), Value: awsclient.StringValue(v.Value.Value)}}
+	case *types.AnalyticsFilterMemberPrefix:
+		c.Filter = &v1beta1.AnalyticsFilter{Prefix: awsclient.String(v.Value)}
+	}
+	return c
+}
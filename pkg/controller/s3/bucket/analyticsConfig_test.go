@@ -0,0 +1,221 @@
+/*
+Copyright 2023 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package bucket
+
+import (
+	"context"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/service/s3"
+	s3types "github.com/aws/aws-sdk-go-v2/service/s3/types"
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/crossplane-contrib/provider-aws/apis/s3/v1beta1"
+	awsclient "github.com/crossplane-contrib/provider-aws/pkg/clients"
+	"github.com/crossplane-contrib/provider-aws/pkg/clients/s3/fake"
+	s3testing "github.com/crossplane-contrib/provider-aws/pkg/controller/s3/testing"
+)
+
+var _ SubresourceClient = &AnalyticsConfigurationClient{}
+
+var (
+	analyticsConfig = v1beta1.AnalyticsConfiguration{
+		ID:     "documents",
+		Filter: &v1beta1.AnalyticsFilter{Tag: &v1beta1.Tag{Key: "type", Value: "document"}},
+		StorageClassAnalysis: v1beta1.StorageClassAnalysis{
+			DataExport: &v1beta1.StorageClassAnalysisDataExport{
+				OutputSchemaVersion: "V_1",
+				Destination: v1beta1.AnalyticsExportDestination{
+					S3BucketDestination: v1beta1.AnalyticsS3BucketDestination{
+						Bucket: "arn:aws:s3:::analytics",
+						Format: "CSV",
+					},
+				},
+			},
+		},
+	}
+	analyticsConfigObserved = analyticsConfig
+	awsAnalyticsConfig      = s3types.AnalyticsConfiguration{
+		Id:     awsclient.String("documents"),
+		Filter: &s3types.AnalyticsFilterMemberTag{Value: s3types.Tag{Key: awsclient.String("type"), Value: awsclient.String("document")}},
+		StorageClassAnalysis: &s3types.StorageClassAnalysis{
+			DataExport: &s3types.StorageClassAnalysisDataExport{
+				OutputSchemaVersion: s3types.StorageClassAnalysisSchemaVersionV1,
+				Destination: &s3types.AnalyticsExportDestination{
+					S3BucketDestination: &s3types.AnalyticsS3BucketDestination{
+						Bucket: awsclient.String("arn:aws:s3:::analytics"),
+						Format: s3types.AnalyticsS3ExportFileFormatCsv,
+					},
+				},
+			},
+		},
+	}
+	awsAnalyticsConfigChanged = s3types.AnalyticsConfiguration{
+		Id:                   awsclient.String("documents"),
+		Filter:               &s3types.AnalyticsFilterMemberTag{Value: s3types.Tag{Key: awsclient.String("type"), Value: awsclient.String("document")}},
+		StorageClassAnalysis: &s3types.StorageClassAnalysis{},
+	}
+)
+
+func listAnalyticsConfigurations(configs ...s3types.AnalyticsConfiguration) func(ctx context.Context, input *s3.ListBucketAnalyticsConfigurationsInput, opts []func(*s3.Options)) (*s3.ListBucketAnalyticsConfigurationsOutput, error) {
+	return func(ctx context.Context, input *s3.ListBucketAnalyticsConfigurationsInput, opts []func(*s3.Options)) (*s3.ListBucketAnalyticsConfigurationsOutput, error) {
+		return &s3.ListBucketAnalyticsConfigurationsOutput{AnalyticsConfigurationList: configs}, nil
+	}
+}
+
+func TestAnalyticsConfigurationObserve(t *testing.T) {
+	type args struct {
+		cl *AnalyticsConfigurationClient
+		b  *v1beta1.Bucket
+	}
+
+	type want struct {
+		status ResourceStatus
+		err    error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"Error": {
+			args: args{
+				b: s3testing.Bucket(s3testing.WithAnalyticsConfigs(analyticsConfig)),
+				cl: NewAnalyticsConfigurationClient(fake.MockBucketClient{
+					MockListBucketAnalyticsConfigurations: func(ctx context.Context, input *s3.ListBucketAnalyticsConfigurationsInput, opts []func(*s3.Options)) (*s3.ListBucketAnalyticsConfigurationsOutput, error) {
+						return nil, errBoom
+					},
+				}),
+			},
+			want: want{
+				status: NeedsUpdate,
+				err:    awsclient.Wrap(errBoom, analyticsListFailed),
+			},
+		},
+		"NotFoundAndNotSet": {
+			args: args{
+				b:  s3testing.Bucket(),
+				cl: NewAnalyticsConfigurationClient(fake.MockBucketClient{MockListBucketAnalyticsConfigurations: listAnalyticsConfigurations()}),
+			},
+			want: want{
+				status: Updated,
+			},
+		},
+		"NeedsDeletion": {
+			args: args{
+				b:  s3testing.Bucket(),
+				cl: NewAnalyticsConfigurationClient(fake.MockBucketClient{MockListBucketAnalyticsConfigurations: listAnalyticsConfigurations(awsAnalyticsConfig)}),
+			},
+			want: want{
+				status: NeedsDeletion,
+			},
+		},
+		"NeedsCreation": {
+			args: args{
+				b:  s3testing.Bucket(s3testing.WithAnalyticsConfigs(analyticsConfig)),
+				cl: NewAnalyticsConfigurationClient(fake.MockBucketClient{MockListBucketAnalyticsConfigurations: listAnalyticsConfigurations()}),
+			},
+			want: want{
+				status: NeedsUpdate,
+			},
+		},
+		"NeedsUpdate": {
+			args: args{
+				b:  s3testing.Bucket(s3testing.WithAnalyticsConfigs(analyticsConfig)),
+				cl: NewAnalyticsConfigurationClient(fake.MockBucketClient{MockListBucketAnalyticsConfigurations: listAnalyticsConfigurations(awsAnalyticsConfigChanged)}),
+			},
+			want: want{
+				status: NeedsUpdate,
+			},
+		},
+		"NoUpdateNeeded": {
+			args: args{
+				b:  s3testing.Bucket(s3testing.WithAnalyticsConfigs(analyticsConfig)),
+				cl: NewAnalyticsConfigurationClient(fake.MockBucketClient{MockListBucketAnalyticsConfigurations: listAnalyticsConfigurations(awsAnalyticsConfig)}),
+			},
+			want: want{
+				status: Updated,
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			status, err := tc.args.cl.Observe(context.Background(), tc.args.b)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.status, status); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestAnalyticsConfigurationLateInit(t *testing.T) {
+	type args struct {
+		cl *AnalyticsConfigurationClient
+		b  *v1beta1.Bucket
+	}
+
+	type want struct {
+		err error
+		cr  *v1beta1.Bucket
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"Error": {
+			args: args{
+				b: s3testing.Bucket(),
+				cl: NewAnalyticsConfigurationClient(fake.MockBucketClient{
+					MockListBucketAnalyticsConfigurations: func(ctx context.Context, input *s3.ListBucketAnalyticsConfigurationsInput, opts []func(*s3.Options)) (*s3.ListBucketAnalyticsConfigurationsOutput, error) {
+						return nil, errBoom
+					},
+				}),
+			},
+			want: want{
+				err: awsclient.Wrap(errBoom, analyticsListFailed),
+				cr:  s3testing.Bucket(),
+			},
+		},
+		"SuccessfulLateInit": {
+			args: args{
+				b:  s3testing.Bucket(),
+				cl: NewAnalyticsConfigurationClient(fake.MockBucketClient{MockListBucketAnalyticsConfigurations: listAnalyticsConfigurations(awsAnalyticsConfig)}),
+			},
+			want: want{
+				cr: s3testing.Bucket(s3testing.WithAnalyticsConfigs(analyticsConfigObserved)),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			err := tc.args.cl.LateInitialize(context.Background(), tc.args.b)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.b, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
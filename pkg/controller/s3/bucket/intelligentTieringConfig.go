@@ -0,0 +1,212 @@
+/*
+Copyright 2023 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package bucket
+
+import (
+	"context"
+	"sort"
+
+	awss3 "github.com/aws/aws-sdk-go-v2/service/s3"
+	"github.com/aws/aws-sdk-go-v2/service/s3/types"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+
+	"github.com/crossplane-contrib/provider-aws/apis/s3/v1beta1"
+	awsclient "github.com/crossplane-contrib/provider-aws/pkg/clients"
+	"github.com/crossplane-contrib/provider-aws/pkg/clients/s3"
+)
+
+const (
+	intelligentTieringListFailed   = "cannot list Bucket intelligent tiering configurations"
+	intelligentTieringPutFailed    = "cannot put Bucket intelligent tiering configuration"
+	intelligentTieringDeleteFailed = "cannot delete Bucket intelligent tiering configuration"
+)
+
+// IntelligentTieringConfigurationClient is the client for API methods and reconciling the IntelligentTieringConfigurations
+type IntelligentTieringConfigurationClient struct {
+	client s3.BucketClient
+}
+
+// NewIntelligentTieringConfigurationClient creates the client for Intelligent Tiering Configurations
+func NewIntelligentTieringConfigurationClient(client s3.BucketClient) *IntelligentTieringConfigurationClient {
+	return &IntelligentTieringConfigurationClient{client: client}
+}
+
+// Observe checks if the resource exists and if it matches the local configuration
+func (in *IntelligentTieringConfigurationClient) Observe(ctx context.Context, bucket *v1beta1.Bucket) (ResourceStatus, error) {
+	observed, err := in.observe(ctx, bucket)
+	if err != nil {
+		return NeedsUpdate, err
+	}
+	return configurationsStatus(desiredIntelligentTieringConfigurations(bucket), observed), nil
+}
+
+// CreateOrUpdate sends a request to have resource created on AWS
+func (in *IntelligentTieringConfigurationClient) CreateOrUpdate(ctx context.Context, bucket *v1beta1.Bucket) error {
+	observed, err := in.observe(ctx, bucket)
+	if err != nil {
+		return err
+	}
+	desired := desiredIntelligentTieringConfigurations(bucket)
+	put, remove := configurationsDiff(desired, observed)
+	for _, id := range put {
+		config := desired[id].(types.IntelligentTieringConfiguration)
+		if _, err := in.client.PutBucketIntelligentTieringConfiguration(ctx, &awss3.PutBucketIntelligentTieringConfigurationInput{
+			Bucket:                          awsclient.String(meta.GetExternalName(bucket)),
+			Id:                              awsclient.String(id),
+			IntelligentTieringConfiguration: &config,
+		}); err != nil {
+			return awsclient.Wrap(err, intelligentTieringPutFailed)
+		}
+	}
+	return in.delete(ctx, bucket, remove)
+}
+
+// Delete creates the request to delete the resource on AWS or set it to the default value.
+func (in *IntelligentTieringConfigurationClient) Delete(ctx context.Context, bucket *v1beta1.Bucket) error {
+	observed, err := in.observe(ctx, bucket)
+	if err != nil {
+		return err
+	}
+	_, remove := configurationsDiff(nil, observed)
+	return in.delete(ctx, bucket, remove)
+}
+
+// LateInitialize is responsible for initializing the resource based on the external value
+func (in *IntelligentTieringConfigurationClient) LateInitialize(ctx context.Context, bucket *v1beta1.Bucket) error {
+	if len(bucket.Spec.ForProvider.IntelligentTieringConfigurations) != 0 {
+		return nil
+	}
+	observed, err := in.observe(ctx, bucket)
+	if err != nil {
+		return err
+	}
+	_, ids := configurationsDiff(nil, observed)
+	for _, id := range ids {
+		bucket.Spec.ForProvider.IntelligentTieringConfigurations = append(bucket.Spec.ForProvider.IntelligentTieringConfigurations,
+			GenerateLocalIntelligentTieringConfiguration(observed[id].(types.IntelligentTieringConfiguration)))
+	}
+	return nil
+}
+
+// SubresourceExists checks if the subresource this controller manages currently exists
+func (in *IntelligentTieringConfigurationClient) SubresourceExists(bucket *v1beta1.Bucket) bool {
+	return len(bucket.Spec.ForProvider.IntelligentTieringConfigurations) != 0
+}
+
+func (in *IntelligentTieringConfigurationClient) observe(ctx context.Context, bucket *v1beta1.Bucket) (map[string]interface{}, error) {
+	observed := map[string]interface{}{}
+	input := &awss3.ListBucketIntelligentTieringConfigurationsInput{Bucket: awsclient.String(meta.GetExternalName(bucket))}
+	for {
+		out, err := in.client.ListBucketIntelligentTieringConfigurations(ctx, input)
+		if err != nil {
+			return nil, awsclient.Wrap(err, intelligentTieringListFailed)
+		}
+		for _, c := range out.IntelligentTieringConfigurationList {
+			observed[awsclient.StringValue(c.Id)] = normalizeIntelligentTieringConfiguration(c)
+		}
+		if !out.IsTruncated {
+			return observed, nil
+		}
+		input.ContinuationToken = out.NextContinuationToken
+	}
+}
+
+func (in *IntelligentTieringConfigurationClient) delete(ctx context.Context, bucket *v1beta1.Bucket, ids []string) error {
+	for _, id := range ids {
+		if _, err := in.client.DeleteBucketIntelligentTieringConfiguration(ctx, &awss3.DeleteBucketIntelligentTieringConfigurationInput{
+			Bucket: awsclient.String(meta.GetExternalName(bucket)),
+			Id:     awsclient.String(id),
+		}); err != nil {
+			return awsclient.Wrap(err, intelligentTieringDeleteFailed)
+		}
+	}
+	return nil
+}
+
+func desiredIntelligentTieringConfigurations(bucket *v1beta1.Bucket) map[string]interface{} {
+	desired := map[string]interface{}{}
+	for _, c := range bucket.Spec.ForProvider.IntelligentTieringConfigurations {
+		desired[c.ID] = normalizeIntelligentTieringConfiguration(GenerateIntelligentTieringConfiguration(c))
+	}
+	return desired
+}
+
+// normalizeIntelligentTieringConfiguration sorts the tags and tierings of the
+// configuration, whose order is irrelevant, and drops an empty filter.
+func normalizeIntelligentTieringConfiguration(c types.IntelligentTieringConfiguration) types.IntelligentTieringConfiguration {
+	if c.Filter != nil && c.Filter.And == nil && c.Filter.Prefix == nil && c.Filter.Tag == nil {
+		c.Filter = nil
+	}
+	if c.Filter != nil && c.Filter.And != nil {
+		c.Filter.And.Tags = s3.SortS3TagSet(c.Filter.And.Tags)
+	}
+	tierings := make([]types.Tiering, len(c.Tierings))
+	copy(tierings, c.Tierings)
+	sort.SliceStable(tierings, func(i, j int) bool {
+		return tierings[i].AccessTier < tierings[j].AccessTier
+	})
+	c.Tierings = tierings
+	return c
+}
+
+// GenerateIntelligentTieringConfiguration creates the IntelligentTieringConfiguration for the AWS SDK
+func GenerateIntelligentTieringConfiguration(local v1beta1.IntelligentTieringConfiguration) types.IntelligentTieringConfiguration {
+	c := types.IntelligentTieringConfiguration{
+		Id:     awsclient.String(local.ID),
+		Status: types.IntelligentTieringStatus(local.Status),
+	}
+	for _, t := range local.Tierings {
+		c.Tierings = append(c.Tierings, types.Tiering{AccessTier: types.IntelligentTieringAccessTier(t.AccessTier), Days: t.Days})
+	}
+	if local.Filter != nil {
+		c.Filter = &types.IntelligentTieringFilter{Prefix: local.Filter.Prefix}
+		if local.Filter.Tag != nil {
+			c.Filter.Tag = &types.Tag{Key: awsclient.String(local.Filter.Tag.Key), Value: awsclient.String(local.Filter.Tag.Value)}
+		}
+		if local.Filter.And != nil {
+			c.Filter.And = &types.IntelligentTieringAndOperator{
+				Prefix: local.Filter.And.Prefix,
+				Tags:   s3.CopyTags(local.Filter.And.Tags),
+			}
+		}
+	}
+	return c
+}
+
+// GenerateLocalIntelligentTieringConfiguration creates the local IntelligentTieringConfiguration from the external one
+func GenerateLocalIntelligentTieringConfiguration(external types.IntelligentTieringConfiguration) v1beta1.IntelligentTieringConfiguration {
+	c := v1beta1.IntelligentTieringConfiguration{
+		ID:     awsclient.StringValue(external.Id),
+		Status: string(external.Status),
+	}
+	for _, t := range external.Tierings {
+		c.Tierings = append(c.Tierings, v1beta1.Tiering{AccessTier: string(t.AccessTier), Days: t.Days})
+	}
+	if external.Filter != nil {
+		c.Filter = &v1beta1.IntelligentTieringFilter{Prefix: external.Filter.Prefix}
+		if external.Filter.Tag != nil {
+			c.Filter.Tag = &v1beta1.Tag{Key: awsclient.StringValue(external.Filter.Tag.Key), Value: awsclient.StringValue(external.Filter.Tag.Value)}
+		}
+		if external.Filter.And != nil {
+			c.Filter.And = &v1beta1.IntelligentTieringAndOperator{
+				Prefix: external.Filter.And.Prefix,
+				Tags:   s3.CopyAWSTags(external.Filter.And.Tags),
+			}
+		}
+	}
+	return c
+}
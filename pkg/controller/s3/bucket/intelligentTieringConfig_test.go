@@ -0,0 +1,212 @@
+/*
+Copyright 2023 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package bucket
+
+import (
+	"context"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/service/s3"
+	s3types "github.com/aws/aws-sdk-go-v2/service/s3/types"
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/crossplane-contrib/provider-aws/apis/s3/v1beta1"
+	awsclient "github.com/crossplane-contrib/provider-aws/pkg/clients"
+	"github.com/crossplane-contrib/provider-aws/pkg/clients/s3/fake"
+	s3testing "github.com/crossplane-contrib/provider-aws/pkg/controller/s3/testing"
+)
+
+var _ SubresourceClient = &IntelligentTieringConfigurationClient{}
+
+var (
+	intelligentTieringConfig = v1beta1.IntelligentTieringConfiguration{
+		ID:     "archive",
+		Status: "Enabled",
+		Filter: &v1beta1.IntelligentTieringFilter{Prefix: awsclient.String("data/")},
+		Tierings: []v1beta1.Tiering{
+			{AccessTier: "ARCHIVE_ACCESS", Days: 90},
+			{AccessTier: "DEEP_ARCHIVE_ACCESS", Days: 180},
+		},
+	}
+	intelligentTieringConfigObserved = intelligentTieringConfig
+	awsIntelligentTieringConfig      = s3types.IntelligentTieringConfiguration{
+		Id:     awsclient.String("archive"),
+		Status: s3types.IntelligentTieringStatusEnabled,
+		Filter: &s3types.IntelligentTieringFilter{Prefix: awsclient.String("data/")},
+		Tierings: []s3types.Tiering{
+			{AccessTier: s3types.IntelligentTieringAccessTierDeepArchiveAccess, Days: 180},
+			{AccessTier: s3types.IntelligentTieringAccessTierArchiveAccess, Days: 90},
+		},
+	}
+	awsIntelligentTieringConfigChanged = s3types.IntelligentTieringConfiguration{
+		Id:     awsclient.String("archive"),
+		Status: s3types.IntelligentTieringStatusDisabled,
+		Filter: &s3types.IntelligentTieringFilter{Prefix: awsclient.String("data/")},
+		Tierings: []s3types.Tiering{
+			{AccessTier: s3types.IntelligentTieringAccessTierArchiveAccess, Days: 90},
+		},
+	}
+)
+
+func listIntelligentTieringConfigurations(configs ...s3types.IntelligentTieringConfiguration) func(ctx context.Context, input *s3.ListBucketIntelligentTieringConfigurationsInput, opts []func(*s3.Options)) (*s3.ListBucketIntelligentTieringConfigurationsOutput, error) {
+	return func(ctx context.Context, input *s3.ListBucketIntelligentTieringConfigurationsInput, opts []func(*s3.Options)) (*s3.ListBucketIntelligentTieringConfigurationsOutput, error) {
+		return &s3.ListBucketIntelligentTieringConfigurationsOutput{IntelligentTieringConfigurationList: configs}, nil
+	}
+}
+
+func TestIntelligentTieringConfigurationObserve(t *testing.T) {
+	type args struct {
+		cl *IntelligentTieringConfigurationClient
+		b  *v1beta1.Bucket
+	}
+
+	type want struct {
+		status ResourceStatus
+		err    error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"Error": {
+			args: args{
+				b: s3testing.Bucket(s3testing.WithIntelligentTieringConfigs(intelligentTieringConfig)),
+				cl: NewIntelligentTieringConfigurationClient(fake.MockBucketClient{
+					MockListBucketIntelligentTieringConfigurations: func(ctx context.Context, input *s3.ListBucketIntelligentTieringConfigurationsInput, opts []func(*s3.Options)) (*s3.ListBucketIntelligentTieringConfigurationsOutput, error) {
+						return nil, errBoom
+					},
+				}),
+			},
+			want: want{
+				status: NeedsUpdate,
+				err:    awsclient.Wrap(errBoom, intelligentTieringListFailed),
+			},
+		},
+		"NotFoundAndNotSet": {
+			args: args{
+				b:  s3testing.Bucket(),
+				cl: NewIntelligentTieringConfigurationClient(fake.MockBucketClient{MockListBucketIntelligentTieringConfigurations: listIntelligentTieringConfigurations()}),
+			},
+			want: want{
+				status: Updated,
+			},
+		},
+		"NeedsDeletion": {
+			args: args{
+				b:  s3testing.Bucket(),
+				cl: NewIntelligentTieringConfigurationClient(fake.MockBucketClient{MockListBucketIntelligentTieringConfigurations: listIntelligentTieringConfigurations(awsIntelligentTieringConfig)}),
+			},
+			want: want{
+				status: NeedsDeletion,
+			},
+		},
+		"NeedsCreation": {
+			args: args{
+				b:  s3testing.Bucket(s3testing.WithIntelligentTieringConfigs(intelligentTieringConfig)),
+				cl: NewIntelligentTieringConfigurationClient(fake.MockBucketClient{MockListBucketIntelligentTieringConfigurations: listIntelligentTieringConfigurations()}),
+			},
+			want: want{
+				status: NeedsUpdate,
+			},
+		},
+		"NeedsUpdate": {
+			args: args{
+				b:  s3testing.Bucket(s3testing.WithIntelligentTieringConfigs(intelligentTieringConfig)),
+				cl: NewIntelligentTieringConfigurationClient(fake.MockBucketClient{MockListBucketIntelligentTieringConfigurations: listIntelligentTieringConfigurations(awsIntelligentTieringConfigChanged)}),
+			},
+			want: want{
+				status: NeedsUpdate,
+			},
+		},
+		"NoUpdateNeeded": {
+			args: args{
+				b:  s3testing.Bucket(s3testing.WithIntelligentTieringConfigs(intelligentTieringConfig)),
+				cl: NewIntelligentTieringConfigurationClient(fake.MockBucketClient{MockListBucketIntelligentTieringConfigurations: listIntelligentTieringConfigurations(awsIntelligentTieringConfig)}),
+			},
+			want: want{
+				status: Updated,
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			status, err := tc.args.cl.Observe(context.Background(), tc.args.b)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.status, status); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestIntelligentTieringConfigurationLateInit(t *testing.T) {
+	type args struct {
+		cl *IntelligentTieringConfigurationClient
+		b  *v1beta1.Bucket
+	}
+
+	type want struct {
+		err error
+		cr  *v1beta1.Bucket
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"Error": {
+			args: args{
+				b: s3testing.Bucket(),
+				cl: NewIntelligentTieringConfigurationClient(fake.MockBucketClient{
+					MockListBucketIntelligentTieringConfigurations: func(ctx context.Context, input *s3.ListBucketIntelligentTieringConfigurationsInput, opts []func(*s3.Options)) (*s3.ListBucketIntelligentTieringConfigurationsOutput, error) {
+						return nil, errBoom
+					},
+				}),
+			},
+			want: want{
+				err: awsclient.Wrap(errBoom, intelligentTieringListFailed),
+				cr:  s3testing.Bucket(),
+			},
+		},
+		"SuccessfulLateInit": {
+			args: args{
+				b:  s3testing.Bucket(),
+				cl: NewIntelligentTieringConfigurationClient(fake.MockBucketClient{MockListBucketIntelligentTieringConfigurations: listIntelligentTieringConfigurations(awsIntelligentTieringConfig)}),
+			},
+			want: want{
+				cr: s3testing.Bucket(s3testing.WithIntelligentTieringConfigs(intelligentTieringConfigObserved)),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			err := tc.args.cl.LateInitialize(context.Background(), tc.args.b)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.b, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,225 @@
+/*
+Copyright 2023 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package bucket
+
+import (
+	"context"
+	"sort"
+
+	awss3 "github.com/aws/aws-sdk-go-v2/service/s3"
+	"github.com/aws/aws-sdk-go-v2/service/s3/types"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+
+	"github.com/crossplane-contrib/provider-aws/apis/s3/v1beta1"
+	awsclient "github.com/crossplane-contrib/provider-aws/pkg/clients"
+	"github.com/crossplane-contrib/provider-aws/pkg/clients/s3"
+)
+
+const (
+	inventoryListFailed   = "cannot list Bucket inventory configurations"
+	inventoryPutFailed    = "cannot put Bucket inventory configuration"
+	inventoryDeleteFailed = "cannot delete Bucket inventory configuration"
+)
+
+// InventoryConfigurationClient is the client for API methods and reconciling the InventoryConfigurations
+type InventoryConfigurationClient struct {
+	client s3.BucketClient
+}
+
+// NewInventoryConfigurationClient creates the client for Inventory Configurations
+func NewInventoryConfigurationClient(client s3.BucketClient) *InventoryConfigurationClient {
+	return &InventoryConfigurationClient{client: client}
+}
+
+// Observe checks if the resource exists and if it matches the local configuration
+func (in *InventoryConfigurationClient) Observe(ctx context.Context, bucket *v1beta1.Bucket) (ResourceStatus, error) {
+	observed, err := in.observe(ctx, bucket)
+	if err != nil {
+		return NeedsUpdate, err
+	}
+	return configurationsStatus(desiredInventoryConfigurations(bucket), observed), nil
+}
+
+// CreateOrUpdate sends a request to have resource created on AWS
+func (in *InventoryConfigurationClient) CreateOrUpdate(ctx context.Context, bucket *v1beta1.Bucket) error {
+	observed, err := in.observe(ctx, bucket)
+	if err != nil {
+		return err
+	}
+	desired := desiredInventoryConfigurations(bucket)
+	put, remove := configurationsDiff(desired, observed)
+	for _, id := range put {
+		config := desired[id].(types.InventoryConfiguration)
+		if _, err := in.client.PutBucketInventoryConfiguration(ctx, &awss3.PutBucketInventoryConfigurationInput{
+			Bucket:                 awsclient.String(meta.GetExternalName(bucket)),
+			Id:                     awsclient.String(id),
+			InventoryConfiguration: &config,
+		}); err != nil {
+			return awsclient.Wrap(err, inventoryPutFailed)
+		}
+	}
+	return in.delete(ctx, bucket, remove)
+}
+
+// Delete creates the request to delete the resource on AWS or set it to the default value.
+func (in *InventoryConfigurationClient) Delete(ctx context.Context, bucket *v1beta1.Bucket) error {
+	observed, err := in.observe(ctx, bucket)
+	if err != nil {
+		return err
+	}
+	_, remove := configurationsDiff(nil, observed)
+	return in.delete(ctx, bucket, remove)
+}
+
+// LateInitialize is responsible for initializing the resource based on the external value
+func (in *InventoryConfigurationClient) LateInitialize(ctx context.Context, bucket *v1beta1.Bucket) error {
+	if len(bucket.Spec.ForProvider.InventoryConfigurations) != 0 {
+		return nil
+	}
+	observed, err := in.observe(ctx, bucket)
+	if err != nil {
+		return err
+	}
+	_, ids := configurationsDiff(nil, observed)
+	for _, id := range ids {
+		bucket.Spec.ForProvider.InventoryConfigurations = append(bucket.Spec.ForProvider.InventoryConfigurations,
+			GenerateLocalInventoryConfiguration(observed[id].(types.InventoryConfiguration)))
+	}
+	return nil
+}
+
+// SubresourceExists checks if the subresource this controller manages currently exists
+func (in *InventoryConfigurationClient) SubresourceExists(bucket *v1beta1.Bucket) bool {
+	return len(bucket.Spec.ForProvider.InventoryConfigurations) != 0
+}
+
+func (in *InventoryConfigurationClient) observe(ctx context.Context, bucket *v1beta1.Bucket) (map[string]interface{}, error) {
+	observed := map[string]interface{}{}
+	input := &awss3.ListBucketInventoryConfigurationsInput{Bucket: awsclient.String(meta.GetExternalName(bucket))}
+	for {
+		out, err := in.client.ListBucketInventoryConfigurations(ctx, input)
+		if err != nil {
+			return nil, awsclient.Wrap(err, inventoryListFailed)
+		}
+		for _, c := range out.InventoryConfigurationList {
+			observed[awsclient.StringValue(c.Id)] = normalizeInventoryConfiguration(c)
+		}
+		if !out.IsTruncated {
+			return observed, nil
+		}
+		input.ContinuationToken = out.NextContinuationToken
+	}
+}
+
+func (in *InventoryConfigurationClient) delete(ctx context.Context, bucket *v1beta1.Bucket, ids []string) error {
+	for _, id := range ids {
+		if _, err := in.client.DeleteBucketInventoryConfiguration(ctx, &awss3.DeleteBucketInventoryConfigurationInput{
+			Bucket: awsclient.String(meta.GetExternalName(bucket)),
+			Id:     awsclient.String(id),
+		}); err != nil {
+			return awsclient.Wrap(err, inventoryDeleteFailed)
+		}
+	}
+	return nil
+}
+
+func desiredInventoryConfigurations(bucket *v1beta1.Bucket) map[string]interface{} {
+	desired := map[string]interface{}{}
+	for _, c := range bucket.Spec.ForProvider.InventoryConfigurations {
+		desired[c.ID] = normalizeInventoryConfiguration(GenerateInventoryConfiguration(c))
+	}
+	return desired
+}
+
+// normalizeInventoryConfiguration sorts the optional fields of the
+// configuration, whose order is irrelevant.
+func normalizeInventoryConfiguration(c types.InventoryConfiguration) types.InventoryConfiguration {
+	fields := make([]types.InventoryOptionalField, len(c.OptionalFields))
+	copy(fields, c.OptionalFields)
+	sort.SliceStable(fields, func(i, j int) bool {
+		return fields[i] < fields[j]
+	})
+	c.OptionalFields = fields
+	return c
+}
+
+// GenerateInventoryConfiguration creates the InventoryConfiguration for the AWS SDK
+func GenerateInventoryConfiguration(local v1beta1.InventoryConfiguration) types.InventoryConfiguration {
+	dest := local.Destination.S3BucketDestination
+	c := types.InventoryConfiguration{
+		Id:                     awsclient.String(local.ID),
+		IsEnabled:              local.IsEnabled,
+		IncludedObjectVersions: types.InventoryIncludedObjectVersions(local.IncludedObjectVersions),
+		Schedule:               &types.InventorySchedule{Frequency: types.InventoryFrequency(local.ScheduleFrequency)},
+		Destination: &types.InventoryDestination{
+			S3BucketDestination: &types.InventoryS3BucketDestination{
+				AccountId: dest.AccountID,
+				Bucket:    awsclient.String(dest.Bucket),
+				Format:    types.InventoryFormat(dest.Format),
+				Prefix:    dest.Prefix,
+			},
+		},
+	}
+	if dest.Encryption != nil {
+		if dest.Encryption.SSEKMSKeyID != nil {
+			c.Destination.S3BucketDestination.Encryption = &types.InventoryEncryption{SSEKMS: &types.SSEKMS{KeyId: dest.Encryption.SSEKMSKeyID}}
+		} else {
+			c.Destination.S3BucketDestination.Encryption = &types.InventoryEncryption{SSES3: &types.SSES3{}}
+		}
+	}
+	if local.Filter != nil {
+		c.Filter = &types.InventoryFilter{Prefix: awsclient.String(local.Filter.Prefix)}
+	}
+	for _, f := range local.OptionalFields {
+		c.OptionalFields = append(c.OptionalFields, types.InventoryOptionalField(f))
+	}
+	return c
+}
+
+// GenerateLocalInventoryConfiguration creates the local InventoryConfiguration from the external one
+func GenerateLocalInventoryConfiguration(external types.InventoryConfiguration) v1beta1.InventoryConfiguration {
+	c := v1beta1.InventoryConfiguration{
+		ID:                     awsclient.StringValue(external.Id),
+		IsEnabled:              external.IsEnabled,
+		IncludedObjectVersions: string(external.IncludedObjectVersions),
+	}
+	if external.Schedule != nil {
+		c.ScheduleFrequency = string(external.Schedule.Frequency)
+	}
+	if external.Destination != nil && external.Destination.S3BucketDestination != nil {
+		dest := external.Destination.S3BucketDestination
+		c.Destination.S3BucketDestination = v1beta1.InventoryS3BucketDestination{
+			AccountID: dest.AccountId,
+			Bucket:    awsclient.StringValue(dest.Bucket),
+			Format:    string(dest.Format),
+			Prefix:    dest.Prefix,
+		}
+		if dest.Encryption != nil {
+			c.Destination.S3BucketDestination.Encryption = &v1beta1.InventoryEncryption{}
+			if dest.Encryption.SSEKMS != nil {
+				c.Destination.S3BucketDestination.Encryption.SSEKMSKeyID = dest.Encryption.SSEKMS.KeyId
+			}
+		}
+	}
+	if external.Filter != nil {
+		c.Filter = &v1beta1.InventoryFilter{Prefix: awsclient.StringValue(external.Filter.Prefix)}
+	}
+	for _, f := range external.OptionalFields {
+		c.OptionalFields = append(c.OptionalFields, string(f))
+	}
+	return c
+}
@@ -0,0 +1,236 @@
+/*
+Copyright 2023 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package bucket
+
+import (
+	"context"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/service/s3"
+	s3types "github.com/aws/aws-sdk-go-v2/service/s3/types"
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/crossplane-contrib/provider-aws/apis/s3/v1beta1"
+	awsclient "github.com/crossplane-contrib/provider-aws/pkg/clients"
+	"github.com/crossplane-contrib/provider-aws/pkg/clients/s3/fake"
+	s3testing "github.com/crossplane-contrib/provider-aws/pkg/controller/s3/testing"
+)
+
+var _ SubresourceClient = &InventoryConfigurationClient{}
+
+var (
+	inventoryConfig = v1beta1.InventoryConfiguration{
+		ID:        "weekly",
+		IsEnabled: true,
+		Destination: v1beta1.InventoryDestination{
+			S3BucketDestination: v1beta1.InventoryS3BucketDestination{
+				Bucket: "arn:aws:s3:::inventory",
+				Format: "CSV",
+			},
+		},
+		IncludedObjectVersions: "Current",
+		OptionalFields:         []string{"Size", "ETag"},
+		ScheduleFrequency:      "Weekly",
+	}
+	inventoryConfigObserved = v1beta1.InventoryConfiguration{
+		ID:        "weekly",
+		IsEnabled: true,
+		Destination: v1beta1.InventoryDestination{
+			S3BucketDestination: v1beta1.InventoryS3BucketDestination{
+				Bucket: "arn:aws:s3:::inventory",
+				Format: "CSV",
+			},
+		},
+		IncludedObjectVersions: "Current",
+		OptionalFields:         []string{"ETag", "Size"},
+		ScheduleFrequency:      "Weekly",
+	}
+	awsInventoryConfig = s3types.InventoryConfiguration{
+		Id:        awsclient.String("weekly"),
+		IsEnabled: true,
+		Destination: &s3types.InventoryDestination{
+			S3BucketDestination: &s3types.InventoryS3BucketDestination{
+				Bucket: awsclient.String("arn:aws:s3:::inventory"),
+				Format: s3types.InventoryFormatCsv,
+			},
+		},
+		IncludedObjectVersions: s3types.InventoryIncludedObjectVersionsCurrent,
+		OptionalFields:         []s3types.InventoryOptionalField{s3types.InventoryOptionalFieldETag, s3types.InventoryOptionalFieldSize},
+		Schedule:               &s3types.InventorySchedule{Frequency: s3types.InventoryFrequencyWeekly},
+	}
+	awsInventoryConfigChanged = s3types.InventoryConfiguration{
+		Id:        awsclient.String("weekly"),
+		IsEnabled: true,
+		Destination: &s3types.InventoryDestination{
+			S3BucketDestination: &s3types.InventoryS3BucketDestination{
+				Bucket: awsclient.String("arn:aws:s3:::inventory"),
+				Format: s3types.InventoryFormatCsv,
+			},
+		},
+		IncludedObjectVersions: s3types.InventoryIncludedObjectVersionsCurrent,
+		Schedule:               &s3types.InventorySchedule{Frequency: s3types.InventoryFrequencyDaily},
+	}
+)
+
+func listInventoryConfigurations(configs ...s3types.InventoryConfiguration) func(ctx context.Context, input *s3.ListBucketInventoryConfigurationsInput, opts []func(*s3.Options)) (*s3.ListBucketInventoryConfigurationsOutput, error) {
+	return func(ctx context.Context, input *s3.ListBucketInventoryConfigurationsInput, opts []func(*s3.Options)) (*s3.ListBucketInventoryConfigurationsOutput, error) {
+		return &s3.ListBucketInventoryConfigurationsOutput{InventoryConfigurationList: configs}, nil
+	}
+}
+
+func TestInventoryConfigurationObserve(t *testing.T) {
+	type args struct {
+		cl *InventoryConfigurationClient
+		b  *v1beta1.Bucket
+	}
+
+	type want struct {
+		status ResourceStatus
+		err    error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"Error": {
+			args: args{
+				b: s3testing.Bucket(s3testing.WithInventoryConfigs(inventoryConfig)),
+				cl: NewInventoryConfigurationClient(fake.MockBucketClient{
+					MockListBucketInventoryConfigurations: func(ctx context.Context, input *s3.ListBucketInventoryConfigurationsInput, opts []func(*s3.Options)) (*s3.ListBucketInventoryConfigurationsOutput, error) {
+						return nil, errBoom
+					},
+				}),
+			},
+			want: want{
+				status: NeedsUpdate,
+				err:    awsclient.Wrap(errBoom, inventoryListFailed),
+			},
+		},
+		"NotFoundAndNotSet": {
+			args: args{
+				b:  s3testing.Bucket(),
+				cl: NewInventoryConfigurationClient(fake.MockBucketClient{MockListBucketInventoryConfigurations: listInventoryConfigurations()}),
+			},
+			want: want{
+				status: Updated,
+			},
+		},
+		"NeedsDeletion": {
+			args: args{
+				b:  s3testing.Bucket(),
+				cl: NewInventoryConfigurationClient(fake.MockBucketClient{MockListBucketInventoryConfigurations: listInventoryConfigurations(awsInventoryConfig)}),
+			},
+			want: want{
+				status: NeedsDeletion,
+			},
+		},
+		"NeedsCreation": {
+			args: args{
+				b:  s3testing.Bucket(s3testing.WithInventoryConfigs(inventoryConfig)),
+				cl: NewInventoryConfigurationClient(fake.MockBucketClient{MockListBucketInventoryConfigurations: listInventoryConfigurations()}),
+			},
+			want: want{
+				status: NeedsUpdate,
+			},
+		},
+		"NeedsUpdate": {
+			args: args{
+				b:  s3testing.Bucket(s3testing.WithInventoryConfigs(inventoryConfig)),
+				cl: NewInventoryConfigurationClient(fake.MockBucketClient{MockListBucketInventoryConfigurations: listInventoryConfigurations(awsInventoryConfigChanged)}),
+			},
+			want: want{
+				status: NeedsUpdate,
+			},
+		},
+		"NoUpdateNeeded": {
+			args: args{
+				b:  s3testing.Bucket(s3testing.WithInventoryConfigs(inventoryConfig)),
+				cl: NewInventoryConfigurationClient(fake.MockBucketClient{MockListBucketInventoryConfigurations: listInventoryConfigurations(awsInventoryConfig)}),
+			},
+			want: want{
+				status: Updated,
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			status, err := tc.args.cl.Observe(context.Background(), tc.args.b)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.status, status); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestInventoryConfigurationLateInit(t *testing.T) {
+	type args struct {
+		cl *InventoryConfigurationClient
+		b  *v1beta1.Bucket
+	}
+
+	type want struct {
+		err error
+		cr  *v1beta1.Bucket
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"Error": {
+			args: args{
+				b: s3testing.Bucket(),
+				cl: NewInventoryConfigurationClient(fake.MockBucketClient{
+					MockListBucketInventoryConfigurations: func(ctx context.Context, input *s3.ListBucketInventoryConfigurationsInput, opts []func(*s3.Options)) (*s3.ListBucketInventoryConfigurationsOutput, error) {
+						return nil, errBoom
+					},
+				}),
+			},
+			want: want{
+				err: awsclient.Wrap(errBoom, inventoryListFailed),
+				cr:  s3testing.Bucket(),
+			},
+		},
+		"SuccessfulLateInit": {
+			args: args{
+				b:  s3testing.Bucket(),
+				cl: NewInventoryConfigurationClient(fake.MockBucketClient{MockListBucketInventoryConfigurations: listInventoryConfigurations(awsInventoryConfig)}),
+			},
+			want: want{
+				cr: s3testing.Bucket(s3testing.WithInventoryConfigs(inventoryConfigObserved)),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			err := tc.args.cl.LateInitialize(context.Background(), tc.args.b)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.b, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,199 @@
+/*
+Copyright 2023 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package bucket
+
+import (
+	"context"
+
+	awss3 "github.com/aws/aws-sdk-go-v2/service/s3"
+	"github.com/aws/aws-sdk-go-v2/service/s3/types"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+
+	"github.com/crossplane-contrib/provider-aws/apis/s3/v1beta1"
+	awsclient "github.com/crossplane-contrib/provider-aws/pkg/clients"
+	"github.com/crossplane-contrib/provider-aws/pkg/clients/s3"
+)
+
+const (
+	metricsListFailed   = "cannot list Bucket metrics configurations"
+	metricsPutFailed    = "cannot put Bucket metrics configuration"
+	metricsDeleteFailed = "cannot delete Bucket metrics configuration"
+)
+
+// MetricsConfigurationClient is the client for API methods and reconciling the MetricsConfigurations
+type MetricsConfigurationClient struct {
+	client s3.BucketClient
+}
+
+// NewMetricsConfigurationClient creates the client for Metrics Configurations
+func NewMetricsConfigurationClient(client s3.BucketClient) *MetricsConfigurationClient {
+	return &MetricsConfigurationClient{client: client}
+}
+
+// Observe checks if the resource exists and if it matches the local configuration
+func (in *MetricsConfigurationClient) Observe(ctx context.Context, bucket *v1beta1.Bucket) (ResourceStatus, error) {
+	observed, err := in.observe(ctx, bucket)
+	if err != nil {
+		return NeedsUpdate, err
+	}
+	return configurationsStatus(desiredMetricsConfigurations(bucket), observed), nil
+}
+
+// CreateOrUpdate sends a request to have resource created on AWS
+func (in *MetricsConfigurationClient) CreateOrUpdate(ctx context.Context, bucket *v1beta1.Bucket) error {
+	observed, err := in.observe(ctx, bucket)
+	if err != nil {
+		return err
+	}
+	desired := desiredMetricsConfigurations(bucket)
+	put, remove := configurationsDiff(desired, observed)
+	for _, id := range put {
+		config := desired[id].(types.MetricsConfiguration)
+		if _, err := in.client.PutBucketMetricsConfiguration(ctx, &awss3.PutBucketMetricsConfigurationInput{
+			Bucket:               awsclient.String(meta.GetExternalName(bucket)),
+			Id:                   awsclient.String(id),
+			MetricsConfiguration: &config,
+		}); err != nil {
+			return awsclient.Wrap(err, metricsPutFailed)
+		}
+	}
+	return in.delete(ctx, bucket, remove)
+}
+
+// Delete creates the request to delete the resource on AWS or set it to the default value.
+func (in *MetricsConfigurationClient) Delete(ctx context.Context, bucket *v1beta1.Bucket) error {
+	observed, err := in.observe(ctx, bucket)
+	if err != nil {
+		return err
+	}
+	_, remove := configurationsDiff(nil, observed)
+	return in.delete(ctx, bucket, remove)
+}
+
+// LateInitialize is responsible for initializing the resource based on the external value
+func (in *MetricsConfigurationClient) LateInitialize(ctx context.Context, bucket *v1beta1.Bucket) error {
+	if len(bucket.Spec.ForProvider.MetricsConfigurations) != 0 {
+		return nil
+	}
+	observed, err := in.observe(ctx, bucket)
+	if err != nil {
+		return err
+	}
+	_, ids := configurationsDiff(nil, observed)
+	for _, id := range ids {
+		bucket.Spec.ForProvider.MetricsConfigurations = append(bucket.Spec.ForProvider.MetricsConfigurations,
+			GenerateLocalMetricsConfiguration(observed[id].(types.MetricsConfiguration)))
+	}
+	return nil
+}
+
+// SubresourceExists checks if the subresource this controller manages currently exists
+func (in *MetricsConfigurationClient) SubresourceExists(bucket *v1beta1.Bucket) bool {
+	return len(bucket.Spec.ForProvider.MetricsConfigurations) != 0
+}
+
+func (in *MetricsConfigurationClient) observe(ctx context.Context, bucket *v1beta1.Bucket) (map[string]interface{}, error) {
+	observed := map[string]interface{}{}
+	input := &awss3.ListBucketMetricsConfigurationsInput{Bucket: awsclient.String(meta.GetExternalName(bucket))}
+	for {
+		out, err := in.client.ListBucketMetricsConfigurations(ctx, input)
+		if err != nil {
+			return nil, awsclient.Wrap(err, metricsListFailed)
+		}
+		for _, c := range out.MetricsConfigurationList {
+			observed[awsclient.StringValue(c.Id)] = normalizeMetricsConfiguration(c)
+		}
+		if !out.IsTruncated {
+			return observed, nil
+		}
+		input.ContinuationToken = out.NextContinuationToken
+	}
+}
+
+func (in *MetricsConfigurationClient) delete(ctx context.Context, bucket *v1beta1.Bucket, ids []string) error {
+	for _, id := range ids {
+		if _, err := in.client.DeleteBucketMetricsConfiguration(ctx, &awss3.DeleteBucketMetricsConfigurationInput{
+			Bucket: awsclient.String(meta.GetExternalName(bucket)),
+			Id:     awsclient.String(id),
+		}); err != nil {
+			return awsclient.Wrap(err, metricsDeleteFailed)
+		}
+	}
+	return nil
+}
+
+func desiredMetricsConfigurations(bucket *v1beta1.Bucket) map[string]interface{} {
+	desired := map[string]interface{}{}
+	for _, c := range bucket.Spec.ForProvider.MetricsConfigurations {
+		desired[c.ID] = normalizeMetricsConfiguration(GenerateMetricsConfiguration(c))
+	}
+	return desired
+}
+
+// normalizeMetricsConfiguration sorts the tags of the configuration, whose
+// order is irrelevant.
+func normalizeMetricsConfiguration(c types.MetricsConfiguration) types.MetricsConfiguration {
+	if and, ok := c.Filter.(*types.MetricsFilterMemberAnd); ok {
+		value := and.Value
+		value.Tags = s3.SortS3TagSet(value.Tags)
+		c.Filter = &types.MetricsFilterMemberAnd{Value: value}
+	}
+	return c
+}
+
+// GenerateMetricsConfiguration creates the MetricsConfiguration for the AWS SDK
+func GenerateMetricsConfiguration(local v1beta1.MetricsConfiguration) types.MetricsConfiguration {
+	c := types.MetricsConfiguration{Id: awsclient.String(local.ID)}
+	if local.Filter == nil {
+		return c
+	}
+	switch {
+	case local.Filter.And != nil:
+		c.Filter = &types.MetricsFilterMemberAnd{Value: types.MetricsAndOperator{
+			AccessPointArn: local.Filter.And.AccessPointARN,
+			Prefix:         local.Filter.And.Prefix,
+			Tags:           s3.CopyTags(local.Filter.And.Tags),
+		}}
+	case local.Filter.Tag != nil:
+		c.Filter = &types.MetricsFilterMemberTag{Value: types.Tag{Key: awsclient.String(local.Filter.Tag.Key), Value: awsclient.String(local.Filter.Tag.Value)}}
+	case local.Filter.AccessPointARN != nil:
+		c.Filter = &types.MetricsFilterMemberAccessPointArn{Value: awsclient.StringValue(local.Filter.AccessPointARN)}
+	case local.Filter.Prefix != nil:
+		c.Filter = &types.MetricsFilterMemberPrefix{Value: awsclient.StringValue(local.Filter.Prefix)}
+	}
+	return c
+}
+
+// GenerateLocalMetricsConfiguration creates the local MetricsConfiguration from the external one
+func GenerateLocalMetricsConfiguration(external types.MetricsConfiguration) v1beta1.MetricsConfiguration {
+	c := v1beta1.MetricsConfiguration{ID: awsclient.StringValue(external.Id)}
+	switch v := external.Filter.(type) {
+	case *types.MetricsFilterMemberAnd:
+		c.Filter = &v1beta1.MetricsFilter{And: &v1beta1.MetricsAndOperator{
+			AccessPointARN: v.Value.AccessPointArn,
+			Prefix:         v.Value.Prefix,
+			Tags:           s3.CopyAWSTags(v.Value.Tags),
+		}}
+	case *types.MetricsFilterMemberTag:
+		c.Filter = &v1beta1.MetricsFilter{Tag: &v1beta1.Tag{Key: awsclient.StringValue(v.Value.Key), Value: awsclient.StringValue(v.Value.Value)}}
+	case *types.MetricsFilterMemberAccessPointArn:
+		c.Filter = &v1beta1.MetricsFilter{AccessPointARN: awsclient.String(v.Value)}
+	case *types.MetricsFilterMemberPrefix:
+		c.Filter = &v1beta1.MetricsFilter{Prefix: awsclient.String(v.Value)}
+	}
+	return c
+}
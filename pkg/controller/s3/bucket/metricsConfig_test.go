@@ -0,0 +1,347 @@
+/*
+Copyright 2023 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package bucket
+
+import (
+	"context"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/service/s3"
+	s3types "github.com/aws/aws-sdk-go-v2/service/s3/types"
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/crossplane-contrib/provider-aws/apis/s3/v1beta1"
+	awsclient "github.com/crossplane-contrib/provider-aws/pkg/clients"
+	"github.com/crossplane-contrib/provider-aws/pkg/clients/s3/fake"
+	s3testing "github.com/crossplane-contrib/provider-aws/pkg/controller/s3/testing"
+)
+
+var _ SubresourceClient = &MetricsConfigurationClient{}
+
+var (
+	metricsConfig = v1beta1.MetricsConfiguration{
+		ID: "all",
+		Filter: &v1beta1.MetricsFilter{
+			And: &v1beta1.MetricsAndOperator{
+				Prefix: awsclient.String("logs/"),
+				Tags:   []v1beta1.Tag{{Key: "a", Value: "1"}, {Key: "b", Value: "2"}},
+			},
+		},
+	}
+	awsMetricsConfig = s3types.MetricsConfiguration{
+		Id: awsclient.String("all"),
+		Filter: &s3types.MetricsFilterMemberAnd{Value: s3types.MetricsAndOperator{
+			Prefix: awsclient.String("logs/"),
+			Tags: []s3types.Tag{
+				{Key: awsclient.String("b"), Value: awsclient.String("2")},
+				{Key: awsclient.String("a"), Value: awsclient.String("1")},
+			},
+		}},
+	}
+	awsOtherMetricsConfig = s3types.MetricsConfiguration{
+		Id:     awsclient.String("other"),
+		Filter: &s3types.MetricsFilterMemberPrefix{Value: "other/"},
+	}
+)
+
+func listMetricsConfigurations(configs ...s3types.MetricsConfiguration) func(ctx context.Context, input *s3.ListBucketMetricsConfigurationsInput, opts []func(*s3.Options)) (*s3.ListBucketMetricsConfigurationsOutput, error) {
+	return func(ctx context.Context, input *s3.ListBucketMetricsConfigurationsInput, opts []func(*s3.Options)) (*s3.ListBucketMetricsConfigurationsOutput, error) {
+		return &s3.ListBucketMetricsConfigurationsOutput{MetricsConfigurationList: configs}, nil
+	}
+}
+
+func TestMetricsConfigurationObserve(t *testing.T) {
+	type args struct {
+		cl *MetricsConfigurationClient
+		b  *v1beta1.Bucket
+	}
+
+	type want struct {
+		status ResourceStatus
+		err    error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"Error": {
+			args: args{
+				b: s3testing.Bucket(s3testing.WithMetricsConfigs(metricsConfig)),
+				cl: NewMetricsConfigurationClient(fake.MockBucketClient{
+					MockListBucketMetricsConfigurations: func(ctx context.Context, input *s3.ListBucketMetricsConfigurationsInput, opts []func(*s3.Options)) (*s3.ListBucketMetricsConfigurationsOutput, error) {
+						return nil, errBoom
+					},
+				}),
+			},
+			want: want{
+				status: NeedsUpdate,
+				err:    awsclient.Wrap(errBoom, metricsListFailed),
+			},
+		},
+		"NotFoundAndNotSet": {
+			args: args{
+				b:  s3testing.Bucket(),
+				cl: NewMetricsConfigurationClient(fake.MockBucketClient{MockListBucketMetricsConfigurations: listMetricsConfigurations()}),
+			},
+			want: want{
+				status: Updated,
+			},
+		},
+		"NeedsDeletion": {
+			args: args{
+				b:  s3testing.Bucket(),
+				cl: NewMetricsConfigurationClient(fake.MockBucketClient{MockListBucketMetricsConfigurations: listMetricsConfigurations(awsMetricsConfig)}),
+			},
+			want: want{
+				status: NeedsDeletion,
+			},
+		},
+		"NeedsCreation": {
+			args: args{
+				b:  s3testing.Bucket(s3testing.WithMetricsConfigs(metricsConfig)),
+				cl: NewMetricsConfigurationClient(fake.MockBucketClient{MockListBucketMetricsConfigurations: listMetricsConfigurations()}),
+			},
+			want: want{
+				status: NeedsUpdate,
+			},
+		},
+		"NeedsRemovalOfOther": {
+			args: args{
+				b:  s3testing.Bucket(s3testing.WithMetricsConfigs(metricsConfig)),
+				cl: NewMetricsConfigurationClient(fake.MockBucketClient{MockListBucketMetricsConfigurations: listMetricsConfigurations(awsMetricsConfig, awsOtherMetricsConfig)}),
+			},
+			want: want{
+				status: NeedsUpdate,
+			},
+		},
+		"NoUpdateNeededWithReorderedTags": {
+			args: args{
+				b:  s3testing.Bucket(s3testing.WithMetricsConfigs(metricsConfig)),
+				cl: NewMetricsConfigurationClient(fake.MockBucketClient{MockListBucketMetricsConfigurations: listMetricsConfigurations(awsMetricsConfig)}),
+			},
+			want: want{
+				status: Updated,
+			},
+		},
+		"NoUpdateNeededWithPagination": {
+			args: args{
+				b: s3testing.Bucket(s3testing.WithMetricsConfigs(metricsConfig)),
+				cl: NewMetricsConfigurationClient(fake.MockBucketClient{
+					MockListBucketMetricsConfigurations: func(ctx context.Context, input *s3.ListBucketMetricsConfigurationsInput, opts []func(*s3.Options)) (*s3.ListBucketMetricsConfigurationsOutput, error) {
+						if input.ContinuationToken == nil {
+							return &s3.ListBucketMetricsConfigurationsOutput{IsTruncated: true, NextContinuationToken: awsclient.String("next")}, nil
+						}
+						return &s3.ListBucketMetricsConfigurationsOutput{MetricsConfigurationList: []s3types.MetricsConfiguration{awsMetricsConfig}}, nil
+					},
+				}),
+			},
+			want: want{
+				status: Updated,
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			status, err := tc.args.cl.Observe(context.Background(), tc.args.b)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.status, status); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestMetricsConfigurationCreateOrUpdate(t *testing.T) {
+	type args struct {
+		cl *MetricsConfigurationClient
+		b  *v1beta1.Bucket
+	}
+
+	type want struct {
+		err     error
+		put     []string
+		deleted []string
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"ListError": {
+			args: args{
+				b: s3testing.Bucket(s3testing.WithMetricsConfigs(metricsConfig)),
+				cl: NewMetricsConfigurationClient(fake.MockBucketClient{
+					MockListBucketMetricsConfigurations: func(ctx context.Context, input *s3.ListBucketMetricsConfigurationsInput, opts []func(*s3.Options)) (*s3.ListBucketMetricsConfigurationsOutput, error) {
+						return nil, errBoom
+					},
+				}),
+			},
+			want: want{
+				err: awsclient.Wrap(errBoom, metricsListFailed),
+			},
+		},
+		"PutError": {
+			args: args{
+				b: s3testing.Bucket(s3testing.WithMetricsConfigs(metricsConfig)),
+				cl: NewMetricsConfigurationClient(fake.MockBucketClient{
+					MockListBucketMetricsConfigurations: listMetricsConfigurations(),
+					MockPutBucketMetricsConfiguration: func(ctx context.Context, input *s3.PutBucketMetricsConfigurationInput, opts []func(*s3.Options)) (*s3.PutBucketMetricsConfigurationOutput, error) {
+						return nil, errBoom
+					},
+				}),
+			},
+			want: want{
+				err: awsclient.Wrap(errBoom, metricsPutFailed),
+			},
+		},
+		"DeleteError": {
+			args: args{
+				b: s3testing.Bucket(s3testing.WithMetricsConfigs(metricsConfig)),
+				cl: NewMetricsConfigurationClient(fake.MockBucketClient{
+					MockListBucketMetricsConfigurations: listMetricsConfigurations(awsMetricsConfig, awsOtherMetricsConfig),
+					MockDeleteBucketMetricsConfiguration: func(ctx context.Context, input *s3.DeleteBucketMetricsConfigurationInput, opts []func(*s3.Options)) (*s3.DeleteBucketMetricsConfigurationOutput, error) {
+						return nil, errBoom
+					},
+				}),
+			},
+			want: want{
+				err: awsclient.Wrap(errBoom, metricsDeleteFailed),
+			},
+		},
+		"PutsChangedAndDeletesRemoved": {
+			args: args{
+				b: s3testing.Bucket(s3testing.WithMetricsConfigs(metricsConfig)),
+				cl: NewMetricsConfigurationClient(fake.MockBucketClient{
+					MockListBucketMetricsConfigurations: listMetricsConfigurations(awsOtherMetricsConfig),
+				}),
+			},
+			want: want{
+				put:     []string{"all"},
+				deleted: []string{"other"},
+			},
+		},
+		"NothingToDo": {
+			args: args{
+				b: s3testing.Bucket(s3testing.WithMetricsConfigs(metricsConfig)),
+				cl: NewMetricsConfigurationClient(fake.MockBucketClient{
+					MockListBucketMetricsConfigurations: listMetricsConfigurations(awsMetricsConfig),
+				}),
+			},
+			want: want{},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			var put, deleted []string
+			mock := tc.args.cl.client.(fake.MockBucketClient)
+			if mock.MockPutBucketMetricsConfiguration == nil {
+				mock.MockPutBucketMetricsConfiguration = func(ctx context.Context, input *s3.PutBucketMetricsConfigurationInput, opts []func(*s3.Options)) (*s3.PutBucketMetricsConfigurationOutput, error) {
+					put = append(put, awsclient.StringValue(input.Id))
+					return &s3.PutBucketMetricsConfigurationOutput{}, nil
+				}
+			}
+			if mock.MockDeleteBucketMetricsConfiguration == nil {
+				mock.MockDeleteBucketMetricsConfiguration = func(ctx context.Context, input *s3.DeleteBucketMetricsConfigurationInput, opts []func(*s3.Options)) (*s3.DeleteBucketMetricsConfigurationOutput, error) {
+					deleted = append(deleted, awsclient.StringValue(input.Id))
+					return &s3.DeleteBucketMetricsConfigurationOutput{}, nil
+				}
+			}
+			tc.args.cl.client = mock
+			err := tc.args.cl.CreateOrUpdate(context.Background(), tc.args.b)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.put, put); diff != "" {
+				t.Errorf("put: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.deleted, deleted); diff != "" {
+				t.Errorf("deleted: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestMetricsConfigurationLateInit(t *testing.T) {
+	type args struct {
+		cl *MetricsConfigurationClient
+		b  *v1beta1.Bucket
+	}
+
+	type want struct {
+		err error
+		cr  *v1beta1.Bucket
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"Error": {
+			args: args{
+				b: s3testing.Bucket(),
+				cl: NewMetricsConfigurationClient(fake.MockBucketClient{
+					MockListBucketMetricsConfigurations: func(ctx context.Context, input *s3.ListBucketMetricsConfigurationsInput, opts []func(*s3.Options)) (*s3.ListBucketMetricsConfigurationsOutput, error) {
+						return nil, errBoom
+					},
+				}),
+			},
+			want: want{
+				err: awsclient.Wrap(errBoom, metricsListFailed),
+				cr:  s3testing.Bucket(),
+			},
+		},
+		"SuccessfulLateInit": {
+			args: args{
+				b:  s3testing.Bucket(),
+				cl: NewMetricsConfigurationClient(fake.MockBucketClient{MockListBucketMetricsConfigurations: listMetricsConfigurations(awsOtherMetricsConfig)}),
+			},
+			want: want{
+				cr: s3testing.Bucket(s3testing.WithMetricsConfigs(v1beta1.MetricsConfiguration{
+					ID:     "other",
+					Filter: &v1beta1.MetricsFilter{Prefix: awsclient.String("other/")},
+				})),
+			},
+		},
+		"NoOverwrite": {
+			args: args{
+				b:  s3testing.Bucket(s3testing.WithMetricsConfigs(metricsConfig)),
+				cl: NewMetricsConfigurationClient(fake.MockBucketClient{MockListBucketMetricsConfigurations: listMetricsConfigurations(awsOtherMetricsConfig)}),
+			},
+			want: want{
+				cr: s3testing.Bucket(s3testing.WithMetricsConfigs(metricsConfig)),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			err := tc.args.cl.LateInitialize(context.Background(), tc.args.b)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.b, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,144 @@
+/*
+Copyright 2023 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package bucket
+
+import (
+	"context"
+
+	awss3 "github.com/aws/aws-sdk-go-v2/service/s3"
+	"github.com/aws/aws-sdk-go-v2/service/s3/types"
+	"github.com/aws/smithy-go/document"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+
+	"github.com/crossplane-contrib/provider-aws/apis/s3/v1beta1"
+	awsclient "github.com/crossplane-contrib/provider-aws/pkg/clients"
+	"github.com/crossplane-contrib/provider-aws/pkg/clients/s3"
+)
+
+const (
+	objectLockGetFailed = "cannot get Bucket object lock configuration"
+	objectLockPutFailed = "cannot put Bucket object lock configuration"
+)
+
+// ObjectLockConfigurationClient is the client for API methods and reconciling the ObjectLockConfiguration
+type ObjectLockConfigurationClient struct {
+	client s3.BucketClient
+}
+
+// NewObjectLockConfigurationClient creates the client for Object Lock Configuration
+func NewObjectLockConfigurationClient(client s3.BucketClient) *ObjectLockConfigurationClient {
+	return &ObjectLockConfigurationClient{client: client}
+}
+
+// Observe checks if the resource exists and if it matches the local configuration
+func (in *ObjectLockConfigurationClient) Observe(ctx context.Context, bucket *v1beta1.Bucket) (ResourceStatus, error) {
+	config := bucket.Spec.ForProvider.ObjectLockConfiguration
+	if config == nil {
+		// NOTE: Object Lock cannot be disabled once it is enabled, so the
+		// configuration is only checked if it is set.
+		return Updated, nil
+	}
+	external, err := in.client.GetObjectLockConfiguration(ctx, &awss3.GetObjectLockConfigurationInput{Bucket: awsclient.String(meta.GetExternalName(bucket))})
+	if err != nil {
+		if s3.ObjectLockConfigurationNotFound(err) {
+			return NeedsUpdate, nil
+		}
+		return NeedsUpdate, awsclient.Wrap(err, objectLockGetFailed)
+	}
+	if cmp.Equal(external.ObjectLockConfiguration, GenerateObjectLockConfiguration(config),
+		cmpopts.EquateEmpty(), cmpopts.IgnoreTypes(document.NoSerde{})) {
+		return Updated, nil
+	}
+	return NeedsUpdate, nil
+}
+
+// CreateOrUpdate sends a request to have resource created on AWS
+func (in *ObjectLockConfigurationClient) CreateOrUpdate(ctx context.Context, bucket *v1beta1.Bucket) error {
+	config := bucket.Spec.ForProvider.ObjectLockConfiguration
+	if config == nil {
+		return nil
+	}
+	_, err := in.client.PutObjectLockConfiguration(ctx, &awss3.PutObjectLockConfigurationInput{
+		Bucket:                  awsclient.String(meta.GetExternalName(bucket)),
+		ObjectLockConfiguration: GenerateObjectLockConfiguration(config),
+	})
+	return awsclient.Wrap(err, objectLockPutFailed)
+}
+
+// Delete does nothing since Object Lock cannot be disabled for a bucket.
+func (*ObjectLockConfigurationClient) Delete(_ context.Context, _ *v1beta1.Bucket) error {
+	return nil
+}
+
+// LateInitialize is responsible for initializing the resource based on the external value
+func (in *ObjectLockConfigurationClient) LateInitialize(ctx context.Context, bucket *v1beta1.Bucket) error {
+	external, err := in.client.GetObjectLockConfiguration(ctx, &awss3.GetObjectLockConfigurationInput{Bucket: awsclient.String(meta.GetExternalName(bucket))})
+	if err != nil {
+		return awsclient.Wrap(resource.Ignore(s3.ObjectLockConfigurationNotFound, err), objectLockGetFailed)
+	}
+	if external == nil || external.ObjectLockConfiguration == nil || external.ObjectLockConfiguration.ObjectLockEnabled == "" {
+		return nil
+	}
+
+	fp := &bucket.Spec.ForProvider
+	if fp.ObjectLockConfiguration == nil {
+		fp.ObjectLockConfiguration = &v1beta1.ObjectLockConfiguration{}
+	}
+	config := fp.ObjectLockConfiguration
+	config.ObjectLockEnabled = awsclient.LateInitializeStringPtr(config.ObjectLockEnabled, awsclient.String(string(external.ObjectLockConfiguration.ObjectLockEnabled)))
+	rule := external.ObjectLockConfiguration.Rule
+	if config.Rule == nil && rule != nil && rule.DefaultRetention != nil {
+		retention := &v1beta1.DefaultRetention{Mode: string(rule.DefaultRetention.Mode)}
+		// NOTE: Only one of days and years can be set for the retention.
+		if rule.DefaultRetention.Days != 0 {
+			retention.Days = awsclient.Int32(int(rule.DefaultRetention.Days))
+		}
+		if rule.DefaultRetention.Years != 0 {
+			retention.Years = awsclient.Int32(int(rule.DefaultRetention.Years))
+		}
+		config.Rule = &v1beta1.ObjectLockRule{DefaultRetention: retention}
+	}
+	return nil
+}
+
+// SubresourceExists checks if the subresource this controller manages currently exists
+func (in *ObjectLockConfigurationClient) SubresourceExists(bucket *v1beta1.Bucket) bool {
+	return bucket.Spec.ForProvider.ObjectLockConfiguration != nil
+}
+
+// GenerateObjectLockConfiguration creates the ObjectLockConfiguration for the AWS SDK
+func GenerateObjectLockConfiguration(config *v1beta1.ObjectLockConfiguration) *types.ObjectLockConfiguration {
+	if config == nil {
+		return nil
+	}
+	res := &types.ObjectLockConfiguration{
+		ObjectLockEnabled: types.ObjectLockEnabled(awsclient.StringValue(config.ObjectLockEnabled)),
+	}
+	if config.Rule != nil && config.Rule.DefaultRetention != nil {
+		res.Rule = &types.ObjectLockRule{
+			DefaultRetention: &types.DefaultRetention{
+				Mode:  types.ObjectLockRetentionMode(config.Rule.DefaultRetention.Mode),
+				Days:  awsclient.Int32Value(config.Rule.DefaultRetention.Days),
+				Years: awsclient.Int32Value(config.Rule.DefaultRetention.Years),
+			},
+		}
+	}
+	return res
+}
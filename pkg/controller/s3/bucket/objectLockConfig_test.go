@@ -0,0 +1,279 @@
+/*
+Copyright 2023 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package bucket
+
+import (
+	"context"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/service/s3"
+	s3types "github.com/aws/aws-sdk-go-v2/service/s3/types"
+	"github.com/aws/smithy-go"
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+
+	"github.com/crossplane-contrib/provider-aws/apis/s3/v1beta1"
+	awsclient "github.com/crossplane-contrib/provider-aws/pkg/clients"
+	clientss3 "github.com/crossplane-contrib/provider-aws/pkg/clients/s3"
+	"github.com/crossplane-contrib/provider-aws/pkg/clients/s3/fake"
+	s3testing "github.com/crossplane-contrib/provider-aws/pkg/controller/s3/testing"
+)
+
+var _ SubresourceClient = &ObjectLockConfigurationClient{}
+
+var (
+	objectLockConfig = &v1beta1.ObjectLockConfiguration{
+		ObjectLockEnabled: awsclient.String("Enabled"),
+		Rule: &v1beta1.ObjectLockRule{
+			DefaultRetention: &v1beta1.DefaultRetention{
+				Mode: "GOVERNANCE",
+				Days: awsclient.Int32(30),
+			},
+		},
+	}
+	awsObjectLockConfig = &s3types.ObjectLockConfiguration{
+		ObjectLockEnabled: s3types.ObjectLockEnabledEnabled,
+		Rule: &s3types.ObjectLockRule{
+			DefaultRetention: &s3types.DefaultRetention{
+				Mode: s3types.ObjectLockRetentionModeGovernance,
+				Days: 30,
+			},
+		},
+	}
+)
+
+func getObjectLockConfiguration(config *s3types.ObjectLockConfiguration, err error) func(ctx context.Context, input *s3.GetObjectLockConfigurationInput, opts []func(*s3.Options)) (*s3.GetObjectLockConfigurationOutput, error) {
+	return func(ctx context.Context, input *s3.GetObjectLockConfigurationInput, opts []func(*s3.Options)) (*s3.GetObjectLockConfigurationOutput, error) {
+		if err != nil {
+			return nil, err
+		}
+		return &s3.GetObjectLockConfigurationOutput{ObjectLockConfiguration: config}, nil
+	}
+}
+
+func TestObjectLockConfigurationObserve(t *testing.T) {
+	type args struct {
+		cl *ObjectLockConfigurationClient
+		b  *v1beta1.Bucket
+	}
+
+	type want struct {
+		status ResourceStatus
+		err    error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"Error": {
+			args: args{
+				b:  s3testing.Bucket(s3testing.WithObjectLockConfig(objectLockConfig)),
+				cl: NewObjectLockConfigurationClient(fake.MockBucketClient{MockGetObjectLockConfiguration: getObjectLockConfiguration(nil, errBoom)}),
+			},
+			want: want{
+				status: NeedsUpdate,
+				err:    awsclient.Wrap(errBoom, objectLockGetFailed),
+			},
+		},
+		"NotSet": {
+			args: args{
+				b:  s3testing.Bucket(),
+				cl: NewObjectLockConfigurationClient(fake.MockBucketClient{MockGetObjectLockConfiguration: getObjectLockConfiguration(awsObjectLockConfig, nil)}),
+			},
+			want: want{
+				status: Updated,
+			},
+		},
+		"NotFound": {
+			args: args{
+				b: s3testing.Bucket(s3testing.WithObjectLockConfig(objectLockConfig)),
+				cl: NewObjectLockConfigurationClient(fake.MockBucketClient{
+					MockGetObjectLockConfiguration: getObjectLockConfiguration(nil, &smithy.GenericAPIError{Code: clientss3.ObjectLockNotFoundErrCode}),
+				}),
+			},
+			want: want{
+				status: NeedsUpdate,
+			},
+		},
+		"NeedsUpdate": {
+			args: args{
+				b: s3testing.Bucket(s3testing.WithObjectLockConfig(objectLockConfig)),
+				cl: NewObjectLockConfigurationClient(fake.MockBucketClient{
+					MockGetObjectLockConfiguration: getObjectLockConfiguration(&s3types.ObjectLockConfiguration{ObjectLockEnabled: s3types.ObjectLockEnabledEnabled}, nil),
+				}),
+			},
+			want: want{
+				status: NeedsUpdate,
+			},
+		},
+		"NoUpdateNeeded": {
+			args: args{
+				b:  s3testing.Bucket(s3testing.WithObjectLockConfig(objectLockConfig)),
+				cl: NewObjectLockConfigurationClient(fake.MockBucketClient{MockGetObjectLockConfiguration: getObjectLockConfiguration(awsObjectLockConfig, nil)}),
+			},
+			want: want{
+				status: Updated,
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			status, err := tc.args.cl.Observe(context.Background(), tc.args.b)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.status, status); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestObjectLockConfigurationCreateOrUpdate(t *testing.T) {
+	type args struct {
+		cl *ObjectLockConfigurationClient
+		b  *v1beta1.Bucket
+	}
+
+	type want struct {
+		err error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"Error": {
+			args: args{
+				b: s3testing.Bucket(s3testing.WithObjectLockConfig(objectLockConfig)),
+				cl: NewObjectLockConfigurationClient(fake.MockBucketClient{
+					MockPutObjectLockConfiguration: func(ctx context.Context, input *s3.PutObjectLockConfigurationInput, opts []func(*s3.Options)) (*s3.PutObjectLockConfigurationOutput, error) {
+						return nil, errBoom
+					},
+				}),
+			},
+			want: want{
+				err: awsclient.Wrap(errBoom, objectLockPutFailed),
+			},
+		},
+		"NotSet": {
+			args: args{
+				b:  s3testing.Bucket(),
+				cl: NewObjectLockConfigurationClient(fake.MockBucketClient{}),
+			},
+			want: want{
+				err: nil,
+			},
+		},
+		"Success": {
+			args: args{
+				b: s3testing.Bucket(s3testing.WithObjectLockConfig(objectLockConfig)),
+				cl: NewObjectLockConfigurationClient(fake.MockBucketClient{
+					MockPutObjectLockConfiguration: func(ctx context.Context, input *s3.PutObjectLockConfigurationInput, opts []func(*s3.Options)) (*s3.PutObjectLockConfigurationOutput, error) {
+						if diff := cmp.Diff(awsObjectLockConfig, input.ObjectLockConfiguration, cmpopts.IgnoreUnexported(s3types.ObjectLockConfiguration{}, s3types.ObjectLockRule{}, s3types.DefaultRetention{})); diff != "" {
+							t.Errorf("r: -want, +got:\n%s", diff)
+						}
+						return &s3.PutObjectLockConfigurationOutput{}, nil
+					},
+				}),
+			},
+			want: want{
+				err: nil,
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			err := tc.args.cl.CreateOrUpdate(context.Background(), tc.args.b)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestObjectLockConfigurationLateInit(t *testing.T) {
+	type args struct {
+		cl *ObjectLockConfigurationClient
+		b  *v1beta1.Bucket
+	}
+
+	type want struct {
+		err error
+		cr  *v1beta1.Bucket
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"Error": {
+			args: args{
+				b:  s3testing.Bucket(),
+				cl: NewObjectLockConfigurationClient(fake.MockBucketClient{MockGetObjectLockConfiguration: getObjectLockConfiguration(nil, errBoom)}),
+			},
+			want: want{
+				err: awsclient.Wrap(errBoom, objectLockGetFailed),
+				cr:  s3testing.Bucket(),
+			},
+		},
+		"NotFound": {
+			args: args{
+				b: s3testing.Bucket(),
+				cl: NewObjectLockConfigurationClient(fake.MockBucketClient{
+					MockGetObjectLockConfiguration: getObjectLockConfiguration(nil, &smithy.GenericAPIError{Code: clientss3.ObjectLockNotFoundErrCode}),
+				}),
+			},
+			want: want{
+				cr: s3testing.Bucket(),
+			},
+		},
+		"SuccessfulLateInit": {
+			args: args{
+				b:  s3testing.Bucket(),
+				cl: NewObjectLockConfigurationClient(fake.MockBucketClient{MockGetObjectLockConfiguration: getObjectLockConfiguration(awsObjectLockConfig, nil)}),
+			},
+			want: want{
+				cr: s3testing.Bucket(s3testing.WithObjectLockConfig(&v1beta1.ObjectLockConfiguration{
+					ObjectLockEnabled: awsclient.String("Enabled"),
+					Rule: &v1beta1.ObjectLockRule{
+						DefaultRetention: &v1beta1.DefaultRetention{
+							Mode: "GOVERNANCE",
+							Days: awsclient.Int32(30),
+						},
+					},
+				})),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			err := tc.args.cl.LateInitialize(context.Background(), tc.args.b)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.b, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,119 @@
+/*
+Copyright 2023 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package bucket
+
+import (
+	"context"
+
+	awss3 "github.com/aws/aws-sdk-go-v2/service/s3"
+	"github.com/aws/aws-sdk-go-v2/service/s3/types"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+
+	"github.com/crossplane-contrib/provider-aws/apis/s3/v1beta1"
+	awsclient "github.com/crossplane-contrib/provider-aws/pkg/clients"
+	"github.com/crossplane-contrib/provider-aws/pkg/clients/s3"
+)
+
+const (
+	ownershipControlsGetFailed    = "cannot get Bucket ownership controls"
+	ownershipControlsPutFailed    = "cannot put Bucket ownership controls"
+	ownershipControlsDeleteFailed = "cannot delete Bucket ownership controls"
+)
+
+// OwnershipControlsClient is the client for API methods and reconciling the ObjectOwnership
+type OwnershipControlsClient struct {
+	client s3.BucketClient
+}
+
+// NewOwnershipControlsClient creates the client for Ownership Controls
+func NewOwnershipControlsClient(client s3.BucketClient) *OwnershipControlsClient {
+	return &OwnershipControlsClient{client: client}
+}
+
+// Observe checks if the resource exists and if it matches the local configuration
+func (in *OwnershipControlsClient) Observe(ctx context.Context, bucket *v1beta1.Bucket) (ResourceStatus, error) {
+	external, err := in.client.GetBucketOwnershipControls(ctx, &awss3.GetBucketOwnershipControlsInput{Bucket: awsclient.String(meta.GetExternalName(bucket))})
+	local := bucket.Spec.ForProvider.ObjectOwnership
+	if err != nil {
+		if s3.OwnershipControlsNotFound(err) && local == nil {
+			return Updated, nil
+		}
+		return NeedsUpdate, awsclient.Wrap(resource.Ignore(s3.OwnershipControlsNotFound, err), ownershipControlsGetFailed)
+	}
+	current := externalObjectOwnership(external)
+	switch {
+	case current == "" && local == nil:
+		return Updated, nil
+	case local == nil:
+		return NeedsDeletion, nil
+	case current == awsclient.StringValue(local):
+		return Updated, nil
+	default:
+		return NeedsUpdate, nil
+	}
+}
+
+// CreateOrUpdate sends a request to have resource created on AWS
+func (in *OwnershipControlsClient) CreateOrUpdate(ctx context.Context, bucket *v1beta1.Bucket) error {
+	if bucket.Spec.ForProvider.ObjectOwnership == nil {
+		return nil
+	}
+	_, err := in.client.PutBucketOwnershipControls(ctx, &awss3.PutBucketOwnershipControlsInput{
+		Bucket: awsclient.String(meta.GetExternalName(bucket)),
+		OwnershipControls: &types.OwnershipControls{
+			Rules: []types.OwnershipControlsRule{
+				{ObjectOwnership: types.ObjectOwnership(awsclient.StringValue(bucket.Spec.ForProvider.ObjectOwnership))},
+			},
+		},
+	})
+	return awsclient.Wrap(err, ownershipControlsPutFailed)
+}
+
+// Delete creates the request to delete the resource on AWS or set it to the default value.
+func (in *OwnershipControlsClient) Delete(ctx context.Context, bucket *v1beta1.Bucket) error {
+	_, err := in.client.DeleteBucketOwnershipControls(ctx,
+		&awss3.DeleteBucketOwnershipControlsInput{
+			Bucket: awsclient.String(meta.GetExternalName(bucket)),
+		},
+	)
+	return awsclient.Wrap(resource.Ignore(s3.OwnershipControlsNotFound, err), ownershipControlsDeleteFailed)
+}
+
+// LateInitialize is responsible for initializing the resource based on the external value
+func (in *OwnershipControlsClient) LateInitialize(ctx context.Context, bucket *v1beta1.Bucket) error {
+	external, err := in.client.GetBucketOwnershipControls(ctx, &awss3.GetBucketOwnershipControlsInput{Bucket: awsclient.String(meta.GetExternalName(bucket))})
+	if err != nil {
+		return awsclient.Wrap(resource.Ignore(s3.OwnershipControlsNotFound, err), ownershipControlsGetFailed)
+	}
+	if current := externalObjectOwnership(external); current != "" {
+		bucket.Spec.ForProvider.ObjectOwnership = awsclient.LateInitializeStringPtr(bucket.Spec.ForProvider.ObjectOwnership, &current)
+	}
+	return nil
+}
+
+// SubresourceExists checks if the subresource this controller manages currently exists
+func (in *OwnershipControlsClient) SubresourceExists(bucket *v1beta1.Bucket) bool {
+	return bucket.Spec.ForProvider.ObjectOwnership != nil
+}
+
+func externalObjectOwnership(external *awss3.GetBucketOwnershipControlsOutput) string {
+	if external == nil || external.OwnershipControls == nil || len(external.OwnershipControls.Rules) == 0 {
+		return ""
+	}
+	return string(external.OwnershipControls.Rules[0].ObjectOwnership)
+}
@@ -18,6 +18,11 @@ package bucket
 
 import (
 	"context"
+	"sort"
+
+	"github.com/aws/smithy-go/document"
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
 
 	"github.com/crossplane-contrib/provider-aws/apis/s3/v1beta1"
 	"github.com/crossplane-contrib/provider-aws/pkg/clients/s3"
@@ -50,6 +55,12 @@ func NewSubresourceClients(client s3.BucketClient) []SubresourceClient {
 		NewWebsiteConfigurationClient(client),
 		NewPublicAccessBlockClient(client),
 		NewPolicyClient(client),
+		NewOwnershipControlsClient(client),
+		NewObjectLockConfigurationClient(client),
+		NewIntelligentTieringConfigurationClient(client),
+		NewInventoryConfigurationClient(client),
+		NewMetricsConfigurationClient(client),
+		NewAnalyticsConfigurationClient(client),
 	}
 }
 
@@ -64,3 +75,38 @@ const (
 	// NeedsDeletion is returned if the resource needs to be deleted.
 	NeedsDeletion
 )
+
+// configurationsDiff compares the desired and the observed configurations of a
+// sub-resource that a bucket can have several of, both keyed by their ID. It
+// returns the IDs of the desired configurations that need to be put and the IDs
+// of the observed configurations that need to be deleted.
+func configurationsDiff(desired, observed map[string]interface{}) (put, remove []string) {
+	for id, d := range desired {
+		o, ok := observed[id]
+		if !ok || !cmp.Equal(d, o, cmpopts.EquateEmpty(), cmpopts.IgnoreTypes(document.NoSerde{})) {
+			put = append(put, id)
+		}
+	}
+	for id := range observed {
+		if _, ok := desired[id]; !ok {
+			remove = append(remove, id)
+		}
+	}
+	sort.Strings(put)
+	sort.Strings(remove)
+	return put, remove
+}
+
+// configurationsStatus returns the status of a sub-resource that a bucket can
+// have several configurations of.
+func configurationsStatus(desired, observed map[string]interface{}) ResourceStatus {
+	put, remove := configurationsDiff(desired, observed)
+	switch {
+	case len(desired) == 0 && len(observed) != 0:
+		return NeedsDeletion
+	case len(put) == 0 && len(remove) == 0:
+		return Updated
+	default:
+		return NeedsUpdate
+	}
+}
@@ -19,6 +19,7 @@ package s3
 import (
 	"context"
 	"fmt"
+	"sort"
 	"testing"
 
 	"github.com/aws/aws-sdk-go-v2/aws"
@@ -139,9 +140,9 @@ func TestObserve(t *testing.T) {
 				result: managed.ExternalObservation{},
 			},
 		},
-		"ValidInputNoLateInitializeUpdateBucketOwnershipControlsFail": {
+		"ValidInputNoLateInitializeGetBucketOwnershipControlsFail": {
 			args: args{
-				s3: s3Testing.Client(s3Testing.WithDeleteOwnershipControls(func(ctx context.Context, input *awss3.DeleteBucketOwnershipControlsInput, opts []func(*awss3.Options)) (*awss3.DeleteBucketOwnershipControlsOutput, error) {
+				s3: s3Testing.Client(s3Testing.WithGetOwnershipControls(func(ctx context.Context, input *awss3.GetBucketOwnershipControlsInput, opts []func(*awss3.Options)) (*awss3.GetBucketOwnershipControlsOutput, error) {
 					return nil, errBoom
 				})),
 				cr: s3Testing.Bucket(),
@@ -150,7 +151,7 @@ func TestObserve(t *testing.T) {
 				cr: s3Testing.Bucket(
 					s3Testing.WithArn(fmt.Sprintf("arn:aws:s3:::%s", s3Testing.BucketName)),
 				),
-				err:    errBoom,
+				err:    awsclient.Wrap(errBoom, "cannot get Bucket ownership controls"),
 				result: managed.ExternalObservation{},
 			},
 		},
@@ -735,6 +736,121 @@ func TestDelete(t *testing.T) {
 				cr: s3Testing.Bucket(s3Testing.WithConditions(xpv1.Deleting())),
 			},
 		},
+		"ForceDestroyEmptiesBucket": {
+			args: args{
+				s3: &fake.MockBucketClient{
+					MockListMultipartUploads: func(ctx context.Context, input *awss3.ListMultipartUploadsInput, opts []func(*awss3.Options)) (*awss3.ListMultipartUploadsOutput, error) {
+						return &awss3.ListMultipartUploadsOutput{Uploads: []awss3types.MultipartUpload{{Key: aws.String("upload"), UploadId: aws.String("id")}}}, nil
+					},
+					MockAbortMultipartUpload: func(ctx context.Context, input *awss3.AbortMultipartUploadInput, opts []func(*awss3.Options)) (*awss3.AbortMultipartUploadOutput, error) {
+						return &awss3.AbortMultipartUploadOutput{}, nil
+					},
+					MockListObjectVersions: func() func(ctx context.Context, input *awss3.ListObjectVersionsInput, opts []func(*awss3.Options)) (*awss3.ListObjectVersionsOutput, error) {
+						calls := 0
+						return func(ctx context.Context, input *awss3.ListObjectVersionsInput, opts []func(*awss3.Options)) (*awss3.ListObjectVersionsOutput, error) {
+							calls++
+							if calls > 1 {
+								return &awss3.ListObjectVersionsOutput{}, nil
+							}
+							return &awss3.ListObjectVersionsOutput{
+								Versions:      []awss3types.ObjectVersion{{Key: aws.String("a"), VersionId: aws.String("1")}, {Key: aws.String("a"), VersionId: aws.String("2")}},
+								DeleteMarkers: []awss3types.DeleteMarkerEntry{{Key: aws.String("a"), VersionId: aws.String("3")}},
+							}, nil
+						}
+					}(),
+					MockDeleteObjects: func(ctx context.Context, input *awss3.DeleteObjectsInput, opts []func(*awss3.Options)) (*awss3.DeleteObjectsOutput, error) {
+						return &awss3.DeleteObjectsOutput{}, nil
+					},
+					MockDeleteBucket: func(ctx context.Context, input *awss3.DeleteBucketInput, opts []func(*awss3.Options)) (*awss3.DeleteBucketOutput, error) {
+						return &awss3.DeleteBucketOutput{}, nil
+					},
+				},
+				cr: s3Testing.Bucket(s3Testing.WithForceDestroy(true)),
+			},
+			want: want{
+				cr: s3Testing.Bucket(s3Testing.WithForceDestroy(true),
+					s3Testing.WithForceDestroyStatus(&v1beta1.BucketForceDestroyStatus{DeletedObjects: 3, AbortedMultipartUploads: 1}),
+					s3Testing.WithConditions(xpv1.Deleting())),
+			},
+		},
+		"ForceDestroyInProgress": {
+			args: args{
+				s3: &fake.MockBucketClient{
+					MockListMultipartUploads: func(ctx context.Context, input *awss3.ListMultipartUploadsInput, opts []func(*awss3.Options)) (*awss3.ListMultipartUploadsOutput, error) {
+						return &awss3.ListMultipartUploadsOutput{}, nil
+					},
+					MockListObjectVersions: func(ctx context.Context, input *awss3.ListObjectVersionsInput, opts []func(*awss3.Options)) (*awss3.ListObjectVersionsOutput, error) {
+						return &awss3.ListObjectVersionsOutput{
+							Versions:    []awss3types.ObjectVersion{{Key: aws.String("a"), VersionId: aws.String("1")}},
+							IsTruncated: true,
+						}, nil
+					},
+					MockDeleteObjects: func(ctx context.Context, input *awss3.DeleteObjectsInput, opts []func(*awss3.Options)) (*awss3.DeleteObjectsOutput, error) {
+						return &awss3.DeleteObjectsOutput{}, nil
+					},
+				},
+				cr: s3Testing.Bucket(s3Testing.WithForceDestroy(true),
+					s3Testing.WithForceDestroyStatus(&v1beta1.BucketForceDestroyStatus{DeletedObjects: 5})),
+			},
+			want: want{
+				cr: s3Testing.Bucket(s3Testing.WithForceDestroy(true),
+					s3Testing.WithForceDestroyStatus(&v1beta1.BucketForceDestroyStatus{DeletedObjects: 5 + maxEmptyBucketBatches}),
+					s3Testing.WithConditions(xpv1.Deleting())),
+			},
+		},
+		"ForceDestroyDeleteObjectsError": {
+			args: args{
+				s3: &fake.MockBucketClient{
+					MockListMultipartUploads: func(ctx context.Context, input *awss3.ListMultipartUploadsInput, opts []func(*awss3.Options)) (*awss3.ListMultipartUploadsOutput, error) {
+						return &awss3.ListMultipartUploadsOutput{}, nil
+					},
+					MockListObjectVersions: func(ctx context.Context, input *awss3.ListObjectVersionsInput, opts []func(*awss3.Options)) (*awss3.ListObjectVersionsOutput, error) {
+						return &awss3.ListObjectVersionsOutput{
+							Versions: []awss3types.ObjectVersion{{Key: aws.String("a"), VersionId: aws.String("1")}},
+						}, nil
+					},
+					MockDeleteObjects: func(ctx context.Context, input *awss3.DeleteObjectsInput, opts []func(*awss3.Options)) (*awss3.DeleteObjectsOutput, error) {
+						return nil, errBoom
+					},
+				},
+				cr: s3Testing.Bucket(s3Testing.WithForceDestroy(true)),
+			},
+			want: want{
+				cr: s3Testing.Bucket(s3Testing.WithForceDestroy(true),
+					s3Testing.WithForceDestroyStatus(&v1beta1.BucketForceDestroyStatus{}),
+					s3Testing.WithConditions(xpv1.Deleting())),
+				err: errors.Wrap(awsclient.Wrap(errBoom, "cannot delete objects"), errEmptyBucket),
+			},
+		},
+		"ForceDestroyLockedObjects": {
+			args: args{
+				s3: &fake.MockBucketClient{
+					MockListMultipartUploads: func(ctx context.Context, input *awss3.ListMultipartUploadsInput, opts []func(*awss3.Options)) (*awss3.ListMultipartUploadsOutput, error) {
+						return &awss3.ListMultipartUploadsOutput{}, nil
+					},
+					MockListObjectVersions: func(ctx context.Context, input *awss3.ListObjectVersionsInput, opts []func(*awss3.Options)) (*awss3.ListObjectVersionsOutput, error) {
+						return &awss3.ListObjectVersionsOutput{
+							Versions: []awss3types.ObjectVersion{{Key: aws.String("a"), VersionId: aws.String("1")}, {Key: aws.String("b"), VersionId: aws.String("1")}},
+						}, nil
+					},
+					MockDeleteObjects: func(ctx context.Context, input *awss3.DeleteObjectsInput, opts []func(*awss3.Options)) (*awss3.DeleteObjectsOutput, error) {
+						if !input.BypassGovernanceRetention {
+							t.Errorf("DeleteObjects: governance retention is not bypassed")
+						}
+						return &awss3.DeleteObjectsOutput{
+							Errors: []awss3types.Error{{Key: aws.String("b"), VersionId: aws.String("1"), Code: aws.String("AccessDenied"), Message: aws.String("Access Denied because object protected by object lock.")}},
+						}, nil
+					},
+				},
+				cr: s3Testing.Bucket(s3Testing.WithForceDestroy(true)),
+			},
+			want: want{
+				cr: s3Testing.Bucket(s3Testing.WithForceDestroy(true),
+					s3Testing.WithForceDestroyStatus(&v1beta1.BucketForceDestroyStatus{DeletedObjects: 1}),
+					s3Testing.WithConditions(xpv1.Deleting())),
+				err: errors.Wrap(errors.New("cannot delete objects: 1 objects could not be deleted, e.g. b (version 1): Access Denied because object protected by object lock."), errEmptyBucket),
+			},
+		},
 	}
 
 	for name, tc := range cases {
@@ -751,3 +867,73 @@ func TestDelete(t *testing.T) {
 		})
 	}
 }
+
+func TestInitialize(t *testing.T) {
+	withTags := func(tagMaps ...map[string]string) s3Testing.BucketModifier {
+		tagMap := map[string]string{}
+		for _, m := range tagMaps {
+			for k, v := range m {
+				tagMap[k] = v
+			}
+		}
+		tagging := &v1beta1.Tagging{TagSet: make([]v1beta1.Tag, 0, len(tagMap))}
+		for k, v := range tagMap {
+			tagging.TagSet = append(tagging.TagSet, v1beta1.Tag{Key: k, Value: v})
+		}
+		sort.Slice(tagging.TagSet, func(i, j int) bool {
+			return tagging.TagSet[i].Key < tagging.TagSet[j].Key
+		})
+		return s3Testing.WithTaggingConfig(tagging)
+	}
+	type want struct {
+		cr  resource.Managed
+		err error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"Successful": {
+			args: args{
+				cr:   s3Testing.Bucket(withTags(map[string]string{"foo": "bar"})),
+				kube: &test.MockClient{MockUpdate: test.NewMockUpdateFn(nil)},
+			},
+			want: want{
+				cr: s3Testing.Bucket(withTags(resource.GetExternalTags(s3Testing.Bucket()), map[string]string{"foo": "bar"})),
+			},
+		},
+		"NoTagging": {
+			args: args{
+				cr:   s3Testing.Bucket(),
+				kube: &test.MockClient{MockUpdate: test.NewMockUpdateFn(nil)},
+			},
+			want: want{
+				cr: s3Testing.Bucket(withTags(resource.GetExternalTags(s3Testing.Bucket()))),
+			},
+		},
+		"AlreadyTagged": {
+			args: args{
+				cr:   s3Testing.Bucket(withTags(resource.GetExternalTags(s3Testing.Bucket()), map[string]string{"foo": "bar"})),
+				kube: &test.MockClient{MockUpdate: test.NewMockUpdateFn(errBoom)},
+			},
+			want: want{
+				cr: s3Testing.Bucket(withTags(resource.GetExternalTags(s3Testing.Bucket()), map[string]string{"foo": "bar"})),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &tagger{kube: tc.kube}
+			err := e.Initialize(context.Background(), tc.args.cr)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr); err == nil && diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
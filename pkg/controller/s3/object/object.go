@@ -0,0 +1,274 @@
+/*
+Copyright 2023 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package object
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	awss3 "github.com/aws/aws-sdk-go-v2/service/s3"
+	"github.com/pkg/errors"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/types"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/connection"
+	"github.com/crossplane/crossplane-runtime/pkg/controller"
+	"github.com/crossplane/crossplane-runtime/pkg/event"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+
+	"github.com/crossplane-contrib/provider-aws/apis/s3/v1alpha1"
+	awsv1alpha1 "github.com/crossplane-contrib/provider-aws/apis/v1alpha1"
+	awsclient "github.com/crossplane-contrib/provider-aws/pkg/clients"
+	"github.com/crossplane-contrib/provider-aws/pkg/clients/s3"
+	"github.com/crossplane-contrib/provider-aws/pkg/features"
+)
+
+const (
+	errUnexpectedObject = "The managed resource is not an Object resource"
+	errHead             = "cannot get Object"
+	errGetTagging       = "cannot get Object tags"
+	errPut              = "cannot put Object"
+	errPutTagging       = "cannot put Object tags"
+	errDeleteTagging    = "cannot delete Object tags"
+	errDelete           = "cannot delete Object"
+	errGetConfigMap     = "cannot get ConfigMap with the content of the Object"
+	errGetSecret        = "cannot get Secret with the content of the Object"
+	errNoContent        = "no content, configMapKeyRef or secretKeyRef specified"
+	errOnlyOneContent   = "only one of content, configMapKeyRef or secretKeyRef must be set"
+	errKeyNotFound      = "key %q not found in %s %s/%s"
+)
+
+// SetupObject adds a controller that reconciles Objects.
+func SetupObject(mgr ctrl.Manager, o controller.Options) error {
+	name := managed.ControllerName(v1alpha1.ObjectGroupKind)
+
+	cps := []managed.ConnectionPublisher{managed.NewAPISecretPublisher(mgr.GetClient(), mgr.GetScheme())}
+	if o.Features.Enabled(features.EnableAlphaExternalSecretStores) {
+		cps = append(cps, connection.NewDetailsManager(mgr.GetClient(), awsv1alpha1.StoreConfigGroupVersionKind))
+	}
+
+	return ctrl.NewControllerManagedBy(mgr).
+		Named(name).
+		WithOptions(o.ForControllerRuntime()).
+		WithEventFilter(resource.DesiredStateChanged()).
+		For(&v1alpha1.Object{}).
+		Complete(managed.NewReconciler(mgr,
+			resource.ManagedKind(v1alpha1.ObjectGroupVersionKind),
+			managed.WithExternalConnecter(&connector{kube: mgr.GetClient(), newClientFn: s3.NewObjectClient}),
+			managed.WithReferenceResolver(managed.NewAPISimpleReferenceResolver(mgr.GetClient())),
+			managed.WithInitializers(),
+			managed.WithPollInterval(o.PollInterval),
+			managed.WithLogger(o.Logger.WithValues("controller", name)),
+			managed.WithRecorder(event.NewAPIRecorder(mgr.GetEventRecorderFor(name))),
+			managed.WithConnectionPublishers(cps...)))
+}
+
+type connector struct {
+	kube        client.Client
+	newClientFn func(config aws.Config) s3.ObjectClient
+}
+
+func (c *connector) Connect(ctx context.Context, mg resource.Managed) (managed.ExternalClient, error) {
+	cr, ok := mg.(*v1alpha1.Object)
+	if !ok {
+		return nil, errors.New(errUnexpectedObject)
+	}
+	cfg, err := awsclient.GetConfig(ctx, c.kube, mg, cr.Spec.ForProvider.Region)
+	if err != nil {
+		return nil, err
+	}
+	return &external{client: c.newClientFn(*cfg), kube: c.kube}, nil
+}
+
+type external struct {
+	client s3.ObjectClient
+	kube   client.Client
+}
+
+func (e *external) Observe(ctx context.Context, mg resource.Managed) (managed.ExternalObservation, error) {
+	cr, ok := mg.(*v1alpha1.Object)
+	if !ok {
+		return managed.ExternalObservation{}, errors.New(errUnexpectedObject)
+	}
+
+	head, err := e.client.HeadObject(ctx, &awss3.HeadObjectInput{
+		Bucket: cr.Spec.ForProvider.BucketName,
+		Key:    awsclient.String(cr.Spec.ForProvider.Key),
+	})
+	if err != nil {
+		if s3.IsErrorObjectNotFound(err) || s3.IsErrorBucketNotFound(err) {
+			return managed.ExternalObservation{}, nil
+		}
+		return managed.ExternalObservation{}, awsclient.Wrap(err, errHead)
+	}
+
+	content, err := e.getContent(ctx, cr)
+	if err != nil {
+		return managed.ExternalObservation{}, err
+	}
+
+	tagging, err := e.client.GetObjectTagging(ctx, &awss3.GetObjectTaggingInput{
+		Bucket: cr.Spec.ForProvider.BucketName,
+		Key:    awsclient.String(cr.Spec.ForProvider.Key),
+	})
+	if err != nil {
+		return managed.ExternalObservation{}, awsclient.Wrap(err, errGetTagging)
+	}
+
+	cr.SetConditions(xpv1.Available())
+
+	return managed.ExternalObservation{
+		ResourceExists: true,
+		ResourceUpToDate: s3.IsObjectUpToDate(cr.Spec.ForProvider, cr.Status.AtProvider, content, head) &&
+			s3.AreObjectTagsUpToDate(cr.Spec.ForProvider.Tags, tagging.TagSet),
+	}, nil
+}
+
+func (e *external) Create(ctx context.Context, mg resource.Managed) (managed.ExternalCreation, error) {
+	cr, ok := mg.(*v1alpha1.Object)
+	if !ok {
+		return managed.ExternalCreation{}, errors.New(errUnexpectedObject)
+	}
+
+	cr.SetConditions(xpv1.Creating())
+
+	return managed.ExternalCreation{}, e.put(ctx, cr)
+}
+
+func (e *external) Update(ctx context.Context, mg resource.Managed) (managed.ExternalUpdate, error) {
+	cr, ok := mg.(*v1alpha1.Object)
+	if !ok {
+		return managed.ExternalUpdate{}, errors.New(errUnexpectedObject)
+	}
+
+	head, err := e.client.HeadObject(ctx, &awss3.HeadObjectInput{
+		Bucket: cr.Spec.ForProvider.BucketName,
+		Key:    awsclient.String(cr.Spec.ForProvider.Key),
+	})
+	if err != nil {
+		return managed.ExternalUpdate{}, awsclient.Wrap(err, errHead)
+	}
+	content, err := e.getContent(ctx, cr)
+	if err != nil {
+		return managed.ExternalUpdate{}, err
+	}
+	if !s3.IsObjectUpToDate(cr.Spec.ForProvider, cr.Status.AtProvider, content, head) {
+		// NOTE: The content and the attributes of an Object cannot be
+		// changed without uploading it again, which also sets its tags.
+		return managed.ExternalUpdate{}, e.put(ctx, cr)
+	}
+
+	// Only the tags of the Object are outdated.
+	if len(cr.Spec.ForProvider.Tags) == 0 {
+		_, err = e.client.DeleteObjectTagging(ctx, &awss3.DeleteObjectTaggingInput{
+			Bucket: cr.Spec.ForProvider.BucketName,
+			Key:    awsclient.String(cr.Spec.ForProvider.Key),
+		})
+		return managed.ExternalUpdate{}, awsclient.Wrap(err, errDeleteTagging)
+	}
+	_, err = e.client.PutObjectTagging(ctx, &awss3.PutObjectTaggingInput{
+		Bucket:  cr.Spec.ForProvider.BucketName,
+		Key:     awsclient.String(cr.Spec.ForProvider.Key),
+		Tagging: s3.GenerateObjectTagging(cr.Spec.ForProvider.Tags),
+	})
+	return managed.ExternalUpdate{}, awsclient.Wrap(err, errPutTagging)
+}
+
+func (e *external) Delete(ctx context.Context, mg resource.Managed) error {
+	cr, ok := mg.(*v1alpha1.Object)
+	if !ok {
+		return errors.New(errUnexpectedObject)
+	}
+
+	cr.SetConditions(xpv1.Deleting())
+
+	_, err := e.client.DeleteObject(ctx, &awss3.DeleteObjectInput{
+		Bucket: cr.Spec.ForProvider.BucketName,
+		Key:    awsclient.String(cr.Spec.ForProvider.Key),
+	})
+	return awsclient.Wrap(resource.Ignore(s3.IsErrorBucketNotFound, err), errDelete)
+}
+
+// put uploads the content of the Object and records the ETag of the upload,
+// which is needed to detect changes of Objects encrypted with SSE-KMS.
+func (e *external) put(ctx context.Context, cr *v1alpha1.Object) error {
+	content, err := e.getContent(ctx, cr)
+	if err != nil {
+		return err
+	}
+	out, err := e.client.PutObject(ctx, s3.GeneratePutObjectInput(cr.Spec.ForProvider, content))
+	if err != nil {
+		return awsclient.Wrap(err, errPut)
+	}
+	cr.Status.AtProvider = v1alpha1.ObjectObservation{
+		ETag:       awsclient.StringValue(out.ETag),
+		VersionID:  awsclient.StringValue(out.VersionId),
+		ContentMD5: s3.ContentMD5(content),
+	}
+	return nil
+}
+
+// getContent returns the content of the Object from the inline content, the
+// ConfigMap or the Secret it is specified by.
+func (e *external) getContent(ctx context.Context, cr *v1alpha1.Object) ([]byte, error) {
+	p := cr.Spec.ForProvider
+	sources := 0
+	if p.Content != nil {
+		sources++
+	}
+	if p.ContentFrom != nil && p.ContentFrom.ConfigMapKeyRef != nil {
+		sources++
+	}
+	if p.ContentFrom != nil && p.ContentFrom.SecretKeyRef != nil {
+		sources++
+	}
+	if sources > 1 {
+		return nil, errors.New(errOnlyOneContent)
+	}
+	switch {
+	case p.Content != nil:
+		return []byte(*p.Content), nil
+	case p.ContentFrom != nil && p.ContentFrom.ConfigMapKeyRef != nil:
+		ref := p.ContentFrom.ConfigMapKeyRef
+		cm := &corev1.ConfigMap{}
+		if err := e.kube.Get(ctx, types.NamespacedName{Name: ref.Name, Namespace: ref.Namespace}, cm); err != nil {
+			return nil, errors.Wrap(err, errGetConfigMap)
+		}
+		if v, ok := cm.Data[ref.Key]; ok {
+			return []byte(v), nil
+		}
+		if v, ok := cm.BinaryData[ref.Key]; ok {
+			return v, nil
+		}
+		return nil, errors.Errorf(errKeyNotFound, ref.Key, "ConfigMap", ref.Namespace, ref.Name)
+	case p.ContentFrom != nil && p.ContentFrom.SecretKeyRef != nil:
+		ref := p.ContentFrom.SecretKeyRef
+		s := &corev1.Secret{}
+		if err := e.kube.Get(ctx, types.NamespacedName{Name: ref.Name, Namespace: ref.Namespace}, s); err != nil {
+			return nil, errors.Wrap(err, errGetSecret)
+		}
+		if v, ok := s.Data[ref.Key]; ok {
+			return v, nil
+		}
+		return nil, errors.Errorf(errKeyNotFound, ref.Key, "Secret", ref.Namespace, ref.Name)
+	}
+	return nil, errors.New(errNoContent)
+}
@@ -0,0 +1,498 @@
+/*
+Copyright 2023 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package object
+
+import (
+	"context"
+	"testing"
+
+	awss3 "github.com/aws/aws-sdk-go-v2/service/s3"
+	s3types "github.com/aws/aws-sdk-go-v2/service/s3/types"
+	"github.com/aws/smithy-go"
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+	corev1 "k8s.io/api/core/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+
+	"github.com/crossplane-contrib/provider-aws/apis/s3/v1alpha1"
+	awsclient "github.com/crossplane-contrib/provider-aws/pkg/clients"
+	"github.com/crossplane-contrib/provider-aws/pkg/clients/s3"
+	"github.com/crossplane-contrib/provider-aws/pkg/clients/s3/fake"
+)
+
+var (
+	// an arbitrary managed resource
+	unexpectedItem resource.Managed
+
+	bucketName = "test.s3.crossplane.com"
+	key        = "scripts/bootstrap.sh"
+	content    = "#!/bin/sh\necho hello\n"
+	// contentETag is the hex encoded MD5 digest of content.
+	contentETag = `"d604a220708aa59433ba410986cd4ffa"`
+	// contentMD5 is the base64 encoded MD5 digest of content.
+	contentMD5 = "1gSiIHCKpZQzukEJhs1P+g=="
+
+	errBoom = errors.New("boom")
+)
+
+type args struct {
+	s3   s3.ObjectClient
+	kube client.Client
+	cr   resource.Managed
+}
+
+type objectModifier func(*v1alpha1.Object)
+
+func withConditions(c ...xpv1.Condition) objectModifier {
+	return func(r *v1alpha1.Object) { r.Status.ConditionedStatus.Conditions = c }
+}
+
+func withContent(s string) objectModifier {
+	return func(r *v1alpha1.Object) { r.Spec.ForProvider.Content = &s }
+}
+
+func withConfigMapKeyRef(name, key string) objectModifier {
+	return func(r *v1alpha1.Object) {
+		r.Spec.ForProvider.ContentFrom = &v1alpha1.ObjectContentSource{
+			ConfigMapKeyRef: &v1alpha1.ConfigMapKeySelector{Name: name, Namespace: "default", Key: key},
+		}
+	}
+}
+
+func withTags(tags map[string]string) objectModifier {
+	return func(r *v1alpha1.Object) { r.Spec.ForProvider.Tags = tags }
+}
+
+func withAtProvider(o v1alpha1.ObjectObservation) objectModifier {
+	return func(r *v1alpha1.Object) { r.Status.AtProvider = o }
+}
+
+func object(m ...objectModifier) *v1alpha1.Object {
+	cr := &v1alpha1.Object{
+		Spec: v1alpha1.ObjectSpec{
+			ForProvider: v1alpha1.ObjectParameters{
+				Region:     "us-east-1",
+				BucketName: &bucketName,
+				Key:        key,
+			},
+		},
+	}
+	for _, f := range m {
+		f(cr)
+	}
+	return cr
+}
+
+func headObject(etag string) func(ctx context.Context, input *awss3.HeadObjectInput, opts []func(*awss3.Options)) (*awss3.HeadObjectOutput, error) {
+	return func(ctx context.Context, input *awss3.HeadObjectInput, opts []func(*awss3.Options)) (*awss3.HeadObjectOutput, error) {
+		return &awss3.HeadObjectOutput{ETag: awsclient.String(etag)}, nil
+	}
+}
+
+func getObjectTagging(tags ...s3types.Tag) func(ctx context.Context, input *awss3.GetObjectTaggingInput, opts []func(*awss3.Options)) (*awss3.GetObjectTaggingOutput, error) {
+	return func(ctx context.Context, input *awss3.GetObjectTaggingInput, opts []func(*awss3.Options)) (*awss3.GetObjectTaggingOutput, error) {
+		return &awss3.GetObjectTaggingOutput{TagSet: tags}, nil
+	}
+}
+
+func getConfigMap(data map[string]string) *test.MockClient {
+	return &test.MockClient{
+		MockGet: func(ctx context.Context, key client.ObjectKey, obj client.Object) error {
+			cm, ok := obj.(*corev1.ConfigMap)
+			if !ok {
+				return errBoom
+			}
+			cm.Data = data
+			return nil
+		},
+	}
+}
+
+func TestObserve(t *testing.T) {
+	type want struct {
+		cr     resource.Managed
+		result managed.ExternalObservation
+		err    error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"InValidInput": {
+			args: args{
+				cr: unexpectedItem,
+			},
+			want: want{
+				cr:  unexpectedItem,
+				err: errors.New(errUnexpectedObject),
+			},
+		},
+		"NotFound": {
+			args: args{
+				s3: &fake.MockObjectClient{
+					MockHeadObject: func(ctx context.Context, input *awss3.HeadObjectInput, opts []func(*awss3.Options)) (*awss3.HeadObjectOutput, error) {
+						return nil, &smithy.GenericAPIError{Code: s3.ObjectNotFoundErrCode}
+					},
+				},
+				cr: object(withContent(content)),
+			},
+			want: want{
+				cr:     object(withContent(content)),
+				result: managed.ExternalObservation{},
+			},
+		},
+		"ClientError": {
+			args: args{
+				s3: &fake.MockObjectClient{
+					MockHeadObject: func(ctx context.Context, input *awss3.HeadObjectInput, opts []func(*awss3.Options)) (*awss3.HeadObjectOutput, error) {
+						return nil, errBoom
+					},
+				},
+				cr: object(withContent(content)),
+			},
+			want: want{
+				cr:  object(withContent(content)),
+				err: awsclient.Wrap(errBoom, errHead),
+			},
+		},
+		"UpToDateFromConfigMap": {
+			args: args{
+				s3: &fake.MockObjectClient{
+					MockHeadObject:       headObject(contentETag),
+					MockGetObjectTagging: getObjectTagging(),
+				},
+				kube: getConfigMap(map[string]string{"bootstrap.sh": content}),
+				cr:   object(withConfigMapKeyRef("bootstrap", "bootstrap.sh")),
+			},
+			want: want{
+				cr: object(withConfigMapKeyRef("bootstrap", "bootstrap.sh"), withConditions(xpv1.Available())),
+				result: managed.ExternalObservation{
+					ResourceExists:   true,
+					ResourceUpToDate: true,
+				},
+			},
+		},
+		"ConfigMapKeyNotFound": {
+			args: args{
+				s3: &fake.MockObjectClient{
+					MockHeadObject: headObject(contentETag),
+				},
+				kube: getConfigMap(map[string]string{}),
+				cr:   object(withConfigMapKeyRef("bootstrap", "bootstrap.sh")),
+			},
+			want: want{
+				cr:  object(withConfigMapKeyRef("bootstrap", "bootstrap.sh")),
+				err: errors.Errorf(errKeyNotFound, "bootstrap.sh", "ConfigMap", "default", "bootstrap"),
+			},
+		},
+		"ContentChanged": {
+			args: args{
+				s3: &fake.MockObjectClient{
+					MockHeadObject:       headObject(contentETag),
+					MockGetObjectTagging: getObjectTagging(),
+				},
+				cr: object(withContent("changed")),
+			},
+			want: want{
+				cr: object(withContent("changed"), withConditions(xpv1.Available())),
+				result: managed.ExternalObservation{
+					ResourceExists:   true,
+					ResourceUpToDate: false,
+				},
+			},
+		},
+		"UpToDateWithLastUploadedETag": {
+			args: args{
+				s3: &fake.MockObjectClient{
+					MockHeadObject:       headObject(`"kms-etag"`),
+					MockGetObjectTagging: getObjectTagging(),
+				},
+				cr: object(withContent(content), withAtProvider(v1alpha1.ObjectObservation{ETag: `"kms-etag"`, ContentMD5: contentMD5})),
+			},
+			want: want{
+				cr: object(withContent(content), withAtProvider(v1alpha1.ObjectObservation{ETag: `"kms-etag"`, ContentMD5: contentMD5}),
+					withConditions(xpv1.Available())),
+				result: managed.ExternalObservation{
+					ResourceExists:   true,
+					ResourceUpToDate: true,
+				},
+			},
+		},
+		"TagsChanged": {
+			args: args{
+				s3: &fake.MockObjectClient{
+					MockHeadObject:       headObject(contentETag),
+					MockGetObjectTagging: getObjectTagging(s3types.Tag{Key: awsclient.String("team"), Value: awsclient.String("a")}),
+				},
+				cr: object(withContent(content), withTags(map[string]string{"team": "b"})),
+			},
+			want: want{
+				cr: object(withContent(content), withTags(map[string]string{"team": "b"}), withConditions(xpv1.Available())),
+				result: managed.ExternalObservation{
+					ResourceExists:   true,
+					ResourceUpToDate: false,
+				},
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{client: tc.s3, kube: tc.kube}
+			o, err := e.Observe(context.Background(), tc.args.cr)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.result, o); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestCreate(t *testing.T) {
+	type want struct {
+		cr  resource.Managed
+		err error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"InValidInput": {
+			args: args{
+				cr: unexpectedItem,
+			},
+			want: want{
+				cr:  unexpectedItem,
+				err: errors.New(errUnexpectedObject),
+			},
+		},
+		"NoContent": {
+			args: args{
+				cr: object(),
+			},
+			want: want{
+				cr:  object(withConditions(xpv1.Creating())),
+				err: errors.New(errNoContent),
+			},
+		},
+		"MoreThanOneContentSource": {
+			args: args{
+				cr: object(withContent(content), withConfigMapKeyRef("bootstrap", "bootstrap.sh")),
+			},
+			want: want{
+				cr:  object(withContent(content), withConfigMapKeyRef("bootstrap", "bootstrap.sh"), withConditions(xpv1.Creating())),
+				err: errors.New(errOnlyOneContent),
+			},
+		},
+		"ClientError": {
+			args: args{
+				s3: &fake.MockObjectClient{
+					MockPutObject: func(ctx context.Context, input *awss3.PutObjectInput, opts []func(*awss3.Options)) (*awss3.PutObjectOutput, error) {
+						return nil, errBoom
+					},
+				},
+				cr: object(withContent(content)),
+			},
+			want: want{
+				cr:  object(withContent(content), withConditions(xpv1.Creating())),
+				err: awsclient.Wrap(errBoom, errPut),
+			},
+		},
+		"ValidInput": {
+			args: args{
+				s3: &fake.MockObjectClient{
+					MockPutObject: func(ctx context.Context, input *awss3.PutObjectInput, opts []func(*awss3.Options)) (*awss3.PutObjectOutput, error) {
+						if diff := cmp.Diff("team=platform", awsclient.StringValue(input.Tagging)); diff != "" {
+							t.Errorf("r: -want, +got:\n%s", diff)
+						}
+						return &awss3.PutObjectOutput{ETag: awsclient.String(contentETag), VersionId: awsclient.String("v1")}, nil
+					},
+				},
+				cr: object(withContent(content), withTags(map[string]string{"team": "platform"})),
+			},
+			want: want{
+				cr: object(withContent(content), withTags(map[string]string{"team": "platform"}),
+					withConditions(xpv1.Creating()),
+					withAtProvider(v1alpha1.ObjectObservation{ETag: contentETag, VersionID: "v1", ContentMD5: contentMD5})),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{client: tc.s3, kube: tc.kube}
+			_, err := e.Create(context.Background(), tc.args.cr)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestUpdate(t *testing.T) {
+	type want struct {
+		cr  resource.Managed
+		err error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"ContentChanged": {
+			args: args{
+				s3: &fake.MockObjectClient{
+					MockHeadObject: headObject(`"outdated"`),
+					MockPutObject: func(ctx context.Context, input *awss3.PutObjectInput, opts []func(*awss3.Options)) (*awss3.PutObjectOutput, error) {
+						return &awss3.PutObjectOutput{ETag: awsclient.String(contentETag)}, nil
+					},
+				},
+				cr: object(withContent(content)),
+			},
+			want: want{
+				cr: object(withContent(content), withAtProvider(v1alpha1.ObjectObservation{ETag: contentETag, ContentMD5: contentMD5})),
+			},
+		},
+		"OnlyTagsChanged": {
+			args: args{
+				s3: &fake.MockObjectClient{
+					MockHeadObject: headObject(contentETag),
+					MockPutObjectTagging: func(ctx context.Context, input *awss3.PutObjectTaggingInput, opts []func(*awss3.Options)) (*awss3.PutObjectTaggingOutput, error) {
+						return nil, errBoom
+					},
+				},
+				cr: object(withContent(content), withTags(map[string]string{"team": "platform"})),
+			},
+			want: want{
+				cr:  object(withContent(content), withTags(map[string]string{"team": "platform"})),
+				err: awsclient.Wrap(errBoom, errPutTagging),
+			},
+		},
+		"TagsRemoved": {
+			args: args{
+				s3: &fake.MockObjectClient{
+					MockHeadObject: headObject(contentETag),
+					MockDeleteObjectTagging: func(ctx context.Context, input *awss3.DeleteObjectTaggingInput, opts []func(*awss3.Options)) (*awss3.DeleteObjectTaggingOutput, error) {
+						return &awss3.DeleteObjectTaggingOutput{}, nil
+					},
+				},
+				cr: object(withContent(content)),
+			},
+			want: want{
+				cr: object(withContent(content)),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{client: tc.s3, kube: tc.kube}
+			_, err := e.Update(context.Background(), tc.args.cr)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestDelete(t *testing.T) {
+	type want struct {
+		cr  resource.Managed
+		err error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"ValidInput": {
+			args: args{
+				s3: &fake.MockObjectClient{
+					MockDeleteObject: func(ctx context.Context, input *awss3.DeleteObjectInput, opts []func(*awss3.Options)) (*awss3.DeleteObjectOutput, error) {
+						return &awss3.DeleteObjectOutput{}, nil
+					},
+				},
+				cr: object(withContent(content)),
+			},
+			want: want{
+				cr: object(withContent(content), withConditions(xpv1.Deleting())),
+			},
+		},
+		"BucketNotFound": {
+			args: args{
+				s3: &fake.MockObjectClient{
+					MockDeleteObject: func(ctx context.Context, input *awss3.DeleteObjectInput, opts []func(*awss3.Options)) (*awss3.DeleteObjectOutput, error) {
+						return nil, &smithy.GenericAPIError{Code: "NoSuchBucket"}
+					},
+				},
+				cr: object(withContent(content)),
+			},
+			want: want{
+				cr: object(withContent(content), withConditions(xpv1.Deleting())),
+			},
+		},
+		"ClientError": {
+			args: args{
+				s3: &fake.MockObjectClient{
+					MockDeleteObject: func(ctx context.Context, input *awss3.DeleteObjectInput, opts []func(*awss3.Options)) (*awss3.DeleteObjectOutput, error) {
+						return nil, errBoom
+					},
+				},
+				cr: object(withContent(content)),
+			},
+			want: want{
+				cr:  object(withContent(content), withConditions(xpv1.Deleting())),
+				err: awsclient.Wrap(errBoom, errDelete),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{client: tc.s3, kube: tc.kube}
+			err := e.Delete(context.Background(), tc.args.cr)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
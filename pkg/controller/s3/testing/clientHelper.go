@@ -66,6 +66,24 @@ func Client(m ...ClientModifier) *fake.MockBucketClient {
 		MockDeletePublicAccessBlock: func(ctx context.Context, input *awss3.DeletePublicAccessBlockInput, opts []func(*awss3.Options)) (*awss3.DeletePublicAccessBlockOutput, error) {
 			return &awss3.DeletePublicAccessBlockOutput{}, nil
 		},
+		MockGetBucketOwnershipControls: func(ctx context.Context, input *awss3.GetBucketOwnershipControlsInput, opts []func(*awss3.Options)) (*awss3.GetBucketOwnershipControlsOutput, error) {
+			return nil, &smithy.GenericAPIError{Code: clients3.OwnershipControlsNotFoundErrCode}
+		},
+		MockGetObjectLockConfiguration: func(ctx context.Context, input *awss3.GetObjectLockConfigurationInput, opts []func(*awss3.Options)) (*awss3.GetObjectLockConfigurationOutput, error) {
+			return nil, &smithy.GenericAPIError{Code: clients3.ObjectLockNotFoundErrCode}
+		},
+		MockListBucketIntelligentTieringConfigurations: func(ctx context.Context, input *awss3.ListBucketIntelligentTieringConfigurationsInput, opts []func(*awss3.Options)) (*awss3.ListBucketIntelligentTieringConfigurationsOutput, error) {
+			return &awss3.ListBucketIntelligentTieringConfigurationsOutput{}, nil
+		},
+		MockListBucketInventoryConfigurations: func(ctx context.Context, input *awss3.ListBucketInventoryConfigurationsInput, opts []func(*awss3.Options)) (*awss3.ListBucketInventoryConfigurationsOutput, error) {
+			return &awss3.ListBucketInventoryConfigurationsOutput{}, nil
+		},
+		MockListBucketMetricsConfigurations: func(ctx context.Context, input *awss3.ListBucketMetricsConfigurationsInput, opts []func(*awss3.Options)) (*awss3.ListBucketMetricsConfigurationsOutput, error) {
+			return &awss3.ListBucketMetricsConfigurationsOutput{}, nil
+		},
+		MockListBucketAnalyticsConfigurations: func(ctx context.Context, input *awss3.ListBucketAnalyticsConfigurationsInput, opts []func(*awss3.Options)) (*awss3.ListBucketAnalyticsConfigurationsOutput, error) {
+			return &awss3.ListBucketAnalyticsConfigurationsOutput{}, nil
+		},
 		MockPutBucketOwnershipControls: func(ctx context.Context, input *awss3.PutBucketOwnershipControlsInput, opts []func(*awss3.Options)) (*awss3.PutBucketOwnershipControlsOutput, error) {
 			return &awss3.PutBucketOwnershipControlsOutput{}, nil
 		},
@@ -135,6 +153,13 @@ func WithPutACL(input func(ctx context.Context, input *awss3.PutBucketAclInput,
 	}
 }
 
+// WithGetOwnershipControls sets the MockGetBucketOwnershipControlsRequest of the mock S3 Client
+func WithGetOwnershipControls(input func(ctx context.Context, input *awss3.GetBucketOwnershipControlsInput, opts []func(*awss3.Options)) (*awss3.GetBucketOwnershipControlsOutput, error)) ClientModifier {
+	return func(client *fake.MockBucketClient) {
+		client.MockGetBucketOwnershipControls = input
+	}
+}
+
 // WithPutOwnershipControls sets the MockPutBucketOwnershipControlsRequest of the mock S3 Client
 func WithPutOwnershipControls(input func(ctx context.Context, input *awss3.PutBucketOwnershipControlsInput, opts []func(*awss3.Options)) (*awss3.PutBucketOwnershipControlsOutput, error)) ClientModifier {
 	return func(client *fake.MockBucketClient) {
@@ -49,6 +49,20 @@ func WithArn(arn string) BucketModifier {
 	}
 }
 
+// WithForceDestroy sets ForceDestroy for an S3 Bucket
+func WithForceDestroy(b bool) BucketModifier {
+	return func(bucket *v1beta1.Bucket) {
+		bucket.Spec.ForProvider.ForceDestroy = &b
+	}
+}
+
+// WithForceDestroyStatus sets the progress of emptying an S3 Bucket
+func WithForceDestroyStatus(s *v1beta1.BucketForceDestroyStatus) BucketModifier {
+	return func(bucket *v1beta1.Bucket) {
+		bucket.Status.AtProvider.ForceDestroy = s
+	}
+}
+
 // WithConditions sets the Conditions for an S3 Bucket
 func WithConditions(c ...xpv1.Condition) BucketModifier { //nolint
 	return func(r *v1beta1.Bucket) { r.Status.ConditionedStatus.Conditions = c }
@@ -119,6 +133,36 @@ func WithPolicyUpdatePolicy(s *v1beta1.BucketPolicyUpdatePolicy) BucketModifier
 	return func(r *v1beta1.Bucket) { r.Spec.ForProvider.PolicyUpdatePolicy = s }
 }
 
+// WithObjectOwnership sets ObjectOwnership for an S3 Bucket.
+func WithObjectOwnership(s *string) BucketModifier { // nolint
+	return func(r *v1beta1.Bucket) { r.Spec.ForProvider.ObjectOwnership = s }
+}
+
+// WithObjectLockConfig sets ObjectLockConfiguration for an S3 Bucket.
+func WithObjectLockConfig(s *v1beta1.ObjectLockConfiguration) BucketModifier { // nolint
+	return func(r *v1beta1.Bucket) { r.Spec.ForProvider.ObjectLockConfiguration = s }
+}
+
+// WithIntelligentTieringConfigs sets IntelligentTieringConfigurations for an S3 Bucket.
+func WithIntelligentTieringConfigs(s ...v1beta1.IntelligentTieringConfiguration) BucketModifier { // nolint
+	return func(r *v1beta1.Bucket) { r.Spec.ForProvider.IntelligentTieringConfigurations = s }
+}
+
+// WithInventoryConfigs sets InventoryConfigurations for an S3 Bucket.
+func WithInventoryConfigs(s ...v1beta1.InventoryConfiguration) BucketModifier { // nolint
+	return func(r *v1beta1.Bucket) { r.Spec.ForProvider.InventoryConfigurations = s }
+}
+
+// WithMetricsConfigs sets MetricsConfigurations for an S3 Bucket.
+func WithMetricsConfigs(s ...v1beta1.MetricsConfiguration) BucketModifier { // nolint
+	return func(r *v1beta1.Bucket) { r.Spec.ForProvider.MetricsConfigurations = s }
+}
+
+// WithAnalyticsConfigs sets AnalyticsConfigurations for an S3 Bucket.
+func WithAnalyticsConfigs(s ...v1beta1.AnalyticsConfiguration) BucketModifier { // nolint
+	return func(r *v1beta1.Bucket) { r.Spec.ForProvider.AnalyticsConfigurations = s }
+}
+
 // Bucket creates a v1beta1 Bucket for use in testing
 func Bucket(m ...BucketModifier) *v1beta1.Bucket {
 	cr := &v1beta1.Bucket{
@@ -63,6 +63,9 @@ const (
 	errOnlyOneSecretRef     = "only one of binarySecretRef or stringSecretRef must be set"
 	errParseSpecPolicy      = "cannot parse spec policy"
 	errParseExternalPolicy  = "cannot parse external policy"
+	errRotateSecret         = "cannot configure rotation of the secret"
+	errReplicateSecret      = "cannot replicate the secret to regions"
+	errRemoveReplicas       = "cannot remove regions from the replication of the secret"
 )
 
 // SetupSecret adds a controller that reconciles a Secret.
@@ -247,6 +250,13 @@ func (e *hooks) isUpToDate(cr *svcapitypes.Secret, resp *svcsdk.DescribeSecretOu
 	if len(add) != 0 && len(remove) != 0 {
 		return false, nil
 	}
+	if !isRotationUpToDate(&cr.Spec.ForProvider, resp) {
+		return false, nil
+	}
+	addReplicas, removeReplicas := DiffReplicas(cr.Spec.ForProvider.AddReplicaRegions, resp.ReplicationStatus)
+	if len(addReplicas) != 0 || len(removeReplicas) != 0 {
+		return false, nil
+	}
 
 	isPolicyUpToDate, err := e.isPolicyUpToDate(ctx, cr)
 	if err != nil {
@@ -256,9 +266,47 @@ func (e *hooks) isUpToDate(cr *svcapitypes.Secret, resp *svcsdk.DescribeSecretOu
 		return false, nil
 	}
 
+	// NOTE: The value of a rotated secret is managed by its rotation function.
+	if cr.Spec.ForProvider.RotationLambdaARN != nil || awsclients.BoolValue(resp.RotationEnabled) {
+		return true, nil
+	}
+
 	return e.isPayloadUpToDate(ctx, cr)
 }
 
+// isRotationUpToDate returns true if the rotation of the secret is turned on
+// with the desired function and rules. The rotation is not managed if no
+// function is given.
+func isRotationUpToDate(spec *svcapitypes.SecretParameters, resp *svcsdk.DescribeSecretOutput) bool {
+	if spec.RotationLambdaARN == nil {
+		return true
+	}
+	if !awsclients.BoolValue(resp.RotationEnabled) ||
+		awsclients.StringValue(spec.RotationLambdaARN) != awsclients.StringValue(resp.RotationLambdaARN) {
+		return false
+	}
+	rules := spec.RotationRules
+	if rules == nil {
+		return true
+	}
+	observed := resp.RotationRules
+	if observed == nil {
+		observed = &svcsdk.RotationRulesType{}
+	}
+	// NOTE: Secrets Manager derives AutomaticallyAfterDays from rate
+	// expressions, hence only the rules that are set are compared.
+	if rules.AutomaticallyAfterDays != nil && awsclients.Int64Value(rules.AutomaticallyAfterDays) != awsclients.Int64Value(observed.AutomaticallyAfterDays) {
+		return false
+	}
+	if rules.Duration != nil && awsclients.StringValue(rules.Duration) != awsclients.StringValue(observed.Duration) {
+		return false
+	}
+	if rules.ScheduleExpression != nil && awsclients.StringValue(rules.ScheduleExpression) != awsclients.StringValue(observed.ScheduleExpression) {
+		return false
+	}
+	return true
+}
+
 func (e *hooks) isPolicyUpToDate(ctx context.Context, cr *svcapitypes.Secret) (bool, error) {
 	res, err := e.client.GetResourcePolicyWithContext(ctx, &svcsdk.GetResourcePolicyInput{
 		SecretId: awsclients.String(meta.GetExternalName(cr)),
@@ -391,6 +439,21 @@ func (e *hooks) preUpdate(ctx context.Context, cr *svcapitypes.Secret, obj *svcs
 		}
 	}
 
+	if err := e.updateReplicas(ctx, cr, resp); err != nil {
+		return err
+	}
+	if err := e.updateRotation(ctx, cr, resp); err != nil {
+		return err
+	}
+
+	obj.SecretId = awsclients.String(meta.GetExternalName(cr))
+	obj.Description = cr.Spec.ForProvider.Description
+	obj.KmsKeyId = cr.Spec.ForProvider.KMSKeyID
+
+	// The value of a rotated secret is managed by its rotation function.
+	if cr.Spec.ForProvider.RotationLambdaARN != nil || awsclients.BoolValue(resp.RotationEnabled) {
+		return nil
+	}
 	payload, err := e.getPayload(ctx, &cr.Spec.ForProvider)
 	if err != nil {
 		return err
@@ -401,12 +464,51 @@ func (e *hooks) preUpdate(ctx context.Context, cr *svcapitypes.Secret, obj *svcs
 	case cr.Spec.ForProvider.BinarySecretRef != nil:
 		obj.SecretBinary = payload
 	}
-	obj.SecretId = awsclients.String(meta.GetExternalName(cr))
-	obj.Description = cr.Spec.ForProvider.Description
-	obj.KmsKeyId = cr.Spec.ForProvider.KMSKeyID
 	return nil
 }
 
+func (e *hooks) updateReplicas(ctx context.Context, cr *svcapitypes.Secret, resp *svcsdk.DescribeSecretOutput) error {
+	add, remove := DiffReplicas(cr.Spec.ForProvider.AddReplicaRegions, resp.ReplicationStatus)
+	if len(remove) != 0 {
+		if _, err := e.client.RemoveRegionsFromReplicationWithContext(ctx, &svcsdk.RemoveRegionsFromReplicationInput{
+			SecretId:             awsclients.String(meta.GetExternalName(cr)),
+			RemoveReplicaRegions: remove,
+		}); err != nil {
+			return awsclients.Wrap(err, errRemoveReplicas)
+		}
+	}
+	if len(add) != 0 {
+		if _, err := e.client.ReplicateSecretToRegionsWithContext(ctx, &svcsdk.ReplicateSecretToRegionsInput{
+			SecretId:                    awsclients.String(meta.GetExternalName(cr)),
+			AddReplicaRegions:           add,
+			ForceOverwriteReplicaSecret: cr.Spec.ForProvider.ForceOverwriteReplicaSecret,
+		}); err != nil {
+			return awsclients.Wrap(err, errReplicateSecret)
+		}
+	}
+	return nil
+}
+
+func (e *hooks) updateRotation(ctx context.Context, cr *svcapitypes.Secret, resp *svcsdk.DescribeSecretOutput) error {
+	if isRotationUpToDate(&cr.Spec.ForProvider, resp) {
+		return nil
+	}
+	input := &svcsdk.RotateSecretInput{
+		SecretId:          awsclients.String(meta.GetExternalName(cr)),
+		RotationLambdaARN: cr.Spec.ForProvider.RotationLambdaARN,
+		RotateImmediately: cr.Spec.ForProvider.RotateImmediately,
+	}
+	if rules := cr.Spec.ForProvider.RotationRules; rules != nil {
+		input.RotationRules = &svcsdk.RotationRulesType{
+			AutomaticallyAfterDays: rules.AutomaticallyAfterDays,
+			Duration:               rules.Duration,
+			ScheduleExpression:     rules.ScheduleExpression,
+		}
+	}
+	_, err := e.client.RotateSecretWithContext(ctx, input)
+	return awsclients.Wrap(err, errRotateSecret)
+}
+
 func (e *hooks) preCreate(ctx context.Context, cr *svcapitypes.Secret, obj *svcsdk.CreateSecretInput) error {
 	payload, err := e.getPayload(ctx, &cr.Spec.ForProvider)
 	if err != nil {
@@ -419,6 +521,9 @@ func (e *hooks) preCreate(ctx context.Context, cr *svcapitypes.Secret, obj *svcs
 		obj.SecretBinary = payload
 	}
 	obj.Name = awsclients.String(meta.GetExternalName(cr))
+	for _, r := range cr.Spec.ForProvider.AddReplicaRegions {
+		obj.AddReplicaRegions = append(obj.AddReplicaRegions, &svcsdk.ReplicaRegionType{Region: r.Region, KmsKeyId: r.KMSKeyID})
+	}
 	return nil
 }
 
@@ -443,8 +548,8 @@ func (t *tagger) Initialize(ctx context.Context, mg resource.Managed) error {
 	for _, tags := range cr.Spec.ForProvider.Tags {
 		tagMap[awsclients.StringValue(tags.Key)] = awsclients.StringValue(tags.Value)
 	}
-	for k, v := range resource.GetExternalTags(mg) {
-		tagMap[k] = v
+	if err := awsclients.AddDefaultTags(ctx, t.kube, mg, tagMap); err != nil {
+		return err
 	}
 	cr.Spec.ForProvider.Tags = make([]*svcapitypes.Tag, len(tagMap))
 	i := 0
@@ -480,3 +585,32 @@ func DiffTags(spec []*svcapitypes.Tag, current []*svcsdk.Tag) (addTags []*svcsdk
 	}
 	return
 }
+
+// DiffReplicas returns the replica regions that should be added and the
+// regions whose replicas should be removed. A replica that is encrypted with
+// another KMS key than the desired one is removed, it is added again with the
+// desired key by the next update. Note that this deletes the replica secret.
+// Replicas are not managed if no replica regions are desired.
+func DiffReplicas(spec []*svcapitypes.ReplicaRegionType, current []*svcsdk.ReplicationStatusType) (add []*svcsdk.ReplicaRegionType, remove []*string) {
+	if len(spec) == 0 {
+		return nil, nil
+	}
+	desired := make(map[string]*svcapitypes.ReplicaRegionType, len(spec))
+	for _, r := range spec {
+		desired[awsclients.StringValue(r.Region)] = r
+	}
+	observed := make(map[string]bool, len(current))
+	for _, r := range current {
+		observed[awsclients.StringValue(r.Region)] = true
+		d, ok := desired[awsclients.StringValue(r.Region)]
+		if !ok || (d.KMSKeyID != nil && awsclients.StringValue(d.KMSKeyID) != awsclients.StringValue(r.KmsKeyId)) {
+			remove = append(remove, r.Region)
+		}
+	}
+	for _, r := range spec {
+		if !observed[awsclients.StringValue(r.Region)] {
+			add = append(add, &svcsdk.ReplicaRegionType{Region: r.Region, KmsKeyId: r.KMSKeyID})
+		}
+	}
+	return add, remove
+}
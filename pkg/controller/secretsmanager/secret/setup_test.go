@@ -22,9 +22,11 @@ import (
 	"encoding/json"
 	"testing"
 
+	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/secretsmanager"
 	"github.com/aws/aws-sdk-go/service/secretsmanager/secretsmanageriface"
 	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
 	corev1 "k8s.io/api/core/v1"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 
@@ -34,6 +36,7 @@ import (
 	"github.com/crossplane/crossplane-runtime/pkg/test"
 
 	"github.com/crossplane-contrib/provider-aws/apis/secretsmanager/v1beta1"
+	awsclients "github.com/crossplane-contrib/provider-aws/pkg/clients"
 	"github.com/crossplane-contrib/provider-aws/pkg/clients/secretsmanager/fake"
 )
 
@@ -42,6 +45,10 @@ var (
 	issue1804Policy string
 	//go:embed testdata/issue1804_policy.json
 	issue1804PolicyCompact string
+
+	lambdaARN = "arn:aws:lambda:us-east-1:123456789012:function:rotate"
+
+	errBoom = errors.New("boom")
 )
 
 type args struct {
@@ -64,6 +71,10 @@ func withExternalName(n string) secretModifier {
 	return func(s *v1beta1.Secret) { meta.SetExternalName(s, n) }
 }
 
+func withStatus(o v1beta1.SecretObservation) secretModifier {
+	return func(r *v1beta1.Secret) { r.Status.AtProvider = o }
+}
+
 func secret(m ...secretModifier) *v1beta1.Secret {
 	cr := &v1beta1.Secret{}
 	for _, f := range m {
@@ -149,6 +160,140 @@ func TestObserve(t *testing.T) {
 				},
 			},
 		},
+		"RotationNotEnabled": {
+			args: args{
+				secretsmanager: &fake.MockSecretsManagerClient{
+					MockDescribeSecretWithContext: func(dsi *secretsmanager.DescribeSecretInput) (*secretsmanager.DescribeSecretOutput, error) {
+						return &secretsmanager.DescribeSecretOutput{}, nil
+					},
+				},
+				kube: &test.MockClient{
+					MockGet: test.NewMockGetFn(nil),
+				},
+				cr: secret(
+					withExternalName("test"),
+					withSpec(v1beta1.SecretParameters{
+						CustomSecretParameters: v1beta1.CustomSecretParameters{
+							StringSecretRef:   &v1beta1.SecretReference{Name: "test-secret", Namespace: "test"},
+							RotationLambdaARN: &lambdaARN,
+						},
+					}),
+				),
+			},
+			want: want{
+				cr: secret(
+					withExternalName("test"),
+					withSpec(v1beta1.SecretParameters{
+						CustomSecretParameters: v1beta1.CustomSecretParameters{
+							StringSecretRef:   &v1beta1.SecretReference{Name: "test-secret", Namespace: "test"},
+							RotationLambdaARN: &lambdaARN,
+						},
+					}),
+					withConditions(xpv1.Available()),
+				),
+				result: managed.ExternalObservation{
+					ResourceExists:   true,
+					ResourceUpToDate: false,
+				},
+			},
+		},
+		"RotatedSecretIgnoresValue": {
+			args: args{
+				secretsmanager: &fake.MockSecretsManagerClient{
+					MockDescribeSecretWithContext: func(dsi *secretsmanager.DescribeSecretInput) (*secretsmanager.DescribeSecretOutput, error) {
+						return &secretsmanager.DescribeSecretOutput{
+							RotationEnabled:   aws.Bool(true),
+							RotationLambdaARN: &lambdaARN,
+							RotationRules: &secretsmanager.RotationRulesType{
+								AutomaticallyAfterDays: aws.Int64(10),
+								ScheduleExpression:     aws.String("rate(10 days)"),
+							},
+							ReplicationStatus: []*secretsmanager.ReplicationStatusType{
+								{Region: aws.String("eu-west-1"), KmsKeyId: aws.String("alias/aws/secretsmanager")},
+							},
+						}, nil
+					},
+					MockGetResourcePolicyWithContext: func(grpi *secretsmanager.GetResourcePolicyInput) (*secretsmanager.GetResourcePolicyOutput, error) {
+						return &secretsmanager.GetResourcePolicyOutput{}, nil
+					},
+				},
+				kube: &test.MockClient{
+					MockGet: test.NewMockGetFn(nil),
+				},
+				cr: secret(
+					withExternalName("test"),
+					withSpec(v1beta1.SecretParameters{
+						CustomSecretParameters: v1beta1.CustomSecretParameters{
+							AddReplicaRegions: []*v1beta1.ReplicaRegionType{{Region: aws.String("eu-west-1")}},
+							StringSecretRef:   &v1beta1.SecretReference{Name: "test-secret", Namespace: "test"},
+							RotationLambdaARN: &lambdaARN,
+							RotationRules:     &v1beta1.RotationRulesType{ScheduleExpression: aws.String("rate(10 days)")},
+						},
+					}),
+				),
+			},
+			want: want{
+				cr: secret(
+					withExternalName("test"),
+					withSpec(v1beta1.SecretParameters{
+						CustomSecretParameters: v1beta1.CustomSecretParameters{
+							AddReplicaRegions: []*v1beta1.ReplicaRegionType{{Region: aws.String("eu-west-1")}},
+							StringSecretRef:   &v1beta1.SecretReference{Name: "test-secret", Namespace: "test"},
+							RotationLambdaARN: &lambdaARN,
+							RotationRules:     &v1beta1.RotationRulesType{ScheduleExpression: aws.String("rate(10 days)")},
+						},
+					}),
+					withStatus(v1beta1.SecretObservation{
+						ReplicationStatus: []*v1beta1.ReplicationStatusType{
+							{Region: aws.String("eu-west-1"), KMSKeyID: aws.String("alias/aws/secretsmanager")},
+						},
+						RotationEnabled: aws.Bool(true),
+					}),
+					withConditions(xpv1.Available()),
+				),
+				result: managed.ExternalObservation{
+					ResourceExists:   true,
+					ResourceUpToDate: true,
+				},
+			},
+		},
+		"ReplicaMissing": {
+			args: args{
+				secretsmanager: &fake.MockSecretsManagerClient{
+					MockDescribeSecretWithContext: func(dsi *secretsmanager.DescribeSecretInput) (*secretsmanager.DescribeSecretOutput, error) {
+						return &secretsmanager.DescribeSecretOutput{}, nil
+					},
+				},
+				kube: &test.MockClient{
+					MockGet: test.NewMockGetFn(nil),
+				},
+				cr: secret(
+					withExternalName("test"),
+					withSpec(v1beta1.SecretParameters{
+						CustomSecretParameters: v1beta1.CustomSecretParameters{
+							AddReplicaRegions: []*v1beta1.ReplicaRegionType{{Region: aws.String("eu-west-1")}},
+							StringSecretRef:   &v1beta1.SecretReference{Name: "test-secret", Namespace: "test"},
+						},
+					}),
+				),
+			},
+			want: want{
+				cr: secret(
+					withExternalName("test"),
+					withSpec(v1beta1.SecretParameters{
+						CustomSecretParameters: v1beta1.CustomSecretParameters{
+							AddReplicaRegions: []*v1beta1.ReplicaRegionType{{Region: aws.String("eu-west-1")}},
+							StringSecretRef:   &v1beta1.SecretReference{Name: "test-secret", Namespace: "test"},
+						},
+					}),
+					withConditions(xpv1.Available()),
+				),
+				result: managed.ExternalObservation{
+					ResourceExists:   true,
+					ResourceUpToDate: false,
+				},
+			},
+		},
 	}
 
 	for name, tc := range cases {
@@ -168,3 +313,186 @@ func TestObserve(t *testing.T) {
 		})
 	}
 }
+
+func TestUpdate(t *testing.T) {
+	type want struct {
+		err       error
+		rotate    *secretsmanager.RotateSecretInput
+		cancel    bool
+		replicate *secretsmanager.ReplicateSecretToRegionsInput
+		remove    *secretsmanager.RemoveRegionsFromReplicationInput
+		value     bool
+	}
+
+	cases := map[string]struct {
+		observed *secretsmanager.DescribeSecretOutput
+		spec     v1beta1.SecretParameters
+		rotateFn func(*secretsmanager.RotateSecretInput) (*secretsmanager.RotateSecretOutput, error)
+		want     want
+	}{
+		"TurnOnRotation": {
+			observed: &secretsmanager.DescribeSecretOutput{},
+			spec: v1beta1.SecretParameters{
+				CustomSecretParameters: v1beta1.CustomSecretParameters{
+					StringSecretRef:   &v1beta1.SecretReference{Name: "test-secret", Namespace: "test"},
+					RotationLambdaARN: &lambdaARN,
+					RotationRules:     &v1beta1.RotationRulesType{ScheduleExpression: aws.String("rate(10 days)")},
+					RotateImmediately: aws.Bool(false),
+				},
+			},
+			want: want{
+				rotate: &secretsmanager.RotateSecretInput{
+					SecretId:          aws.String("test"),
+					RotationLambdaARN: &lambdaARN,
+					RotationRules:     &secretsmanager.RotationRulesType{ScheduleExpression: aws.String("rate(10 days)")},
+					RotateImmediately: aws.Bool(false),
+				},
+			},
+		},
+		"UnmanagedRotation": {
+			observed: &secretsmanager.DescribeSecretOutput{
+				RotationEnabled:   aws.Bool(true),
+				RotationLambdaARN: &lambdaARN,
+			},
+			spec: v1beta1.SecretParameters{
+				CustomSecretParameters: v1beta1.CustomSecretParameters{
+					StringSecretRef: &v1beta1.SecretReference{Name: "test-secret", Namespace: "test"},
+				},
+			},
+			want: want{},
+		},
+		"RotateError": {
+			observed: &secretsmanager.DescribeSecretOutput{},
+			spec: v1beta1.SecretParameters{
+				CustomSecretParameters: v1beta1.CustomSecretParameters{
+					StringSecretRef:   &v1beta1.SecretReference{Name: "test-secret", Namespace: "test"},
+					RotationLambdaARN: &lambdaARN,
+				},
+			},
+			rotateFn: func(*secretsmanager.RotateSecretInput) (*secretsmanager.RotateSecretOutput, error) {
+				return nil, errBoom
+			},
+			want: want{
+				err: errors.Wrap(awsclients.Wrap(errBoom, errRotateSecret), "pre-update failed"),
+				rotate: &secretsmanager.RotateSecretInput{
+					SecretId:          aws.String("test"),
+					RotationLambdaARN: &lambdaARN,
+				},
+			},
+		},
+		"UpdateReplicas": {
+			observed: &secretsmanager.DescribeSecretOutput{
+				ReplicationStatus: []*secretsmanager.ReplicationStatusType{
+					{Region: aws.String("eu-west-1"), KmsKeyId: aws.String("alias/aws/secretsmanager")},
+					{Region: aws.String("eu-central-1"), KmsKeyId: aws.String("alias/aws/secretsmanager")},
+				},
+			},
+			spec: v1beta1.SecretParameters{
+				ForceOverwriteReplicaSecret: aws.Bool(true),
+				CustomSecretParameters: v1beta1.CustomSecretParameters{
+					AddReplicaRegions: []*v1beta1.ReplicaRegionType{
+						{Region: aws.String("eu-west-1")},
+						{Region: aws.String("us-west-2"), KMSKeyID: aws.String("alias/replica")},
+					},
+					StringSecretRef: &v1beta1.SecretReference{Name: "test-secret", Namespace: "test"},
+				},
+			},
+			want: want{
+				replicate: &secretsmanager.ReplicateSecretToRegionsInput{
+					SecretId: aws.String("test"),
+					AddReplicaRegions: []*secretsmanager.ReplicaRegionType{
+						{Region: aws.String("us-west-2"), KmsKeyId: aws.String("alias/replica")},
+					},
+					ForceOverwriteReplicaSecret: aws.Bool(true),
+				},
+				remove: &secretsmanager.RemoveRegionsFromReplicationInput{
+					SecretId:             aws.String("test"),
+					RemoveReplicaRegions: []*string{aws.String("eu-central-1")},
+				},
+				value: true,
+			},
+		},
+		"UnmanagedReplicas": {
+			observed: &secretsmanager.DescribeSecretOutput{
+				ReplicationStatus: []*secretsmanager.ReplicationStatusType{
+					{Region: aws.String("eu-west-1"), KmsKeyId: aws.String("alias/aws/secretsmanager")},
+				},
+			},
+			spec: v1beta1.SecretParameters{
+				CustomSecretParameters: v1beta1.CustomSecretParameters{
+					StringSecretRef: &v1beta1.SecretReference{Name: "test-secret", Namespace: "test"},
+				},
+			},
+			want: want{
+				value: true,
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			var (
+				rotate    *secretsmanager.RotateSecretInput
+				cancel    bool
+				replicate *secretsmanager.ReplicateSecretToRegionsInput
+				remove    *secretsmanager.RemoveRegionsFromReplicationInput
+				value     bool
+			)
+			client := &fake.MockSecretsManagerClient{
+				MockDescribeSecretWithContext: func(*secretsmanager.DescribeSecretInput) (*secretsmanager.DescribeSecretOutput, error) {
+					return tc.observed, nil
+				},
+				MockDeleteResourcePolicyWithContext: func(*secretsmanager.DeleteResourcePolicyInput) (*secretsmanager.DeleteResourcePolicyOutput, error) {
+					return &secretsmanager.DeleteResourcePolicyOutput{}, nil
+				},
+				MockUpdateSecretWithContext: func(in *secretsmanager.UpdateSecretInput) (*secretsmanager.UpdateSecretOutput, error) {
+					value = in.SecretString != nil
+					return &secretsmanager.UpdateSecretOutput{}, nil
+				},
+				MockRotateSecretWithContext: func(in *secretsmanager.RotateSecretInput) (*secretsmanager.RotateSecretOutput, error) {
+					rotate = in
+					if tc.rotateFn != nil {
+						return tc.rotateFn(in)
+					}
+					return &secretsmanager.RotateSecretOutput{}, nil
+				},
+				MockCancelRotateSecretWithContext: func(*secretsmanager.CancelRotateSecretInput) (*secretsmanager.CancelRotateSecretOutput, error) {
+					cancel = true
+					return &secretsmanager.CancelRotateSecretOutput{}, nil
+				},
+				MockReplicateSecretToRegionsWithContext: func(in *secretsmanager.ReplicateSecretToRegionsInput) (*secretsmanager.ReplicateSecretToRegionsOutput, error) {
+					replicate = in
+					return &secretsmanager.ReplicateSecretToRegionsOutput{}, nil
+				},
+				MockRemoveRegionsFromReplicationWithContext: func(in *secretsmanager.RemoveRegionsFromReplicationInput) (*secretsmanager.RemoveRegionsFromReplicationOutput, error) {
+					remove = in
+					return &secretsmanager.RemoveRegionsFromReplicationOutput{}, nil
+				},
+			}
+			kube := &test.MockClient{
+				MockGet: test.NewMockGetFn(nil),
+			}
+			e := newExternal(kube, client, []option{setupExternal})
+			_, err := e.Update(context.Background(), secret(withExternalName("test"), withSpec(tc.spec)))
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.rotate, rotate); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cancel, cancel); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.replicate, replicate); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.remove, remove); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.value, value); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
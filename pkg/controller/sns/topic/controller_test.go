@@ -19,6 +19,7 @@ package topic
 import (
 	"context"
 	"fmt"
+	"sort"
 	"testing"
 
 	"github.com/crossplane-contrib/provider-aws/apis/sns/v1beta1"
@@ -516,3 +517,65 @@ func TestDelete(t *testing.T) {
 		})
 	}
 }
+
+func TestInitialize(t *testing.T) {
+	withTags := func(tagMaps ...map[string]string) topicModifier {
+		return func(r *v1beta1.Topic) {
+			tagMap := map[string]string{}
+			for _, m := range tagMaps {
+				for k, v := range m {
+					tagMap[k] = v
+				}
+			}
+			r.Spec.ForProvider.Tags = make([]v1beta1.Tag, 0, len(tagMap))
+			for k, v := range tagMap {
+				r.Spec.ForProvider.Tags = append(r.Spec.ForProvider.Tags, v1beta1.Tag{Key: k, Value: awsclient.String(v, awsclient.FieldRequired)})
+			}
+			sort.Slice(r.Spec.ForProvider.Tags, func(i, j int) bool {
+				return r.Spec.ForProvider.Tags[i].Key < r.Spec.ForProvider.Tags[j].Key
+			})
+		}
+	}
+	type want struct {
+		cr  resource.Managed
+		err error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"Successful": {
+			args: args{
+				cr:   topic(withTags(map[string]string{"foo": "bar"})),
+				kube: &test.MockClient{MockUpdate: test.NewMockUpdateFn(nil)},
+			},
+			want: want{
+				cr: topic(withTags(resource.GetExternalTags(topic()), map[string]string{"foo": "bar"})),
+			},
+		},
+		"AlreadyTagged": {
+			args: args{
+				cr:   topic(withTags(resource.GetExternalTags(topic()), map[string]string{"foo": "bar"})),
+				kube: &test.MockClient{MockUpdate: test.NewMockUpdateFn(errBoom)},
+			},
+			want: want{
+				cr: topic(withTags(resource.GetExternalTags(topic()), map[string]string{"foo": "bar"})),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &tagger{kube: tc.kube}
+			err := e.Initialize(context.Background(), tc.args.cr)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr); err == nil && diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
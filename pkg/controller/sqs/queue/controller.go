@@ -71,6 +71,7 @@ func SetupQueue(mgr ctrl.Manager, o controller.Options) error {
 		Complete(managed.NewReconciler(mgr,
 			resource.ManagedKind(v1beta1.QueueGroupVersionKind),
 			managed.WithExternalConnecter(&connector{kube: mgr.GetClient(), newClientFn: sqs.NewClient}),
+			managed.WithInitializers(managed.NewNameAsExternalName(mgr.GetClient()), &tagger{kube: mgr.GetClient()}),
 			managed.WithPollInterval(o.PollInterval),
 			managed.WithLogger(o.Logger.WithValues("controller", name)),
 			managed.WithRecorder(event.NewAPIRecorder(mgr.GetEventRecorderFor(name))),
@@ -237,3 +238,25 @@ func (e *external) Delete(ctx context.Context, mg resource.Managed) error {
 	})
 	return awsclient.Wrap(resource.Ignore(sqs.IsNotFound, err), errDeleteFailed)
 }
+
+type tagger struct {
+	kube client.Client
+}
+
+func (t *tagger) Initialize(ctx context.Context, mg resource.Managed) error {
+	cr, ok := mg.(*v1beta1.Queue)
+	if !ok {
+		return errors.New(errNotQueue)
+	}
+	if cr.Spec.ForProvider.Tags == nil {
+		cr.Spec.ForProvider.Tags = map[string]string{}
+	}
+	changed, err := awsclient.MergeDefaultTags(ctx, t.kube, mg, cr.Spec.ForProvider.Tags)
+	if err != nil {
+		return err
+	}
+	if !changed {
+		return nil
+	}
+	return errors.Wrap(t.kube.Update(ctx, cr), errKubeUpdateFailed)
+}
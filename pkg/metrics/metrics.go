@@ -0,0 +1,144 @@
+/*
+Copyright 2023 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package metrics contains the Prometheus metrics that are recorded for the
+// AWS API calls made by the provider.
+package metrics
+
+import (
+	"context"
+	"strconv"
+	"time"
+
+	awsmiddleware "github.com/aws/aws-sdk-go-v2/aws/middleware"
+	"github.com/aws/aws-sdk-go-v2/aws/retry"
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	requestv1 "github.com/aws/aws-sdk-go/aws/request"
+	"github.com/aws/smithy-go"
+	"github.com/aws/smithy-go/middleware"
+	"github.com/pkg/errors"
+	"github.com/prometheus/client_golang/prometheus"
+)
+
+const (
+	namespace = "aws"
+	subsystem = "api"
+
+	// CodeOK is the code label value of calls that did not return an error.
+	CodeOK = "OK"
+	// CodeUnknown is the code label value of calls that failed without an
+	// AWS API error code, e.g. because of a network error.
+	CodeUnknown = "Unknown"
+)
+
+// Label names of the AWS API call metrics.
+const (
+	LabelService        = "service"
+	LabelOperation      = "operation"
+	LabelProviderConfig = "provider_config"
+	LabelCode           = "code"
+	LabelThrottled      = "throttled"
+)
+
+var (
+	// APICalls counts the AWS API calls made by the provider.
+	APICalls = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Namespace: namespace,
+		Subsystem: subsystem,
+		Name:      "calls_total",
+		Help:      "Number of AWS API calls made by the provider, partitioned by service, operation, ProviderConfig, result code and whether the call was throttled.",
+	}, []string{LabelService, LabelOperation, LabelProviderConfig, LabelCode, LabelThrottled})
+
+	// APICallDuration observes the latency of the AWS API calls made by the
+	// provider, including any retries.
+	APICallDuration = prometheus.NewHistogramVec(prometheus.HistogramOpts{
+		Namespace: namespace,
+		Subsystem: subsystem,
+		Name:      "call_duration_seconds",
+		Help:      "Latency of AWS API calls made by the provider including retries, partitioned by service, operation and ProviderConfig.",
+		Buckets:   prometheus.DefBuckets,
+	}, []string{LabelService, LabelOperation, LabelProviderConfig})
+)
+
+// Register registers the AWS API call metrics with the supplied registerer.
+func Register(r prometheus.Registerer) error {
+	for _, c := range []prometheus.Collector{APICalls, APICallDuration} {
+		if err := r.Register(c); err != nil {
+			return errors.Wrap(err, "cannot register AWS API metrics")
+		}
+	}
+	return nil
+}
+
+// ObserveAPICall records a single AWS API call that took d to complete.
+func ObserveAPICall(service, operation, providerConfig, code string, throttled bool, d time.Duration) {
+	APICalls.WithLabelValues(service, operation, providerConfig, code, strconv.FormatBool(throttled)).Inc()
+	APICallDuration.WithLabelValues(service, operation, providerConfig).Observe(d.Seconds())
+}
+
+// ErrorCode returns the AWS API error code of the supplied error. It returns
+// CodeOK for nil errors and CodeUnknown for errors that do not carry an AWS
+// API error code. Errors of both AWS SDK v1 and v2 are supported.
+func ErrorCode(err error) string {
+	if err == nil {
+		return CodeOK
+	}
+	var apiErr smithy.APIError
+	if errors.As(err, &apiErr) && apiErr.ErrorCode() != "" {
+		return apiErr.ErrorCode()
+	}
+	if v1Err, ok := err.(awserr.Error); ok && v1Err.Code() != "" { //nolint:errorlint
+		return v1Err.Code()
+	}
+	return CodeUnknown
+}
+
+// APIOptionV2 returns an AWS SDK v2 API option that records metrics for every
+// call made by clients built from a config produced for the supplied
+// ProviderConfig.
+func APIOptionV2(providerConfig string) func(*middleware.Stack) error {
+	return func(s *middleware.Stack) error {
+		// NOTE: The service metadata is registered by the client at the very
+		// beginning of the initialize step so it's available to us once we
+		// are at its end. Retries happen further down in the finalize step,
+		// so the latency we observe here includes them.
+		return s.Initialize.Add(middleware.InitializeMiddlewareFunc("crossplane.APICallMetrics",
+			func(ctx context.Context, in middleware.InitializeInput, next middleware.InitializeHandler) (middleware.InitializeOutput, middleware.Metadata, error) {
+				start := time.Now()
+				out, md, err := next.HandleInitialize(ctx, in)
+				throttled := err != nil && retry.IsErrorThrottles(retry.DefaultThrottles).IsErrorThrottle(err).Bool()
+				ObserveAPICall(awsmiddleware.GetServiceID(ctx), awsmiddleware.GetOperationName(ctx), providerConfig, ErrorCode(err), throttled, time.Since(start))
+				return out, md, err
+			}), middleware.After)
+	}
+}
+
+// HandlerV1 returns an AWS SDK v1 handler that records metrics for every call
+// made by clients built from a session produced for the supplied
+// ProviderConfig. It is meant to be added to the Complete handler list, which
+// runs once per call after all retries are done.
+func HandlerV1(providerConfig string) requestv1.NamedHandler {
+	return requestv1.NamedHandler{
+		Name: "crossplane.APICallMetricsHandler",
+		Fn: func(r *requestv1.Request) {
+			op := ""
+			if r.Operation != nil {
+				op = r.Operation.Name
+			}
+			ObserveAPICall(r.ClientInfo.ServiceID, op, providerConfig, ErrorCode(r.Error), requestv1.IsErrorThrottle(r.Error), time.Since(r.Time))
+		},
+	}
+}
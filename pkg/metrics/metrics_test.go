@@ -0,0 +1,179 @@
+/*
+Copyright 2023 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package metrics
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	awsmiddleware "github.com/aws/aws-sdk-go-v2/aws/middleware"
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	awsmetadatav1 "github.com/aws/aws-sdk-go/aws/client/metadata"
+	requestv1 "github.com/aws/aws-sdk-go/aws/request"
+	"github.com/aws/smithy-go"
+	"github.com/aws/smithy-go/middleware"
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/testutil"
+)
+
+func TestErrorCode(t *testing.T) {
+	cases := map[string]struct {
+		err  error
+		want string
+	}{
+		"NoError": {
+			want: CodeOK,
+		},
+		"V2APIError": {
+			err:  errors.Wrap(&smithy.GenericAPIError{Code: "ThrottlingException"}, "some context"),
+			want: "ThrottlingException",
+		},
+		"V1APIError": {
+			err:  awserr.New("NoSuchBucket", "the bucket does not exist", nil),
+			want: "NoSuchBucket",
+		},
+		"UnknownError": {
+			err:  errors.New("connection reset by peer"),
+			want: CodeUnknown,
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			if diff := cmp.Diff(tc.want, ErrorCode(tc.err)); diff != "" {
+				t.Errorf("ErrorCode(...): -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestHandlerV1(t *testing.T) {
+	type want struct {
+		calls     float64
+		throttled float64
+	}
+	cases := map[string]struct {
+		err  error
+		want want
+	}{
+		"Successful": {
+			want: want{calls: 1},
+		},
+		"Throttled": {
+			err:  awserr.New("Throttling", "rate exceeded", nil),
+			want: want{throttled: 1},
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			APICalls.Reset()
+			r := &requestv1.Request{
+				ClientInfo: awsmetadatav1.ClientInfo{ServiceID: "SQS"},
+				Operation:  &requestv1.Operation{Name: "GetQueueAttributes"},
+				Time:       time.Now(),
+				Error:      tc.err,
+			}
+			HandlerV1("default").Fn(r)
+
+			calls := testutil.ToFloat64(APICalls.WithLabelValues("SQS", "GetQueueAttributes", "default", CodeOK, "false"))
+			if diff := cmp.Diff(tc.want.calls, calls); diff != "" {
+				t.Errorf("successful calls: -want, +got:\n%s", diff)
+			}
+			throttled := testutil.ToFloat64(APICalls.WithLabelValues("SQS", "GetQueueAttributes", "default", "Throttling", "true"))
+			if diff := cmp.Diff(tc.want.throttled, throttled); diff != "" {
+				t.Errorf("throttled calls: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestAPIOptionV2(t *testing.T) {
+	type want struct {
+		code      string
+		throttled string
+	}
+	cases := map[string]struct {
+		err  error
+		want want
+	}{
+		"Successful": {
+			want: want{code: CodeOK, throttled: "false"},
+		},
+		"Failed": {
+			err:  &smithy.GenericAPIError{Code: "ResourceNotFoundException"},
+			want: want{code: "ResourceNotFoundException", throttled: "false"},
+		},
+		"Throttled": {
+			err:  &smithy.GenericAPIError{Code: "ThrottlingException"},
+			want: want{code: "ThrottlingException", throttled: "true"},
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			APICalls.Reset()
+			APICallDuration.Reset()
+
+			// The SDK clients register the service metadata the same way.
+			s := middleware.NewStack("GetQueueAttributes", func() interface{} { return struct{}{} })
+			if err := s.Initialize.Add(&awsmiddleware.RegisterServiceMetadata{ServiceID: "SQS", OperationName: "GetQueueAttributes"}, middleware.Before); err != nil {
+				t.Fatal(err)
+			}
+			if err := APIOptionV2("default")(s); err != nil {
+				t.Fatalf("APIOptionV2(...): %v", err)
+			}
+			h := middleware.DecorateHandler(middleware.HandlerFunc(func(_ context.Context, _ interface{}) (interface{}, middleware.Metadata, error) {
+				return nil, middleware.Metadata{}, tc.err
+			}), s)
+			if _, _, err := h.Handle(context.Background(), nil); !errors.Is(err, tc.err) {
+				t.Errorf("Handle(...): want error %v, got %v", tc.err, err)
+			}
+
+			calls := testutil.ToFloat64(APICalls.WithLabelValues("SQS", "GetQueueAttributes", "default", tc.want.code, tc.want.throttled))
+			if diff := cmp.Diff(float64(1), calls); diff != "" {
+				t.Errorf("calls: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(1, testutil.CollectAndCount(APICalls)); diff != "" {
+				t.Errorf("call series: -want, +got:\n%s", diff)
+			}
+
+			r := prometheus.NewRegistry()
+			if err := r.Register(APICallDuration); err != nil {
+				t.Fatal(err)
+			}
+			mfs, err := r.Gather()
+			if err != nil {
+				t.Fatal(err)
+			}
+			if len(mfs) != 1 || len(mfs[0].GetMetric()) != 1 {
+				t.Fatalf("duration: want a single series, got %v", mfs)
+			}
+			m := mfs[0].GetMetric()[0]
+			labels := map[string]string{}
+			for _, l := range m.GetLabel() {
+				labels[l.GetName()] = l.GetValue()
+			}
+			if diff := cmp.Diff(map[string]string{LabelService: "SQS", LabelOperation: "GetQueueAttributes", LabelProviderConfig: "default"}, labels); diff != "" {
+				t.Errorf("duration labels: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(uint64(1), m.GetHistogram().GetSampleCount()); diff != "" {
+				t.Errorf("duration samples: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
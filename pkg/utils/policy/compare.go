@@ -1,12 +1,153 @@
 package policy
 
 import (
+	"encoding/json"
+	"fmt"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+
 	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
 )
 
+// rootARN matches the ARN of the root user of an AWS account, which is
+// equivalent to the account ID when used as a principal.
+var rootARN = regexp.MustCompile(`^arn:[a-z-]+:iam::(\d{12}):root$`)
+
 // ArePoliciesEqal determines if the two Policy objects can be considered
-// equal.
+// equal. The policies are compared semantically, i.e. the order of the
+// statements and of the values in lists, the case of action names, single
+// values vs. lists with one value, account IDs vs. the ARNs of their root
+// users as principals and "*" vs. {"AWS": "*"} as principal are ignored.
 func ArePoliciesEqal(a, b *Policy) (equal bool, diff string) {
-	diff = cmp.Diff(a, b)
+	diff = cmp.Diff(Normalize(a), Normalize(b), cmpopts.EquateEmpty())
 	return diff == "", diff
 }
+
+// Normalize returns a copy of the supplied policy in canonical form, so that
+// semantically equal policies have equal canonical forms.
+func Normalize(p *Policy) *Policy {
+	if p == nil {
+		return nil
+	}
+	res := &Policy{
+		Version:    p.Version,
+		ID:         p.ID,
+		Statements: make(StatementList, len(p.Statements)),
+	}
+	for i, s := range p.Statements {
+		res.Statements[i] = normalizeStatement(s)
+	}
+	sort.SliceStable(res.Statements, func(i, j int) bool {
+		return statementKey(res.Statements[i]) < statementKey(res.Statements[j])
+	})
+	return res
+}
+
+// statementKey orders statements by their Sid first, so that the diff of
+// policies with Sids is readable, and by their content otherwise.
+func statementKey(s Statement) string {
+	content, _ := json.Marshal(s)
+	return s.SID + "\x00" + string(content)
+}
+
+func normalizeStatement(s Statement) Statement {
+	return Statement{
+		SID:          s.SID,
+		Effect:       s.Effect,
+		Principal:    normalizePrincipal(s.Principal),
+		NotPrincipal: normalizePrincipal(s.NotPrincipal),
+		Action:       normalizeStrings(s.Action, strings.ToLower),
+		NotAction:    normalizeStrings(s.NotAction, strings.ToLower),
+		Resource:     normalizeStrings(s.Resource, nil),
+		NotResource:  normalizeStrings(s.NotResource, nil),
+		Condition:    normalizeConditions(s.Condition),
+	}
+}
+
+func normalizePrincipal(p *Principal) *Principal {
+	if p == nil {
+		return nil
+	}
+	aws := p.AWSPrincipals
+	if p.AllowAnon {
+		aws = append(StringOrArray{"*"}, aws...)
+	}
+	res := &Principal{
+		AWSPrincipals: normalizeStrings(aws, func(s string) string {
+			if m := rootARN.FindStringSubmatch(s); m != nil {
+				return m[1]
+			}
+			return s
+		}),
+		Federated: p.Federated,
+		Service:   normalizeStrings(p.Service, nil),
+	}
+	if len(res.AWSPrincipals) == 0 && res.Federated == "" && len(res.Service) == 0 {
+		return nil
+	}
+	return res
+}
+
+// normalizeStrings applies the supplied function to all values, if any, and
+// returns them sorted and without duplicates.
+func normalizeStrings(values []string, fn func(string) string) StringOrArray {
+	if len(values) == 0 {
+		return nil
+	}
+	set := make(map[string]bool, len(values))
+	for _, v := range values {
+		if fn != nil {
+			v = fn(v)
+		}
+		set[v] = true
+	}
+	res := make(StringOrArray, 0, len(set))
+	for v := range set {
+		res = append(res, v)
+	}
+	sort.Strings(res)
+	return res
+}
+
+func normalizeConditions(m ConditionMap) ConditionMap {
+	if len(m) == 0 {
+		return nil
+	}
+	res := make(ConditionMap, len(m))
+	for op, settings := range m {
+		ns := make(ConditionSettings, len(settings))
+		for k, v := range settings {
+			// NOTE: Condition keys are case-insensitive, their values are
+			// sets of strings.
+			ns[strings.ToLower(k)] = normalizeStrings(conditionValues(v), nil)
+		}
+		res[op] = ns
+	}
+	return res
+}
+
+func conditionValues(v any) []string {
+	switch t := v.(type) {
+	case []any:
+		res := make([]string, 0, len(t))
+		for _, e := range t {
+			res = append(res, conditionValues(e)...)
+		}
+		return res
+	case []string:
+		return t
+	case string:
+		return []string{t}
+	case bool:
+		return []string{strconv.FormatBool(t)}
+	case float64:
+		return []string{strconv.FormatFloat(t, 'f', -1, 64)}
+	case nil:
+		return nil
+	default:
+		return []string{fmt.Sprint(t)}
+	}
+}
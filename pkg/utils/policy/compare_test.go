@@ -0,0 +1,105 @@
+package policy
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestArePoliciesEqal(t *testing.T) {
+	cases := map[string]struct {
+		a, b string
+		want bool
+	}{
+		"Identical": {
+			a:    `{"Version":"2012-10-17","Statement":[{"Effect":"Allow","Action":"s3:GetObject","Resource":"*"}]}`,
+			b:    `{"Version":"2012-10-17","Statement":[{"Effect":"Allow","Action":"s3:GetObject","Resource":"*"}]}`,
+			want: true,
+		},
+		"ReorderedStatements": {
+			a: `{"Version":"2012-10-17","Statement":[
+				{"Sid":"A","Effect":"Allow","Action":"s3:GetObject","Resource":"*"},
+				{"Sid":"B","Effect":"Deny","Action":"s3:DeleteObject","Resource":"*"}]}`,
+			b: `{"Version":"2012-10-17","Statement":[
+				{"Sid":"B","Effect":"Deny","Action":"s3:DeleteObject","Resource":"*"},
+				{"Sid":"A","Effect":"Allow","Action":"s3:GetObject","Resource":"*"}]}`,
+			want: true,
+		},
+		"ReorderedStatementsWithoutSid": {
+			a: `{"Version":"2012-10-17","Statement":[
+				{"Effect":"Allow","Action":"s3:GetObject","Resource":"*"},
+				{"Effect":"Deny","Action":"s3:DeleteObject","Resource":"*"}]}`,
+			b: `{"Version":"2012-10-17","Statement":[
+				{"Effect":"Deny","Action":"s3:DeleteObject","Resource":"*"},
+				{"Effect":"Allow","Action":"s3:GetObject","Resource":"*"}]}`,
+			want: true,
+		},
+		"SingleStatementVsList": {
+			a:    `{"Version":"2012-10-17","Statement":{"Effect":"Allow","Action":"s3:GetObject","Resource":"*"}}`,
+			b:    `{"Version":"2012-10-17","Statement":[{"Effect":"Allow","Action":"s3:GetObject","Resource":"*"}]}`,
+			want: true,
+		},
+		"ActionCase": {
+			a:    `{"Version":"2012-10-17","Statement":[{"Effect":"Allow","Action":["s3:getobject","S3:ListBucket"],"Resource":"*"}]}`,
+			b:    `{"Version":"2012-10-17","Statement":[{"Effect":"Allow","Action":["s3:ListBucket","s3:GetObject"],"Resource":"*"}]}`,
+			want: true,
+		},
+		"StringVsArray": {
+			a:    `{"Version":"2012-10-17","Statement":[{"Effect":"Allow","Action":"s3:*","Resource":["*"]}]}`,
+			b:    `{"Version":"2012-10-17","Statement":[{"Effect":"Allow","Action":["s3:*"],"Resource":"*"}]}`,
+			want: true,
+		},
+		"AccountIDVsRootARN": {
+			a:    `{"Version":"2012-10-17","Statement":[{"Effect":"Allow","Principal":{"AWS":"123456789012"},"Action":"sqs:SendMessage","Resource":"*"}]}`,
+			b:    `{"Version":"2012-10-17","Statement":[{"Effect":"Allow","Principal":{"AWS":["arn:aws:iam::123456789012:root"]},"Action":"sqs:SendMessage","Resource":"*"}]}`,
+			want: true,
+		},
+		"AnonymousPrincipal": {
+			a:    `{"Version":"2012-10-17","Statement":[{"Effect":"Allow","Principal":"*","Action":"sns:Publish","Resource":"*"}]}`,
+			b:    `{"Version":"2012-10-17","Statement":[{"Effect":"Allow","Principal":{"AWS":"*"},"Action":"sns:Publish","Resource":"*"}]}`,
+			want: true,
+		},
+		"ReorderedConditionValues": {
+			a: `{"Version":"2012-10-17","Statement":[{"Effect":"Allow","Action":"s3:GetObject","Resource":"*",
+				"Condition":{"StringEquals":{"aws:SourceAccount":["111111111111","222222222222"]},"Bool":{"aws:SecureTransport":true}}}]}`,
+			b: `{"Version":"2012-10-17","Statement":[{"Effect":"Allow","Action":"s3:GetObject","Resource":"*",
+				"Condition":{"StringEquals":{"aws:sourceaccount":["222222222222","111111111111"]},"Bool":{"aws:SecureTransport":"true"}}}]}`,
+			want: true,
+		},
+		"DifferentResources": {
+			a:    `{"Version":"2012-10-17","Statement":[{"Effect":"Allow","Action":"s3:GetObject","Resource":"arn:aws:s3:::a/*"}]}`,
+			b:    `{"Version":"2012-10-17","Statement":[{"Effect":"Allow","Action":"s3:GetObject","Resource":"arn:aws:s3:::A/*"}]}`,
+			want: false,
+		},
+		"DifferentPrincipals": {
+			a:    `{"Version":"2012-10-17","Statement":[{"Effect":"Allow","Principal":{"AWS":"123456789012"},"Action":"sqs:SendMessage","Resource":"*"}]}`,
+			b:    `{"Version":"2012-10-17","Statement":[{"Effect":"Allow","Principal":{"AWS":"arn:aws:iam::123456789012:role/foo"},"Action":"sqs:SendMessage","Resource":"*"}]}`,
+			want: false,
+		},
+		"DifferentConditionValues": {
+			a:    `{"Version":"2012-10-17","Statement":[{"Effect":"Allow","Action":"s3:GetObject","Resource":"*","Condition":{"StringEquals":{"aws:SourceAccount":"111111111111"}}}]}`,
+			b:    `{"Version":"2012-10-17","Statement":[{"Effect":"Allow","Action":"s3:GetObject","Resource":"*","Condition":{"StringEquals":{"aws:SourceAccount":"222222222222"}}}]}`,
+			want: false,
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			a, err := ParsePolicyString(tc.a)
+			if err != nil {
+				t.Fatalf("ParsePolicyString(a): %s", err)
+			}
+			b, err := ParsePolicyString(tc.b)
+			if err != nil {
+				t.Fatalf("ParsePolicyString(b): %s", err)
+			}
+			got, diff := ArePoliciesEqal(&a, &b)
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("ArePoliciesEqal(...): -want, +got:\n%s", diff)
+			}
+			if got != (diff == "") {
+				t.Errorf("ArePoliciesEqal(...): equal is %t but diff is %q", got, diff)
+			}
+		})
+	}
+}